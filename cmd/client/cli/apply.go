@@ -0,0 +1,75 @@
+package cli
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+	proto "gitlab.com/netbook-devs/spawner-service/proto/netbookai/spawner"
+)
+
+func printPlan(changes []*proto.PlannedChange) {
+	if len(changes) == 0 {
+		fmt.Println("cluster matches the spec, no changes")
+		return
+	}
+	w := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(w, "ACTION\tTARGET\tDETAIL")
+	for _, c := range changes {
+		fmt.Fprintf(w, "%s\t%s\t%s\n", c.Action, c.Target, c.Detail)
+	}
+	w.Flush()
+}
+
+func apply() *cobra.Command {
+	addr := ""
+	ifile := ""
+	dryRun := false
+
+	c := &cobra.Command{
+		Use:     "apply",
+		Short:   "apply -f spec.json",
+		Long:    "reconcile the cluster with the declarative spec, only the changes needed to reach the spec are made",
+		Example: "apply -f examples/aws/cluster_spec.json --dry-run",
+		Args:    cobra.NoArgs,
+		Version: "0.0.1",
+		Run: func(cmd *cobra.Command, args []string) {
+			req := &proto.ApplyClusterSpecRequest{}
+			err := unmarshalFile(ifile, req)
+			if err != nil {
+				log.Fatal(err.Error())
+			}
+			req.DryRun = dryRun
+
+			conn, err := getSpawnerConn(addr)
+			if err != nil {
+				log.Fatal("failed to connect to spawner ", addr)
+			}
+			defer conn.Close()
+			client := proto.NewSpawnerServiceClient(conn)
+
+			res, err := client.ApplyClusterSpec(cmd.Context(), req)
+			if err != nil {
+				log.Fatal("apply failed: ", err.Error())
+			}
+			printPlan(res.Changes)
+			if dryRun || res.OperationId == "" {
+				return
+			}
+
+			log.Printf("applying spec of cluster '%s', operation %s\n", req.ClusterName, res.OperationId)
+			_, err = waitForOperation(cmd.Context(), client, res.OperationId)
+			if err != nil {
+				log.Fatal("apply failed: ", err.Error())
+			}
+			log.Printf("cluster '%s' matches the spec\n", req.ClusterName)
+		},
+	}
+	c.Flags().StringVarP(&addr, "addr", "a", "localhost:8083", "spanwner service hoost address 'ip:port'")
+	c.Flags().StringVarP(&ifile, "file", "f", "", "cluster spec file in json")
+	c.Flags().BoolVar(&dryRun, "dry-run", false, "print the planned changes without applying them")
+	c.MarkFlagRequired("file")
+	return c
+}
@@ -0,0 +1,70 @@
+package cli
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+	proto "gitlab.com/netbook-devs/spawner-service/proto/netbookai/spawner"
+)
+
+func auditLog() *cobra.Command {
+	addr := ""
+	showRequest := false
+	req := &proto.QueryAuditLogRequest{}
+
+	c := &cobra.Command{
+		Use:     "audit",
+		Short:   "query the audit log",
+		Long:    "query the audit log of the mutating calls, filtered by caller, rpc, provider, account, target and time",
+		Example: "audit --method DeleteCluster --account netbook-aws --since 2022-03-01T00:00:00Z --verify",
+		Version: "0.0.1",
+		Run: func(cmd *cobra.Command, args []string) {
+			conn, err := getSpawnerConn(addr)
+			if err != nil {
+				log.Fatal("failed to connect to spawner ", addr)
+			}
+			defer conn.Close()
+			client := proto.NewSpawnerServiceClient(conn)
+
+			res, err := client.QueryAuditLog(cmd.Context(), req)
+			if err != nil {
+				log.Fatalf("failed to query audit log: %s\n", err.Error())
+			}
+
+			w := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+			fmt.Fprintln(w, "SEQ\tTIME\tSUBJECT\tMETHOD\tPROVIDER\tACCOUNT\tREGION\tTARGET\tOUTCOME\tDURATION\tOPERATION")
+			for _, e := range res.Entries {
+				fmt.Fprintf(w, "%d\t%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\t%dms\t%s\n", e.Sequence, e.Time, e.Subject, e.Method, e.Provider, e.AccountName, e.Region, e.Target, e.Outcome, e.DurationMs, e.OperationId)
+				if showRequest {
+					fmt.Fprintf(w, "\trequest: %s\n", e.Request)
+				}
+				if e.Error != "" {
+					fmt.Fprintf(w, "\terror: %s\n", e.Error)
+				}
+			}
+			w.Flush()
+
+			if req.Verify {
+				if !res.Verified {
+					log.Fatalf("audit log verification failed: %s\n", res.VerifyError)
+				}
+				log.Println("audit log hash chain verified")
+			}
+		},
+	}
+	c.Flags().StringVarP(&addr, "addr", "a", "localhost:8083", "spanwner service hoost address 'ip:port'")
+	c.Flags().StringVarP(&req.Subject, "subject", "s", "", "caller identity")
+	c.Flags().StringVarP(&req.Method, "method", "m", "", "rpc name, ex: DeleteCluster")
+	c.Flags().StringVarP(&req.Provider, "provider", "p", "", "cloud provider")
+	c.Flags().StringVarP(&req.AccountName, "account", "", "", "account name")
+	c.Flags().StringVarP(&req.Target, "target", "t", "", "cluster, cluster/nodepool, volume or snapshot")
+	c.Flags().StringVarP(&req.Since, "since", "", "", "RFC3339 time, entries on or after")
+	c.Flags().StringVarP(&req.Until, "until", "", "", "RFC3339 time, entries before")
+	c.Flags().Int32VarP(&req.Limit, "limit", "l", 0, "show only the latest entries")
+	c.Flags().BoolVarP(&req.Verify, "verify", "", false, "verify the hash chain of the whole log")
+	c.Flags().BoolVarP(&showRequest, "request", "r", false, "show the redacted request payload")
+	return c
+}
@@ -0,0 +1,132 @@
+package cli
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+	proto "gitlab.com/netbook-devs/spawner-service/proto/netbookai/spawner"
+)
+
+func setBudget() *cobra.Command {
+	addr := ""
+	b := &proto.Budget{}
+
+	c := &cobra.Command{
+		Use:     "set",
+		Short:   "set workspace",
+		Long:    "create or replace the monthly budget of the workspace, thresholds are percent of the limit",
+		Example: "budget set ws-1 --provider aws --account netbook-aws --limit 500 --soft 80 --hard 100 --scale-down-gpu",
+		Args:    cobra.ExactArgs(1),
+		Version: "0.0.1",
+		Run: func(cmd *cobra.Command, args []string) {
+			conn, err := getSpawnerConn(addr)
+			if err != nil {
+				log.Fatal("failed to connect to spawner ", addr)
+			}
+			defer conn.Close()
+			client := proto.NewSpawnerServiceClient(conn)
+
+			b.Workspace = args[0]
+			res, err := client.SetBudget(cmd.Context(), &proto.SetBudgetRequest{Budget: b})
+			if err != nil {
+				log.Fatal("failed to set budget: ", err.Error())
+			}
+			fmt.Printf("budget of '%s' set to %.2f, soft %.0f%%, hard %.0f%%\n", res.Workspace, res.MonthlyLimit, res.SoftThreshold, res.HardThreshold)
+		},
+	}
+	c.Flags().StringVarP(&addr, "addr", "a", "localhost:8083", "spanwner service hoost address 'ip:port'")
+	c.Flags().StringVarP(&b.Provider, "provider", "p", "", "cloud provider the workspace cost is read from")
+	c.Flags().StringVarP(&b.AccountName, "account", "", "", "account name")
+	c.Flags().Float64VarP(&b.MonthlyLimit, "limit", "l", 0, "monthly limit")
+	c.Flags().Float64Var(&b.SoftThreshold, "soft", 0, "soft threshold in percent of the limit, defaults to 80")
+	c.Flags().Float64Var(&b.HardThreshold, "hard", 0, "hard threshold in percent of the limit, defaults to 100")
+	c.Flags().BoolVar(&b.ScaleDownGPU, "scale-down-gpu", false, "scale the gpu node pools of the workspace to zero when hard threshold is crossed")
+	c.MarkFlagRequired("provider")
+	c.MarkFlagRequired("account")
+	c.MarkFlagRequired("limit")
+	return c
+}
+
+func deleteBudget() *cobra.Command {
+	addr := ""
+
+	c := &cobra.Command{
+		Use:     "delete",
+		Short:   "delete workspace",
+		Long:    "delete the budget of the workspace",
+		Example: "budget delete ws-1",
+		Args:    cobra.ExactArgs(1),
+		Version: "0.0.1",
+		Run: func(cmd *cobra.Command, args []string) {
+			conn, err := getSpawnerConn(addr)
+			if err != nil {
+				log.Fatal("failed to connect to spawner ", addr)
+			}
+			defer conn.Close()
+			client := proto.NewSpawnerServiceClient(conn)
+
+			_, err = client.DeleteBudget(cmd.Context(), &proto.DeleteBudgetRequest{Workspace: args[0]})
+			if err != nil {
+				log.Fatal("failed to delete budget: ", err.Error())
+			}
+			fmt.Printf("budget of '%s' deleted\n", args[0])
+		},
+	}
+	c.Flags().StringVarP(&addr, "addr", "a", "localhost:8083", "spanwner service hoost address 'ip:port'")
+	return c
+}
+
+func budgetStatus() *cobra.Command {
+	addr := ""
+
+	c := &cobra.Command{
+		Use:     "status",
+		Short:   "status [workspace]",
+		Long:    "show the month to date spend and state of the workspace budget, all budgets when workspace is not given",
+		Example: "budget status ws-1",
+		Args:    cobra.MaximumNArgs(1),
+		Version: "0.0.1",
+		Run: func(cmd *cobra.Command, args []string) {
+			conn, err := getSpawnerConn(addr)
+			if err != nil {
+				log.Fatal("failed to connect to spawner ", addr)
+			}
+			defer conn.Close()
+			client := proto.NewSpawnerServiceClient(conn)
+
+			req := &proto.GetBudgetStatusRequest{}
+			if len(args) > 0 {
+				req.Workspace = args[0]
+			}
+			res, err := client.GetBudgetStatus(cmd.Context(), req)
+			if err != nil {
+				log.Fatal("failed to get budget status: ", err.Error())
+			}
+
+			w := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+			fmt.Fprintln(w, "WORKSPACE\tPROVIDER\tACCOUNT\tSPENT\tLIMIT\tSTATE\tEVALUATED\tERROR")
+			for _, st := range res.Status {
+				b := st.Budget
+				fmt.Fprintf(w, "%s\t%s\t%s\t%.2f\t%.2f\t%s\t%s\t%s\n", b.Workspace, b.Provider, b.AccountName, st.Spent, b.MonthlyLimit, st.State, st.EvaluatedAt, st.Error)
+			}
+			w.Flush()
+		},
+	}
+	c.Flags().StringVarP(&addr, "addr", "a", "localhost:8083", "spanwner service hoost address 'ip:port'")
+	return c
+}
+
+func budgets() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "budget",
+		Short: "budget [set|delete|status]",
+		Long:  "manage the monthly workspace budgets, workspaces over the hard threshold can not add nodes or volumes",
+	}
+	c.AddCommand(setBudget())
+	c.AddCommand(deleteBudget())
+	c.AddCommand(budgetStatus())
+	return c
+}
@@ -2,13 +2,65 @@ package cli
 
 import (
 	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"io/ioutil"
 	"log"
+	"os"
 	"time"
 
 	"github.com/spf13/cobra"
+	"gitlab.com/netbook-devs/spawner-service/pkg/idempotency"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
 )
 
+//idempotencyKey sent with every call, rerunning the command with same key does not repeat the change
+var idempotencyKey string
+
+//connection security, plain text connection is used when tlsCA is not set
+var (
+	tlsCA   string
+	tlsCert string
+	tlsKey  string
+	token   string
+)
+
+//bearerToken sends the token in authorization metadata of every call, only over tls
+type bearerToken string
+
+func (t bearerToken) GetRequestMetadata(ctx context.Context, uri ...string) (map[string]string, error) {
+	return map[string]string{"authorization": "Bearer " + string(t)}, nil
+}
+
+func (t bearerToken) RequireTransportSecurity() bool {
+	return true
+}
+
+func transportCredentials() (grpc.DialOption, error) {
+	if tlsCA == "" {
+		return grpc.WithInsecure(), nil
+	}
+
+	pem, err := ioutil.ReadFile(tlsCA)
+	if err != nil {
+		return nil, err
+	}
+	pool := x509.NewCertPool()
+	pool.AppendCertsFromPEM(pem)
+	conf := &tls.Config{RootCAs: pool, MinVersion: tls.VersionTLS12}
+
+	if tlsCert != "" {
+		cert, err := tls.LoadX509KeyPair(tlsCert, tlsKey)
+		if err != nil {
+			return nil, err
+		}
+		conf.Certificates = []tls.Certificate{cert}
+	}
+	return grpc.WithTransportCredentials(credentials.NewTLS(conf)), nil
+}
+
 var rootCommand = &cobra.Command{
 	Use:   "spawner",
 	Short: "spawner",
@@ -17,15 +69,55 @@ var rootCommand = &cobra.Command{
 
 func getSpawnerConn(addr string) (*grpc.ClientConn, error) {
 	log.Println("connecting to ", addr, "...")
-	return grpc.Dial(addr, grpc.WithInsecure(), grpc.WithTimeout(time.Second))
+	transport, err := transportCredentials()
+	if err != nil {
+		return nil, err
+	}
+	opts := []grpc.DialOption{transport, grpc.WithTimeout(time.Second), grpc.WithUnaryInterceptor(withIdempotencyKey)}
+	if token == "" {
+		token = os.Getenv("SPAWNER_TOKEN")
+	}
+	if token != "" {
+		opts = append(opts, grpc.WithPerRPCCredentials(bearerToken(token)))
+	}
+	return grpc.Dial(addr, opts...)
+}
+
+func withIdempotencyKey(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	if idempotencyKey != "" {
+		ctx = metadata.AppendToOutgoingContext(ctx, idempotency.MetadataKey, idempotencyKey)
+	}
+	return invoker(ctx, method, req, reply, cc, opts...)
 }
 
 func setupCommands() {
+	rootCommand.PersistentFlags().StringVar(&tlsCA, "tls-ca", "", "ca certificate to verify the service, connects over tls when set")
+	rootCommand.PersistentFlags().StringVar(&tlsCert, "tls-cert", "", "client certificate for mTLS")
+	rootCommand.PersistentFlags().StringVar(&tlsKey, "tls-key", "", "client certificate key for mTLS")
+	rootCommand.PersistentFlags().StringVar(&token, "token", "", "bearer token, defaults to SPAWNER_TOKEN env, requires tls")
+	rootCommand.PersistentFlags().StringVar(&idempotencyKey, "idempotency-key", "", "key to safely retry the command, server returns the result of the first call made with the key")
 	rootCommand.AddCommand(createCluster())
 	rootCommand.AddCommand(clusteStatus())
 	rootCommand.AddCommand(deleteCluster())
 	rootCommand.AddCommand(nodepool())
 	rootCommand.AddCommand(kubeConfig())
+	rootCommand.AddCommand(listResources())
+	rootCommand.AddCommand(operations())
+	rootCommand.AddCommand(auditLog())
+	rootCommand.AddCommand(quotaUsage())
+	rootCommand.AddCommand(budgets())
+	rootCommand.AddCommand(apply())
+	rootCommand.AddCommand(getDrift())
+	rootCommand.AddCommand(extendLease())
+	rootCommand.AddCommand(listOrphans())
+	rootCommand.AddCommand(schedules())
+	rootCommand.AddCommand(kubernetesVersions())
+	rootCommand.AddCommand(upgradeCluster())
+	rootCommand.AddCommand(machineTypes())
+	rootCommand.AddCommand(estimateCost())
+	rootCommand.AddCommand(volume())
+	rootCommand.AddCommand(snapshot())
+	rootCommand.AddCommand(dnsRecords())
 }
 
 //Execute sets up a command execute command handlers
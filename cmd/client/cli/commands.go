@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"log"
 	"os"
+	"time"
 
 	"github.com/imdario/mergo"
 	"github.com/pkg/errors"
@@ -31,6 +32,11 @@ func createCluster() *cobra.Command {
 	provider := ""
 	addr := ""
 	ifile := "request.json"
+	wait := false
+	timeout := 20 * time.Minute
+	version := ""
+	threshold := defaultCostThreshold
+	yes := false
 	c := &cobra.Command{
 		Use:     "create-cluster",
 		Short:   "create-cluster clustename",
@@ -60,6 +66,9 @@ func createCluster() *cobra.Command {
 			if provider != "" {
 				req.Provider = provider
 			}
+			if version != "" {
+				req.KubernetesVersion = version
+			}
 			conn, err := getSpawnerConn(addr)
 			if err != nil {
 				log.Fatal("failed to connect to spawner ", addr)
@@ -67,19 +76,28 @@ func createCluster() *cobra.Command {
 			defer conn.Close()
 			client := proto.NewSpawnerServiceClient(conn)
 
+			if !confirmCost(cmd.Context(), client, &proto.EstimateCostRequest{Request: &proto.EstimateCostRequest_Cluster{Cluster: req}}, threshold, yes) {
+				log.Fatal("cluster creation cancelled")
+			}
 			log.Printf("creating cluster '%s'\n", name)
 
-			_, err = client.CreateCluster(cmd.Context(), req)
-
+			res, err := client.CreateCluster(cmd.Context(), req)
+			if err == nil {
+				_, err = waitForOperation(cmd.Context(), client, res.OperationId)
+			}
 			if err != nil {
 				log.Fatal("create cluster failed: ", err.Error())
 			}
 
-			if req.Provider == "aws" {
-				stat := waitUntilClusterReady(cmd.Context(), client, req)
+			//aws cluster is created without nodes, default node is added once the cluster is active
+			if wait || req.Provider == "aws" {
+				stat := waitUntilClusterReady(cmd.Context(), client, req, timeout)
 				if stat != "ACTIVE" {
 					log.Fatal("failed to wait on cluster activation, please check provider portal")
 				}
+			}
+
+			if req.Provider == "aws" {
 
 				//add default node
 				nsr := &proto.NodeSpawnRequest{}
@@ -88,7 +106,10 @@ func createCluster() *cobra.Command {
 				nsr.NodeSpec = req.Node
 				nsr.ClusterName = req.ClusterName
 				log.Printf("cluster '%s' is active, adding node '%s'\n", name, req.Node.Name)
-				_, err := client.AddNode(cmd.Context(), nsr)
+				res, err := client.AddNode(cmd.Context(), nsr)
+				if err == nil {
+					_, err = waitForOperation(cmd.Context(), client, res.OperationId)
+				}
 				if err != nil {
 					log.Fatalf("failed to attach node to cluster '%s', can retry 'nodepool add' %s\n", name, err.Error())
 					return
@@ -103,6 +124,10 @@ func createCluster() *cobra.Command {
 	c.Flags().StringVarP(&addr, "addr", "a", "localhost:8083", "spanwner service hoost address 'ip:port'")
 	c.Flags().StringVarP(&provider, "provider", "p", "", "cloud provider, one of ['aws', 'azure']")
 	c.Flags().StringVarP(&ifile, "request", "r", "request.json", "file containing cluster spec")
+	c.Flags().BoolVarP(&wait, "wait", "w", false, "wait for the cluster to become active, always enabled for aws")
+	c.Flags().DurationVarP(&timeout, "timeout", "t", 20*time.Minute, "maximum time to wait for the cluster to become active")
+	c.Flags().StringVar(&version, "kubernetes-version", "", "kubernetes version of the cluster, overrides the request file, provider default when empty")
+	addCostFlags(c, &threshold, &yes)
 	return c
 }
 
@@ -199,9 +224,12 @@ func deleteCluster() *cobra.Command {
 			defer conn.Close()
 			client := proto.NewSpawnerServiceClient(conn)
 			log.Printf("deleting cluster '%s'\n", name)
-			_, err = client.DeleteCluster(cmd.Context(), req)
+			res, err := client.DeleteCluster(cmd.Context(), req)
+			if err == nil {
+				_, err = waitForOperation(cmd.Context(), client, res.OperationId)
+			}
 			if err != nil {
-				log.Fatal("failed to get status: ", err.Error())
+				log.Fatal("failed to delete cluster: ", err.Error())
 			}
 
 			log.Printf("cluster '%s' deleted\n", name)
@@ -223,6 +251,8 @@ func addNodePool() *cobra.Command {
 	name := ""
 	addr := ""
 	ifile := ""
+	threshold := defaultCostThreshold
+	yes := false
 
 	c := &cobra.Command{
 		Use:     "add",
@@ -255,8 +285,14 @@ func addNodePool() *cobra.Command {
 			}
 			defer conn.Close()
 			client := proto.NewSpawnerServiceClient(conn)
+			if !confirmCost(cmd.Context(), client, &proto.EstimateCostRequest{Request: &proto.EstimateCostRequest_NodePool{NodePool: req}}, threshold, yes) {
+				log.Fatal("nodepool creation cancelled")
+			}
 			log.Printf("adding nodepool '%s' to cluster '%s'\n", req.NodeSpec.Name, name)
-			_, err = client.AddNode(cmd.Context(), req)
+			res, err := client.AddNode(cmd.Context(), req)
+			if err == nil {
+				_, err = waitForOperation(cmd.Context(), client, res.OperationId)
+			}
 			if err != nil {
 				log.Fatal("failed to add new node pool: ", err.Error())
 			}
@@ -268,6 +304,7 @@ func addNodePool() *cobra.Command {
 	c.Flags().StringVarP(&name, "name", "n", "", "cluster name")
 	c.Flags().StringVarP(&ifile, "request", "r", "request.json", "file containing nodepool spec")
 	c.Flags().StringVarP(&addr, "addr", "a", "localhost:8083", "spanwner service hoost address 'ip:port'")
+	addCostFlags(c, &threshold, &yes)
 
 	return c
 }
@@ -310,7 +347,10 @@ func deleteNodePool() *cobra.Command {
 			defer conn.Close()
 			client := proto.NewSpawnerServiceClient(conn)
 			log.Printf("deleting nodepool '%s' in cluster '%s'\n", req.NodeGroupName, name)
-			_, err = client.DeleteNode(cmd.Context(), req)
+			res, err := client.DeleteNode(cmd.Context(), req)
+			if err == nil {
+				_, err = waitForOperation(cmd.Context(), client, res.OperationId)
+			}
 			if err != nil {
 				log.Fatal("failed to delete node pool: ", err.Error())
 			}
@@ -337,11 +377,13 @@ func nodepool() *cobra.Command {
 
 	c := &cobra.Command{
 		Use:   "nodepool",
-		Short: "nodepool [add|delete]",
-		Long:  "add or delete nodepool from cluster",
+		Short: "nodepool [add|delete|scale|update]",
+		Long:  "add, delete, scale or update nodepool of the cluster",
 	}
 	c.AddCommand(addNodePool())
 	c.AddCommand(deleteNodePool())
+	c.AddCommand(scaleNodePool())
+	c.AddCommand(updateNodePool())
 	return c
 }
 
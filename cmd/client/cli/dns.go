@@ -0,0 +1,159 @@
+package cli
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+	proto "gitlab.com/netbook-devs/spawner-service/proto/netbookai/spawner"
+)
+
+func upsertDNSRecord() *cobra.Command {
+	addr := ""
+	record := &proto.DNSRecord{}
+	req := &proto.UpsertDNSRecordRequest{Record: record}
+
+	c := &cobra.Command{
+		Use:     "upsert",
+		Short:   "create or replace dns record",
+		Long:    "create or replace A, CNAME or TXT record in the zone, record tied to a cluster is deleted along with the cluster",
+		Example: "dns upsert app.example.com --provider aws --region us-west-2 --account netbook-aws --zone Z08929991BJOO3WMC7L0Q --type A --value 10.0.0.1 --cluster dev",
+		Args:    cobra.ExactArgs(1),
+		Version: "0.0.1",
+		Run: func(cmd *cobra.Command, args []string) {
+			record.Name = args[0]
+
+			conn, err := getSpawnerConn(addr)
+			if err != nil {
+				log.Fatal("failed to connect to spawner ", addr)
+			}
+			defer conn.Close()
+			client := proto.NewSpawnerServiceClient(conn)
+
+			res, err := client.UpsertDNSRecord(cmd.Context(), req)
+			if err == nil {
+				_, err = waitForOperation(cmd.Context(), client, res.OperationId)
+			}
+			if err != nil {
+				log.Fatal("failed to upsert dns record: ", err.Error())
+			}
+			log.Printf("%s record '%s' updated\n", strings.ToUpper(record.Type), record.Name)
+		},
+	}
+	c.Flags().StringVarP(&addr, "addr", "a", "localhost:8083", "spanwner service hoost address 'ip:port'")
+	c.Flags().StringVarP(&req.Provider, "provider", "p", "", "cloud provider")
+	c.Flags().StringVarP(&req.Region, "region", "r", "", "provider region")
+	c.Flags().StringVarP(&req.AccountName, "account", "", "", "account name")
+	c.Flags().StringVarP(&record.Zone, "zone", "z", "", "hosted zone id on aws, defaults to the spawner hosted zone, dns zone name on azure")
+	c.Flags().StringVarP(&record.Type, "type", "t", "A", "record type A, CNAME or TXT")
+	c.Flags().StringSliceVarP(&record.Values, "value", "v", nil, "record value, can be repeated")
+	c.Flags().Int64Var(&record.Ttl, "ttl", 0, "ttl in seconds, defaults to 300")
+	c.Flags().StringVarP(&record.ClusterName, "cluster", "c", "", "cluster the record belongs to")
+	c.MarkFlagRequired("provider")
+	c.MarkFlagRequired("region")
+	c.MarkFlagRequired("value")
+	return c
+}
+
+func deleteDNSRecord() *cobra.Command {
+	addr := ""
+	req := &proto.DeleteDNSRecordRequest{}
+
+	c := &cobra.Command{
+		Use:     "delete",
+		Short:   "delete dns record",
+		Example: "dns delete app.example.com --provider aws --region us-west-2 --account netbook-aws --type A",
+		Args:    cobra.ExactArgs(1),
+		Version: "0.0.1",
+		Run: func(cmd *cobra.Command, args []string) {
+			req.Name = args[0]
+
+			conn, err := getSpawnerConn(addr)
+			if err != nil {
+				log.Fatal("failed to connect to spawner ", addr)
+			}
+			defer conn.Close()
+			client := proto.NewSpawnerServiceClient(conn)
+
+			res, err := client.DeleteDNSRecord(cmd.Context(), req)
+			if err == nil {
+				_, err = waitForOperation(cmd.Context(), client, res.OperationId)
+			}
+			if err != nil {
+				log.Fatal("failed to delete dns record: ", err.Error())
+			}
+			log.Printf("%s record '%s' deleted\n", strings.ToUpper(req.Type), req.Name)
+		},
+	}
+	c.Flags().StringVarP(&addr, "addr", "a", "localhost:8083", "spanwner service hoost address 'ip:port'")
+	c.Flags().StringVarP(&req.Provider, "provider", "p", "", "cloud provider")
+	c.Flags().StringVarP(&req.Region, "region", "r", "", "provider region")
+	c.Flags().StringVarP(&req.AccountName, "account", "", "", "account name")
+	c.Flags().StringVarP(&req.Zone, "zone", "z", "", "hosted zone id on aws, defaults to the spawner hosted zone, dns zone name on azure")
+	c.Flags().StringVarP(&req.Type, "type", "t", "A", "record type A, CNAME or TXT")
+	c.MarkFlagRequired("provider")
+	c.MarkFlagRequired("region")
+	return c
+}
+
+func listDNSRecords() *cobra.Command {
+	addr := ""
+	req := &proto.ListDNSRecordsRequest{}
+
+	c := &cobra.Command{
+		Use:     "list",
+		Short:   "list dns records",
+		Long:    "list A, CNAME and TXT records of the zone, optionally of a single type",
+		Example: "dns list --provider azure --region eastus --account netbook-azure --zone example.com --type CNAME",
+		Version: "0.0.1",
+		Run: func(cmd *cobra.Command, args []string) {
+			conn, err := getSpawnerConn(addr)
+			if err != nil {
+				log.Fatal("failed to connect to spawner ", addr)
+			}
+			defer conn.Close()
+			client := proto.NewSpawnerServiceClient(conn)
+
+			res, err := client.ListDNSRecords(cmd.Context(), req)
+			if err != nil {
+				log.Fatalf("failed to list dns records: %s\n", err.Error())
+			}
+			if len(res.Records) == 0 {
+				fmt.Println("no records found")
+				return
+			}
+
+			w := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+			fmt.Fprintln(w, "NAME\tTYPE\tTTL\tVALUES\tCLUSTER")
+			for _, r := range res.Records {
+				fmt.Fprintf(w, "%s\t%s\t%d\t%s\t%s\n", r.Name, r.Type, r.Ttl, strings.Join(r.Values, ","), r.ClusterName)
+			}
+			w.Flush()
+		},
+	}
+	c.Flags().StringVarP(&addr, "addr", "a", "localhost:8083", "spanwner service hoost address 'ip:port'")
+	c.Flags().StringVarP(&req.Provider, "provider", "p", "", "cloud provider")
+	c.Flags().StringVarP(&req.Region, "region", "r", "", "provider region")
+	c.Flags().StringVarP(&req.AccountName, "account", "", "", "account name")
+	c.Flags().StringVarP(&req.Zone, "zone", "z", "", "hosted zone id on aws, defaults to the spawner hosted zone, dns zone name on azure")
+	c.Flags().StringVarP(&req.Type, "type", "t", "", "only the records of the type")
+	c.MarkFlagRequired("provider")
+	c.MarkFlagRequired("region")
+	return c
+}
+
+func dnsRecords() *cobra.Command {
+
+	c := &cobra.Command{
+		Use:   "dns",
+		Short: "dns [upsert|delete|list]",
+		Long:  "create, replace, delete or list dns records of the provider zones",
+	}
+	c.AddCommand(upsertDNSRecord())
+	c.AddCommand(deleteDNSRecord())
+	c.AddCommand(listDNSRecords())
+	return c
+}
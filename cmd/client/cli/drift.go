@@ -0,0 +1,54 @@
+package cli
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+	proto "gitlab.com/netbook-devs/spawner-service/proto/netbookai/spawner"
+)
+
+func getDrift() *cobra.Command {
+	addr := ""
+	req := &proto.GetDriftRequest{}
+
+	c := &cobra.Command{
+		Use:     "drift",
+		Short:   "show drift",
+		Long:    "compare the clusters created by spawner with the provider and show the changes made outside spawner",
+		Example: "drift --provider aws --account netbook-aws --region us-west-2",
+		Version: "0.0.1",
+		Run: func(cmd *cobra.Command, args []string) {
+			conn, err := getSpawnerConn(addr)
+			if err != nil {
+				log.Fatal("failed to connect to spawner ", addr)
+			}
+			defer conn.Close()
+			client := proto.NewSpawnerServiceClient(conn)
+
+			res, err := client.GetDrift(cmd.Context(), req)
+			if err != nil {
+				log.Fatalf("failed to get drift: %s\n", err.Error())
+			}
+			if len(res.Drifts) == 0 {
+				fmt.Println("no drift detected at", res.DetectedAt)
+				return
+			}
+
+			w := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+			fmt.Fprintln(w, "PROVIDER\tACCOUNT\tREGION\tRESOURCE\tTYPE\tEXPECTED\tACTUAL")
+			for _, d := range res.Drifts {
+				fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\t%s\n", d.Provider, d.AccountName, d.Region, d.Resource, d.Type, d.Expected, d.Actual)
+			}
+			w.Flush()
+		},
+	}
+	c.Flags().StringVarP(&addr, "addr", "a", "localhost:8083", "spanwner service hoost address 'ip:port'")
+	c.Flags().StringVarP(&req.Provider, "provider", "p", "", "cloud provider")
+	c.Flags().StringVarP(&req.AccountName, "account", "", "", "account name")
+	c.Flags().StringVarP(&req.Region, "region", "r", "", "provider region")
+	c.Flags().StringVarP(&req.ClusterName, "cluster", "c", "", "cluster name")
+	return c
+}
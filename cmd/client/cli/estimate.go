@@ -0,0 +1,108 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+	proto "gitlab.com/netbook-devs/spawner-service/proto/netbookai/spawner"
+)
+
+//defaultCostThreshold monthly cost estimate above which the creation has to be confirmed
+const defaultCostThreshold = 500.0
+
+func printEstimate(res *proto.EstimateCostResponse) {
+	w := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(w, "KIND\tNAME\tTYPE\tQUANTITY\tCAPACITY\tHOURLY\tMONTHLY")
+	for _, i := range res.Items {
+		capacity := "-"
+		if i.Kind == "node-pool" {
+			capacity = i.CapacityType.String()
+		}
+		if i.Warning != "" {
+			fmt.Fprintf(w, "%s\t%s\t%s\t%d\t%s\t-\t-\t%s\n", i.Kind, i.Name, i.Type, i.Quantity, capacity, i.Warning)
+			continue
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%d\t%s\t%.4f\t%.2f\n", i.Kind, i.Name, i.Type, i.Quantity, capacity, i.Hourly, i.Monthly)
+	}
+	fmt.Fprintf(w, "TOTAL %s\t\t\t\t\t%.4f\t%.2f\n", res.Currency, res.Hourly, res.Monthly)
+	w.Flush()
+	fmt.Printf("prices as of %s\n", res.PricesUpdatedAt)
+}
+
+//confirmCost prints the estimate of the request, creation above the monthly threshold has to be confirmed.
+//creation is not blocked when the estimate is not available
+func confirmCost(ctx context.Context, client proto.SpawnerServiceClient, req *proto.EstimateCostRequest, threshold float64, yes bool) bool {
+	res, err := client.EstimateCost(ctx, req)
+	if err != nil {
+		log.Printf("cost estimate not available: %s\n", err.Error())
+		return true
+	}
+	printEstimate(res)
+	if yes || threshold <= 0 || res.Monthly <= threshold {
+		return true
+	}
+
+	fmt.Printf("estimated monthly cost %.2f %s is above %.2f, continue? [y/N] ", res.Monthly, res.Currency, threshold)
+	answer := ""
+	fmt.Scanln(&answer)
+	answer = strings.ToLower(strings.TrimSpace(answer))
+	return answer == "y" || answer == "yes"
+}
+
+func addCostFlags(c *cobra.Command, threshold *float64, yes *bool) {
+	c.Flags().Float64Var(threshold, "cost-threshold", defaultCostThreshold, "monthly cost estimate above which the creation has to be confirmed, 0 never asks")
+	c.Flags().BoolVarP(yes, "yes", "y", false, "create without confirming the cost")
+}
+
+func estimateCost() *cobra.Command {
+	addr := ""
+	ifile := "request.json"
+	nodePool := false
+
+	c := &cobra.Command{
+		Use:     "estimate",
+		Short:   "estimate cost of the cluster or nodepool",
+		Long:    "estimate the hourly and monthly cost of the cluster or nodepool request before it is created",
+		Example: "estimate --request request.json --nodepool",
+		Args:    cobra.NoArgs,
+		Version: "0.0.1",
+		Run: func(cmd *cobra.Command, args []string) {
+			req := &proto.EstimateCostRequest{}
+			if nodePool {
+				nsr := &proto.NodeSpawnRequest{}
+				if err := unmarshalFile(ifile, nsr); err != nil {
+					log.Fatal(err.Error())
+				}
+				req.Request = &proto.EstimateCostRequest_NodePool{NodePool: nsr}
+			} else {
+				cr := &proto.ClusterRequest{}
+				if err := unmarshalFile(ifile, cr); err != nil {
+					log.Fatal(err.Error())
+				}
+				req.Request = &proto.EstimateCostRequest_Cluster{Cluster: cr}
+			}
+
+			conn, err := getSpawnerConn(addr)
+			if err != nil {
+				log.Fatal("failed to connect to spawner ", addr)
+			}
+			defer conn.Close()
+			client := proto.NewSpawnerServiceClient(conn)
+
+			res, err := client.EstimateCost(cmd.Context(), req)
+			if err != nil {
+				log.Fatal("failed to estimate cost: ", err.Error())
+			}
+			printEstimate(res)
+		},
+	}
+	c.Flags().StringVarP(&addr, "addr", "a", "localhost:8083", "spanwner service hoost address 'ip:port'")
+	c.Flags().StringVarP(&ifile, "request", "r", "request.json", "file containing cluster or nodepool spec")
+	c.Flags().BoolVar(&nodePool, "nodepool", false, "request file contains nodepool spec")
+	return c
+}
@@ -0,0 +1,51 @@
+package cli
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/spf13/cobra"
+	proto "gitlab.com/netbook-devs/spawner-service/proto/netbookai/spawner"
+)
+
+func extendLease() *cobra.Command {
+	addr := ""
+	req := &proto.ExtendLeaseRequest{}
+
+	c := &cobra.Command{
+		Use:     "extend-lease",
+		Short:   "extend-lease cluster [nodepool]",
+		Long:    "postpone the expiry of the cluster or the node pool, ttl is added to the current expiry",
+		Example: "extend-lease my-cluster gpu-pool --provider aws --region us-west-2 --account netbook-aws --ttl 120",
+		Args:    cobra.RangeArgs(1, 2),
+		Version: "0.0.1",
+		Run: func(cmd *cobra.Command, args []string) {
+			req.ClusterName = args[0]
+			if len(args) == 2 {
+				req.NodeGroupName = args[1]
+			}
+
+			conn, err := getSpawnerConn(addr)
+			if err != nil {
+				log.Fatal("failed to connect to spawner ", addr)
+			}
+			defer conn.Close()
+			client := proto.NewSpawnerServiceClient(conn)
+
+			res, err := client.ExtendLease(cmd.Context(), req)
+			if err != nil {
+				log.Fatal("failed to extend lease: ", err.Error())
+			}
+			fmt.Printf("'%s' expires at %s\n", args[len(args)-1], res.ExpiresAt)
+		},
+	}
+	c.Flags().StringVarP(&addr, "addr", "a", "localhost:8083", "spanwner service hoost address 'ip:port'")
+	c.Flags().StringVarP(&req.Provider, "provider", "p", "", "cloud provider")
+	c.Flags().StringVarP(&req.Region, "region", "r", "", "provider region")
+	c.Flags().StringVarP(&req.AccountName, "account", "", "", "account name")
+	c.Flags().Int64Var(&req.TtlMinutes, "ttl", 0, "minutes added to the current expiry")
+	c.Flags().StringVar(&req.ExpiresAt, "expires-at", "", "new expiry in RFC3339, alternative to ttl")
+	c.MarkFlagRequired("provider")
+	c.MarkFlagRequired("region")
+	return c
+}
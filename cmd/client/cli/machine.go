@@ -0,0 +1,53 @@
+package cli
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+	proto "gitlab.com/netbook-devs/spawner-service/proto/netbookai/spawner"
+)
+
+func machineTypes() *cobra.Command {
+	addr := ""
+	req := &proto.ListMachineTypesRequest{}
+
+	c := &cobra.Command{
+		Use:     "machine-types",
+		Short:   "list machine types",
+		Long:    "list the machine types of the catalog with the instance type, cpus, memory and gpus on the provider",
+		Example: "machine-types --provider aws --region us-west-2",
+		Args:    cobra.NoArgs,
+		Version: "0.0.1",
+		Run: func(cmd *cobra.Command, args []string) {
+			conn, err := getSpawnerConn(addr)
+			if err != nil {
+				log.Fatal("failed to connect to spawner ", addr)
+			}
+			defer conn.Close()
+			client := proto.NewSpawnerServiceClient(conn)
+
+			res, err := client.ListMachineTypes(cmd.Context(), req)
+			if err != nil {
+				log.Fatalf("failed to list machine types: %s\n", err.Error())
+			}
+
+			w := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+			fmt.Fprintln(w, "NAME\tPROVIDER\tINSTANCE\tVCPU\tMEMORY GiB\tGPU")
+			for _, m := range res.MachineTypes {
+				gpu := "-"
+				if m.GpuCount > 0 {
+					gpu = fmt.Sprintf("%d x %s", m.GpuCount, m.GpuModel)
+				}
+				fmt.Fprintf(w, "%s\t%s\t%s\t%d\t%g\t%s\n", m.Name, m.Provider, m.Instance, m.Vcpu, m.MemoryGiB, gpu)
+			}
+			w.Flush()
+		},
+	}
+	c.Flags().StringVarP(&addr, "addr", "a", "localhost:8083", "spanwner service hoost address 'ip:port'")
+	c.Flags().StringVarP(&req.Provider, "provider", "p", "", "cloud provider, every provider when empty")
+	c.Flags().StringVarP(&req.Region, "region", "r", "", "provider region, applies the region overrides")
+	return c
+}
@@ -0,0 +1,120 @@
+package cli
+
+import (
+	"log"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	proto "gitlab.com/netbook-devs/spawner-service/proto/netbookai/spawner"
+)
+
+//parseTaint parses 'key=value:effect', value is optional
+func parseTaint(s string) (*proto.Taint, error) {
+	i := strings.LastIndex(s, ":")
+	if i < 0 {
+		return nil, errors.Errorf("taint '%s' must be 'key=value:effect'", s)
+	}
+	kv := strings.SplitN(s[:i], "=", 2)
+	t := &proto.Taint{Key: kv[0], Effect: s[i+1:]}
+	if len(kv) == 2 {
+		t.Value = kv[1]
+	}
+	return t, nil
+}
+
+func scaleNodePool() *cobra.Command {
+	addr := ""
+	req := &proto.ScaleNodePoolRequest{}
+
+	c := &cobra.Command{
+		Use:     "scale",
+		Short:   "scale cluster nodepool",
+		Long:    "set the desired size of the nodepool, min and max set the autoscaling bounds, otherwise they are widened to the size",
+		Example: "nodepool scale my-cluster gpu-pool --provider aws --region us-west-2 --account netbook-aws --count 2 --min 1 --max 4",
+		Args:    cobra.ExactArgs(2),
+		Version: "0.0.1",
+		Run: func(cmd *cobra.Command, args []string) {
+			req.ClusterName, req.NodeGroupName = args[0], args[1]
+
+			conn, err := getSpawnerConn(addr)
+			if err != nil {
+				log.Fatal("failed to connect to spawner ", addr)
+			}
+			defer conn.Close()
+			client := proto.NewSpawnerServiceClient(conn)
+
+			res, err := client.ScaleNodePool(cmd.Context(), req)
+			if err == nil {
+				_, err = waitForOperation(cmd.Context(), client, res.OperationId)
+			}
+			if err != nil {
+				log.Fatal("failed to scale nodepool: ", err.Error())
+			}
+			log.Printf("nodepool '%s' scaled to %d\n", req.NodeGroupName, req.Count)
+		},
+	}
+	c.Flags().StringVarP(&addr, "addr", "a", "localhost:8083", "spanwner service hoost address 'ip:port'")
+	c.Flags().StringVarP(&req.Provider, "provider", "p", "", "cloud provider")
+	c.Flags().StringVarP(&req.Region, "region", "r", "", "provider region")
+	c.Flags().StringVarP(&req.AccountName, "account", "", "", "account name")
+	c.Flags().Int64VarP(&req.Count, "count", "c", 0, "desired number of nodes")
+	c.Flags().Int64Var(&req.MinCount, "min", 0, "autoscaling min size, applied when max is set")
+	c.Flags().Int64Var(&req.MaxCount, "max", 0, "autoscaling max size")
+	c.MarkFlagRequired("provider")
+	c.MarkFlagRequired("region")
+	c.MarkFlagRequired("count")
+	return c
+}
+
+func updateNodePool() *cobra.Command {
+	addr := ""
+	taints := []string{}
+	req := &proto.UpdateNodePoolRequest{}
+
+	c := &cobra.Command{
+		Use:     "update",
+		Short:   "update cluster nodepool",
+		Long:    "add or update labels and taints of the nodepool, upgrade its kubernetes version or node image",
+		Example: "nodepool update my-cluster gpu-pool --provider aws --region us-west-2 --account netbook-aws --label team=ml --taint gpu=true:NoSchedule --kubernetes-version 1.22",
+		Args:    cobra.ExactArgs(2),
+		Version: "0.0.1",
+		Run: func(cmd *cobra.Command, args []string) {
+			req.ClusterName, req.NodeGroupName = args[0], args[1]
+			for _, s := range taints {
+				t, err := parseTaint(s)
+				if err != nil {
+					log.Fatal(err.Error())
+				}
+				req.Taints = append(req.Taints, t)
+			}
+
+			conn, err := getSpawnerConn(addr)
+			if err != nil {
+				log.Fatal("failed to connect to spawner ", addr)
+			}
+			defer conn.Close()
+			client := proto.NewSpawnerServiceClient(conn)
+
+			res, err := client.UpdateNodePool(cmd.Context(), req)
+			if err == nil {
+				_, err = waitForOperation(cmd.Context(), client, res.OperationId)
+			}
+			if err != nil {
+				log.Fatal("failed to update nodepool: ", err.Error())
+			}
+			log.Printf("nodepool '%s' updated\n", req.NodeGroupName)
+		},
+	}
+	c.Flags().StringVarP(&addr, "addr", "a", "localhost:8083", "spanwner service hoost address 'ip:port'")
+	c.Flags().StringVarP(&req.Provider, "provider", "p", "", "cloud provider")
+	c.Flags().StringVarP(&req.Region, "region", "r", "", "provider region")
+	c.Flags().StringVarP(&req.AccountName, "account", "", "", "account name")
+	c.Flags().StringToStringVarP(&req.Labels, "label", "l", nil, "label 'key=value' added or updated, can be repeated")
+	c.Flags().StringArrayVar(&taints, "taint", nil, "taint 'key=value:effect' added or updated, effect is NoSchedule, PreferNoSchedule or NoExecute")
+	c.Flags().StringVar(&req.KubernetesVersion, "kubernetes-version", "", "kubernetes version the nodes are upgraded to")
+	c.Flags().StringVar(&req.ImageVersion, "image-version", "", "EKS AMI release version, 'latest' on AKS, image type on GKE")
+	c.MarkFlagRequired("provider")
+	c.MarkFlagRequired("region")
+	return c
+}
@@ -0,0 +1,140 @@
+package cli
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+	proto "gitlab.com/netbook-devs/spawner-service/proto/netbookai/spawner"
+)
+
+func printOperation(op *proto.Operation) {
+	fmt.Printf("%s %s %s '%s': %s\n", op.Id, op.Type, op.Provider, op.Target, op.Status)
+	for _, step := range op.Steps {
+		fmt.Printf("  %s  %s\n", step.CompletedAt, step.Name)
+	}
+	if op.Error != "" {
+		fmt.Println("error:", op.Error)
+	}
+}
+
+func getOperation() *cobra.Command {
+	addr := ""
+	wait := false
+
+	c := &cobra.Command{
+		Use:     "get",
+		Short:   "get operationid",
+		Long:    "get the progress of the operation, optionally wait for it to complete",
+		Example: "operation get op-6c0f2e5b9a1d3f47 --wait",
+		Args:    cobra.ExactArgs(1),
+		Version: "0.0.1",
+		Run: func(cmd *cobra.Command, args []string) {
+			conn, err := getSpawnerConn(addr)
+			if err != nil {
+				log.Fatal("failed to connect to spawner ", addr)
+			}
+			defer conn.Close()
+			client := proto.NewSpawnerServiceClient(conn)
+
+			if wait {
+				_, err := waitForOperation(cmd.Context(), client, args[0])
+				if err != nil {
+					log.Fatal(err.Error())
+				}
+			}
+
+			op, err := client.GetOperation(cmd.Context(), &proto.GetOperationRequest{OperationId: args[0]})
+			if err != nil {
+				log.Fatal("failed to get operation: ", err.Error())
+			}
+			printOperation(op)
+		},
+	}
+	c.Flags().StringVarP(&addr, "addr", "a", "localhost:8083", "spanwner service hoost address 'ip:port'")
+	c.Flags().BoolVarP(&wait, "wait", "w", false, "wait for the operation to complete")
+	return c
+}
+
+func listOperations() *cobra.Command {
+	addr := ""
+	req := &proto.ListOperationsRequest{}
+
+	c := &cobra.Command{
+		Use:     "list",
+		Short:   "list operations",
+		Long:    "list operations, latest first",
+		Example: "operation list --provider aws --active",
+		Version: "0.0.1",
+		Run: func(cmd *cobra.Command, args []string) {
+			conn, err := getSpawnerConn(addr)
+			if err != nil {
+				log.Fatal("failed to connect to spawner ", addr)
+			}
+			defer conn.Close()
+			client := proto.NewSpawnerServiceClient(conn)
+
+			res, err := client.ListOperations(cmd.Context(), req)
+			if err != nil {
+				log.Fatalf("failed to list operations: %s\n", err.Error())
+			}
+
+			w := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+			fmt.Fprintln(w, "ID\tTYPE\tPROVIDER\tREGION\tTARGET\tSTATUS\tCREATED")
+			for _, op := range res.Operations {
+				fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\t%s\n", op.Id, op.Type, op.Provider, op.Region, op.Target, op.Status, op.CreatedAt)
+			}
+			w.Flush()
+		},
+	}
+	c.Flags().StringVarP(&addr, "addr", "a", "localhost:8083", "spanwner service hoost address 'ip:port'")
+	c.Flags().StringVarP(&req.Provider, "provider", "p", "", "cloud provider")
+	c.Flags().StringVarP(&req.AccountName, "account", "", "", "account name")
+	c.Flags().StringVarP(&req.Region, "region", "r", "", "provider region")
+	c.Flags().StringVarP(&req.Type, "type", "t", "", "operation type, ex: CreateCluster")
+	c.Flags().BoolVar(&req.ActiveOnly, "active", false, "list only pending and running operations")
+	return c
+}
+
+func cancelOperation() *cobra.Command {
+	addr := ""
+
+	c := &cobra.Command{
+		Use:     "cancel",
+		Short:   "cancel operationid",
+		Long:    "cancel the running operation, resources created so far are not rolled back",
+		Example: "operation cancel op-6c0f2e5b9a1d3f47",
+		Args:    cobra.ExactArgs(1),
+		Version: "0.0.1",
+		Run: func(cmd *cobra.Command, args []string) {
+			conn, err := getSpawnerConn(addr)
+			if err != nil {
+				log.Fatal("failed to connect to spawner ", addr)
+			}
+			defer conn.Close()
+			client := proto.NewSpawnerServiceClient(conn)
+
+			op, err := client.CancelOperation(cmd.Context(), &proto.CancelOperationRequest{OperationId: args[0]})
+			if err != nil {
+				log.Fatal("failed to cancel operation: ", err.Error())
+			}
+			printOperation(op)
+		},
+	}
+	c.Flags().StringVarP(&addr, "addr", "a", "localhost:8083", "spanwner service hoost address 'ip:port'")
+	return c
+}
+
+func operations() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "operation",
+		Short: "operation [get|list|cancel]",
+		Long:  "follow or cancel the long running operations started by create and delete commands",
+	}
+	c.AddCommand(getOperation())
+	c.AddCommand(listOperations())
+	c.AddCommand(cancelOperation())
+	return c
+}
@@ -0,0 +1,54 @@
+package cli
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+	proto "gitlab.com/netbook-devs/spawner-service/proto/netbookai/spawner"
+)
+
+func listOrphans() *cobra.Command {
+	addr := ""
+	req := &proto.ListOrphansRequest{}
+
+	c := &cobra.Command{
+		Use:     "orphans",
+		Short:   "list orphans",
+		Long:    "list the unattached volumes and snapshots created by spawner without workspace whose cluster is gone, dry run unless --delete is given",
+		Example: "orphans --provider aws --account netbook-aws --region us-west-2 --delete",
+		Version: "0.0.1",
+		Run: func(cmd *cobra.Command, args []string) {
+			conn, err := getSpawnerConn(addr)
+			if err != nil {
+				log.Fatal("failed to connect to spawner ", addr)
+			}
+			defer conn.Close()
+			client := proto.NewSpawnerServiceClient(conn)
+
+			res, err := client.ListOrphans(cmd.Context(), req)
+			if err != nil {
+				log.Fatalf("failed to list orphans: %s\n", err.Error())
+			}
+			if len(res.Orphans) == 0 {
+				fmt.Println("no orphans found")
+				return
+			}
+
+			w := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+			fmt.Fprintln(w, "KIND\tPROVIDER\tACCOUNT\tREGION\tID\tCREATED\tREASON\tOPERATION")
+			for _, o := range res.Orphans {
+				fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\n", o.Kind, o.Provider, o.AccountName, o.Region, o.Id, o.CreatedAt, o.Reason, o.OperationId)
+			}
+			w.Flush()
+		},
+	}
+	c.Flags().StringVarP(&addr, "addr", "a", "localhost:8083", "spanwner service hoost address 'ip:port'")
+	c.Flags().StringVarP(&req.Provider, "provider", "p", "", "cloud provider")
+	c.Flags().StringVarP(&req.AccountName, "account", "", "", "account name")
+	c.Flags().StringVarP(&req.Region, "region", "r", "", "provider region")
+	c.Flags().BoolVar(&req.Delete, "delete", false, "delete the orphans found")
+	return c
+}
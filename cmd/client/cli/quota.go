@@ -0,0 +1,61 @@
+package cli
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+	proto "gitlab.com/netbook-devs/spawner-service/proto/netbookai/spawner"
+)
+
+//limit formats used/limit, zero limit is unlimited
+func limit(used, limit int64) string {
+	if limit == 0 {
+		return fmt.Sprintf("%d/-", used)
+	}
+	return fmt.Sprintf("%d/%d", used, limit)
+}
+
+func quotaUsage() *cobra.Command {
+	addr := ""
+	req := &proto.GetQuotaUsageRequest{}
+
+	c := &cobra.Command{
+		Use:     "quota",
+		Short:   "show quota usage",
+		Long:    "show the quota limits and consumption of the account and workspace, all the quotas are listed when neither is given",
+		Example: "quota --account netbook-aws --workspace ws-1",
+		Version: "0.0.1",
+		Run: func(cmd *cobra.Command, args []string) {
+			conn, err := getSpawnerConn(addr)
+			if err != nil {
+				log.Fatal("failed to connect to spawner ", addr)
+			}
+			defer conn.Close()
+			client := proto.NewSpawnerServiceClient(conn)
+
+			res, err := client.GetQuotaUsage(cmd.Context(), req)
+			if err != nil {
+				log.Fatalf("failed to get quota usage: %s\n", err.Error())
+			}
+
+			w := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+			fmt.Fprintln(w, "SCOPE\tNAME\tCLUSTERS\tNODES\tGPUS\tVOLUME GiB")
+			for _, u := range res.Usage {
+				used, l := u.Used, u.Limits
+				fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\n", u.Scope, u.Name,
+					limit(used.Clusters+u.Pending.Clusters, l.Clusters),
+					limit(used.Nodes+u.Pending.Nodes, l.Nodes),
+					limit(used.Gpus+u.Pending.Gpus, l.Gpus),
+					limit(used.VolumeGiB+u.Pending.VolumeGiB, l.VolumeGiB))
+			}
+			w.Flush()
+		},
+	}
+	c.Flags().StringVarP(&addr, "addr", "a", "localhost:8083", "spanwner service hoost address 'ip:port'")
+	c.Flags().StringVarP(&req.AccountName, "account", "", "", "account name")
+	c.Flags().StringVarP(&req.Workspace, "workspace", "w", "", "workspace id")
+	return c
+}
@@ -0,0 +1,53 @@
+package cli
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+	proto "gitlab.com/netbook-devs/spawner-service/proto/netbookai/spawner"
+)
+
+func listResources() *cobra.Command {
+	addr := ""
+	req := &proto.ListResourcesRequest{}
+
+	c := &cobra.Command{
+		Use:     "resources",
+		Short:   "list resources created by spawner",
+		Long:    "list resources created by spawner from the inventory, filtered by provider, account, region, workspace, kind and labels",
+		Example: "resources --provider aws --workspace ws-1 --label team=ml",
+		Version: "0.0.1",
+		Run: func(cmd *cobra.Command, args []string) {
+			conn, err := getSpawnerConn(addr)
+			if err != nil {
+				log.Fatal("failed to connect to spawner ", addr)
+			}
+			defer conn.Close()
+			client := proto.NewSpawnerServiceClient(conn)
+
+			res, err := client.ListResources(cmd.Context(), req)
+			if err != nil {
+				log.Fatalf("failed to list resources: %s\n", err.Error())
+			}
+
+			w := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+			fmt.Fprintln(w, "KIND\tID\tPROVIDER\tACCOUNT\tREGION\tWORKSPACE\tCREATED\tDELETED")
+			for _, r := range res.Resources {
+				fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\n", r.Kind, r.Id, r.Provider, r.AccountName, r.Region, r.Workspace, r.CreatedAt, r.DeletedAt)
+			}
+			w.Flush()
+		},
+	}
+	c.Flags().StringVarP(&addr, "addr", "a", "localhost:8083", "spanwner service hoost address 'ip:port'")
+	c.Flags().StringVarP(&req.Provider, "provider", "p", "", "cloud provider")
+	c.Flags().StringVarP(&req.AccountName, "account", "", "", "account name")
+	c.Flags().StringVarP(&req.Region, "region", "r", "", "provider region")
+	c.Flags().StringVarP(&req.Workspace, "workspace", "w", "", "workspace id")
+	c.Flags().StringVarP(&req.Kind, "kind", "k", "", "resource kind, one of [cluster, nodepool, volume, snapshot, iam-role, network-stack, dns-record]")
+	c.Flags().StringToStringVarP(&req.Labels, "label", "l", nil, "label the resources must have, key=value")
+	c.Flags().BoolVarP(&req.IncludeDeleted, "deleted", "d", false, "include deleted resources")
+	return c
+}
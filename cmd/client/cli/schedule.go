@@ -0,0 +1,188 @@
+package cli
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	proto "gitlab.com/netbook-devs/spawner-service/proto/netbookai/spawner"
+)
+
+//parseRule parses 'cron=desired[,min,max]'
+func parseRule(s string) (*proto.ScheduleRule, error) {
+	i := strings.LastIndex(s, "=")
+	if i < 0 {
+		return nil, errors.Errorf("rule '%s' must be 'cron=desired[,min,max]'", s)
+	}
+	sizes := strings.Split(s[i+1:], ",")
+	if len(sizes) != 1 && len(sizes) != 3 {
+		return nil, errors.Errorf("rule '%s' must be 'cron=desired[,min,max]'", s)
+	}
+	values := make([]int64, len(sizes))
+	for j, size := range sizes {
+		v, err := strconv.ParseInt(strings.TrimSpace(size), 10, 64)
+		if err != nil {
+			return nil, errors.Wrapf(err, "rule '%s'", s)
+		}
+		values[j] = v
+	}
+	r := &proto.ScheduleRule{Cron: strings.TrimSpace(s[:i]), DesiredSize: values[0]}
+	if len(values) == 3 {
+		r.MinSize, r.MaxSize = values[1], values[2]
+	}
+	return r, nil
+}
+
+func setSchedule() *cobra.Command {
+	addr := ""
+	rules := []string{}
+	req := &proto.SetNodePoolScheduleRequest{}
+
+	c := &cobra.Command{
+		Use:     "set",
+		Short:   "set cluster nodepool",
+		Long:    "create or replace the scaling schedule of the node pool, every rule 'cron=desired[,min,max]' sets the size when its cron fires",
+		Example: "schedule set my-cluster gpu-pool --provider aws --region us-west-2 --account netbook-aws --timezone Europe/Berlin --rule '0 20 * * 1-5=0,0,1' --rule '0 8 * * 1-5=2,1,4'",
+		Args:    cobra.ExactArgs(2),
+		Version: "0.0.1",
+		Run: func(cmd *cobra.Command, args []string) {
+			req.ClusterName, req.NodeGroupName = args[0], args[1]
+			for _, s := range rules {
+				r, err := parseRule(s)
+				if err != nil {
+					log.Fatal(err.Error())
+				}
+				req.Rules = append(req.Rules, r)
+			}
+
+			conn, err := getSpawnerConn(addr)
+			if err != nil {
+				log.Fatal("failed to connect to spawner ", addr)
+			}
+			defer conn.Close()
+			client := proto.NewSpawnerServiceClient(conn)
+
+			res, err := client.SetNodePoolSchedule(cmd.Context(), req)
+			if err != nil {
+				log.Fatal("failed to set schedule: ", err.Error())
+			}
+			fmt.Printf("schedule of '%s/%s' set with %d rules in %s\n", res.ClusterName, res.NodeGroupName, len(res.Rules), res.Timezone)
+		},
+	}
+	c.Flags().StringVarP(&addr, "addr", "a", "localhost:8083", "spanwner service hoost address 'ip:port'")
+	c.Flags().StringVarP(&req.Provider, "provider", "p", "", "cloud provider")
+	c.Flags().StringVarP(&req.Region, "region", "r", "", "provider region")
+	c.Flags().StringVarP(&req.AccountName, "account", "", "", "account name")
+	c.Flags().StringVar(&req.Timezone, "timezone", "", "time zone the rules are evaluated in, defaults to UTC")
+	c.Flags().StringArrayVar(&rules, "rule", nil, "'cron=desired[,min,max]', can be repeated")
+	c.MarkFlagRequired("provider")
+	c.MarkFlagRequired("region")
+	c.MarkFlagRequired("account")
+	c.MarkFlagRequired("rule")
+	return c
+}
+
+func deleteSchedule() *cobra.Command {
+	addr := ""
+	req := &proto.SetNodePoolScheduleRequest{}
+
+	c := &cobra.Command{
+		Use:     "delete",
+		Short:   "delete cluster nodepool",
+		Long:    "remove the scaling schedule of the node pool, node pool keeps its current size",
+		Example: "schedule delete my-cluster gpu-pool --provider aws --region us-west-2 --account netbook-aws",
+		Args:    cobra.ExactArgs(2),
+		Version: "0.0.1",
+		Run: func(cmd *cobra.Command, args []string) {
+			req.ClusterName, req.NodeGroupName = args[0], args[1]
+
+			conn, err := getSpawnerConn(addr)
+			if err != nil {
+				log.Fatal("failed to connect to spawner ", addr)
+			}
+			defer conn.Close()
+			client := proto.NewSpawnerServiceClient(conn)
+
+			if _, err := client.SetNodePoolSchedule(cmd.Context(), req); err != nil {
+				log.Fatal("failed to delete schedule: ", err.Error())
+			}
+			fmt.Printf("schedule of '%s/%s' deleted\n", args[0], args[1])
+		},
+	}
+	c.Flags().StringVarP(&addr, "addr", "a", "localhost:8083", "spanwner service hoost address 'ip:port'")
+	c.Flags().StringVarP(&req.Provider, "provider", "p", "", "cloud provider")
+	c.Flags().StringVarP(&req.Region, "region", "r", "", "provider region")
+	c.Flags().StringVarP(&req.AccountName, "account", "", "", "account name")
+	c.MarkFlagRequired("provider")
+	c.MarkFlagRequired("region")
+	c.MarkFlagRequired("account")
+	return c
+}
+
+func getSchedule() *cobra.Command {
+	addr := ""
+	req := &proto.GetNodePoolScheduleRequest{}
+
+	c := &cobra.Command{
+		Use:     "get",
+		Short:   "get cluster nodepool",
+		Long:    "show the scaling schedule of the node pool and the rule applied last",
+		Example: "schedule get my-cluster gpu-pool --provider aws --region us-west-2 --account netbook-aws",
+		Args:    cobra.ExactArgs(2),
+		Version: "0.0.1",
+		Run: func(cmd *cobra.Command, args []string) {
+			req.ClusterName, req.NodeGroupName = args[0], args[1]
+
+			conn, err := getSpawnerConn(addr)
+			if err != nil {
+				log.Fatal("failed to connect to spawner ", addr)
+			}
+			defer conn.Close()
+			client := proto.NewSpawnerServiceClient(conn)
+
+			res, err := client.GetNodePoolSchedule(cmd.Context(), req)
+			if err != nil {
+				log.Fatal("failed to get schedule: ", err.Error())
+			}
+
+			fmt.Printf("timezone: %s, updated at %s\n", res.Schedule.Timezone, res.Schedule.UpdatedAt)
+			w := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+			fmt.Fprintln(w, "CRON\tDESIRED\tMIN\tMAX")
+			for _, r := range res.Schedule.Rules {
+				fmt.Fprintf(w, "%s\t%d\t%d\t%d\n", r.Cron, r.DesiredSize, r.MinSize, r.MaxSize)
+			}
+			w.Flush()
+			if r := res.AppliedRule; r != nil {
+				fmt.Printf("applied '%s' fired at %s, operation %s\n", r.Cron, res.FiredAt, res.OperationId)
+			}
+			if res.Error != "" {
+				fmt.Println("error:", res.Error)
+			}
+		},
+	}
+	c.Flags().StringVarP(&addr, "addr", "a", "localhost:8083", "spanwner service hoost address 'ip:port'")
+	c.Flags().StringVarP(&req.Provider, "provider", "p", "", "cloud provider")
+	c.Flags().StringVarP(&req.Region, "region", "r", "", "provider region")
+	c.Flags().StringVarP(&req.AccountName, "account", "", "", "account name")
+	c.MarkFlagRequired("provider")
+	c.MarkFlagRequired("region")
+	c.MarkFlagRequired("account")
+	return c
+}
+
+func schedules() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "schedule",
+		Short: "schedule [set|get|delete]",
+		Long:  "manage the scaling schedules of the node pools, ex: scale to zero outside office hours",
+	}
+	c.AddCommand(setSchedule())
+	c.AddCommand(getSchedule())
+	c.AddCommand(deleteSchedule())
+	return c
+}
@@ -0,0 +1,200 @@
+package cli
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+	proto "gitlab.com/netbook-devs/spawner-service/proto/netbookai/spawner"
+)
+
+func listSnapshots() *cobra.Command {
+	addr := ""
+	req := &proto.ListSnapshotsRequest{}
+
+	c := &cobra.Command{
+		Use:     "list",
+		Short:   "list snapshots",
+		Long:    "list the snapshots created by spawner in the region, optionally of a workspace or with the labels",
+		Example: "snapshot list --provider aws --region us-west-2 --account netbook-aws --workspace ws-1",
+		Version: "0.0.1",
+		Run: func(cmd *cobra.Command, args []string) {
+			conn, err := getSpawnerConn(addr)
+			if err != nil {
+				log.Fatal("failed to connect to spawner ", addr)
+			}
+			defer conn.Close()
+			client := proto.NewSpawnerServiceClient(conn)
+
+			res, err := client.ListSnapshots(cmd.Context(), req)
+			if err != nil {
+				log.Fatalf("failed to list snapshots: %s\n", err.Error())
+			}
+			if len(res.Snapshots) == 0 {
+				fmt.Println("no snapshots found")
+				return
+			}
+
+			w := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+			fmt.Fprintln(w, "ID\tVOLUME\tSIZE\tSTATE\tCREATED")
+			for _, s := range res.Snapshots {
+				fmt.Fprintf(w, "%s\t%s\t%d\t%s\t%s\n", s.SnapshotId, s.VolumeId, s.Size, s.State, s.CreatedAt)
+			}
+			w.Flush()
+		},
+	}
+	c.Flags().StringVarP(&addr, "addr", "a", "localhost:8083", "spanwner service hoost address 'ip:port'")
+	c.Flags().StringVarP(&req.Provider, "provider", "p", "", "cloud provider")
+	c.Flags().StringVarP(&req.Region, "region", "r", "", "provider region")
+	c.Flags().StringVarP(&req.AccountName, "account", "", "", "account name")
+	c.Flags().StringVarP(&req.Workspace, "workspace", "w", "", "only the snapshots of the workspace")
+	c.Flags().StringToStringVarP(&req.Labels, "label", "l", nil, "label 'key=value' the snapshots must carry, can be repeated")
+	c.MarkFlagRequired("provider")
+	c.MarkFlagRequired("region")
+	return c
+}
+
+func deleteSnapshot() *cobra.Command {
+	addr := ""
+	req := &proto.DeleteSnapshotRequest{}
+
+	c := &cobra.Command{
+		Use:     "delete",
+		Short:   "delete snapshot",
+		Example: "snapshot delete snap-0a1b2c3d --provider aws --region us-west-2 --account netbook-aws",
+		Args:    cobra.ExactArgs(1),
+		Version: "0.0.1",
+		Run: func(cmd *cobra.Command, args []string) {
+			req.SnapshotId = args[0]
+
+			conn, err := getSpawnerConn(addr)
+			if err != nil {
+				log.Fatal("failed to connect to spawner ", addr)
+			}
+			defer conn.Close()
+			client := proto.NewSpawnerServiceClient(conn)
+
+			res, err := client.DeleteSnapshot(cmd.Context(), req)
+			if err == nil {
+				_, err = waitForOperation(cmd.Context(), client, res.OperationId)
+			}
+			if err != nil {
+				log.Fatal("failed to delete snapshot: ", err.Error())
+			}
+			log.Printf("snapshot '%s' deleted\n", req.SnapshotId)
+		},
+	}
+	c.Flags().StringVarP(&addr, "addr", "a", "localhost:8083", "spanwner service hoost address 'ip:port'")
+	c.Flags().StringVarP(&req.Provider, "provider", "p", "", "cloud provider")
+	c.Flags().StringVarP(&req.Region, "region", "r", "", "provider region")
+	c.Flags().StringVarP(&req.AccountName, "account", "", "", "account name")
+	c.MarkFlagRequired("provider")
+	c.MarkFlagRequired("region")
+	return c
+}
+
+func restoreSnapshot() *cobra.Command {
+	addr := ""
+	req := &proto.RestoreSnapshotRequest{}
+
+	c := &cobra.Command{
+		Use:     "restore",
+		Short:   "restore snapshot",
+		Long:    "create a new volume from the snapshot in the availability zone, volume carries the labels of the source volume",
+		Example: "snapshot restore snap-0a1b2c3d --provider aws --region us-west-2 --account netbook-aws --zone us-west-2a",
+		Args:    cobra.ExactArgs(1),
+		Version: "0.0.1",
+		Run: func(cmd *cobra.Command, args []string) {
+			req.SnapshotId = args[0]
+
+			conn, err := getSpawnerConn(addr)
+			if err != nil {
+				log.Fatal("failed to connect to spawner ", addr)
+			}
+			defer conn.Close()
+			client := proto.NewSpawnerServiceClient(conn)
+
+			res, err := client.RestoreSnapshot(cmd.Context(), req)
+			var op *proto.Operation
+			if err == nil {
+				op, err = waitForOperation(cmd.Context(), client, res.OperationId)
+			}
+			if err != nil {
+				log.Fatal("failed to restore snapshot: ", err.Error())
+			}
+			log.Printf("snapshot '%s' restored to volume '%s'\n", req.SnapshotId, op.GetRestoreSnapshot().GetVolumeId())
+		},
+	}
+	c.Flags().StringVarP(&addr, "addr", "a", "localhost:8083", "spanwner service hoost address 'ip:port'")
+	c.Flags().StringVarP(&req.Provider, "provider", "p", "", "cloud provider")
+	c.Flags().StringVarP(&req.Region, "region", "r", "", "provider region")
+	c.Flags().StringVarP(&req.AccountName, "account", "", "", "account name")
+	c.Flags().StringVarP(&req.Availabilityzone, "zone", "z", "", "availability zone of the new volume")
+	c.Flags().StringVarP(&req.Volumetype, "type", "t", "", "volume type, defaults to the provider default")
+	c.Flags().Int64VarP(&req.Size, "size", "s", 0, "volume size in GiB, defaults to the snapshot size")
+	c.Flags().StringToStringVarP(&req.Labels, "label", "l", nil, "extra label 'key=value' of the volume, can be repeated")
+	c.MarkFlagRequired("provider")
+	c.MarkFlagRequired("region")
+	return c
+}
+
+func copySnapshot() *cobra.Command {
+	addr := ""
+	req := &proto.CopySnapshotRequest{}
+
+	c := &cobra.Command{
+		Use:     "copy",
+		Short:   "copy snapshot to another region",
+		Long:    "copy the snapshot to the destination region, copy carries the labels of the source snapshot",
+		Example: "snapshot copy snap-0a1b2c3d --provider aws --region us-west-2 --account netbook-aws --destination us-east-1",
+		Args:    cobra.ExactArgs(1),
+		Version: "0.0.1",
+		Run: func(cmd *cobra.Command, args []string) {
+			req.SnapshotId = args[0]
+
+			conn, err := getSpawnerConn(addr)
+			if err != nil {
+				log.Fatal("failed to connect to spawner ", addr)
+			}
+			defer conn.Close()
+			client := proto.NewSpawnerServiceClient(conn)
+
+			res, err := client.CopySnapshot(cmd.Context(), req)
+			var op *proto.Operation
+			if err == nil {
+				op, err = waitForOperation(cmd.Context(), client, res.OperationId)
+			}
+			if err != nil {
+				log.Fatal("failed to copy snapshot: ", err.Error())
+			}
+			log.Printf("snapshot '%s' copied to %s as '%s'\n", req.SnapshotId, req.DestinationRegion, op.GetCopySnapshot().GetSnapshotId())
+		},
+	}
+	c.Flags().StringVarP(&addr, "addr", "a", "localhost:8083", "spanwner service hoost address 'ip:port'")
+	c.Flags().StringVarP(&req.Provider, "provider", "p", "", "cloud provider")
+	c.Flags().StringVarP(&req.Region, "region", "r", "", "provider region")
+	c.Flags().StringVarP(&req.AccountName, "account", "", "", "account name")
+	c.Flags().StringVarP(&req.DestinationRegion, "destination", "d", "", "destination region")
+	c.Flags().StringToStringVarP(&req.Labels, "label", "l", nil, "extra label 'key=value' of the copy, can be repeated")
+	c.MarkFlagRequired("provider")
+	c.MarkFlagRequired("region")
+	c.MarkFlagRequired("destination")
+	return c
+}
+
+func snapshot() *cobra.Command {
+
+	c := &cobra.Command{
+		Use:   "snapshot",
+		Short: "snapshot [list|delete|restore|copy|policy]",
+		Long:  "list, delete, restore or copy snapshots and manage the snapshot policies",
+	}
+	c.AddCommand(listSnapshots())
+	c.AddCommand(deleteSnapshot())
+	c.AddCommand(restoreSnapshot())
+	c.AddCommand(copySnapshot())
+	c.AddCommand(snapshotPolicy())
+	return c
+}
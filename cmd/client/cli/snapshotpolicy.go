@@ -0,0 +1,135 @@
+package cli
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+	proto "gitlab.com/netbook-devs/spawner-service/proto/netbookai/spawner"
+)
+
+func createSnapshotPolicy() *cobra.Command {
+	addr := ""
+	p := &proto.SnapshotPolicy{}
+
+	c := &cobra.Command{
+		Use:     "create",
+		Short:   "create policy",
+		Long:    "create or replace the snapshot policy of the volumes carrying the selector labels, snapshots over the retention count or age are pruned",
+		Example: "snapshot policy create nightly --provider aws --region us-west-2 --account netbook-aws --selector backup=true --frequency daily --retain-count 7 --copy-region us-east-1",
+		Args:    cobra.ExactArgs(1),
+		Version: "0.0.1",
+		Run: func(cmd *cobra.Command, args []string) {
+			conn, err := getSpawnerConn(addr)
+			if err != nil {
+				log.Fatal("failed to connect to spawner ", addr)
+			}
+			defer conn.Close()
+			client := proto.NewSpawnerServiceClient(conn)
+
+			p.Name = args[0]
+			res, err := client.CreateSnapshotPolicy(cmd.Context(), &proto.CreateSnapshotPolicyRequest{Policy: p})
+			if err != nil {
+				log.Fatal("failed to create snapshot policy: ", err.Error())
+			}
+			fmt.Printf("snapshot policy '%s' created, %s snapshots of %v\n", res.Name, res.Frequency, res.Selector)
+		},
+	}
+	c.Flags().StringVarP(&addr, "addr", "a", "localhost:8083", "spanwner service hoost address 'ip:port'")
+	c.Flags().StringVarP(&p.Provider, "provider", "p", "", "cloud provider")
+	c.Flags().StringVarP(&p.Region, "region", "r", "", "provider region")
+	c.Flags().StringVarP(&p.AccountName, "account", "", "", "account name")
+	c.Flags().StringToStringVarP(&p.Selector, "selector", "l", nil, "label 'key=value' the volumes must carry, can be repeated")
+	c.Flags().StringVarP(&p.Frequency, "frequency", "f", "daily", "hourly, daily or weekly")
+	c.Flags().Int32Var(&p.RetainCount, "retain-count", 0, "newest snapshots kept per volume")
+	c.Flags().Int64Var(&p.RetainHours, "retain-hours", 0, "snapshots older than this are deleted")
+	c.Flags().StringVar(&p.CopyRegion, "copy-region", "", "region the snapshots are copied to")
+	c.MarkFlagRequired("provider")
+	c.MarkFlagRequired("region")
+	c.MarkFlagRequired("account")
+	c.MarkFlagRequired("selector")
+	return c
+}
+
+func deleteSnapshotPolicy() *cobra.Command {
+	addr := ""
+
+	c := &cobra.Command{
+		Use:     "delete",
+		Short:   "delete policy",
+		Long:    "delete the snapshot policy, snapshots taken by it are kept",
+		Example: "snapshot policy delete nightly",
+		Args:    cobra.ExactArgs(1),
+		Version: "0.0.1",
+		Run: func(cmd *cobra.Command, args []string) {
+			conn, err := getSpawnerConn(addr)
+			if err != nil {
+				log.Fatal("failed to connect to spawner ", addr)
+			}
+			defer conn.Close()
+			client := proto.NewSpawnerServiceClient(conn)
+
+			_, err = client.DeleteSnapshotPolicy(cmd.Context(), &proto.DeleteSnapshotPolicyRequest{Name: args[0]})
+			if err != nil {
+				log.Fatal("failed to delete snapshot policy: ", err.Error())
+			}
+			fmt.Printf("snapshot policy '%s' deleted\n", args[0])
+		},
+	}
+	c.Flags().StringVarP(&addr, "addr", "a", "localhost:8083", "spanwner service hoost address 'ip:port'")
+	return c
+}
+
+func listSnapshotPolicies() *cobra.Command {
+	addr := ""
+
+	c := &cobra.Command{
+		Use:     "list",
+		Short:   "list [policy]",
+		Long:    "show the snapshot policies and the result of their last run, all policies when name is not given",
+		Example: "snapshot policy list nightly",
+		Args:    cobra.MaximumNArgs(1),
+		Version: "0.0.1",
+		Run: func(cmd *cobra.Command, args []string) {
+			conn, err := getSpawnerConn(addr)
+			if err != nil {
+				log.Fatal("failed to connect to spawner ", addr)
+			}
+			defer conn.Close()
+			client := proto.NewSpawnerServiceClient(conn)
+
+			req := &proto.ListSnapshotPoliciesRequest{}
+			if len(args) > 0 {
+				req.Name = args[0]
+			}
+			res, err := client.ListSnapshotPolicies(cmd.Context(), req)
+			if err != nil {
+				log.Fatal("failed to list snapshot policies: ", err.Error())
+			}
+
+			w := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+			fmt.Fprintln(w, "NAME\tPROVIDER\tREGION\tFREQUENCY\tLAST RUN\tNEXT RUN\tSNAPSHOTS\tPRUNED\tERROR")
+			for _, st := range res.Policies {
+				p := st.Policy
+				fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\t%d\t%d\t%s\n", p.Name, p.Provider, p.Region, p.Frequency, st.LastRunAt, st.NextRunAt, len(st.Snapshots), len(st.Pruned), st.Error)
+			}
+			w.Flush()
+		},
+	}
+	c.Flags().StringVarP(&addr, "addr", "a", "localhost:8083", "spanwner service hoost address 'ip:port'")
+	return c
+}
+
+func snapshotPolicy() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "policy",
+		Short: "policy [create|delete|list]",
+		Long:  "manage the scheduled snapshots of the volumes selected by labels",
+	}
+	c.AddCommand(createSnapshotPolicy())
+	c.AddCommand(deleteSnapshotPolicy())
+	c.AddCommand(listSnapshotPolicies())
+	return c
+}
@@ -0,0 +1,93 @@
+package cli
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/spf13/cobra"
+	proto "gitlab.com/netbook-devs/spawner-service/proto/netbookai/spawner"
+)
+
+func kubernetesVersions() *cobra.Command {
+	addr := ""
+	req := &proto.ListKubernetesVersionsRequest{}
+
+	c := &cobra.Command{
+		Use:     "kubernetes-versions",
+		Short:   "kubernetes-versions",
+		Long:    "list the kubernetes versions clusters can be created with or upgraded to in the region",
+		Example: "kubernetes-versions --provider azure --region eastus --account netbook-azure",
+		Args:    cobra.NoArgs,
+		Version: "0.0.1",
+		Run: func(cmd *cobra.Command, args []string) {
+			conn, err := getSpawnerConn(addr)
+			if err != nil {
+				log.Fatal("failed to connect to spawner ", addr)
+			}
+			defer conn.Close()
+			client := proto.NewSpawnerServiceClient(conn)
+
+			res, err := client.ListKubernetesVersions(cmd.Context(), req)
+			if err != nil {
+				log.Fatal("failed to list kubernetes versions: ", err.Error())
+			}
+			for _, v := range res.Versions {
+				if v == res.DefaultVersion {
+					fmt.Printf("%s (default)\n", v)
+					continue
+				}
+				fmt.Println(v)
+			}
+		},
+	}
+	c.Flags().StringVarP(&addr, "addr", "a", "localhost:8083", "spanwner service hoost address 'ip:port'")
+	c.Flags().StringVarP(&req.Provider, "provider", "p", "", "cloud provider")
+	c.Flags().StringVarP(&req.Region, "region", "r", "", "provider region")
+	c.Flags().StringVarP(&req.AccountName, "account", "", "", "account name")
+	c.MarkFlagRequired("provider")
+	c.MarkFlagRequired("region")
+	return c
+}
+
+func upgradeCluster() *cobra.Command {
+	addr := ""
+	req := &proto.UpgradeClusterRequest{}
+
+	c := &cobra.Command{
+		Use:     "upgrade-cluster",
+		Short:   "upgrade-cluster clustername",
+		Long:    "upgrade the control plane of the cluster to the kubernetes version, then every node pool one after another",
+		Example: "upgrade-cluster my-cluster --provider aws --region us-west-2 --account netbook-aws --kubernetes-version 1.22",
+		Args:    cobra.ExactArgs(1),
+		Version: "0.0.1",
+		Run: func(cmd *cobra.Command, args []string) {
+			req.ClusterName = args[0]
+
+			conn, err := getSpawnerConn(addr)
+			if err != nil {
+				log.Fatal("failed to connect to spawner ", addr)
+			}
+			defer conn.Close()
+			client := proto.NewSpawnerServiceClient(conn)
+
+			log.Printf("upgrading cluster '%s' to %s\n", req.ClusterName, req.KubernetesVersion)
+			res, err := client.UpgradeCluster(cmd.Context(), req)
+			if err == nil {
+				_, err = waitForOperation(cmd.Context(), client, res.OperationId)
+			}
+			if err != nil {
+				log.Fatal("failed to upgrade cluster: ", err.Error())
+			}
+			log.Printf("cluster '%s' upgraded to %s\n", req.ClusterName, req.KubernetesVersion)
+		},
+	}
+	c.Flags().StringVarP(&addr, "addr", "a", "localhost:8083", "spanwner service hoost address 'ip:port'")
+	c.Flags().StringVarP(&req.Provider, "provider", "p", "", "cloud provider")
+	c.Flags().StringVarP(&req.Region, "region", "r", "", "provider region")
+	c.Flags().StringVarP(&req.AccountName, "account", "", "", "account name")
+	c.Flags().StringVar(&req.KubernetesVersion, "kubernetes-version", "", "kubernetes version to upgrade to, ex: 1.22")
+	c.MarkFlagRequired("provider")
+	c.MarkFlagRequired("region")
+	c.MarkFlagRequired("kubernetes-version")
+	return c
+}
@@ -0,0 +1,256 @@
+package cli
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+	proto "gitlab.com/netbook-devs/spawner-service/proto/netbookai/spawner"
+)
+
+func listVolumes() *cobra.Command {
+	addr := ""
+	req := &proto.ListVolumesRequest{}
+
+	c := &cobra.Command{
+		Use:     "list",
+		Short:   "list volumes",
+		Long:    "list the volumes created by spawner in the region, optionally of a workspace or with the labels",
+		Example: "volume list --provider aws --region us-west-2 --account netbook-aws --workspace ws-1",
+		Version: "0.0.1",
+		Run: func(cmd *cobra.Command, args []string) {
+			conn, err := getSpawnerConn(addr)
+			if err != nil {
+				log.Fatal("failed to connect to spawner ", addr)
+			}
+			defer conn.Close()
+			client := proto.NewSpawnerServiceClient(conn)
+
+			res, err := client.ListVolumes(cmd.Context(), req)
+			if err != nil {
+				log.Fatalf("failed to list volumes: %s\n", err.Error())
+			}
+			if len(res.Volumes) == 0 {
+				fmt.Println("no volumes found")
+				return
+			}
+
+			w := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+			fmt.Fprintln(w, "ID\tZONE\tTYPE\tSIZE\tSTATE\tATTACHED TO\tCREATED")
+			for _, v := range res.Volumes {
+				fmt.Fprintf(w, "%s\t%s\t%s\t%d\t%s\t%s\t%s\n", v.VolumeId, v.Availabilityzone, v.Volumetype, v.Size, v.State, strings.Join(v.Attachments, ","), v.CreatedAt)
+			}
+			w.Flush()
+		},
+	}
+	c.Flags().StringVarP(&addr, "addr", "a", "localhost:8083", "spanwner service hoost address 'ip:port'")
+	c.Flags().StringVarP(&req.Provider, "provider", "p", "", "cloud provider")
+	c.Flags().StringVarP(&req.Region, "region", "r", "", "provider region")
+	c.Flags().StringVarP(&req.AccountName, "account", "", "", "account name")
+	c.Flags().StringVarP(&req.Workspace, "workspace", "w", "", "only the volumes of the workspace")
+	c.Flags().StringToStringVarP(&req.Labels, "label", "l", nil, "label 'key=value' the volumes must carry, can be repeated")
+	c.MarkFlagRequired("provider")
+	c.MarkFlagRequired("region")
+	return c
+}
+
+func getVolume() *cobra.Command {
+	addr := ""
+	req := &proto.GetVolumeRequest{}
+
+	c := &cobra.Command{
+		Use:     "get",
+		Short:   "describe volume",
+		Long:    "state, size, type, zone, attachments and tags of the volume",
+		Example: "volume get vol-0a1b2c3d --provider aws --region us-west-2 --account netbook-aws",
+		Args:    cobra.ExactArgs(1),
+		Version: "0.0.1",
+		Run: func(cmd *cobra.Command, args []string) {
+			req.VolumeId = args[0]
+
+			conn, err := getSpawnerConn(addr)
+			if err != nil {
+				log.Fatal("failed to connect to spawner ", addr)
+			}
+			defer conn.Close()
+			client := proto.NewSpawnerServiceClient(conn)
+
+			v, err := client.GetVolume(cmd.Context(), req)
+			if err != nil {
+				log.Fatalf("failed to get volume: %s\n", err.Error())
+			}
+
+			w := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+			fmt.Fprintf(w, "ID\t%s\n", v.VolumeId)
+			fmt.Fprintf(w, "REGION\t%s\n", v.Region)
+			fmt.Fprintf(w, "ZONE\t%s\n", v.Availabilityzone)
+			fmt.Fprintf(w, "TYPE\t%s\n", v.Volumetype)
+			fmt.Fprintf(w, "SIZE\t%dGiB\n", v.Size)
+			fmt.Fprintf(w, "STATE\t%s\n", v.State)
+			fmt.Fprintf(w, "ATTACHED TO\t%s\n", strings.Join(v.Attachments, ","))
+			fmt.Fprintf(w, "SNAPSHOT\t%s\n", v.SnapshotId)
+			fmt.Fprintf(w, "CREATED\t%s\n", v.CreatedAt)
+			keys := make([]string, 0, len(v.Labels))
+			for k := range v.Labels {
+				keys = append(keys, k)
+			}
+			sort.Strings(keys)
+			for _, k := range keys {
+				fmt.Fprintf(w, "TAG\t%s=%s\n", k, v.Labels[k])
+			}
+			w.Flush()
+		},
+	}
+	c.Flags().StringVarP(&addr, "addr", "a", "localhost:8083", "spanwner service hoost address 'ip:port'")
+	c.Flags().StringVarP(&req.Provider, "provider", "p", "", "cloud provider")
+	c.Flags().StringVarP(&req.Region, "region", "r", "", "provider region")
+	c.Flags().StringVarP(&req.AccountName, "account", "", "", "account name")
+	c.MarkFlagRequired("provider")
+	c.MarkFlagRequired("region")
+	return c
+}
+
+func resizeVolume() *cobra.Command {
+	addr := ""
+	req := &proto.ResizeVolumeRequest{}
+
+	c := &cobra.Command{
+		Use:     "resize",
+		Short:   "resize volume",
+		Long:    "grow the volume to the size in GiB, file system on the volume has to be extended on the instance",
+		Example: "volume resize vol-0a1b2c3d --provider aws --region us-west-2 --account netbook-aws --size 200",
+		Args:    cobra.ExactArgs(1),
+		Version: "0.0.1",
+		Run: func(cmd *cobra.Command, args []string) {
+			req.VolumeId = args[0]
+
+			conn, err := getSpawnerConn(addr)
+			if err != nil {
+				log.Fatal("failed to connect to spawner ", addr)
+			}
+			defer conn.Close()
+			client := proto.NewSpawnerServiceClient(conn)
+
+			res, err := client.ResizeVolume(cmd.Context(), req)
+			if err == nil {
+				_, err = waitForOperation(cmd.Context(), client, res.OperationId)
+			}
+			if err != nil {
+				log.Fatal("failed to resize volume: ", err.Error())
+			}
+			log.Printf("volume '%s' resized to %dGiB\n", req.VolumeId, req.Size)
+		},
+	}
+	c.Flags().StringVarP(&addr, "addr", "a", "localhost:8083", "spanwner service hoost address 'ip:port'")
+	c.Flags().StringVarP(&req.Provider, "provider", "p", "", "cloud provider")
+	c.Flags().StringVarP(&req.Region, "region", "r", "", "provider region")
+	c.Flags().StringVarP(&req.AccountName, "account", "", "", "account name")
+	c.Flags().Int64VarP(&req.Size, "size", "s", 0, "new size in GiB")
+	c.MarkFlagRequired("provider")
+	c.MarkFlagRequired("region")
+	c.MarkFlagRequired("size")
+	return c
+}
+
+func attachVolume() *cobra.Command {
+	addr := ""
+	req := &proto.AttachVolumeRequest{}
+
+	c := &cobra.Command{
+		Use:     "attach",
+		Short:   "attach volume",
+		Long:    "attach the volume to the instance, device is required on aws, lun on azure is picked when not given",
+		Example: "volume attach vol-0a1b2c3d i-0a1b2c3d --provider aws --region us-west-2 --account netbook-aws --device /dev/sdf",
+		Args:    cobra.ExactArgs(2),
+		Version: "0.0.1",
+		Run: func(cmd *cobra.Command, args []string) {
+			req.VolumeId, req.Instance = args[0], args[1]
+
+			conn, err := getSpawnerConn(addr)
+			if err != nil {
+				log.Fatal("failed to connect to spawner ", addr)
+			}
+			defer conn.Close()
+			client := proto.NewSpawnerServiceClient(conn)
+
+			res, err := client.AttachVolume(cmd.Context(), req)
+			var op *proto.Operation
+			if err == nil {
+				op, err = waitForOperation(cmd.Context(), client, res.OperationId)
+			}
+			if err != nil {
+				log.Fatal("failed to attach volume: ", err.Error())
+			}
+			log.Printf("volume '%s' attached to '%s' as '%s'\n", req.VolumeId, req.Instance, op.GetAttachVolume().GetDevice())
+		},
+	}
+	c.Flags().StringVarP(&addr, "addr", "a", "localhost:8083", "spanwner service hoost address 'ip:port'")
+	c.Flags().StringVarP(&req.Provider, "provider", "p", "", "cloud provider")
+	c.Flags().StringVarP(&req.Region, "region", "r", "", "provider region")
+	c.Flags().StringVarP(&req.AccountName, "account", "", "", "account name")
+	c.Flags().StringVarP(&req.Device, "device", "d", "", "device name on aws and gcp, lun on azure")
+	c.MarkFlagRequired("provider")
+	c.MarkFlagRequired("region")
+	return c
+}
+
+func detachVolume() *cobra.Command {
+	addr := ""
+	req := &proto.DetachVolumeRequest{}
+
+	c := &cobra.Command{
+		Use:     "detach",
+		Short:   "detach volume",
+		Long:    "detach the volume from the instance it is attached to, unmount the volume on the instance first",
+		Example: "volume detach vol-0a1b2c3d --provider aws --region us-west-2 --account netbook-aws",
+		Args:    cobra.ExactArgs(1),
+		Version: "0.0.1",
+		Run: func(cmd *cobra.Command, args []string) {
+			req.VolumeId = args[0]
+
+			conn, err := getSpawnerConn(addr)
+			if err != nil {
+				log.Fatal("failed to connect to spawner ", addr)
+			}
+			defer conn.Close()
+			client := proto.NewSpawnerServiceClient(conn)
+
+			res, err := client.DetachVolume(cmd.Context(), req)
+			if err == nil {
+				_, err = waitForOperation(cmd.Context(), client, res.OperationId)
+			}
+			if err != nil {
+				log.Fatal("failed to detach volume: ", err.Error())
+			}
+			log.Printf("volume '%s' detached\n", req.VolumeId)
+		},
+	}
+	c.Flags().StringVarP(&addr, "addr", "a", "localhost:8083", "spanwner service hoost address 'ip:port'")
+	c.Flags().StringVarP(&req.Provider, "provider", "p", "", "cloud provider")
+	c.Flags().StringVarP(&req.Region, "region", "r", "", "provider region")
+	c.Flags().StringVarP(&req.AccountName, "account", "", "", "account name")
+	c.Flags().StringVarP(&req.Instance, "instance", "i", "", "instance the volume is detached from, defaults to the instance it is attached to")
+	c.Flags().BoolVar(&req.Force, "force", false, "force detach, data not flushed to the volume is lost")
+	c.MarkFlagRequired("provider")
+	c.MarkFlagRequired("region")
+	return c
+}
+
+func volume() *cobra.Command {
+
+	c := &cobra.Command{
+		Use:   "volume",
+		Short: "volume [list|get|resize|attach|detach]",
+		Long:  "list, describe, resize, attach or detach volumes",
+	}
+	c.AddCommand(listVolumes())
+	c.AddCommand(getVolume())
+	c.AddCommand(resizeVolume())
+	c.AddCommand(attachVolume())
+	c.AddCommand(detachVolume())
+	return c
+}
@@ -2,45 +2,85 @@ package cli
 
 import (
 	"context"
+	"fmt"
 	"log"
 	"time"
 
 	proto "gitlab.com/netbook-devs/spawner-service/proto/netbookai/spawner"
 )
 
-//waitUntilClusterReady Wait until the give cluster return "ACTIVE" status
+//waitUntilClusterReady watch the cluster until it returns "ACTIVE" status
 //
-// This will run for about 12min, with multiuple iteration of sleeps.
-// Starting with 6min, then 4min, 2min.
-// Cluster can become fully active in any one of sleep cycle, as soon as we see status is ACTIVE, we return it.
-// When sleep counter reaches 0, we return FAILED status, 15min is too long for cluster to become active
-func waitUntilClusterReady(ctx context.Context, c proto.SpawnerServiceClient, req *proto.ClusterRequest) string {
-	clusterStatus := &proto.ClusterStatusRequest{
+// Every status change pushed by the spawner is logged, returns the last seen status when the cluster fails
+// or the timeout expires before the cluster becomes active.
+func waitUntilClusterReady(ctx context.Context, c proto.SpawnerServiceClient, req *proto.ClusterRequest, timeout time.Duration) string {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	stream, err := c.WatchCluster(ctx, &proto.WatchClusterRequest{
 		Provider:    req.Provider,
 		Region:      req.Region,
+		AccountName: req.AccountName,
 		ClusterName: req.ClusterName,
+	})
+	if err != nil {
+		log.Println("failed to watch cluster: ", err.Error())
+		return "FAILED"
 	}
 
-	waitfor := 6 //minute
-
+	last := "UNKNOWN"
 	for {
-		log.Println("wait for cluster to be active, duration ", waitfor, "min")
-		time.Sleep(time.Duration(waitfor) * time.Minute)
-
-		//check cluster status and if active return
-		stat, err := c.ClusterStatus(ctx, clusterStatus)
+		event, err := stream.Recv()
 		if err != nil {
-			log.Println("failed to fetch cluster stat")
+			log.Printf("stopped waiting for cluster '%s' after %s, status %s: %s\n", req.ClusterName, timeout, last, err.Error())
+			return last
 		}
 
-		if stat.Status == "ACTIVE" {
-			return stat.Status
+		if event.Error != "" {
+			log.Printf("cluster '%s': %s\n", req.ClusterName, event.Error)
+			continue
+		}
+		last = event.Status
+		log.Printf("cluster '%s' is %s %s\n", req.ClusterName, event.Status, event.ProvisioningState)
+		for _, np := range event.NodePools {
+			for _, issue := range np.GetHealth().GetIssue() {
+				log.Printf("nodepool '%s': %s %s\n", np.NodePoolName, issue.Code, issue.Description)
+			}
 		}
-		waitfor = waitfor - 2
 
-		if waitfor == 0 {
-			//already waited for 12+min
+		if event.Status == "ACTIVE" || event.Status == "FAILED" {
+			return last
+		}
+		if event.ProvisioningState == "Failed" {
 			return "FAILED"
 		}
 	}
 }
+
+//waitForOperation polls the operation until it is done, logging every new step
+func waitForOperation(ctx context.Context, c proto.SpawnerServiceClient, id string) (*proto.Operation, error) {
+	seen := 0
+	for {
+		op, err := c.GetOperation(ctx, &proto.GetOperationRequest{OperationId: id})
+		if err != nil {
+			return nil, err
+		}
+		for _, step := range op.Steps[seen:] {
+			log.Println(step.Name)
+		}
+		seen = len(op.Steps)
+
+		switch op.Status {
+		case proto.OperationStatus_OPERATION_SUCCEEDED:
+			return op, nil
+		case proto.OperationStatus_OPERATION_FAILED, proto.OperationStatus_OPERATION_CANCELLED:
+			return op, fmt.Errorf("operation %s %s: %s", id, op.Status, op.Error)
+		}
+
+		select {
+		case <-ctx.Done():
+			return op, ctx.Err()
+		case <-time.After(5 * time.Second):
+		}
+	}
+}
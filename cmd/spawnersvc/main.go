@@ -8,17 +8,30 @@ import (
 	"os"
 	"os/signal"
 	"syscall"
+	"time"
 
 	"github.com/netbook-ai/interceptors"
 	"github.com/oklog/oklog/pkg/group"
+	"github.com/pkg/errors"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"gitlab.com/netbook-devs/spawner-service/pkg/audit"
+	"gitlab.com/netbook-devs/spawner-service/pkg/auth"
+	"gitlab.com/netbook-devs/spawner-service/pkg/backup"
+	"gitlab.com/netbook-devs/spawner-service/pkg/budget"
 	"gitlab.com/netbook-devs/spawner-service/pkg/config"
 	"gitlab.com/netbook-devs/spawner-service/pkg/gateway"
+	"gitlab.com/netbook-devs/spawner-service/pkg/idempotency"
+	"gitlab.com/netbook-devs/spawner-service/pkg/inventory"
 	"gitlab.com/netbook-devs/spawner-service/pkg/metrics"
+	"gitlab.com/netbook-devs/spawner-service/pkg/pricing"
+	"gitlab.com/netbook-devs/spawner-service/pkg/quota"
+	"gitlab.com/netbook-devs/spawner-service/pkg/schedule"
 	"gitlab.com/netbook-devs/spawner-service/pkg/service"
+	"gitlab.com/netbook-devs/spawner-service/pkg/service/common"
 	proto "gitlab.com/netbook-devs/spawner-service/proto/netbookai/spawner"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 )
 
 func startHttpServer(g *group.Group, config config.Config, logger *zap.SugaredLogger) {
@@ -46,10 +59,115 @@ func startHttpServer(g *group.Group, config config.Config, logger *zap.SugaredLo
 	})
 }
 
+//authOptions serves grpc over tls and guards the calls when authentication is configured
+func authOptions(config config.Config, logger *zap.SugaredLogger) ([]grpc.ServerOption, []interceptors.InterceptorOption, error) {
+	opts := []grpc.ServerOption{}
+	mtls := config.TLSClientCAFile != ""
+
+	if config.TLSCertFile != "" {
+		tlsConfig, err := auth.ServerTLSConfig(config.TLSCertFile, config.TLSKeyFile, config.TLSClientCAFile, config.AuthJWKSFile == "")
+		if err != nil {
+			return nil, nil, err
+		}
+		opts = append(opts, grpc.Creds(credentials.NewTLS(tlsConfig)))
+	} else if mtls || config.AuthJWKSFile != "" {
+		return nil, nil, errors.New("TLS_CERT_FILE and TLS_KEY_FILE are required for authentication")
+	}
+
+	authenticators := []auth.Authenticator{}
+	if mtls {
+		authenticators = append(authenticators, auth.NewMTLSAuthenticator())
+	}
+	if config.AuthJWKSFile != "" {
+		jwt, err := auth.NewJWTAuthenticator(config.AuthJWKSFile, config.AuthJWTIssuer, config.AuthJWTAudience)
+		if err != nil {
+			return nil, nil, err
+		}
+		authenticators = append(authenticators, jwt)
+	}
+
+	if len(authenticators) == 0 {
+		logger.Warnw("authentication is disabled, every call is allowed")
+		return opts, nil, nil
+	}
+	if config.AuthPolicyFile == "" {
+		return nil, nil, errors.New("AUTH_POLICY_FILE is required when authentication is enabled")
+	}
+	policy, err := auth.LoadPolicy(config.AuthPolicyFile)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	guard := auth.NewGuard(logger, policy, authenticators, "/"+proto.SpawnerService_ServiceDesc.ServiceName+"/HealthCheck")
+	opts = append(opts, grpc.ChainStreamInterceptor(guard.StreamServerInterceptor()))
+	return opts, []interceptors.InterceptorOption{interceptors.WithInterecptor(guard.UnaryServerInterceptor())}, nil
+}
+
 func startGRPCServer(g *group.Group, config config.Config, logger *zap.SugaredLogger) {
 
 	address := fmt.Sprintf("%s:%d", "", config.Port)
-	service := service.New(logger)
+
+	store, err := inventory.Open(config.InventoryPath)
+	if err != nil {
+		logger.Errorw("startGRPCServer", "during", "inventory.Open", "error", err)
+		os.Exit(1)
+	}
+
+	sink, err := audit.Open(config.AuditLogPath)
+	if err != nil {
+		logger.Errorw("startGRPCServer", "during", "audit.Open", "error", err)
+		os.Exit(1)
+	}
+	auditLog, err := audit.NewLog(sink)
+	if err != nil {
+		logger.Errorw("startGRPCServer", "during", "audit.NewLog", "error", err)
+		os.Exit(1)
+	}
+
+	var quotas *quota.Manager
+	if config.QuotaFile != "" {
+		limits, err := quota.Load(config.QuotaFile)
+		if err != nil {
+			logger.Errorw("startGRPCServer", "during", "quota.Load", "error", err)
+			os.Exit(1)
+		}
+		quotas = quota.NewManager(logger, store, *limits)
+	}
+
+	if config.MachineCatalogFile != "" {
+		machines, err := common.LoadCatalog(config.MachineCatalogFile)
+		if err != nil {
+			logger.Errorw("startGRPCServer", "during", "common.LoadCatalog", "error", err)
+			os.Exit(1)
+		}
+		common.SetCatalog(common.DefaultCatalog().Merge(machines))
+	}
+
+	budgets, err := budget.Open(config.BudgetPath)
+	if err != nil {
+		logger.Errorw("startGRPCServer", "during", "budget.Open", "error", err)
+		os.Exit(1)
+	}
+
+	schedules, err := schedule.Open(config.SchedulePath)
+	if err != nil {
+		logger.Errorw("startGRPCServer", "during", "schedule.Open", "error", err)
+		os.Exit(1)
+	}
+
+	policies, err := backup.Open(config.SnapshotPolicyPath)
+	if err != nil {
+		logger.Errorw("startGRPCServer", "during", "backup.Open", "error", err)
+		os.Exit(1)
+	}
+
+	prices, err := pricing.NewBook(logger, config.PriceTableFile)
+	if err != nil {
+		logger.Errorw("startGRPCServer", "during", "pricing.NewBook", "error", err)
+		os.Exit(1)
+	}
+
+	service := service.New(logger, store, auditLog, quotas, budgets, schedules, prices, policies)
 	grpcServer := gateway.New(service)
 	listener, err := net.Listen("tcp", address)
 	if err != nil {
@@ -57,20 +175,37 @@ func startGRPCServer(g *group.Group, config config.Config, logger *zap.SugaredLo
 		os.Exit(1)
 	}
 
+	serverOptions, authInterceptors, err := authOptions(config, logger)
+	if err != nil {
+		logger.Errorw("startGRPCServer", "during", "authOptions", "error", err)
+		os.Exit(1)
+	}
+
+	//calls are authorized before they are audited and the idempotent responses are replayed
 	interceptors := interceptors.NewInterceptor("spawnerservice",
 		logger,
-		interceptors.WithInterecptor(metrics.RPCInstrumentation()))
+		append(authInterceptors,
+			interceptors.WithInterecptor(audit.UnaryServerInterceptor(logger, auditLog, gateway.MutatingMethods)),
+			interceptors.WithInterecptor(metrics.RPCInstrumentation()),
+			interceptors.WithInterecptor(idempotency.UnaryServerInterceptor(
+				idempotency.NewCache(logger, time.Duration(config.IdempotencyKeyRetentionMinutes)*time.Minute),
+				gateway.MutatingMethods)))...)
 
 	g.Add(func() error {
 		logger.Infow("startGRPCServer", "transport", "gRPC", "address", address)
 
-		baseServer := grpc.NewServer(interceptors.Get())
+		baseServer := grpc.NewServer(append(serverOptions, interceptors.Get())...)
 
 		proto.RegisterSpawnerServiceServer(baseServer, grpcServer)
 		return baseServer.Serve(listener)
 	}, func(error) {
 		logger.Errorw("startGRPCServer", "error", err)
 		listener.Close()
+		store.Close()
+		auditLog.Close()
+		budgets.Close()
+		schedules.Close()
+		policies.Close()
 	})
 
 }
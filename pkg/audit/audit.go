@@ -0,0 +1,204 @@
+package audit
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+var ERR_CHAIN_BROKEN = errors.New("audit log hash chain is broken")
+
+//SystemSubject subject of the calls spawner makes on its own, ex: lease janitor deleting the expired clusters
+const SystemSubject = "system:spawner"
+
+//Entry is the audit record of a call
+type Entry struct {
+	Sequence uint64    `json:"seq"`
+	Time     time.Time `json:"time"`
+	//Subject caller identity, empty when authentication is disabled
+	Subject    string `json:"subject,omitempty"`
+	AuthMethod string `json:"authMethod,omitempty"`
+	//Method rpc name, ex: DeleteCluster
+	Method   string `json:"method"`
+	Provider string `json:"provider,omitempty"`
+	Account  string `json:"account,omitempty"`
+	Region   string `json:"region,omitempty"`
+	//Target cluster, nodepool, volume or snapshot the call acts on
+	Target string `json:"target,omitempty"`
+	//Request json payload with the secrets redacted
+	Request string `json:"request,omitempty"`
+	//Outcome grpc status code of the call, OK when succeeded
+	Outcome     string `json:"outcome"`
+	Error       string `json:"error,omitempty"`
+	DurationMs  int64  `json:"durationMs"`
+	OperationID string `json:"operationId,omitempty"`
+	//PrevHash hash of the previous entry, empty for the first one
+	PrevHash string `json:"prevHash"`
+	//Hash sha256 of the previous hash and this entry, any change to the past entries breaks the chain
+	Hash string `json:"hash"`
+}
+
+//computeHash hash of the entry chained to the previous one
+func (e Entry) computeHash() (string, error) {
+	e.Hash = ""
+	b, err := json.Marshal(e)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to marshal audit entry")
+	}
+	h := sha256.New()
+	h.Write([]byte(e.PrevHash))
+	h.Write(b)
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+//Filter selects the entries in Query, empty fields match everything
+type Filter struct {
+	Subject  string
+	Method   string
+	Provider string
+	Account  string
+	Target   string
+	Since    time.Time
+	Until    time.Time
+	//Limit returns only the latest entries when set
+	Limit int
+}
+
+//Match check if the entry satisfy the filter
+func (f Filter) Match(e Entry) bool {
+	if f.Subject != "" && f.Subject != e.Subject {
+		return false
+	}
+	if f.Method != "" && f.Method != e.Method {
+		return false
+	}
+	if f.Provider != "" && f.Provider != e.Provider {
+		return false
+	}
+	if f.Account != "" && f.Account != e.Account {
+		return false
+	}
+	if f.Target != "" && f.Target != e.Target {
+		return false
+	}
+	if !f.Since.IsZero() && e.Time.Before(f.Since) {
+		return false
+	}
+	if !f.Until.IsZero() && !e.Time.Before(f.Until) {
+		return false
+	}
+	return true
+}
+
+//Sink stores the audit entries, entries must never be modified once appended
+type Sink interface {
+	//Append writes the entry after the last one
+	Append(e Entry) error
+	//Query returns the entries matching filter in the order they were appended
+	Query(f Filter) ([]Entry, error)
+	//Last returns the last appended entry, false when the sink is empty
+	Last() (Entry, bool, error)
+	Close() error
+}
+
+//Log appends the entries to the sink chaining each one to the hash of the previous entry
+type Log struct {
+	sink Sink
+	now  func() time.Time
+
+	mu   sync.Mutex
+	seq  uint64
+	last string
+}
+
+//NewLog continues the chain from the last entry in the sink, mutating calls are recorded by the grpc interceptor
+func NewLog(sink Sink) (*Log, error) {
+	l := &Log{
+		sink: sink,
+		now:  time.Now,
+	}
+	last, ok, err := sink.Last()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read last audit entry")
+	}
+	if ok {
+		l.seq = last.Sequence
+		l.last = last.Hash
+	}
+	return l, nil
+}
+
+//Record appends the entry, sequence, time and hashes are set by the log
+func (l *Log) Record(e Entry) (Entry, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	e.Sequence = l.seq + 1
+	if e.Time.IsZero() {
+		e.Time = l.now()
+	}
+	e.Time = e.Time.UTC().Round(0)
+	e.PrevHash = l.last
+
+	hash, err := e.computeHash()
+	if err != nil {
+		return e, err
+	}
+	e.Hash = hash
+
+	if err := l.sink.Append(e); err != nil {
+		return e, errors.Wrap(err, "failed to append audit entry")
+	}
+	l.seq = e.Sequence
+	l.last = e.Hash
+	return e, nil
+}
+
+//Query returns the entries matching filter, oldest first
+func (l *Log) Query(f Filter) ([]Entry, error) {
+	entries, err := l.sink.Query(f)
+	if err != nil {
+		return nil, err
+	}
+	if f.Limit > 0 && len(entries) > f.Limit {
+		entries = entries[len(entries)-f.Limit:]
+	}
+	return entries, nil
+}
+
+//Verify recomputes the hash chain of the whole log, ERR_CHAIN_BROKEN at the first entry which was altered,
+//removed or reordered
+func (l *Log) Verify() error {
+	entries, err := l.sink.Query(Filter{})
+	if err != nil {
+		return err
+	}
+
+	prev := ""
+	for i, e := range entries {
+		if e.Sequence != uint64(i+1) {
+			return errors.Wrapf(ERR_CHAIN_BROKEN, "expected sequence %d, found %d", i+1, e.Sequence)
+		}
+		if e.PrevHash != prev {
+			return errors.Wrapf(ERR_CHAIN_BROKEN, "entry %d is not chained to the previous entry", e.Sequence)
+		}
+		hash, err := e.computeHash()
+		if err != nil {
+			return err
+		}
+		if hash != e.Hash {
+			return errors.Wrapf(ERR_CHAIN_BROKEN, "entry %d was modified", e.Sequence)
+		}
+		prev = e.Hash
+	}
+	return nil
+}
+
+//Close closes the sink
+func (l *Log) Close() error {
+	return l.sink.Close()
+}
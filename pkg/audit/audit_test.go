@@ -0,0 +1,123 @@
+package audit
+
+import (
+	"context"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	proto "gitlab.com/netbook-devs/spawner-service/proto/netbookai/spawner"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func Test_FileLogChain(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit.log")
+	sink, err := NewFileSink(path)
+	require.NoError(t, err)
+	l, err := NewLog(sink)
+	require.NoError(t, err)
+
+	_, err = l.Record(Entry{Method: "CreateCluster", Account: "dev", Target: "c1", Outcome: "OK"})
+	require.NoError(t, err)
+	_, err = l.Record(Entry{Method: "DeleteCluster", Account: "dev", Target: "c1", Outcome: "OK"})
+	require.NoError(t, err)
+	require.NoError(t, l.Close())
+
+	//chain continues after reopening
+	sink, err = NewFileSink(path)
+	require.NoError(t, err)
+	l, err = NewLog(sink)
+	require.NoError(t, err)
+	e, err := l.Record(Entry{Method: "DeleteCluster", Account: "prod", Target: "c2", Outcome: "PermissionDenied"})
+	require.NoError(t, err)
+	assert.Equal(t, uint64(3), e.Sequence)
+	require.NoError(t, l.Verify())
+
+	entries, err := l.Query(Filter{Method: "DeleteCluster"})
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+	assert.Equal(t, "c1", entries[0].Target)
+
+	entries, err = l.Query(Filter{Limit: 1})
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	assert.Equal(t, uint64(3), entries[0].Sequence)
+	require.NoError(t, l.Close())
+
+	//rewriting history breaks the chain
+	b, err := ioutil.ReadFile(path)
+	require.NoError(t, err)
+	require.NoError(t, ioutil.WriteFile(path, []byte(strings.Replace(string(b), `"account":"prod"`, `"account":"dev"`, 1)), 0600))
+
+	sink, err = NewFileSink(path)
+	require.NoError(t, err)
+	l, err = NewLog(sink)
+	require.NoError(t, err)
+	defer l.Close()
+	err = l.Verify()
+	assert.Equal(t, ERR_CHAIN_BROKEN, errors.Cause(err))
+	assert.Contains(t, err.Error(), "entry 3")
+}
+
+func Test_Redact(t *testing.T) {
+	req := &proto.WriteCredentialRequest{
+		Account:  "dev",
+		Provider: "aws",
+		Cred: &proto.WriteCredentialRequest_AwsCred{AwsCred: &proto.AwsCredentials{
+			AccessKeyID:     "AKIA",
+			SecretAccessKey: "very-secret",
+			Token:           "session",
+		}},
+	}
+
+	out := Redact(req)
+	assert.NotContains(t, out, "very-secret")
+	assert.NotContains(t, out, "session")
+	assert.Contains(t, out, "AKIA")
+	assert.Contains(t, out, redacted)
+	//original request is untouched
+	assert.Equal(t, "very-secret", req.GetAwsCred().SecretAccessKey)
+}
+
+func Test_Interceptor(t *testing.T) {
+	l, err := NewLog(NewMemorySink())
+	require.NoError(t, err)
+	intercept := UnaryServerInterceptor(zap.NewNop().Sugar(), l, []string{"/spawner.SpawnerService/DeleteNode"})
+
+	req := &proto.NodeDeleteRequest{Provider: "aws", AccountName: "dev", Region: "us-west-2", ClusterName: "c1", NodeGroupName: "gpu"}
+	_, err = intercept(context.Background(), req, &grpc.UnaryServerInfo{FullMethod: "/spawner.SpawnerService/DeleteNode"}, func(ctx context.Context, req interface{}) (interface{}, error) {
+		return &proto.NodeDeleteResponse{OperationId: "op-1"}, nil
+	})
+	require.NoError(t, err)
+
+	_, err = intercept(context.Background(), req, &grpc.UnaryServerInfo{FullMethod: "/spawner.SpawnerService/DeleteNode"}, func(ctx context.Context, req interface{}) (interface{}, error) {
+		return nil, status.Error(codes.NotFound, "nodegroup not found")
+	})
+	assert.Error(t, err)
+
+	//read calls are not audited
+	_, err = intercept(context.Background(), &proto.GetClusterRequest{}, &grpc.UnaryServerInfo{FullMethod: "/spawner.SpawnerService/GetCluster"}, func(ctx context.Context, req interface{}) (interface{}, error) {
+		return &proto.ClusterSpec{}, nil
+	})
+	require.NoError(t, err)
+
+	entries, err := l.Query(Filter{})
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+
+	assert.Equal(t, "DeleteNode", entries[0].Method)
+	assert.Equal(t, "c1/gpu", entries[0].Target)
+	assert.Equal(t, "us-west-2", entries[0].Region)
+	assert.Equal(t, "OK", entries[0].Outcome)
+	assert.Equal(t, "op-1", entries[0].OperationID)
+	assert.Equal(t, "NotFound", entries[1].Outcome)
+	assert.Equal(t, entries[0].Hash, entries[1].PrevHash)
+	assert.NoError(t, l.Verify())
+}
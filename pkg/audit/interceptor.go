@@ -0,0 +1,147 @@
+package audit
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"gitlab.com/netbook-devs/spawner-service/pkg/auth"
+	proto "gitlab.com/netbook-devs/spawner-service/proto/netbookai/spawner"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+	gproto "google.golang.org/protobuf/proto"
+)
+
+type (
+	providerRequest   interface{ GetProvider() string }
+	accountRequest    interface{ GetAccountName() string }
+	credentialRequest interface{ GetAccount() string }
+	regionRequest     interface{ GetRegion() string }
+	clusterRequest    interface{ GetClusterName() string }
+	nodeGroupRequest  interface{ GetNodeGroupName() string }
+	nodeGroupsRequest interface{ GetNodeGroup() string }
+	nodeSpawnRequest  interface{ GetNodeSpec() *proto.NodeSpec }
+	volumeRequest     interface{ GetVolumeid() string }
+	recordRequest     interface{ GetRecordName() string }
+	operationRequest  interface{ GetOperationId() string }
+	workspaceRequest  interface{ GetWorkspace() string }
+	budgetRequest     interface{ GetBudget() *proto.Budget }
+	operationResponse interface{ GetOperationId() string }
+)
+
+//target resource the request acts on, 'cluster/nodepool' for the node pools
+func target(req interface{}) string {
+	cluster := ""
+	if r, ok := req.(clusterRequest); ok {
+		cluster = r.GetClusterName()
+	}
+
+	node := ""
+	switch r := req.(type) {
+	case nodeGroupRequest:
+		node = r.GetNodeGroupName()
+	case nodeGroupsRequest:
+		node = r.GetNodeGroup()
+	case nodeSpawnRequest:
+		node = r.GetNodeSpec().GetName()
+	}
+	if node != "" {
+		return fmt.Sprintf("%s/%s", cluster, node)
+	}
+	if cluster != "" {
+		return cluster
+	}
+
+	switch r := req.(type) {
+	case volumeRequest:
+		return r.GetVolumeid()
+	case recordRequest:
+		return r.GetRecordName()
+	case operationRequest:
+		return r.GetOperationId()
+	case workspaceRequest:
+		return r.GetWorkspace()
+	case budgetRequest:
+		return r.GetBudget().GetWorkspace()
+	}
+	return ""
+}
+
+//entry describes the call, outcome is set once the call completes
+func entry(ctx context.Context, fullMethod string, req interface{}) Entry {
+	e := Entry{
+		Method: fullMethod[strings.LastIndex(fullMethod, "/")+1:],
+		Target: target(req),
+	}
+	if id := auth.FromContext(ctx); id != nil {
+		e.Subject = id.Subject
+		e.AuthMethod = id.Method
+	}
+	if r, ok := req.(providerRequest); ok {
+		e.Provider = r.GetProvider()
+	}
+	switch r := req.(type) {
+	case accountRequest:
+		e.Account = r.GetAccountName()
+	case credentialRequest:
+		e.Account = r.GetAccount()
+	}
+	if r, ok := req.(regionRequest); ok {
+		e.Region = r.GetRegion()
+	}
+	if m, ok := req.(gproto.Message); ok {
+		e.Request = Redact(m)
+	}
+	return e
+}
+
+//UnaryServerInterceptor records the calls to the given full method names in the audit log,
+//must run after authentication to record the caller
+func UnaryServerInterceptor(logger *zap.SugaredLogger, l *Log, methods []string) grpc.UnaryServerInterceptor {
+	audited := make(map[string]bool, len(methods))
+	for _, m := range methods {
+		audited[m] = true
+	}
+
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if !audited[info.FullMethod] {
+			return handler(ctx, req)
+		}
+
+		e := entry(ctx, info.FullMethod, req)
+		start := time.Now()
+		resp, err := handler(ctx, req)
+
+		e.Time = start
+		e.DurationMs = time.Since(start).Milliseconds()
+		e.Outcome = status.Code(err).String()
+		if err != nil {
+			e.Error = err.Error()
+		}
+		if r, ok := resp.(operationResponse); ok && err == nil {
+			e.OperationID = r.GetOperationId()
+		}
+
+		if _, aerr := l.Record(e); aerr != nil {
+			logger.Errorw("failed to record audit entry", "method", e.Method, "subject", e.Subject, "error", aerr)
+		}
+		return resp, err
+	}
+}
+
+//RecordCall records the call spawner made on its own behalf outside of the grpc server, ex: deletion of the expired cluster
+func (l *Log) RecordCall(ctx context.Context, subject, method string, req interface{}, start time.Time, operationID string, err error) (Entry, error) {
+	e := entry(ctx, method, req)
+	e.Subject = subject
+	e.AuthMethod = ""
+	e.Time = start
+	e.DurationMs = time.Since(start).Milliseconds()
+	e.Outcome = status.Code(err).String()
+	if err != nil {
+		e.Error = err.Error()
+	}
+	e.OperationID = operationID
+	return l.Record(e)
+}
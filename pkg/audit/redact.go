@@ -0,0 +1,84 @@
+package audit
+
+import (
+	"strings"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	gproto "google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+const redacted = "REDACTED"
+
+//sensitiveFields parts of the field names holding secrets, ex: secretAccessKey, clientSecret, serviceAccountKey
+var sensitiveFields = []string{"secret", "password", "token", "privatekey", "serviceaccountkey"}
+
+func sensitive(fd protoreflect.FieldDescriptor) bool {
+	name := strings.ToLower(string(fd.Name()))
+	for _, s := range sensitiveFields {
+		if strings.Contains(name, s) {
+			return true
+		}
+	}
+	return false
+}
+
+func redactValue(fd protoreflect.FieldDescriptor) (protoreflect.Value, bool) {
+	switch fd.Kind() {
+	case protoreflect.StringKind:
+		return protoreflect.ValueOfString(redacted), true
+	case protoreflect.BytesKind:
+		return protoreflect.ValueOfBytes([]byte(redacted)), true
+	}
+	return protoreflect.Value{}, false
+}
+
+//redact replaces the sensitive string and bytes fields in place, nested messages included
+func redact(m protoreflect.Message) {
+	replace := map[protoreflect.FieldDescriptor]protoreflect.Value{}
+
+	m.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		switch {
+		case fd.IsList():
+			list := v.List()
+			for i := 0; i < list.Len(); i++ {
+				if fd.Kind() == protoreflect.MessageKind {
+					redact(list.Get(i).Message())
+				} else if r, ok := redactValue(fd); ok && sensitive(fd) {
+					list.Set(i, r)
+				}
+			}
+		case fd.IsMap():
+			v.Map().Range(func(k protoreflect.MapKey, mv protoreflect.Value) bool {
+				if fd.MapValue().Kind() == protoreflect.MessageKind {
+					redact(mv.Message())
+				} else if r, ok := redactValue(fd.MapValue()); ok && sensitive(fd) {
+					v.Map().Set(k, r)
+				}
+				return true
+			})
+		case fd.Kind() == protoreflect.MessageKind:
+			redact(v.Message())
+		case sensitive(fd):
+			if r, ok := redactValue(fd); ok {
+				replace[fd] = r
+			}
+		}
+		return true
+	})
+
+	for fd, v := range replace {
+		m.Set(fd, v)
+	}
+}
+
+//Redact json of the message with the secrets replaced, message is not modified
+func Redact(m gproto.Message) string {
+	c := gproto.Clone(m)
+	redact(c.ProtoReflect())
+	b, err := protojson.Marshal(c)
+	if err != nil {
+		return ""
+	}
+	return string(b)
+}
@@ -0,0 +1,150 @@
+package audit
+
+import (
+	"bufio"
+	"encoding/json"
+	"os"
+	"sync"
+
+	"github.com/pkg/errors"
+)
+
+//maxEntrySize longest json line read back from the file
+const maxEntrySize = 4 << 20
+
+//FileSink appends the entries as json lines to the file, file is opened in append only mode
+type FileSink struct {
+	path string
+
+	mu   sync.Mutex
+	file *os.File
+}
+
+//NewFileSink opens or creates the log file at path
+func NewFileSink(path string) (*FileSink, error) {
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to open audit log at '%s'", path)
+	}
+	return &FileSink{path: path, file: f}, nil
+}
+
+//Append writes the entry and syncs the file, entry is durable once Append returns
+func (s *FileSink) Append(e Entry) error {
+	b, err := json.Marshal(e)
+	if err != nil {
+		return err
+	}
+	b = append(b, '\n')
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, err := s.file.Write(b); err != nil {
+		return err
+	}
+	return s.file.Sync()
+}
+
+//scan calls fn for each entry in the file
+func (s *FileSink) scan(fn func(e Entry)) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	f, err := os.Open(s.path)
+	if err != nil {
+		return errors.Wrap(err, "failed to read audit log")
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 64*1024), maxEntrySize)
+	line := 0
+	for scanner.Scan() {
+		line++
+		var e Entry
+		if err := json.Unmarshal(scanner.Bytes(), &e); err != nil {
+			return errors.Wrapf(err, "corrupted audit log at line %d", line)
+		}
+		fn(e)
+	}
+	return scanner.Err()
+}
+
+func (s *FileSink) Query(f Filter) ([]Entry, error) {
+	entries := []Entry{}
+	err := s.scan(func(e Entry) {
+		if f.Match(e) {
+			entries = append(entries, e)
+		}
+	})
+	return entries, err
+}
+
+func (s *FileSink) Last() (Entry, bool, error) {
+	var (
+		last  Entry
+		found bool
+	)
+	err := s.scan(func(e Entry) {
+		last = e
+		found = true
+	})
+	return last, found, err
+}
+
+func (s *FileSink) Close() error {
+	return s.file.Close()
+}
+
+//MemorySink keeps the entries in memory, used when no audit log path is configured and in tests
+type MemorySink struct {
+	mu      sync.RWMutex
+	entries []Entry
+}
+
+//NewMemorySink returns empty in-memory sink
+func NewMemorySink() *MemorySink {
+	return &MemorySink{}
+}
+
+func (m *MemorySink) Append(e Entry) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.entries = append(m.entries, e)
+	return nil
+}
+
+func (m *MemorySink) Query(f Filter) ([]Entry, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	entries := []Entry{}
+	for _, e := range m.entries {
+		if f.Match(e) {
+			entries = append(entries, e)
+		}
+	}
+	return entries, nil
+}
+
+func (m *MemorySink) Last() (Entry, bool, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	if len(m.entries) == 0 {
+		return Entry{}, false, nil
+	}
+	return m.entries[len(m.entries)-1], true, nil
+}
+
+func (m *MemorySink) Close() error {
+	return nil
+}
+
+//Open opens the file sink at the path, in-memory sink is returned when path is empty
+func Open(path string) (Sink, error) {
+	if path == "" {
+		return NewMemorySink(), nil
+	}
+	return NewFileSink(path)
+}
@@ -0,0 +1,59 @@
+package auth
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+)
+
+var (
+	//ERR_NO_CREDENTIALS request does not carry the credentials of the authenticator, next authenticator is tried
+	ERR_NO_CREDENTIALS    = errors.New("no credentials")
+	ERR_UNAUTHENTICATED   = errors.New("unauthenticated")
+	ERR_PERMISSION_DENIED = errors.New("permission denied")
+)
+
+//Identity is the authenticated caller
+type Identity struct {
+	//Subject certificate uri SAN or common name, jwt sub claim
+	Subject string
+	//Method authenticator which verified the identity, 'mtls' or 'jwt'
+	Method string
+}
+
+//Authenticator verifies the credentials carried by the request
+type Authenticator interface {
+	//Authenticate returns the caller identity, ERR_NO_CREDENTIALS when the request has none of its credentials
+	Authenticate(ctx context.Context) (*Identity, error)
+}
+
+type identityKey struct{}
+type authorizerKey struct{}
+
+//authorizer checks the calls made by the identity in the request context
+type authorizer struct {
+	policy   *Policy
+	identity *Identity
+}
+
+func newContext(ctx context.Context, a *authorizer) context.Context {
+	ctx = context.WithValue(ctx, identityKey{}, a.identity)
+	return context.WithValue(ctx, authorizerKey{}, a)
+}
+
+//FromContext returns the identity of the caller, nil when authentication is disabled
+func FromContext(ctx context.Context) *Identity {
+	id, _ := ctx.Value(identityKey{}).(*Identity)
+	return id
+}
+
+//Authorize checks whether the caller can call method on the provider account, used for the resources
+//which are looked up by id and only known after the request is authorized. always allowed when authentication is disabled,
+//denied calls fail with codes.PermissionDenied
+func Authorize(ctx context.Context, method, provider, account string) error {
+	a, ok := ctx.Value(authorizerKey{}).(*authorizer)
+	if !ok {
+		return nil
+	}
+	return statusError(a.policy.Authorize(a.identity, Request{Method: method, Provider: &provider, Account: &account}))
+}
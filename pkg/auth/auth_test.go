@@ -0,0 +1,180 @@
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/json"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	proto "gitlab.com/netbook-devs/spawner-service/proto/netbookai/spawner"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	jose "gopkg.in/square/go-jose.v2"
+	"gopkg.in/square/go-jose.v2/jwt"
+)
+
+const policyYaml = `
+rules:
+  - subjects: ["ci"]
+    accounts: ["dev-*"]
+    providers: ["aws", "fake"]
+    methods: ["CreateCluster", "Get*"]
+  - subjects: ["admin"]
+    accounts: ["*"]
+    providers: ["*"]
+    methods: ["*"]
+`
+
+func writeFile(t *testing.T, name, content string) string {
+	p := filepath.Join(t.TempDir(), name)
+	require.NoError(t, ioutil.WriteFile(p, []byte(content), 0600))
+	return p
+}
+
+func Test_Policy(t *testing.T) {
+	p, err := LoadPolicy(writeFile(t, "policy.yaml", policyYaml))
+	require.NoError(t, err)
+
+	ci := &Identity{Subject: "ci"}
+	admin := &Identity{Subject: "admin"}
+
+	allowed := request("CreateCluster", &proto.ClusterRequest{Provider: "aws", AccountName: "dev-1"})
+	assert.NoError(t, p.Authorize(ci, allowed))
+	assert.NoError(t, p.Authorize(ci, request("GetCluster", &proto.GetClusterRequest{Provider: "fake", AccountName: "dev-2"})))
+
+	assert.Equal(t, ERR_PERMISSION_DENIED, errors.Cause(p.Authorize(ci, request("DeleteCluster", &proto.ClusterDeleteRequest{Provider: "aws", AccountName: "dev-1"}))))
+	assert.Equal(t, ERR_PERMISSION_DENIED, errors.Cause(p.Authorize(ci, request("CreateCluster", &proto.ClusterRequest{Provider: "azure", AccountName: "dev-1"}))))
+	assert.Equal(t, ERR_PERMISSION_DENIED, errors.Cause(p.Authorize(ci, request("CreateCluster", &proto.ClusterRequest{Provider: "aws", AccountName: "prod"}))))
+	//credential calls carry the account in a different field
+	assert.Equal(t, ERR_PERMISSION_DENIED, errors.Cause(p.Authorize(ci, request("GetKubeConfig", &proto.ReadCredentialRequest{Provider: "aws", Account: "prod"}))))
+	//listing all the accounts requires '*'
+	assert.Equal(t, ERR_PERMISSION_DENIED, errors.Cause(p.Authorize(ci, request("GetClusters", &proto.GetClustersRequest{Provider: "aws"}))))
+	assert.NoError(t, p.Authorize(admin, request("ListOperations", &proto.ListOperationsRequest{})))
+	assert.Equal(t, ERR_PERMISSION_DENIED, errors.Cause(p.Authorize(&Identity{Subject: "other"}, allowed)))
+
+	_, err = LoadPolicy(writeFile(t, "bad.yaml", "rules:\n  - subject: [ci]\n"))
+	assert.Error(t, err)
+}
+
+type jwtFixture struct {
+	key  *rsa.PrivateKey
+	auth *JWTAuthenticator
+}
+
+func newJWTFixture(t *testing.T) jwtFixture {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	jwks := jose.JSONWebKeySet{Keys: []jose.JSONWebKey{{Key: key.Public(), KeyID: "k1", Algorithm: "RS256", Use: "sig"}}}
+	b, err := json.Marshal(jwks)
+	require.NoError(t, err)
+
+	a, err := NewJWTAuthenticator(writeFile(t, "jwks.json", string(b)), "issuer", "spawner")
+	require.NoError(t, err)
+	return jwtFixture{key: key, auth: a}
+}
+
+func (f jwtFixture) token(t *testing.T, claims jwt.Claims) context.Context {
+	signer, err := jose.NewSigner(jose.SigningKey{Algorithm: jose.RS256, Key: f.key}, (&jose.SignerOptions{}).WithHeader("kid", "k1"))
+	require.NoError(t, err)
+	raw, err := jwt.Signed(signer).Claims(claims).CompactSerialize()
+	require.NoError(t, err)
+	return metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer "+raw))
+}
+
+func Test_JWTAuthenticator(t *testing.T) {
+	f := newJWTFixture(t)
+	valid := jwt.Claims{
+		Subject:  "ci",
+		Issuer:   "issuer",
+		Audience: jwt.Audience{"spawner"},
+		Expiry:   jwt.NewNumericDate(time.Now().Add(time.Hour)),
+	}
+
+	id, err := f.auth.Authenticate(f.token(t, valid))
+	require.NoError(t, err)
+	assert.Equal(t, &Identity{Subject: "ci", Method: "jwt"}, id)
+
+	_, err = f.auth.Authenticate(context.Background())
+	assert.Equal(t, ERR_NO_CREDENTIALS, err)
+
+	expired := valid
+	expired.Expiry = jwt.NewNumericDate(time.Now().Add(-time.Hour))
+	_, err = f.auth.Authenticate(f.token(t, expired))
+	assert.Equal(t, ERR_UNAUTHENTICATED, errors.Cause(err))
+
+	audience := valid
+	audience.Audience = jwt.Audience{"other"}
+	_, err = f.auth.Authenticate(f.token(t, audience))
+	assert.Equal(t, ERR_UNAUTHENTICATED, errors.Cause(err))
+
+	//signed by a key not in jwks
+	other, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	_, err = f.auth.Authenticate(jwtFixture{key: other}.token(t, valid))
+	assert.Equal(t, ERR_UNAUTHENTICATED, errors.Cause(err))
+}
+
+func Test_MTLSAuthenticator(t *testing.T) {
+	cert := &x509.Certificate{Subject: pkix.Name{CommonName: "ci"}}
+	ctx := peer.NewContext(context.Background(), &peer.Peer{
+		AuthInfo: credentials.TLSInfo{State: tls.ConnectionState{VerifiedChains: [][]*x509.Certificate{{cert}}}},
+	})
+
+	id, err := NewMTLSAuthenticator().Authenticate(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, &Identity{Subject: "ci", Method: "mtls"}, id)
+
+	_, err = NewMTLSAuthenticator().Authenticate(context.Background())
+	assert.Equal(t, ERR_NO_CREDENTIALS, err)
+}
+
+func Test_Guard(t *testing.T) {
+	p, err := LoadPolicy(writeFile(t, "policy.yaml", policyYaml))
+	require.NoError(t, err)
+	f := newJWTFixture(t)
+	g := NewGuard(zap.NewNop().Sugar(), p, []Authenticator{NewMTLSAuthenticator(), f.auth}, "/spawner.SpawnerService/HealthCheck")
+	intercept := g.UnaryServerInterceptor()
+
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return FromContext(ctx), nil
+	}
+	info := &grpc.UnaryServerInfo{FullMethod: "/spawner.SpawnerService/CreateCluster"}
+	req := &proto.ClusterRequest{Provider: "aws", AccountName: "dev-1"}
+
+	_, err = intercept(context.Background(), req, info, handler)
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	_, err = intercept(context.Background(), &proto.Empty{}, &grpc.UnaryServerInfo{FullMethod: "/spawner.SpawnerService/HealthCheck"}, handler)
+	assert.NoError(t, err)
+
+	ctx := f.token(t, jwt.Claims{Subject: "ci", Issuer: "issuer", Audience: jwt.Audience{"spawner"}, Expiry: jwt.NewNumericDate(time.Now().Add(time.Hour))})
+	id, err := intercept(ctx, req, info, handler)
+	require.NoError(t, err)
+	assert.Equal(t, "ci", id.(*Identity).Subject)
+
+	_, err = intercept(ctx, &proto.ReadCredentialRequest{Provider: "aws", Account: "dev-1"}, &grpc.UnaryServerInfo{FullMethod: "/spawner.SpawnerService/ReadCredential"}, handler)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	//resources looked up by id are authorized by the service
+	_, err = intercept(ctx, &proto.GetOperationRequest{}, &grpc.UnaryServerInfo{FullMethod: "/spawner.SpawnerService/GetOperation"}, func(ctx context.Context, req interface{}) (interface{}, error) {
+		return nil, Authorize(ctx, "GetOperation", "azure", "dev-1")
+	})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	assert.NoError(t, Authorize(context.Background(), "GetOperation", "azure", "dev-1"))
+}
@@ -0,0 +1,160 @@
+package auth
+
+import (
+	"context"
+	"strings"
+
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type providerRequest interface {
+	GetProvider() string
+}
+
+type accountRequest interface {
+	GetAccountName() string
+}
+
+//credentialRequest credential calls name the account field differently
+type credentialRequest interface {
+	GetAccount() string
+}
+
+//request extracts the provider and account the call acts on
+func request(method string, req interface{}) Request {
+	r := Request{Method: method}
+	if p, ok := req.(providerRequest); ok {
+		provider := p.GetProvider()
+		r.Provider = &provider
+	}
+	switch a := req.(type) {
+	case accountRequest:
+		account := a.GetAccountName()
+		r.Account = &account
+	case credentialRequest:
+		account := a.GetAccount()
+		r.Account = &account
+	}
+	return r
+}
+
+func methodName(fullMethod string) string {
+	return fullMethod[strings.LastIndex(fullMethod, "/")+1:]
+}
+
+func statusError(err error) error {
+	switch errors.Cause(err) {
+	case ERR_UNAUTHENTICATED:
+		return status.Error(codes.Unauthenticated, err.Error())
+	case ERR_PERMISSION_DENIED:
+		return status.Error(codes.PermissionDenied, err.Error())
+	}
+	return err
+}
+
+//Guard authenticates the calls with the first authenticator finding its credentials in the request
+//and authorizes them against the policy
+type Guard struct {
+	logger         *zap.SugaredLogger
+	authenticators []Authenticator
+	policy         *Policy
+	//public methods served without authentication, ex: HealthCheck
+	public map[string]bool
+}
+
+//NewGuard returns the guard, public full method names are served without authentication
+func NewGuard(logger *zap.SugaredLogger, policy *Policy, authenticators []Authenticator, public ...string) *Guard {
+	g := &Guard{
+		logger:         logger,
+		authenticators: authenticators,
+		policy:         policy,
+		public:         make(map[string]bool),
+	}
+	for _, m := range public {
+		g.public[m] = true
+	}
+	return g
+}
+
+func (g *Guard) authenticate(ctx context.Context) (*Identity, error) {
+	for _, a := range g.authenticators {
+		id, err := a.Authenticate(ctx)
+		if err == ERR_NO_CREDENTIALS {
+			continue
+		}
+		return id, err
+	}
+	return nil, errors.Wrap(ERR_UNAUTHENTICATED, "no credentials provided")
+}
+
+//check authenticates and authorizes the call, returned context carries the identity
+func (g *Guard) check(ctx context.Context, fullMethod string, req interface{}) (context.Context, error) {
+	id, err := g.authenticate(ctx)
+	if err != nil {
+		g.logger.Warnw("unauthenticated call", "method", fullMethod, "error", err)
+		return nil, statusError(err)
+	}
+
+	if err := g.policy.Authorize(id, request(methodName(fullMethod), req)); err != nil {
+		g.logger.Warnw("call denied", "method", fullMethod, "subject", id.Subject, "error", err)
+		return nil, statusError(err)
+	}
+	return newContext(ctx, &authorizer{policy: g.policy, identity: id}), nil
+}
+
+//UnaryServerInterceptor guards the unary calls
+func (g *Guard) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if g.public[info.FullMethod] {
+			return handler(ctx, req)
+		}
+		ctx, err := g.check(ctx, info.FullMethod, req)
+		if err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+//guardedStream authorizes the server streaming call once its request is received
+type guardedStream struct {
+	grpc.ServerStream
+	guard      *Guard
+	fullMethod string
+	ctx        context.Context
+}
+
+func (s *guardedStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *guardedStream) RecvMsg(m interface{}) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	ctx, err := s.guard.check(s.ServerStream.Context(), s.fullMethod, m)
+	if err != nil {
+		return err
+	}
+	s.ctx = ctx
+	return nil
+}
+
+//StreamServerInterceptor guards the streaming calls, caller must be authenticated before the request is read
+//and the request is authorized when the handler receives it
+func (g *Guard) StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if g.public[info.FullMethod] {
+			return handler(srv, ss)
+		}
+		if _, err := g.authenticate(ss.Context()); err != nil {
+			g.logger.Warnw("unauthenticated call", "method", info.FullMethod, "error", err)
+			return statusError(err)
+		}
+		return handler(srv, &guardedStream{ServerStream: ss, guard: g, fullMethod: info.FullMethod, ctx: ss.Context()})
+	}
+}
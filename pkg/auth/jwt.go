@@ -0,0 +1,117 @@
+package auth
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/metadata"
+	jose "gopkg.in/square/go-jose.v2"
+	"gopkg.in/square/go-jose.v2/jwt"
+)
+
+const bearerPrefix = "bearer "
+
+//jwtLeeway allowed clock skew validating exp and nbf claims
+const jwtLeeway = time.Minute
+
+//JWTAuthenticator identifies the caller by the bearer token in 'authorization' metadata,
+//token must be signed by one of the keys in the local JWKS file
+type JWTAuthenticator struct {
+	keys     jose.JSONWebKeySet
+	issuer   string
+	audience string
+	now      func() time.Time
+}
+
+//NewJWTAuthenticator loads the keys from JWKS file, issuer and audience are validated when not empty
+func NewJWTAuthenticator(jwksFile, issuer, audience string) (*JWTAuthenticator, error) {
+	b, err := ioutil.ReadFile(jwksFile)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read jwks")
+	}
+	var keys jose.JSONWebKeySet
+	if err := json.Unmarshal(b, &keys); err != nil {
+		return nil, errors.Wrapf(err, "invalid jwks '%s'", jwksFile)
+	}
+	if len(keys.Keys) == 0 {
+		return nil, errors.Errorf("no keys found in jwks '%s'", jwksFile)
+	}
+	return &JWTAuthenticator{
+		keys:     keys,
+		issuer:   issuer,
+		audience: audience,
+		now:      time.Now,
+	}, nil
+}
+
+func bearerToken(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+	for _, v := range md.Get("authorization") {
+		if len(v) > len(bearerPrefix) && strings.EqualFold(v[:len(bearerPrefix)], bearerPrefix) {
+			return strings.TrimSpace(v[len(bearerPrefix):])
+		}
+	}
+	return ""
+}
+
+func (j *JWTAuthenticator) key(tok *jwt.JSONWebToken) (*jose.JSONWebKey, error) {
+	if len(tok.Headers) == 0 {
+		return nil, errors.New("token has no header")
+	}
+	kid := tok.Headers[0].KeyID
+	if kid == "" {
+		if len(j.keys.Keys) == 1 {
+			return &j.keys.Keys[0], nil
+		}
+		return nil, errors.New("token has no key id")
+	}
+	keys := j.keys.Key(kid)
+	if len(keys) == 0 {
+		return nil, errors.Errorf("unknown key id '%s'", kid)
+	}
+	return &keys[0], nil
+}
+
+//Authenticate subject is the sub claim of the token
+func (j *JWTAuthenticator) Authenticate(ctx context.Context) (*Identity, error) {
+	raw := bearerToken(ctx)
+	if raw == "" {
+		return nil, ERR_NO_CREDENTIALS
+	}
+
+	tok, err := jwt.ParseSigned(raw)
+	if err != nil {
+		return nil, errors.Wrap(ERR_UNAUTHENTICATED, "malformed token")
+	}
+	key, err := j.key(tok)
+	if err != nil {
+		return nil, errors.Wrap(ERR_UNAUTHENTICATED, err.Error())
+	}
+
+	claims := jwt.Claims{}
+	if err := tok.Claims(key.Public(), &claims); err != nil {
+		return nil, errors.Wrap(ERR_UNAUTHENTICATED, "invalid token signature")
+	}
+
+	expected := jwt.Expected{Issuer: j.issuer, Time: j.now()}
+	if j.audience != "" {
+		expected.Audience = jwt.Audience{j.audience}
+	}
+	if err := claims.ValidateWithLeeway(expected, jwtLeeway); err != nil {
+		return nil, errors.Wrap(ERR_UNAUTHENTICATED, err.Error())
+	}
+	if claims.Expiry == nil {
+		return nil, errors.Wrap(ERR_UNAUTHENTICATED, "token must expire")
+	}
+	if claims.Subject == "" {
+		return nil, errors.Wrap(ERR_UNAUTHENTICATED, "token has no subject")
+	}
+	return &Identity{Subject: claims.Subject, Method: "jwt"}, nil
+}
@@ -0,0 +1,74 @@
+package auth
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"io/ioutil"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+)
+
+//MTLSAuthenticator identifies the caller by the verified tls client certificate
+type MTLSAuthenticator struct{}
+
+//NewMTLSAuthenticator returns the authenticator, server must be configured with ServerTLSConfig
+func NewMTLSAuthenticator() *MTLSAuthenticator {
+	return &MTLSAuthenticator{}
+}
+
+//Authenticate subject is the first uri SAN of the certificate (ex: spiffe id), common name otherwise
+func (m *MTLSAuthenticator) Authenticate(ctx context.Context) (*Identity, error) {
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.AuthInfo == nil {
+		return nil, ERR_NO_CREDENTIALS
+	}
+	info, ok := p.AuthInfo.(credentials.TLSInfo)
+	if !ok || len(info.State.VerifiedChains) == 0 || len(info.State.VerifiedChains[0]) == 0 {
+		return nil, ERR_NO_CREDENTIALS
+	}
+
+	cert := info.State.VerifiedChains[0][0]
+	subject := cert.Subject.CommonName
+	if len(cert.URIs) > 0 {
+		subject = cert.URIs[0].String()
+	}
+	if subject == "" {
+		return nil, errors.Wrap(ERR_UNAUTHENTICATED, "client certificate has no subject")
+	}
+	return &Identity{Subject: subject, Method: "mtls"}, nil
+}
+
+//ServerTLSConfig tls config serving the cert, client certificates signed by clientCA are verified when present.
+//
+//client certificate is not required when requireClientCert is false, so that callers can use bearer tokens instead
+func ServerTLSConfig(certFile, keyFile, clientCAFile string, requireClientCert bool) (*tls.Config, error) {
+	cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to load server certificate")
+	}
+	conf := &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		MinVersion:   tls.VersionTLS12,
+	}
+	if clientCAFile == "" {
+		return conf, nil
+	}
+
+	pem, err := ioutil.ReadFile(clientCAFile)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read client ca")
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(pem) {
+		return nil, errors.Errorf("no certificates found in client ca '%s'", clientCAFile)
+	}
+	conf.ClientCAs = pool
+	conf.ClientAuth = tls.VerifyClientCertIfGiven
+	if requireClientCert {
+		conf.ClientAuth = tls.RequireAndVerifyClientCert
+	}
+	return conf, nil
+}
@@ -0,0 +1,99 @@
+package auth
+
+import (
+	"io/ioutil"
+	"path"
+
+	"github.com/pkg/errors"
+	"gopkg.in/yaml.v2"
+)
+
+//Rule allows the subjects to call the methods on the accounts of the providers.
+//
+//values are glob patterns matched with path.Match, ex: "*", "Get*", "netbook-*"
+type Rule struct {
+	Subjects  []string `yaml:"subjects"`
+	Accounts  []string `yaml:"accounts"`
+	Providers []string `yaml:"providers"`
+	//Methods rpc names, ex: CreateCluster
+	Methods []string `yaml:"methods"`
+}
+
+//Policy maps identities to the allowed calls, calls are denied unless a rule allows them
+type Policy struct {
+	Rules []Rule `yaml:"rules"`
+}
+
+//Request is the call being authorized
+//
+//Provider and Account are nil for the calls which do not carry them, ex: GetOperation,
+//they must be authorized once the resource is looked up. empty value means all the providers or accounts,
+//ex: ListResources without account, and is allowed only by the '*' pattern.
+type Request struct {
+	Method   string
+	Provider *string
+	Account  *string
+}
+
+//LoadPolicy reads the yaml policy file
+func LoadPolicy(file string) (*Policy, error) {
+	b, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read auth policy")
+	}
+	p := &Policy{}
+	if err := yaml.UnmarshalStrict(b, p); err != nil {
+		return nil, errors.Wrapf(err, "invalid auth policy '%s'", file)
+	}
+	for i, r := range p.Rules {
+		for _, patterns := range [][]string{r.Subjects, r.Accounts, r.Providers, r.Methods} {
+			for _, pattern := range patterns {
+				if _, err := path.Match(pattern, ""); err != nil {
+					return nil, errors.Wrapf(err, "invalid pattern '%s' in rule %d", pattern, i)
+				}
+			}
+		}
+	}
+	return p, nil
+}
+
+func matchAny(patterns []string, value string) bool {
+	for _, p := range patterns {
+		if p == "*" {
+			return true
+		}
+		if value == "" {
+			continue
+		}
+		if ok, _ := path.Match(p, value); ok {
+			return true
+		}
+	}
+	return false
+}
+
+func (r Rule) allows(id *Identity, req Request) bool {
+	if !matchAny(r.Subjects, id.Subject) || !matchAny(r.Methods, req.Method) {
+		return false
+	}
+	if req.Provider != nil && !matchAny(r.Providers, *req.Provider) {
+		return false
+	}
+	if req.Account != nil && !matchAny(r.Accounts, *req.Account) {
+		return false
+	}
+	return true
+}
+
+//Authorize returns ERR_PERMISSION_DENIED unless any of the rules allow the request
+func (p *Policy) Authorize(id *Identity, req Request) error {
+	if id == nil {
+		return ERR_UNAUTHENTICATED
+	}
+	for _, r := range p.Rules {
+		if r.allows(id, req) {
+			return nil
+		}
+	}
+	return errors.Wrapf(ERR_PERMISSION_DENIED, "'%s' is not allowed to call %s", id.Subject, req.Method)
+}
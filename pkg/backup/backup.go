@@ -0,0 +1,150 @@
+package backup
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+var (
+	ERR_NOT_FOUND      = errors.New("snapshot policy not found")
+	ERR_INVALID_POLICY = errors.New("invalid snapshot policy")
+)
+
+//labels of the snapshots taken by the policies, only snapshots carrying the policy label are pruned
+const (
+	PolicyLabel       = "snapshot-policy"
+	PolicyVolumeLabel = "snapshot-policy-volume"
+)
+
+//Frequency how often the volumes of the policy are snapshotted
+type Frequency string
+
+const (
+	Hourly Frequency = "hourly"
+	Daily  Frequency = "daily"
+	Weekly Frequency = "weekly"
+)
+
+//Period between the runs of the frequency, false when frequency is unknown
+func (f Frequency) Period() (time.Duration, bool) {
+	switch f {
+	case Hourly:
+		return time.Hour, true
+	case Daily:
+		return 24 * time.Hour, true
+	case Weekly:
+		return 7 * 24 * time.Hour, true
+	}
+	return 0, false
+}
+
+//Policy snapshots the volumes of the region carrying all the selector labels, snapshots over the
+//retention count or older than retention age are pruned
+type Policy struct {
+	Name     string `json:"name"`
+	Provider string `json:"provider"`
+	Account  string `json:"account"`
+	Region   string `json:"region"`
+	//Selector labels the volumes must carry
+	Selector  map[string]string `json:"selector"`
+	Frequency Frequency         `json:"frequency"`
+	//RetainCount newest snapshots kept per volume, 0 keeps all
+	RetainCount int `json:"retainCount"`
+	//RetainHours snapshots older than this are deleted, 0 keeps them
+	RetainHours int64 `json:"retainHours"`
+	//CopyRegion snapshots are copied to the region when set
+	CopyRegion string    `json:"copyRegion"`
+	UpdatedAt  time.Time `json:"updatedAt"`
+}
+
+//Validate checks the policy, at least one retention limit is required so that snapshots do not pile up
+func (p *Policy) Validate() error {
+	if p.Name == "" || p.Provider == "" || p.Account == "" || p.Region == "" {
+		return errors.Wrap(ERR_INVALID_POLICY, "name, provider, account and region are required")
+	}
+	if len(p.Selector) == 0 {
+		return errors.Wrap(ERR_INVALID_POLICY, "at least one selector label is required")
+	}
+	if _, ok := p.Frequency.Period(); !ok {
+		return errors.Wrapf(ERR_INVALID_POLICY, "frequency must be hourly, daily or weekly, got '%s'", p.Frequency)
+	}
+	if p.RetainCount < 0 || p.RetainHours < 0 {
+		return errors.Wrap(ERR_INVALID_POLICY, "retention can not be negative")
+	}
+	if p.RetainCount == 0 && p.RetainHours == 0 {
+		return errors.Wrap(ERR_INVALID_POLICY, "retention count or age is required")
+	}
+	if p.CopyRegion == p.Region {
+		return errors.Wrap(ERR_INVALID_POLICY, "copy region must differ from the region")
+	}
+	return nil
+}
+
+//Regions the snapshots of the policy are kept in
+func (p Policy) Regions() []string {
+	if p.CopyRegion != "" {
+		return []string{p.Region, p.CopyRegion}
+	}
+	return []string{p.Region}
+}
+
+//expired whether the snapshot at index i of the volume snapshots sorted newest first is to be pruned,
+//newest snapshot of the volume is always kept
+func (p Policy) expired(i int, created, now time.Time) bool {
+	if i == 0 {
+		return false
+	}
+	if p.RetainCount > 0 && i >= p.RetainCount {
+		return true
+	}
+	if p.RetainHours > 0 && !created.IsZero() && now.Sub(created) > time.Duration(p.RetainHours)*time.Hour {
+		return true
+	}
+	return false
+}
+
+//Status of the policy, result of the last run
+type Status struct {
+	Policy Policy
+	//LastRunAt time the last run started, zero until the policy ran
+	LastRunAt time.Time
+	//Snapshots taken, copied and deleted by the last run
+	Snapshots []string
+	Copies    []string
+	Pruned    []string
+	//Error of the last run
+	Error string
+}
+
+//NextRunAt time the policy runs next, zero when it runs on the next check
+func (st Status) NextRunAt() time.Time {
+	period, ok := st.Policy.Frequency.Period()
+	if !ok || st.LastRunAt.IsZero() || st.retry() {
+		return time.Time{}
+	}
+	return st.LastRunAt.Add(period)
+}
+
+//retry runs which failed before a snapshot was taken are repeated on the next check
+func (st Status) retry() bool {
+	return st.Error != "" && len(st.Snapshots) == 0
+}
+
+//Store persists the policies
+type Store interface {
+	Put(ctx context.Context, p Policy) error
+	Get(ctx context.Context, name string) (Policy, error)
+	Delete(ctx context.Context, name string) error
+	List(ctx context.Context) ([]Policy, error)
+	Close() error
+}
+
+//Open returns the file store at path, memory store when path is empty
+func Open(path string) (Store, error) {
+	if path == "" {
+		return NewMemoryStore(), nil
+	}
+	return NewFileStore(path)
+}
@@ -0,0 +1,216 @@
+package backup
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	proto "gitlab.com/netbook-devs/spawner-service/proto/netbookai/spawner"
+	"go.uber.org/zap"
+)
+
+//fakeBackend keeps the snapshots in memory, snapshots are created at now
+type fakeBackend struct {
+	now       func() time.Time
+	volumes   []*proto.Volume
+	snapshots map[string][]*proto.Snapshot
+	seq       int
+	fail      bool
+	//failVolume volume whose snapshot fails
+	failVolume string
+}
+
+func (f *fakeBackend) Volumes(ctx context.Context, p Policy) ([]*proto.Volume, error) {
+	if f.fail {
+		return nil, errors.New("provider unavailable")
+	}
+	return f.volumes, nil
+}
+
+func (f *fakeBackend) Snapshots(ctx context.Context, p Policy, region string) ([]*proto.Snapshot, error) {
+	return f.snapshots[region], nil
+}
+
+func (f *fakeBackend) add(region string, labels map[string]string) string {
+	f.seq++
+	id := fmt.Sprintf("snap-%d", f.seq)
+	f.snapshots[region] = append(f.snapshots[region], &proto.Snapshot{SnapshotId: id, Region: region, Labels: labels, CreatedAt: f.now().Format(time.RFC3339)})
+	return id
+}
+
+func (f *fakeBackend) Snapshot(ctx context.Context, p Policy, v *proto.Volume, labels map[string]string) (string, error) {
+	if v.VolumeId == f.failVolume {
+		return "", errors.New("snapshot limit exceeded")
+	}
+	return f.add(p.Region, labels), nil
+}
+
+func (f *fakeBackend) Copy(ctx context.Context, p Policy, snapshotId string) (string, error) {
+	for _, s := range f.snapshots[p.Region] {
+		if s.SnapshotId == snapshotId {
+			return f.add(p.CopyRegion, s.Labels), nil
+		}
+	}
+	return "", errors.Errorf("snapshot '%s' not found", snapshotId)
+}
+
+func (f *fakeBackend) Delete(ctx context.Context, p Policy, region, snapshotId string) error {
+	kept := []*proto.Snapshot{}
+	for _, s := range f.snapshots[region] {
+		if s.SnapshotId != snapshotId {
+			kept = append(kept, s)
+		}
+	}
+	f.snapshots[region] = kept
+	return nil
+}
+
+func ids(snapshots []*proto.Snapshot) []string {
+	res := []string{}
+	for _, s := range snapshots {
+		res = append(res, s.SnapshotId)
+	}
+	return res
+}
+
+func Test_PolicyValidate(t *testing.T) {
+	p := Policy{Name: "nightly", Provider: "aws", Account: "netbook-aws", Region: "us-west-2", Selector: map[string]string{"backup": "true"}, Frequency: Daily, RetainCount: 7}
+	require.NoError(t, p.Validate())
+
+	invalid := []Policy{
+		{Provider: "aws", Account: "netbook-aws", Region: "us-west-2", Selector: p.Selector, Frequency: Daily, RetainCount: 7},
+		{Name: "nightly", Provider: "aws", Account: "netbook-aws", Region: "us-west-2", Frequency: Daily, RetainCount: 7},
+		{Name: "nightly", Provider: "aws", Account: "netbook-aws", Region: "us-west-2", Selector: p.Selector, Frequency: "monthly", RetainCount: 7},
+		{Name: "nightly", Provider: "aws", Account: "netbook-aws", Region: "us-west-2", Selector: p.Selector, Frequency: Daily},
+		{Name: "nightly", Provider: "aws", Account: "netbook-aws", Region: "us-west-2", Selector: p.Selector, Frequency: Daily, RetainCount: -1},
+		{Name: "nightly", Provider: "aws", Account: "netbook-aws", Region: "us-west-2", Selector: p.Selector, Frequency: Daily, RetainCount: 7, CopyRegion: "us-west-2"},
+	}
+	for _, p := range invalid {
+		assert.True(t, errors.Is(p.Validate(), ERR_INVALID_POLICY), "expected invalid policy %+v", p)
+	}
+}
+
+func Test_FileStore(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "policies.json")
+
+	s, err := NewFileStore(path)
+	require.NoError(t, err)
+	a := Policy{Name: "a", Provider: "fake", Account: "dev", Region: "local", Frequency: Hourly, RetainCount: 2}
+	b := Policy{Name: "b", Provider: "fake", Account: "dev", Region: "local", Frequency: Weekly, RetainHours: 24}
+	require.NoError(t, s.Put(ctx, a))
+	require.NoError(t, s.Put(ctx, b))
+	require.NoError(t, s.Delete(ctx, b.Name))
+
+	reopened, err := NewFileStore(path)
+	require.NoError(t, err)
+	policies, err := reopened.List(ctx)
+	require.NoError(t, err)
+	require.Len(t, policies, 1)
+	assert.Equal(t, a.RetainCount, policies[0].RetainCount)
+
+	_, err = reopened.Get(ctx, b.Name)
+	assert.True(t, errors.Is(err, ERR_NOT_FOUND))
+}
+
+func Test_Runner(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2022, 5, 9, 1, 0, 0, 0, time.UTC)
+	backend := &fakeBackend{
+		now:       func() time.Time { return now },
+		volumes:   []*proto.Volume{{VolumeId: "vol-1", Labels: map[string]string{"backup": "true", "workspaceid": "ws-1"}}},
+		snapshots: map[string][]*proto.Snapshot{},
+	}
+	r := NewRunner(zap.NewNop().Sugar(), NewMemoryStore(), backend)
+	r.now = func() time.Time { return now }
+
+	p, err := r.Set(ctx, Policy{Name: "nightly", Provider: "fake", Account: "dev", Region: "local", CopyRegion: "remote",
+		Selector: map[string]string{"backup": "true"}, Frequency: Daily, RetainCount: 2})
+	require.NoError(t, err)
+
+	st := r.Check(ctx, p)
+	require.Empty(t, st.Error)
+	assert.Equal(t, []string{"snap-1"}, st.Snapshots)
+	assert.Equal(t, []string{"snap-2"}, st.Copies)
+	snap := backend.snapshots["local"][0]
+	assert.Equal(t, "nightly", snap.Labels[PolicyLabel])
+	assert.Equal(t, "vol-1", snap.Labels[PolicyVolumeLabel])
+	assert.Equal(t, "ws-1", snap.Labels["workspaceid"])
+
+	//period has not passed
+	now = now.Add(time.Hour)
+	r.CheckAll(ctx)
+	assert.Len(t, backend.snapshots["local"], 1)
+
+	//oldest snapshot over the retention count is pruned in both regions
+	for i := 0; i < 2; i++ {
+		now = now.Add(24 * time.Hour)
+		r.CheckAll(ctx)
+	}
+	assert.Equal(t, []string{"snap-3", "snap-5"}, ids(backend.snapshots["local"]))
+	assert.Equal(t, []string{"snap-4", "snap-6"}, ids(backend.snapshots["remote"]))
+	st, err = r.Status(ctx, p.Name)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"snap-1", "snap-2"}, st.Pruned)
+	assert.Equal(t, now.Add(24*time.Hour), st.NextRunAt())
+
+	//failed run without snapshots is retried on next check
+	now = now.Add(24 * time.Hour)
+	backend.fail = true
+	st = r.Check(ctx, p)
+	assert.NotEmpty(t, st.Error)
+	assert.True(t, st.NextRunAt().IsZero())
+	backend.fail = false
+	now = now.Add(5 * time.Minute)
+	st = r.Check(ctx, p)
+	assert.Empty(t, st.Error)
+	assert.Equal(t, []string{"snap-7"}, st.Snapshots)
+
+	//last run is read from the newest snapshot after restart
+	restarted := NewRunner(zap.NewNop().Sugar(), r.store, backend)
+	restarted.now = r.now
+	restarted.CheckAll(ctx)
+	assert.Len(t, backend.snapshots["local"], 2, "policy must not run again before its period")
+
+	require.NoError(t, r.Delete(ctx, p.Name))
+	_, err = r.Status(ctx, p.Name)
+	assert.True(t, errors.Is(err, ERR_NOT_FOUND))
+}
+
+func Test_RunnerKeepsBackupsOfFailedVolumes(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2022, 5, 9, 1, 0, 0, 0, time.UTC)
+	backend := &fakeBackend{
+		now:       func() time.Time { return now },
+		volumes:   []*proto.Volume{{VolumeId: "vol-1"}, {VolumeId: "vol-2"}, {VolumeId: "vol-3"}},
+		snapshots: map[string][]*proto.Snapshot{},
+	}
+	r := NewRunner(zap.NewNop().Sugar(), NewMemoryStore(), backend)
+	r.now = func() time.Time { return now }
+
+	p, err := r.Set(ctx, Policy{Name: "hourly", Provider: "fake", Account: "dev", Region: "local",
+		Selector: map[string]string{"backup": "true"}, Frequency: Hourly, RetainHours: 1})
+	require.NoError(t, err)
+	st := r.Execute(ctx, p)
+	require.Empty(t, st.Error)
+	require.Len(t, st.Snapshots, 3)
+
+	//vol-1 snapshot fails and vol-2 no longer matches the policy, their expired snapshots are kept
+	now = now.Add(3 * time.Hour)
+	backend.failVolume = "vol-1"
+	backend.volumes = []*proto.Volume{{VolumeId: "vol-1"}, {VolumeId: "vol-3"}}
+	st = r.Execute(ctx, p)
+	assert.NotEmpty(t, st.Error)
+	assert.Equal(t, []string{"snap-4"}, st.Snapshots)
+	assert.Equal(t, []string{"snap-3"}, st.Pruned)
+	assert.Equal(t, []string{"snap-1", "snap-2", "snap-4"}, ids(backend.snapshots["local"]))
+
+	//newest snapshot of the volume is never pruned
+	assert.False(t, p.expired(0, now.Add(-48*time.Hour), now))
+	assert.True(t, p.expired(1, now.Add(-48*time.Hour), now))
+}
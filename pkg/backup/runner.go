@@ -0,0 +1,315 @@
+package backup
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"gitlab.com/netbook-devs/spawner-service/pkg/metrics"
+	"gitlab.com/netbook-devs/spawner-service/pkg/service/constants"
+	proto "gitlab.com/netbook-devs/spawner-service/proto/netbookai/spawner"
+	"go.uber.org/zap"
+)
+
+//Backend lists the volumes and takes, copies, lists and deletes the snapshots of the policies
+type Backend interface {
+	//Volumes of the policy region carrying all the selector labels
+	Volumes(ctx context.Context, p Policy) ([]*proto.Volume, error)
+	//Snapshots carrying the policy label in the region
+	Snapshots(ctx context.Context, p Policy, region string) ([]*proto.Snapshot, error)
+	//Snapshot takes the snapshot of the volume, returns the snapshot id once it is completed
+	Snapshot(ctx context.Context, p Policy, v *proto.Volume, labels map[string]string) (string, error)
+	//Copy copies the snapshot to the copy region of the policy, returns the id of the copy
+	Copy(ctx context.Context, p Policy, snapshotId string) (string, error)
+	Delete(ctx context.Context, p Policy, region, snapshotId string) error
+}
+
+//Runner snapshots the volumes of the policies once their period has passed and prunes the expired snapshots,
+//time of the last run is read from the newest policy snapshot after restart
+type Runner struct {
+	logger  *zap.SugaredLogger
+	store   Store
+	backend Backend
+	now     func() time.Time
+
+	mu     sync.RWMutex
+	status map[string]Status
+	//running policies, policy is never run twice at the same time
+	running map[string]bool
+}
+
+//NewRunner returns the runner of the policies in store, volumes matching the policies are snapshotted and expired
+//policy snapshots pruned
+func NewRunner(logger *zap.SugaredLogger, store Store, backend Backend) *Runner {
+	return &Runner{
+		logger:  logger,
+		store:   store,
+		backend: backend,
+		now:     time.Now,
+		status:  make(map[string]Status),
+		running: make(map[string]bool),
+	}
+}
+
+//Run checks the policies every interval until ctx is done
+func (r *Runner) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		r.CheckAll(ctx)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+//CheckAll checks every policy in the store
+func (r *Runner) CheckAll(ctx context.Context) {
+	policies, err := r.store.List(ctx)
+	if err != nil {
+		r.logger.Errorw("failed to list snapshot policies", "error", err)
+		return
+	}
+	for _, p := range policies {
+		r.Check(ctx, p)
+	}
+}
+
+func createdAt(s *proto.Snapshot) time.Time {
+	t, err := time.Parse(time.RFC3339, s.CreatedAt)
+	if err != nil {
+		return time.Time{}
+	}
+	return t
+}
+
+//lastRun creation time of the newest snapshot of the policy, zero when none is found
+func (r *Runner) lastRun(ctx context.Context, p Policy) time.Time {
+	snapshots, err := r.backend.Snapshots(ctx, p, p.Region)
+	if err != nil {
+		r.logger.Warnw("failed to read last run of the snapshot policy", "policy", p.Name, "error", err)
+		return time.Time{}
+	}
+	last := time.Time{}
+	for _, s := range snapshots {
+		if t := createdAt(s); t.After(last) {
+			last = t
+		}
+	}
+	return last
+}
+
+//Check runs the policy when its period has passed since the last run
+func (r *Runner) Check(ctx context.Context, p Policy) Status {
+	r.mu.RLock()
+	st, ok := r.status[p.Name]
+	r.mu.RUnlock()
+	if !ok {
+		st = Status{LastRunAt: r.lastRun(ctx, p)}
+	}
+	st.Policy = p
+
+	if next := st.NextRunAt(); !next.IsZero() && r.now().Before(next) {
+		return r.setStatus(st)
+	}
+	return r.Execute(ctx, p)
+}
+
+//labels of the snapshot taken by the policy, selector and workspace labels of the volume are carried over
+func (p Policy) labels(v *proto.Volume) map[string]string {
+	labels := map[string]string{}
+	for k, val := range p.Selector {
+		labels[k] = val
+	}
+	if ws, ok := v.Labels[constants.WorkspaceLabel]; ok {
+		labels[constants.WorkspaceLabel] = ws
+	}
+	labels[PolicyLabel] = p.Name
+	labels[PolicyVolumeLabel] = v.VolumeId
+	return labels
+}
+
+//Execute snapshots the volumes of the policy, copies the snapshots and prunes the expired ones,
+//volumes which failed do not stop the others and are reported in the status error
+func (r *Runner) Execute(ctx context.Context, p Policy) Status {
+	r.mu.Lock()
+	if r.running[p.Name] {
+		st := r.status[p.Name]
+		r.mu.Unlock()
+		return st
+	}
+	r.running[p.Name] = true
+	r.mu.Unlock()
+	defer func() {
+		r.mu.Lock()
+		delete(r.running, p.Name)
+		r.mu.Unlock()
+	}()
+
+	now := r.now()
+	st := Status{Policy: p, LastRunAt: now}
+	failed := []string{}
+
+	//taken snapshots of this run by region and volume, only those volumes are pruned in the region
+	taken := map[string]map[string]string{}
+	for _, region := range p.Regions() {
+		taken[region] = map[string]string{}
+	}
+
+	volumes, err := r.backend.Volumes(ctx, p)
+	if err != nil {
+		failed = append(failed, errors.Wrap(err, "failed to list volumes").Error())
+	}
+	for _, v := range volumes {
+		id, err := r.backend.Snapshot(ctx, p, v, p.labels(v))
+		if err != nil {
+			failed = append(failed, errors.Wrapf(err, "volume '%s'", v.VolumeId).Error())
+			continue
+		}
+		st.Snapshots = append(st.Snapshots, id)
+		taken[p.Region][v.VolumeId] = id
+
+		if p.CopyRegion == "" {
+			continue
+		}
+		copied, err := r.backend.Copy(ctx, p, id)
+		if err != nil {
+			failed = append(failed, errors.Wrapf(err, "copy of snapshot '%s'", id).Error())
+			continue
+		}
+		st.Copies = append(st.Copies, copied)
+		taken[p.CopyRegion][v.VolumeId] = copied
+	}
+
+	for _, region := range p.Regions() {
+		pruned, err := r.prune(ctx, p, region, now, taken[region])
+		st.Pruned = append(st.Pruned, pruned...)
+		if err != nil {
+			failed = append(failed, errors.Wrapf(err, "prune in %s", region).Error())
+		}
+	}
+
+	if len(failed) > 0 {
+		st.Error = strings.Join(failed, "; ")
+		r.logger.Errorw("snapshot policy run failed", "policy", p.Name, "snapshots", st.Snapshots, "pruned", st.Pruned, "error", st.Error)
+	} else {
+		r.logger.Infow("snapshot policy run completed", "policy", p.Name, "snapshots", st.Snapshots, "copies", st.Copies, "pruned", st.Pruned)
+	}
+	metrics.ObserveSnapshotPolicyRun(p.Name, st.Error == "", float64(now.Unix()))
+	return r.setStatus(st)
+}
+
+//prune deletes the policy snapshots of the region over the retention, snapshots are counted per volume.
+//only the volumes with a snapshot taken by the current run in the region are pruned, so that the volumes
+//which failed or no longer match the policy keep their backups, the snapshot taken counts as the newest
+func (r *Runner) prune(ctx context.Context, p Policy, region string, now time.Time, taken map[string]string) ([]string, error) {
+	snapshots, err := r.backend.Snapshots(ctx, p, region)
+	if err != nil {
+		return nil, err
+	}
+
+	byVolume := map[string][]*proto.Snapshot{}
+	for _, s := range snapshots {
+		if s.Labels[PolicyLabel] != p.Name {
+			continue
+		}
+		v := s.Labels[PolicyVolumeLabel]
+		if _, ok := taken[v]; !ok {
+			continue
+		}
+		byVolume[v] = append(byVolume[v], s)
+	}
+
+	pruned := []string{}
+	failed := []string{}
+	for v, list := range byVolume {
+		latest := taken[v]
+		sort.Slice(list, func(i, j int) bool {
+			if (list[i].SnapshotId == latest) != (list[j].SnapshotId == latest) {
+				return list[i].SnapshotId == latest
+			}
+			return createdAt(list[i]).After(createdAt(list[j]))
+		})
+		for i, s := range list {
+			if s.SnapshotId == latest || !p.expired(i, createdAt(s), now) {
+				continue
+			}
+			if err := r.backend.Delete(ctx, p, region, s.SnapshotId); err != nil {
+				failed = append(failed, errors.Wrapf(err, "snapshot '%s'", s.SnapshotId).Error())
+				continue
+			}
+			pruned = append(pruned, s.SnapshotId)
+		}
+	}
+	sort.Strings(pruned)
+	if len(failed) > 0 {
+		return pruned, errors.New(strings.Join(failed, "; "))
+	}
+	return pruned, nil
+}
+
+func (r *Runner) setStatus(st Status) Status {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.status[st.Policy.Name] = st
+	return st
+}
+
+//Set validates and stores the policy, policy runs on next check unless its period has not passed
+func (r *Runner) Set(ctx context.Context, p Policy) (Policy, error) {
+	if err := p.Validate(); err != nil {
+		return Policy{}, err
+	}
+	p.UpdatedAt = r.now().UTC()
+	if err := r.store.Put(ctx, p); err != nil {
+		return Policy{}, err
+	}
+	return p, nil
+}
+
+//Delete removes the policy, snapshots taken by it are kept
+func (r *Runner) Delete(ctx context.Context, name string) error {
+	if err := r.store.Delete(ctx, name); err != nil {
+		return err
+	}
+	r.mu.Lock()
+	delete(r.status, name)
+	r.mu.Unlock()
+	metrics.DeleteSnapshotPolicy(name)
+	return nil
+}
+
+//Status returns the policy and the result of its last run
+func (r *Runner) Status(ctx context.Context, name string) (Status, error) {
+	p, err := r.store.Get(ctx, name)
+	if err != nil {
+		return Status{}, err
+	}
+	r.mu.RLock()
+	st := r.status[name]
+	r.mu.RUnlock()
+	st.Policy = p
+	return st, nil
+}
+
+//List status of all the policies
+func (r *Runner) List(ctx context.Context) ([]Status, error) {
+	policies, err := r.store.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	res := make([]Status, 0, len(policies))
+	for _, p := range policies {
+		st, err := r.Status(ctx, p.Name)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, st)
+	}
+	return res, nil
+}
@@ -0,0 +1,102 @@
+package backup
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"github.com/pkg/errors"
+	"gitlab.com/netbook-devs/spawner-service/pkg/jsonfile"
+)
+
+//MemoryStore keeps the policies in memory, policies are lost on restart
+type MemoryStore struct {
+	mu       sync.RWMutex
+	policies map[string]Policy
+}
+
+//NewMemoryStore returns empty store
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{policies: make(map[string]Policy)}
+}
+
+func (m *MemoryStore) Put(ctx context.Context, p Policy) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.policies[p.Name] = p
+	return nil
+}
+
+func (m *MemoryStore) Get(ctx context.Context, name string) (Policy, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	p, ok := m.policies[name]
+	if !ok {
+		return Policy{}, errors.Wrapf(ERR_NOT_FOUND, "policy '%s'", name)
+	}
+	return p, nil
+}
+
+func (m *MemoryStore) Delete(ctx context.Context, name string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if _, ok := m.policies[name]; !ok {
+		return errors.Wrapf(ERR_NOT_FOUND, "policy '%s'", name)
+	}
+	delete(m.policies, name)
+	return nil
+}
+
+//List returns the policies sorted by name
+func (m *MemoryStore) List(ctx context.Context) ([]Policy, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	res := make([]Policy, 0, len(m.policies))
+	for _, p := range m.policies {
+		res = append(res, p)
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i].Name < res[j].Name })
+	return res, nil
+}
+
+func (m *MemoryStore) Close() error {
+	return nil
+}
+
+//FileStore keeps the policies in memory and writes all of them to the json file on every change
+type FileStore struct {
+	*MemoryStore
+	file *jsonfile.File
+}
+
+//NewFileStore loads the policies from the file at path, file is created on first change
+func NewFileStore(path string) (*FileStore, error) {
+	policies := []Policy{}
+	file, err := jsonfile.Open(path, &policies)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to load snapshot policies")
+	}
+	s := &FileStore{MemoryStore: NewMemoryStore(), file: file}
+	for _, p := range policies {
+		s.MemoryStore.policies[p.Name] = p
+	}
+	return s, nil
+}
+
+func (s *FileStore) Put(ctx context.Context, p Policy) error {
+	return s.file.Write(func() (interface{}, error) {
+		if err := s.MemoryStore.Put(ctx, p); err != nil {
+			return nil, err
+		}
+		return s.MemoryStore.List(ctx)
+	})
+}
+
+func (s *FileStore) Delete(ctx context.Context, name string) error {
+	return s.file.Write(func() (interface{}, error) {
+		if err := s.MemoryStore.Delete(ctx, name); err != nil {
+			return nil, err
+		}
+		return s.MemoryStore.List(ctx)
+	})
+}
@@ -0,0 +1,108 @@
+package budget
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+var (
+	ERR_NOT_FOUND       = errors.New("budget not found")
+	ERR_INVALID_BUDGET  = errors.New("invalid budget")
+	ERR_BUDGET_EXCEEDED = errors.New("workspace budget exceeded")
+)
+
+//default thresholds in percent of the monthly limit
+const (
+	DefaultSoftThreshold = 80
+	DefaultHardThreshold = 100
+)
+
+//Budget monthly spend limit of the workspace, cost is read from the provider account the workspace runs on
+type Budget struct {
+	Workspace    string  `json:"workspace"`
+	Provider     string  `json:"provider"`
+	Account      string  `json:"account"`
+	MonthlyLimit float64 `json:"monthlyLimit"`
+	//SoftThreshold percent of the limit after which the workspace is reported as over the soft limit
+	SoftThreshold float64 `json:"softThreshold"`
+	//HardThreshold percent of the limit after which new nodes and volumes are refused
+	HardThreshold float64 `json:"hardThreshold"`
+	//ScaleDownGPU scales the gpu node pools of the workspace to zero once hard threshold is crossed
+	ScaleDownGPU bool      `json:"scaleDownGPU"`
+	UpdatedAt    time.Time `json:"updatedAt"`
+}
+
+//Validate checks the budget and fills the default thresholds
+func (b *Budget) Validate() error {
+	if b.Workspace == "" || b.Provider == "" || b.Account == "" {
+		return errors.Wrap(ERR_INVALID_BUDGET, "workspace, provider and account are required")
+	}
+	if b.MonthlyLimit <= 0 {
+		return errors.Wrap(ERR_INVALID_BUDGET, "monthly limit must be positive")
+	}
+	if b.SoftThreshold == 0 {
+		b.SoftThreshold = DefaultSoftThreshold
+	}
+	if b.HardThreshold == 0 {
+		b.HardThreshold = DefaultHardThreshold
+	}
+	if b.SoftThreshold < 0 || b.SoftThreshold > b.HardThreshold {
+		return errors.Wrap(ERR_INVALID_BUDGET, "soft threshold must be between 0 and hard threshold")
+	}
+	return nil
+}
+
+//State of the workspace spend against its budget
+type State string
+
+const (
+	//Unknown budget is not evaluated yet or the cost could not be read
+	Unknown State = "UNKNOWN"
+	OK      State = "OK"
+	Soft    State = "SOFT_LIMIT_EXCEEDED"
+	Hard    State = "HARD_LIMIT_EXCEEDED"
+)
+
+//state of the spend against the thresholds of the budget
+func (b Budget) state(spent float64) State {
+	percent := spent * 100 / b.MonthlyLimit
+	switch {
+	case percent >= b.HardThreshold:
+		return Hard
+	case percent >= b.SoftThreshold:
+		return Soft
+	}
+	return OK
+}
+
+//Status result of the last evaluation of the budget
+type Status struct {
+	Budget Budget
+	State  State
+	//Spent month to date cost of the workspace
+	Spent       float64
+	EvaluatedAt time.Time
+	//Error of the last evaluation, previous state is kept when cost could not be read
+	Error string
+	//ScaledDown node pools scaled to zero when hard threshold was crossed this month
+	ScaledDown []string
+}
+
+//Store persists the budgets
+type Store interface {
+	Put(ctx context.Context, b Budget) error
+	Get(ctx context.Context, workspace string) (Budget, error)
+	Delete(ctx context.Context, workspace string) error
+	List(ctx context.Context) ([]Budget, error)
+	Close() error
+}
+
+//Open returns the file store at path, memory store when path is empty
+func Open(path string) (Store, error) {
+	if path == "" {
+		return NewMemoryStore(), nil
+	}
+	return NewFileStore(path)
+}
@@ -0,0 +1,119 @@
+package budget
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func Test_BudgetValidate(t *testing.T) {
+	b := Budget{Workspace: "ws-1", Provider: "aws", Account: "netbook-aws", MonthlyLimit: 100}
+	require.NoError(t, b.Validate())
+	assert.Equal(t, float64(DefaultSoftThreshold), b.SoftThreshold)
+	assert.Equal(t, float64(DefaultHardThreshold), b.HardThreshold)
+
+	assert.Equal(t, OK, b.state(50))
+	assert.Equal(t, Soft, b.state(80))
+	assert.Equal(t, Hard, b.state(120))
+
+	invalid := []Budget{
+		{Provider: "aws", Account: "netbook-aws", MonthlyLimit: 100},
+		{Workspace: "ws-1", Provider: "aws", Account: "netbook-aws"},
+		{Workspace: "ws-1", Provider: "aws", Account: "netbook-aws", MonthlyLimit: 100, SoftThreshold: 90, HardThreshold: 80},
+	}
+	for _, b := range invalid {
+		assert.True(t, errors.Is(b.Validate(), ERR_INVALID_BUDGET), "expected invalid budget %+v", b)
+	}
+}
+
+func Test_MonthToDate(t *testing.T) {
+	start, end := monthToDate(time.Date(2022, 4, 30, 18, 0, 0, 0, time.UTC))
+	assert.Equal(t, "2022-04-01", start.Format("2006-01-02"))
+	assert.Equal(t, "2022-05-01", end.Format("2006-01-02"))
+}
+
+func Test_FileStore(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "budgets.json")
+
+	s, err := NewFileStore(path)
+	require.NoError(t, err)
+	require.NoError(t, s.Put(ctx, Budget{Workspace: "ws-2", MonthlyLimit: 10}))
+	require.NoError(t, s.Put(ctx, Budget{Workspace: "ws-1", MonthlyLimit: 20}))
+	require.NoError(t, s.Delete(ctx, "ws-2"))
+
+	reopened, err := NewFileStore(path)
+	require.NoError(t, err)
+	budgets, err := reopened.List(ctx)
+	require.NoError(t, err)
+	require.Len(t, budgets, 1)
+	assert.Equal(t, 20.0, budgets[0].MonthlyLimit)
+
+	_, err = reopened.Get(ctx, "ws-2")
+	assert.True(t, errors.Is(err, ERR_NOT_FOUND))
+}
+
+func Test_Evaluator(t *testing.T) {
+	ctx := context.Background()
+	spent := 50.0
+	scaled := 0
+	e := NewEvaluator(zap.NewNop().Sugar(), NewMemoryStore(),
+		func(ctx context.Context, b Budget, start, end time.Time) (float64, error) {
+			return spent, nil
+		},
+		func(ctx context.Context, b Budget) ([]string, error) {
+			scaled++
+			return []string{"dev/gpu"}, nil
+		})
+	now := time.Date(2022, 4, 10, 10, 0, 0, 0, time.UTC)
+	e.now = func() time.Time { return now }
+
+	b, err := e.Set(ctx, Budget{Workspace: "ws-1", Provider: "fake", Account: "netbook", MonthlyLimit: 100, ScaleDownGPU: true})
+	require.NoError(t, err)
+
+	st, err := e.Status(ctx, "ws-1")
+	require.NoError(t, err)
+	assert.Equal(t, Unknown, st.State)
+
+	e.EvaluateAll(ctx)
+	st, err = e.Status(ctx, "ws-1")
+	require.NoError(t, err)
+	assert.Equal(t, OK, st.State)
+	assert.NoError(t, e.Check("ws-1"))
+
+	spent = 150
+	e.Evaluate(ctx, b)
+	e.Evaluate(ctx, b)
+	st, err = e.Status(ctx, "ws-1")
+	require.NoError(t, err)
+	assert.Equal(t, Hard, st.State)
+	assert.Equal(t, []string{"dev/gpu"}, st.ScaledDown)
+	assert.Equal(t, 1, scaled, "gpu node pools must be scaled down once a month")
+	assert.True(t, errors.Is(e.Check("ws-1"), ERR_BUDGET_EXCEEDED))
+	assert.NoError(t, e.Check("ws-2"))
+
+	//new month starts over
+	now = time.Date(2022, 5, 1, 10, 0, 0, 0, time.UTC)
+	spent = 5
+	e.Evaluate(ctx, b)
+	assert.NoError(t, e.Check("ws-1"))
+
+	spent = 150
+	e.Evaluate(ctx, b)
+	assert.Equal(t, 2, scaled)
+
+	//same month of the next year is not the current month
+	now = time.Date(2023, 5, 2, 10, 0, 0, 0, time.UTC)
+	e.Evaluate(ctx, b)
+	assert.Equal(t, 3, scaled, "gpu node pools must be scaled down again in the new month")
+
+	require.NoError(t, e.Delete(ctx, "ws-1"))
+	_, err = e.Status(ctx, "ws-1")
+	assert.True(t, errors.Is(err, ERR_NOT_FOUND))
+}
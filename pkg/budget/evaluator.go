@@ -0,0 +1,202 @@
+package budget
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+)
+
+//CostFunc returns the cost of the workspace between start (inclusive) and end (exclusive) dates
+type CostFunc func(ctx context.Context, b Budget, start, end time.Time) (float64, error)
+
+//ScaleDownFunc removes the gpu node pools of the workspace, returns the node pools acted on
+type ScaleDownFunc func(ctx context.Context, b Budget) ([]string, error)
+
+//Evaluator periodically reads the month to date cost of the budgeted workspaces
+//and keeps their state, workspaces over the hard threshold are refused new resources.
+type Evaluator struct {
+	logger    *zap.SugaredLogger
+	store     Store
+	cost      CostFunc
+	scaleDown ScaleDownFunc
+	now       func() time.Time
+
+	mu     sync.RWMutex
+	status map[string]Status
+}
+
+//NewEvaluator returns the evaluator of the budgets in store, workspaces over the hard limit can not add nodes or volumes
+func NewEvaluator(logger *zap.SugaredLogger, store Store, cost CostFunc, scaleDown ScaleDownFunc) *Evaluator {
+	return &Evaluator{
+		logger:    logger,
+		store:     store,
+		cost:      cost,
+		scaleDown: scaleDown,
+		now:       time.Now,
+		status:    make(map[string]Status),
+	}
+}
+
+//monthToDate period of the current month, end is exclusive and never past the next month
+func monthToDate(now time.Time) (time.Time, time.Time) {
+	now = now.UTC()
+	start := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(now.Year(), now.Month(), now.Day()+1, 0, 0, 0, 0, time.UTC)
+	return start, end
+}
+
+//Run evaluates the budgets every interval until ctx is done
+func (e *Evaluator) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		e.EvaluateAll(ctx)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+//EvaluateAll evaluates every budget in the store
+func (e *Evaluator) EvaluateAll(ctx context.Context) {
+	budgets, err := e.store.List(ctx)
+	if err != nil {
+		e.logger.Errorw("failed to list budgets", "error", err)
+		return
+	}
+	for _, b := range budgets {
+		e.Evaluate(ctx, b)
+	}
+}
+
+//Evaluate reads the cost of the workspace and updates its state,
+//gpu node pools are scaled down once per month when the budget asks for it
+func (e *Evaluator) Evaluate(ctx context.Context, b Budget) Status {
+	now := e.now()
+	start, end := monthToDate(now)
+
+	e.mu.RLock()
+	prev, ok := e.status[b.Workspace]
+	e.mu.RUnlock()
+	//scale down is repeated only in the new month
+	if !ok || !sameMonth(prev.EvaluatedAt.UTC(), start) {
+		prev = Status{State: Unknown}
+	}
+
+	st := Status{Budget: b, State: prev.State, Spent: prev.Spent, EvaluatedAt: now, ScaledDown: prev.ScaledDown}
+	spent, err := e.cost(ctx, b, start, end)
+	if err != nil {
+		e.logger.Errorw("failed to read workspace cost", "workspace", b.Workspace, "error", err)
+		st.Error = err.Error()
+		return e.setStatus(st)
+	}
+	st.Spent = spent
+	st.State = b.state(spent)
+
+	if st.State != prev.State {
+		e.logger.Warnw("workspace budget state changed", "workspace", b.Workspace, "state", st.State, "spent", spent, "limit", b.MonthlyLimit)
+	}
+
+	if st.State == Hard && b.ScaleDownGPU && st.ScaledDown == nil && e.scaleDown != nil {
+		pools, err := e.scaleDown(ctx, b)
+		if err != nil {
+			e.logger.Errorw("failed to scale down gpu node pools", "workspace", b.Workspace, "error", err)
+			st.Error = err.Error()
+		} else {
+			e.logger.Warnw("scaled down gpu node pools over budget", "workspace", b.Workspace, "nodePools", pools)
+			st.ScaledDown = append([]string{}, pools...)
+		}
+	}
+	return e.setStatus(st)
+}
+
+func (e *Evaluator) setStatus(st Status) Status {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.status[st.Budget.Workspace] = st
+	return st
+}
+
+//Set validates and stores the budget, budget is evaluated on the next run
+func (e *Evaluator) Set(ctx context.Context, b Budget) (Budget, error) {
+	if err := b.Validate(); err != nil {
+		return Budget{}, err
+	}
+	b.UpdatedAt = e.now()
+	if err := e.store.Put(ctx, b); err != nil {
+		return Budget{}, err
+	}
+
+	//state of the old limits no longer applies
+	e.mu.Lock()
+	delete(e.status, b.Workspace)
+	e.mu.Unlock()
+	return b, nil
+}
+
+//Delete removes the budget of the workspace
+func (e *Evaluator) Delete(ctx context.Context, workspace string) error {
+	if err := e.store.Delete(ctx, workspace); err != nil {
+		return err
+	}
+	e.mu.Lock()
+	delete(e.status, workspace)
+	e.mu.Unlock()
+	return nil
+}
+
+//Status of the workspace budget, state is Unknown until the budget is evaluated
+func (e *Evaluator) Status(ctx context.Context, workspace string) (Status, error) {
+	b, err := e.store.Get(ctx, workspace)
+	if err != nil {
+		return Status{}, err
+	}
+	e.mu.RLock()
+	st, ok := e.status[workspace]
+	e.mu.RUnlock()
+	if !ok {
+		return Status{Budget: b, State: Unknown}, nil
+	}
+	return st, nil
+}
+
+//List status of all the budgets
+func (e *Evaluator) List(ctx context.Context) ([]Status, error) {
+	budgets, err := e.store.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	res := make([]Status, 0, len(budgets))
+	for _, b := range budgets {
+		st, err := e.Status(ctx, b.Workspace)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, st)
+	}
+	return res, nil
+}
+
+//Check fails with ERR_BUDGET_EXCEEDED when the workspace is over its hard threshold,
+//workspaces without budget are always allowed
+func (e *Evaluator) Check(workspace string) error {
+	if workspace == "" {
+		return nil
+	}
+	e.mu.RLock()
+	st, ok := e.status[workspace]
+	e.mu.RUnlock()
+	if ok && st.State == Hard {
+		return errors.Wrapf(ERR_BUDGET_EXCEEDED, "workspace '%s' spent %.2f of %.2f", workspace, st.Spent, st.Budget.MonthlyLimit)
+	}
+	return nil
+}
+
+func sameMonth(a, b time.Time) bool {
+	return a.Year() == b.Year() && a.Month() == b.Month()
+}
@@ -0,0 +1,102 @@
+package budget
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"github.com/pkg/errors"
+	"gitlab.com/netbook-devs/spawner-service/pkg/jsonfile"
+)
+
+//MemoryStore keeps the budgets in memory, budgets are lost on restart
+type MemoryStore struct {
+	mu      sync.RWMutex
+	budgets map[string]Budget
+}
+
+//NewMemoryStore returns empty store
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{budgets: make(map[string]Budget)}
+}
+
+func (m *MemoryStore) Put(ctx context.Context, b Budget) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.budgets[b.Workspace] = b
+	return nil
+}
+
+func (m *MemoryStore) Get(ctx context.Context, workspace string) (Budget, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	b, ok := m.budgets[workspace]
+	if !ok {
+		return Budget{}, errors.Wrapf(ERR_NOT_FOUND, "workspace '%s'", workspace)
+	}
+	return b, nil
+}
+
+func (m *MemoryStore) Delete(ctx context.Context, workspace string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if _, ok := m.budgets[workspace]; !ok {
+		return errors.Wrapf(ERR_NOT_FOUND, "workspace '%s'", workspace)
+	}
+	delete(m.budgets, workspace)
+	return nil
+}
+
+//List returns the budgets sorted by workspace
+func (m *MemoryStore) List(ctx context.Context) ([]Budget, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	res := make([]Budget, 0, len(m.budgets))
+	for _, b := range m.budgets {
+		res = append(res, b)
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i].Workspace < res[j].Workspace })
+	return res, nil
+}
+
+func (m *MemoryStore) Close() error {
+	return nil
+}
+
+//FileStore keeps the budgets in memory and writes all of them to the json file on every change
+type FileStore struct {
+	*MemoryStore
+	file *jsonfile.File
+}
+
+//NewFileStore loads the budgets from the file at path, file is created on first change
+func NewFileStore(path string) (*FileStore, error) {
+	budgets := []Budget{}
+	file, err := jsonfile.Open(path, &budgets)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to load budgets")
+	}
+	s := &FileStore{MemoryStore: NewMemoryStore(), file: file}
+	for _, b := range budgets {
+		s.MemoryStore.budgets[b.Workspace] = b
+	}
+	return s, nil
+}
+
+func (s *FileStore) Put(ctx context.Context, b Budget) error {
+	return s.file.Write(func() (interface{}, error) {
+		if err := s.MemoryStore.Put(ctx, b); err != nil {
+			return nil, err
+		}
+		return s.MemoryStore.List(ctx)
+	})
+}
+
+func (s *FileStore) Delete(ctx context.Context, workspace string) error {
+	return s.file.Write(func() (interface{}, error) {
+		if err := s.MemoryStore.Delete(ctx, workspace); err != nil {
+			return nil, err
+		}
+		return s.MemoryStore.List(ctx)
+	})
+}
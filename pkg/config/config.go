@@ -27,6 +27,12 @@ type Config struct {
 	//AWSToken optinal token for aws sessions
 	AWSToken string `mapstructure:"AWS_TOKEN"`
 
+	//EksVersions comma separated kubernetes versions EKS clusters can be created with or upgraded to, oldest first,
+	//EKS has no api listing them. builtin versions are used when empty
+	EksVersions string `mapstructure:"EKS_VERSIONS"`
+	//EksDefaultVersion version EKS creates the cluster with when none is requested, builtin default when empty
+	EksDefaultVersion string `mapstructure:"EKS_DEFAULT_VERSION"`
+
 	//SecretHostRegion aws secret manager region, used for storing user credentials
 	SecretHostRegion string `mapstructure:"SECRET_HOST_REGION"`
 
@@ -45,6 +51,100 @@ type Config struct {
 	AzureClientID       string `mapstructure:"AZURE_CLIENT_ID"`
 	AzureClientSecret   string `mapstructure:"AZURE_CLIENT_SECRET"`
 	AzureResourceGroup  string `mapstructure:"AZURE_RESOURCE_GROUP"`
+
+	//Gcp config, used for local runs, credentials are read from the secret manager otherwise
+
+	GcpProjectID string `mapstructure:"GCP_PROJECT_ID"`
+	//GcpServiceAccountKeyFile path to the json key of the service account
+	GcpServiceAccountKeyFile string `mapstructure:"GCP_SERVICE_ACCOUNT_KEY_FILE"`
+	//GcpBillingExportTable bigquery table the cloud billing is exported to, ex: project.dataset.gcp_billing_export_v1_XXXXXX
+	GcpBillingExportTable string `mapstructure:"GCP_BILLING_EXPORT_TABLE"`
+
+	//Providers comma separated list of builtin providers to enable, one of 'aws', 'azure', 'gcp', 'fake'.
+	//aws, azure and gcp are enabled when empty, fake only when listed
+	Providers string `mapstructure:"PROVIDERS"`
+	//ProviderPlugins comma separated addresses of the out of process provider plugins,
+	//plugins are registered under the name they report in Describe, connection is plaintext so plugins must be on a trusted local address
+	ProviderPlugins string `mapstructure:"PROVIDER_PLUGINS"`
+
+	//InventoryPath bolt database file where spawner records every resource it creates,
+	//inventory is kept in memory and lost on restart when empty
+	InventoryPath string `mapstructure:"INVENTORY_PATH"`
+
+	//QuotaFile yaml limits of clusters, nodes, gpus and volume size per account and workspace, no limits when empty
+	QuotaFile string `mapstructure:"QUOTA_FILE"`
+
+	//MachineCatalogFile yaml or json machine types merged over the builtin catalog, adds machine types or overrides them per provider and region
+	MachineCatalogFile string `mapstructure:"MACHINE_CATALOG_FILE"`
+
+	//PriceTableFile yaml or json instance, control plane and volume prices per provider and region merged over the builtin prices
+	PriceTableFile string `mapstructure:"PRICE_TABLE_FILE"`
+	//PriceRefreshMinutes how often the price table file is reloaded, defaults to 60 minutes
+	PriceRefreshMinutes int `mapstructure:"PRICE_REFRESH_MINUTES"`
+
+	//BudgetPath json file the workspace budgets are stored in, budgets are kept in memory and lost on restart when empty
+	BudgetPath string `mapstructure:"BUDGET_PATH"`
+	//BudgetEvaluationMinutes how often the workspace cost is read and compared to the budgets, defaults to 60 minutes
+	BudgetEvaluationMinutes int `mapstructure:"BUDGET_EVALUATION_MINUTES"`
+
+	//SchedulePath json file the node pool schedules are stored in, schedules are kept in memory and lost on restart when empty
+	SchedulePath string `mapstructure:"SCHEDULE_PATH"`
+	//ScheduleCheckSeconds how often the node pool schedules are checked for fired rules, defaults to 60s
+	ScheduleCheckSeconds int `mapstructure:"SCHEDULE_CHECK_SECONDS"`
+
+	//SnapshotPolicyPath json file the snapshot policies are stored in, policies are kept in memory and lost on restart when empty
+	SnapshotPolicyPath string `mapstructure:"SNAPSHOT_POLICY_PATH"`
+	//SnapshotPolicyCheckMinutes how often the snapshot policies are checked for due runs, defaults to 5 minutes
+	SnapshotPolicyCheckMinutes int `mapstructure:"SNAPSHOT_POLICY_CHECK_MINUTES"`
+
+	//DriftDetectionMinutes how often the clusters in the inventory are compared with the provider, defaults to 15 minutes
+	DriftDetectionMinutes int `mapstructure:"DRIFT_DETECTION_MINUTES"`
+
+	//LeaseCheckMinutes how often the expiry of the clusters and node pools is checked, defaults to 5 minutes
+	LeaseCheckMinutes int `mapstructure:"LEASE_CHECK_MINUTES"`
+	//LeaseWarningMinutes time before the expiry the warning is sent, defaults to 60 minutes
+	LeaseWarningMinutes int `mapstructure:"LEASE_WARNING_MINUTES"`
+	//LeaseWebhookURL lease warnings and expiries are posted as json to the url, only logged when empty
+	LeaseWebhookURL string `mapstructure:"LEASE_WEBHOOK_URL"`
+
+	//OrphanGCMinutes how often the unattached volumes and snapshots without owner are looked for, defaults to 60 minutes
+	OrphanGCMinutes int `mapstructure:"ORPHAN_GC_MINUTES"`
+	//OrphanGCGraceHours age below which volumes and snapshots are never collected, defaults to 72 hours
+	OrphanGCGraceHours int `mapstructure:"ORPHAN_GC_GRACE_HOURS"`
+	//OrphanGCKeepLabel volumes and snapshots with this label are never collected, defaults to spawner-keep
+	OrphanGCKeepLabel string `mapstructure:"ORPHAN_GC_KEEP_LABEL"`
+	//OrphanGCDelete deletes the orphans found in background, they are only logged when false
+	OrphanGCDelete bool `mapstructure:"ORPHAN_GC_DELETE"`
+
+	//AuditLogPath append only file the audit entries of the mutating calls are written to,
+	//entries are kept in memory and lost on restart when empty
+	AuditLogPath string `mapstructure:"AUDIT_LOG_PATH"`
+
+	//OperationRetentionMinutes time finished operations are kept for GetOperation, defaults to 60 minutes
+	OperationRetentionMinutes int `mapstructure:"OPERATION_RETENTION_MINUTES"`
+
+	//TLSCertFile and TLSKeyFile serve grpc over tls when set
+	TLSCertFile string `mapstructure:"TLS_CERT_FILE"`
+	TLSKeyFile  string `mapstructure:"TLS_KEY_FILE"`
+	//TLSClientCAFile enables mTLS authentication, client certificates must be signed by this ca
+	TLSClientCAFile string `mapstructure:"TLS_CLIENT_CA_FILE"`
+	//AuthJWKSFile enables bearer jwt authentication, tokens must be signed by one of the keys in the file
+	AuthJWKSFile    string `mapstructure:"AUTH_JWKS_FILE"`
+	AuthJWTIssuer   string `mapstructure:"AUTH_JWT_ISSUER"`
+	AuthJWTAudience string `mapstructure:"AUTH_JWT_AUDIENCE"`
+	//AuthPolicyFile yaml rules mapping the identities to allowed accounts, providers and rpcs, required when authentication is enabled
+	AuthPolicyFile string `mapstructure:"AUTH_POLICY_FILE"`
+
+	//IdempotencyKeyRetentionMinutes time the responses are kept for the replays with same idempotency key, defaults to 24 hours.
+	//responses are kept in memory, retries after a restart are not deduped
+	IdempotencyKeyRetentionMinutes int `mapstructure:"IDEMPOTENCY_KEY_RETENTION_MINUTES"`
+
+	//WatchIntervalSeconds how often WatchCluster and WatchNodePool poll the provider, defaults to 15s
+	WatchIntervalSeconds int `mapstructure:"WATCH_INTERVAL_SECONDS"`
+
+	//FakeTransitionSeconds time taken by the in-memory fake provider to move resources
+	//from CREATING to ACTIVE and from DELETING to deleted, defaults to 10s
+	FakeTransitionSeconds int `mapstructure:"FAKE_TRANSITION_SECONDS"`
 }
 
 // Load reads configuration from file or environment variables.
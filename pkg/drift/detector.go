@@ -0,0 +1,175 @@
+package drift
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"gitlab.com/netbook-devs/spawner-service/pkg/inventory"
+	"gitlab.com/netbook-devs/spawner-service/pkg/metrics"
+	"gitlab.com/netbook-devs/spawner-service/pkg/service/constants"
+	proto "gitlab.com/netbook-devs/spawner-service/proto/netbookai/spawner"
+	"go.uber.org/zap"
+)
+
+//ClustersFunc returns the spawner created clusters found on the provider through the creator and scope tags
+type ClustersFunc func(ctx context.Context, provider, account, region string) ([]*proto.ClusterSpec, error)
+
+//StatusFunc returns the status of the cluster, error when the cluster is not found
+type StatusFunc func(ctx context.Context, cluster inventory.Resource) (string, error)
+
+//Detector compares the clusters in the inventory with the clusters on the provider,
+//the drift found is exported as prometheus gauge
+type Detector struct {
+	logger   *zap.SugaredLogger
+	store    inventory.Store
+	clusters ClustersFunc
+	status   StatusFunc
+}
+
+//NewDetector returns the detector of the drift of the clusters in store, drift found is exported as prometheus gauge
+func NewDetector(logger *zap.SugaredLogger, store inventory.Store, clusters ClustersFunc, status StatusFunc) *Detector {
+	return &Detector{
+		logger:   logger,
+		store:    store,
+		clusters: clusters,
+		status:   status,
+	}
+}
+
+//Run detects the drift of all the clusters every interval until ctx is done
+func (d *Detector) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		if _, err := d.Detect(ctx, inventory.Filter{}); err != nil {
+			d.logger.Warnw("drift detection incomplete", "error", err)
+		}
+		d.pruneDrift(ctx)
+	}
+}
+
+//pruneDrift removes the drift of the clusters which left the inventory, clusters of the providers which
+//could not be listed keep their last drift
+func (d *Detector) pruneDrift(ctx context.Context) {
+	clusters, err := d.store.List(ctx, inventory.Filter{Kind: inventory.KindCluster})
+	if err != nil {
+		d.logger.Errorw("failed to list clusters for drift cleanup", "error", err)
+		return
+	}
+	live := map[inventory.Key]bool{}
+	for _, c := range clusters {
+		live[inventory.Key{Provider: c.Provider, Account: c.Account, Region: c.Region, ID: c.Name}] = true
+	}
+	metrics.RetainDrift(func(provider, account, region, cluster string) bool {
+		return live[inventory.Key{Provider: provider, Account: account, Region: region, ID: cluster}]
+	})
+}
+
+type location struct {
+	provider, account, region string
+}
+
+//Detect finds the drift of the clusters in the inventory matching the filter, clusters of the
+//providers which could not be listed are skipped and reported in the error
+func (d *Detector) Detect(ctx context.Context, filter inventory.Filter) ([]Drift, error) {
+	//cluster records are matched by name, node pools are matched to the clusters below
+	name := filter.Cluster
+	filter.Cluster = ""
+	filter.IncludeDeleted = false
+	filter.Kind = inventory.KindCluster
+	clusters, err := d.store.List(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	filter.Kind = inventory.KindNodePool
+	pools, err := d.store.List(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	byCluster := map[string][]inventory.Resource{}
+	for _, np := range pools {
+		key := inventory.Key{Provider: np.Provider, Account: np.Account, Region: np.Region, ID: np.Cluster}
+		byCluster[key.String()] = append(byCluster[key.String()], np)
+	}
+
+	byLocation := map[location][]inventory.Resource{}
+	order := []location{}
+	for _, c := range clusters {
+		if name != "" && c.Name != name {
+			continue
+		}
+		l := location{c.Provider, c.Account, c.Region}
+		if _, ok := byLocation[l]; !ok {
+			order = append(order, l)
+		}
+		byLocation[l] = append(byLocation[l], c)
+	}
+
+	drifts := []Drift{}
+	failed := []string{}
+	for _, l := range order {
+		observed, err := d.clusters(ctx, l.provider, l.account, l.region)
+		if err != nil {
+			d.logger.Errorw("failed to list clusters for drift detection", "provider", l.provider, "account", l.account, "region", l.region, "error", err)
+			failed = append(failed, errors.Wrapf(err, "%s/%s/%s", l.provider, l.account, l.region).Error())
+			continue
+		}
+		specs := map[string]*proto.ClusterSpec{}
+		for _, spec := range observed {
+			specs[spec.Name] = spec
+		}
+
+		for _, c := range byLocation[l] {
+			key := inventory.Key{Provider: c.Provider, Account: c.Account, Region: c.Region, ID: c.Name}
+			found, ok := d.clusterDrift(ctx, c, byCluster[key.String()], specs[c.Name])
+			if !ok {
+				continue
+			}
+			metrics.SetDrift(c.Provider, c.Account, c.Region, c.Name, Counts(found))
+			drifts = append(drifts, found...)
+		}
+	}
+
+	if len(failed) > 0 {
+		return drifts, errors.Errorf("drift not detected in %s", strings.Join(failed, "; "))
+	}
+	return drifts, nil
+}
+
+//clusterDrift drift of the cluster, false when the cluster is in transition and can not be compared
+func (d *Detector) clusterDrift(ctx context.Context, c inventory.Resource, pools []inventory.Resource, observed *proto.ClusterSpec) ([]Drift, bool) {
+	if observed != nil {
+		return CompareCluster(c, pools, observed), true
+	}
+
+	missing := Drift{
+		Provider: c.Provider,
+		Account:  c.Account,
+		Region:   c.Region,
+		Cluster:  c.Name,
+		Resource: c.Name,
+	}
+	//clusters are listed only when active and tagged by spawner
+	status, err := d.status(ctx, c)
+	switch {
+	case err != nil:
+		d.logger.Debugw("cluster in inventory not found on provider", "cluster", c.Name, "error", err)
+		missing.Type = ClusterMissing
+		missing.Expected = c.Name
+	case strings.EqualFold(status, constants.Active):
+		missing.Type = LabelMissing
+		missing.Expected = constants.CreatorLabel + "=" + constants.SpawnerServiceLabel + "," + constants.Scope
+	default:
+		return nil, false
+	}
+	return []Drift{missing}, true
+}
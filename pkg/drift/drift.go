@@ -0,0 +1,148 @@
+package drift
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"gitlab.com/netbook-devs/spawner-service/pkg/inventory"
+	proto "gitlab.com/netbook-devs/spawner-service/proto/netbookai/spawner"
+)
+
+//Type of the difference between the inventory and the provider
+type Type string
+
+const (
+	//ClusterMissing cluster in the inventory is not found on the provider
+	ClusterMissing Type = "CLUSTER_MISSING"
+	//NodeGroupDeleted node group in the inventory is removed on the provider
+	NodeGroupDeleted Type = "NODEGROUP_DELETED"
+	//NodeGroupAdded node group found on the provider is not created by spawner
+	NodeGroupAdded      Type = "NODEGROUP_ADDED"
+	InstanceTypeChanged Type = "INSTANCE_TYPE_CHANGED"
+	ScalingChanged      Type = "SCALING_CHANGED"
+	LabelMissing        Type = "LABEL_MISSING"
+	LabelChanged        Type = "LABEL_CHANGED"
+)
+
+//Drift difference of the resource on the provider from its inventory record
+type Drift struct {
+	Provider string
+	Account  string
+	Region   string
+	Cluster  string
+	//Resource cluster name or node pool id
+	Resource string
+	Type     Type
+	Expected string
+	Actual   string
+}
+
+//Counts number of drifts by type
+func Counts(drifts []Drift) map[string]int {
+	counts := map[string]int{}
+	for _, d := range drifts {
+		counts[string(d.Type)]++
+	}
+	return counts
+}
+
+//CompareCluster finds the differences of the cluster node pools on the provider from the inventory records,
+//node pools are matched by name, provider listing each node of the pool is counted by nodes
+func CompareCluster(cluster inventory.Resource, pools []inventory.Resource, observed *proto.ClusterSpec) []Drift {
+	drift := func(resource string, t Type, expected, actual string) Drift {
+		return Drift{
+			Provider: cluster.Provider,
+			Account:  cluster.Account,
+			Region:   cluster.Region,
+			Cluster:  cluster.Name,
+			Resource: resource,
+			Type:     t,
+			Expected: expected,
+			Actual:   actual,
+		}
+	}
+
+	nodes := map[string][]*proto.NodeSpec{}
+	for _, node := range observed.GetNodeSpec() {
+		if node.Name != "" {
+			nodes[node.Name] = append(nodes[node.Name], node)
+		}
+	}
+
+	drifts := []Drift{}
+	expected := map[string]bool{}
+	for _, np := range pools {
+		expected[np.Name] = true
+		id := inventory.NodePoolID(cluster.Name, np.Name)
+		specs, ok := nodes[np.Name]
+		if !ok {
+			//pool scaled to zero has no nodes on providers listing the nodes
+			if np.Attributes["count"] != "0" {
+				drifts = append(drifts, drift(id, NodeGroupDeleted, np.Name, ""))
+			}
+			continue
+		}
+
+		instance := specs[0].Instance
+		if want := np.Attributes["instance"]; want != "" && instance != "" && !contains(strings.Split(want, ","), instance) {
+			drifts = append(drifts, drift(id, InstanceTypeChanged, want, instance))
+		}
+
+		count := int64(0)
+		for _, s := range specs {
+			if s.Count > count {
+				count = s.Count
+			}
+		}
+		if count == 0 {
+			count = int64(len(specs))
+		}
+		if want, err := strconv.ParseInt(np.Attributes["count"], 10, 64); err == nil && want != count {
+			drifts = append(drifts, drift(id, ScalingChanged, strconv.FormatInt(want, 10), strconv.FormatInt(count, 10)))
+		}
+
+		labels := specs[0].Labels
+		for _, k := range sortedKeys(np.Labels) {
+			want := np.Labels[k]
+			got, ok := labels[k]
+			switch {
+			case !ok:
+				drifts = append(drifts, drift(id, LabelMissing, fmt.Sprintf("%s=%s", k, want), ""))
+			case got != want:
+				drifts = append(drifts, drift(id, LabelChanged, fmt.Sprintf("%s=%s", k, want), fmt.Sprintf("%s=%s", k, got)))
+			}
+		}
+	}
+
+	names := make([]string, 0, len(nodes))
+	for name := range nodes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		if !expected[name] {
+			drifts = append(drifts, drift(inventory.NodePoolID(cluster.Name, name), NodeGroupAdded, "", name))
+		}
+	}
+	return drifts
+}
+
+func contains(values []string, v string) bool {
+	for _, value := range values {
+		if value == v {
+			return true
+		}
+	}
+	return false
+}
+
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
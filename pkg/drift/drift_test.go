@@ -0,0 +1,99 @@
+package drift
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gitlab.com/netbook-devs/spawner-service/pkg/inventory"
+	proto "gitlab.com/netbook-devs/spawner-service/proto/netbookai/spawner"
+	"go.uber.org/zap"
+)
+
+func nodePool(cluster, name, instance, count string, labels map[string]string) inventory.Resource {
+	return inventory.Resource{
+		ID:         inventory.NodePoolID(cluster, name),
+		Kind:       inventory.KindNodePool,
+		Provider:   "fake",
+		Account:    "dev",
+		Region:     "local",
+		Cluster:    cluster,
+		Name:       name,
+		Labels:     labels,
+		Attributes: map[string]string{"instance": instance, "count": count},
+	}
+}
+
+func Test_CompareCluster(t *testing.T) {
+	cluster := inventory.Resource{ID: "dev", Kind: inventory.KindCluster, Provider: "fake", Account: "dev", Region: "local", Name: "dev"}
+	pools := []inventory.Resource{
+		nodePool("dev", "same", "t2.micro", "1", map[string]string{"env": "dev"}),
+		nodePool("dev", "changed", "m5.large", "2", map[string]string{"env": "dev", "team": "ml"}),
+		nodePool("dev", "removed", "t2.micro", "1", nil),
+		nodePool("dev", "idle", "t2.micro", "0", nil),
+	}
+	observed := &proto.ClusterSpec{Name: "dev", NodeSpec: []*proto.NodeSpec{
+		{Name: "same", Instance: "t2.micro", Labels: map[string]string{"env": "dev", "extra": "x"}},
+		{Name: "changed", Instance: "m5.xlarge", Count: 4, Labels: map[string]string{"env": "prod"}},
+		{Name: "manual", Instance: "t2.micro"},
+	}}
+
+	drifts := CompareCluster(cluster, pools, observed)
+	got := []string{}
+	for _, d := range drifts {
+		got = append(got, string(d.Type)+" "+d.Resource+" "+d.Expected+" "+d.Actual)
+	}
+	assert.Equal(t, []string{
+		"INSTANCE_TYPE_CHANGED dev/changed m5.large m5.xlarge",
+		"SCALING_CHANGED dev/changed 2 4",
+		"LABEL_CHANGED dev/changed env=dev env=prod",
+		"LABEL_MISSING dev/changed team=ml ",
+		"NODEGROUP_DELETED dev/removed removed ",
+		"NODEGROUP_ADDED dev/manual  manual",
+	}, got)
+	assert.Equal(t, 1, Counts(drifts)["SCALING_CHANGED"])
+}
+
+func Test_Detect(t *testing.T) {
+	ctx := context.Background()
+	store := inventory.NewMemoryStore()
+	for _, r := range []inventory.Resource{
+		{ID: "dev", Kind: inventory.KindCluster, Provider: "fake", Account: "dev", Region: "local", Name: "dev"},
+		{ID: "gone", Kind: inventory.KindCluster, Provider: "fake", Account: "dev", Region: "local", Name: "gone"},
+		{ID: "new", Kind: inventory.KindCluster, Provider: "fake", Account: "dev", Region: "local", Name: "new"},
+		{ID: "other", Kind: inventory.KindCluster, Provider: "fake", Account: "dev", Region: "remote", Name: "other"},
+		nodePool("dev", "pool", "t2.micro", "1", nil),
+	} {
+		require.NoError(t, store.Put(ctx, r))
+	}
+
+	d := NewDetector(zap.NewNop().Sugar(), store,
+		func(ctx context.Context, provider, account, region string) ([]*proto.ClusterSpec, error) {
+			if region == "remote" {
+				return nil, errors.New("unreachable")
+			}
+			return []*proto.ClusterSpec{{Name: "dev", NodeSpec: []*proto.NodeSpec{{Name: "pool", Instance: "t2.micro", Count: 1}}}}, nil
+		},
+		func(ctx context.Context, c inventory.Resource) (string, error) {
+			if c.Name == "new" {
+				return "CREATING", nil
+			}
+			return "", errors.New("cluster not found")
+		})
+
+	drifts, err := d.Detect(ctx, inventory.Filter{Region: "local"})
+	require.NoError(t, err)
+	require.Len(t, drifts, 1)
+	assert.Equal(t, ClusterMissing, drifts[0].Type)
+	assert.Equal(t, "gone", drifts[0].Cluster)
+
+	drifts, err = d.Detect(ctx, inventory.Filter{Cluster: "dev"})
+	require.NoError(t, err)
+	assert.Empty(t, drifts)
+
+	drifts, err = d.Detect(ctx, inventory.Filter{})
+	require.Error(t, err, "clusters of unreachable region must be reported")
+	assert.Len(t, drifts, 1)
+}
@@ -130,3 +130,193 @@ func (g *gateway) GetKubeConfig(ctx context.Context, req *proto.GetKubeConfigReq
 func (g *gateway) TagNodeInstance(ctx context.Context, req *proto.TagNodeInstanceRequest) (*proto.TagNodeInstanceResponse, error) {
 	return g.service.TagNodeInstance(ctx, req)
 }
+
+//ListResources list resources created by spawner from the inventory
+func (g *gateway) ListResources(ctx context.Context, req *proto.ListResourcesRequest) (*proto.ListResourcesResponse, error) {
+	return g.service.ListResources(ctx, req)
+}
+
+//GetOperation get the progress of the operation started by mutating calls
+func (g *gateway) GetOperation(ctx context.Context, req *proto.GetOperationRequest) (*proto.Operation, error) {
+	return g.service.GetOperation(ctx, req)
+}
+
+//ListOperations list operations
+func (g *gateway) ListOperations(ctx context.Context, req *proto.ListOperationsRequest) (*proto.ListOperationsResponse, error) {
+	return g.service.ListOperations(ctx, req)
+}
+
+//CancelOperation cancel running operation
+func (g *gateway) CancelOperation(ctx context.Context, req *proto.CancelOperationRequest) (*proto.Operation, error) {
+	return g.service.CancelOperation(ctx, req)
+}
+
+//WatchCluster stream cluster status changes
+func (g *gateway) WatchCluster(req *proto.WatchClusterRequest, stream proto.SpawnerService_WatchClusterServer) error {
+	return g.service.WatchCluster(req, stream)
+}
+
+//WatchNodePool stream nodepool status changes
+func (g *gateway) WatchNodePool(req *proto.WatchNodePoolRequest, stream proto.SpawnerService_WatchNodePoolServer) error {
+	return g.service.WatchNodePool(req, stream)
+}
+
+//QueryAuditLog query the audit log of the mutating calls
+func (g *gateway) QueryAuditLog(ctx context.Context, req *proto.QueryAuditLogRequest) (*proto.QueryAuditLogResponse, error) {
+	return g.service.QueryAuditLog(ctx, req)
+}
+
+//GetQuotaUsage get the quota limits and consumption
+func (g *gateway) GetQuotaUsage(ctx context.Context, req *proto.GetQuotaUsageRequest) (*proto.GetQuotaUsageResponse, error) {
+	return g.service.GetQuotaUsage(ctx, req)
+}
+
+//SetBudget create or replace the workspace budget
+func (g *gateway) SetBudget(ctx context.Context, req *proto.SetBudgetRequest) (*proto.Budget, error) {
+	return g.service.SetBudget(ctx, req)
+}
+
+//DeleteBudget remove the workspace budget
+func (g *gateway) DeleteBudget(ctx context.Context, req *proto.DeleteBudgetRequest) (*proto.DeleteBudgetResponse, error) {
+	return g.service.DeleteBudget(ctx, req)
+}
+
+//GetBudgetStatus get the spend and state of the workspace budgets
+func (g *gateway) GetBudgetStatus(ctx context.Context, req *proto.GetBudgetStatusRequest) (*proto.GetBudgetStatusResponse, error) {
+	return g.service.GetBudgetStatus(ctx, req)
+}
+
+//ApplyClusterSpec reconcile the cluster with the declarative spec
+func (g *gateway) ApplyClusterSpec(ctx context.Context, req *proto.ApplyClusterSpecRequest) (*proto.ApplyClusterSpecResponse, error) {
+	return g.service.ApplyClusterSpec(ctx, req)
+}
+
+//GetDrift get the differences between the inventory and the provider
+func (g *gateway) GetDrift(ctx context.Context, req *proto.GetDriftRequest) (*proto.GetDriftResponse, error) {
+	return g.service.GetDrift(ctx, req)
+}
+
+//ExtendLease postpone the expiry of the cluster or node pool
+func (g *gateway) ExtendLease(ctx context.Context, req *proto.ExtendLeaseRequest) (*proto.ExtendLeaseResponse, error) {
+	return g.service.ExtendLease(ctx, req)
+}
+
+//ListOrphans list the orphaned volumes and snapshots, deleting them when asked for
+func (g *gateway) ListOrphans(ctx context.Context, req *proto.ListOrphansRequest) (*proto.ListOrphansResponse, error) {
+	return g.service.ListOrphans(ctx, req)
+}
+
+//SetNodePoolSchedule create, replace or remove the scaling schedule of the node pool
+func (g *gateway) SetNodePoolSchedule(ctx context.Context, req *proto.SetNodePoolScheduleRequest) (*proto.NodePoolSchedule, error) {
+	return g.service.SetNodePoolSchedule(ctx, req)
+}
+
+//GetNodePoolSchedule get the scaling schedule of the node pool
+func (g *gateway) GetNodePoolSchedule(ctx context.Context, req *proto.GetNodePoolScheduleRequest) (*proto.GetNodePoolScheduleResponse, error) {
+	return g.service.GetNodePoolSchedule(ctx, req)
+}
+
+//ScaleNodePool set the desired size and autoscaling bounds of the node pool
+func (g *gateway) ScaleNodePool(ctx context.Context, req *proto.ScaleNodePoolRequest) (*proto.ScaleNodePoolResponse, error) {
+	return g.service.ScaleNodePool(ctx, req)
+}
+
+//UpdateNodePool update labels, taints, kubernetes version or node image of the node pool
+func (g *gateway) UpdateNodePool(ctx context.Context, req *proto.UpdateNodePoolRequest) (*proto.UpdateNodePoolResponse, error) {
+	return g.service.UpdateNodePool(ctx, req)
+}
+
+//ListKubernetesVersions kubernetes versions clusters can be created with or upgraded to
+func (g *gateway) ListKubernetesVersions(ctx context.Context, req *proto.ListKubernetesVersionsRequest) (*proto.ListKubernetesVersionsResponse, error) {
+	return g.service.ListKubernetesVersions(ctx, req)
+}
+
+//UpgradeCluster upgrade the control plane of the cluster, then every node pool
+func (g *gateway) UpgradeCluster(ctx context.Context, req *proto.UpgradeClusterRequest) (*proto.UpgradeClusterResponse, error) {
+	return g.service.UpgradeCluster(ctx, req)
+}
+
+//ListMachineTypes machine types of the catalog available on the provider
+func (g *gateway) ListMachineTypes(ctx context.Context, req *proto.ListMachineTypesRequest) (*proto.ListMachineTypesResponse, error) {
+	return g.service.ListMachineTypes(ctx, req)
+}
+
+//EstimateCost itemized hourly and monthly cost of the cluster or node pool
+func (g *gateway) EstimateCost(ctx context.Context, req *proto.EstimateCostRequest) (*proto.EstimateCostResponse, error) {
+	return g.service.EstimateCost(ctx, req)
+}
+
+//ListVolumes spawner created volumes of the region, optionally of a single workspace
+func (g *gateway) ListVolumes(ctx context.Context, req *proto.ListVolumesRequest) (*proto.ListVolumesResponse, error) {
+	return g.service.ListVolumes(ctx, req)
+}
+
+//GetVolume state, size, type, zone, attachments and tags of the volume
+func (g *gateway) GetVolume(ctx context.Context, req *proto.GetVolumeRequest) (*proto.Volume, error) {
+	return g.service.GetVolume(ctx, req)
+}
+
+//ResizeVolume grow the volume
+func (g *gateway) ResizeVolume(ctx context.Context, req *proto.ResizeVolumeRequest) (*proto.ResizeVolumeResponse, error) {
+	return g.service.ResizeVolume(ctx, req)
+}
+
+//AttachVolume attach the volume to the instance
+func (g *gateway) AttachVolume(ctx context.Context, req *proto.AttachVolumeRequest) (*proto.AttachVolumeResponse, error) {
+	return g.service.AttachVolume(ctx, req)
+}
+
+//DetachVolume detach the volume from the instance
+func (g *gateway) DetachVolume(ctx context.Context, req *proto.DetachVolumeRequest) (*proto.DetachVolumeResponse, error) {
+	return g.service.DetachVolume(ctx, req)
+}
+
+//ListSnapshots spawner created snapshots of the region, optionally of a single workspace
+func (g *gateway) ListSnapshots(ctx context.Context, req *proto.ListSnapshotsRequest) (*proto.ListSnapshotsResponse, error) {
+	return g.service.ListSnapshots(ctx, req)
+}
+
+//DeleteSnapshot delete the snapshot
+func (g *gateway) DeleteSnapshot(ctx context.Context, req *proto.DeleteSnapshotRequest) (*proto.DeleteSnapshotResponse, error) {
+	return g.service.DeleteSnapshot(ctx, req)
+}
+
+//RestoreSnapshot create a new volume from the snapshot
+func (g *gateway) RestoreSnapshot(ctx context.Context, req *proto.RestoreSnapshotRequest) (*proto.RestoreSnapshotResponse, error) {
+	return g.service.RestoreSnapshot(ctx, req)
+}
+
+//CopySnapshot copy the snapshot to another region
+func (g *gateway) CopySnapshot(ctx context.Context, req *proto.CopySnapshotRequest) (*proto.CopySnapshotResponse, error) {
+	return g.service.CopySnapshot(ctx, req)
+}
+
+//CreateSnapshotPolicy create or replace the snapshot policy
+func (g *gateway) CreateSnapshotPolicy(ctx context.Context, req *proto.CreateSnapshotPolicyRequest) (*proto.SnapshotPolicy, error) {
+	return g.service.CreateSnapshotPolicy(ctx, req)
+}
+
+//DeleteSnapshotPolicy remove the snapshot policy
+func (g *gateway) DeleteSnapshotPolicy(ctx context.Context, req *proto.DeleteSnapshotPolicyRequest) (*proto.DeleteSnapshotPolicyResponse, error) {
+	return g.service.DeleteSnapshotPolicy(ctx, req)
+}
+
+//ListSnapshotPolicies get the snapshot policies and the result of their last run
+func (g *gateway) ListSnapshotPolicies(ctx context.Context, req *proto.ListSnapshotPoliciesRequest) (*proto.ListSnapshotPoliciesResponse, error) {
+	return g.service.ListSnapshotPolicies(ctx, req)
+}
+
+//UpsertDNSRecord create or replace the dns record
+func (g *gateway) UpsertDNSRecord(ctx context.Context, req *proto.UpsertDNSRecordRequest) (*proto.UpsertDNSRecordResponse, error) {
+	return g.service.UpsertDNSRecord(ctx, req)
+}
+
+//DeleteDNSRecord delete the dns record
+func (g *gateway) DeleteDNSRecord(ctx context.Context, req *proto.DeleteDNSRecordRequest) (*proto.DeleteDNSRecordResponse, error) {
+	return g.service.DeleteDNSRecord(ctx, req)
+}
+
+//ListDNSRecords get the records of the dns zone
+func (g *gateway) ListDNSRecords(ctx context.Context, req *proto.ListDNSRecordsRequest) (*proto.ListDNSRecordsResponse, error) {
+	return g.service.ListDNSRecords(ctx, req)
+}
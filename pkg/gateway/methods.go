@@ -0,0 +1,47 @@
+package gateway
+
+import (
+	proto "gitlab.com/netbook-devs/spawner-service/proto/netbookai/spawner"
+)
+
+func fullMethod(name string) string {
+	return "/" + proto.SpawnerService_ServiceDesc.ServiceName + "/" + name
+}
+
+//MutatingMethods full names of the rpcs which change the provider or service state,
+//retries of these calls are deduped with the idempotency key
+var MutatingMethods = []string{
+	fullMethod("CreateCluster"),
+	fullMethod("DeleteCluster"),
+	fullMethod("AddNode"),
+	fullMethod("DeleteNode"),
+	fullMethod("CreateVolume"),
+	fullMethod("DeleteVolume"),
+	fullMethod("CreateSnapshot"),
+	fullMethod("CreateSnapshotAndDelete"),
+	fullMethod("TagNodeInstance"),
+	fullMethod("AddToken"),
+	fullMethod("AddRoute53Record"),
+	fullMethod("RegisterWithRancher"),
+	fullMethod("WriteCredential"),
+	fullMethod("CancelOperation"),
+	fullMethod("SetBudget"),
+	fullMethod("DeleteBudget"),
+	fullMethod("ApplyClusterSpec"),
+	fullMethod("ExtendLease"),
+	fullMethod("ListOrphans"),
+	fullMethod("SetNodePoolSchedule"),
+	fullMethod("ScaleNodePool"),
+	fullMethod("UpdateNodePool"),
+	fullMethod("UpgradeCluster"),
+	fullMethod("ResizeVolume"),
+	fullMethod("AttachVolume"),
+	fullMethod("DetachVolume"),
+	fullMethod("DeleteSnapshot"),
+	fullMethod("RestoreSnapshot"),
+	fullMethod("CopySnapshot"),
+	fullMethod("CreateSnapshotPolicy"),
+	fullMethod("DeleteSnapshotPolicy"),
+	fullMethod("UpsertDNSRecord"),
+	fullMethod("DeleteDNSRecord"),
+}
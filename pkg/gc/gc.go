@@ -0,0 +1,276 @@
+package gc
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"gitlab.com/netbook-devs/spawner-service/pkg/inventory"
+	"gitlab.com/netbook-devs/spawner-service/pkg/service/constants"
+	proto "gitlab.com/netbook-devs/spawner-service/proto/netbookai/spawner"
+	"go.uber.org/zap"
+)
+
+//VolumesFunc returns the spawner tagged volumes in the region
+type VolumesFunc func(ctx context.Context, provider, account, region string) ([]*proto.Volume, error)
+
+//SnapshotsFunc returns the spawner tagged snapshots in the region
+type SnapshotsFunc func(ctx context.Context, provider, account, region string) ([]*proto.Snapshot, error)
+
+//ClusterFunc checks whether the cluster exists on the provider, error when it could not be found out
+type ClusterFunc func(ctx context.Context, provider, account, region, name string) (bool, error)
+
+//DeleteFunc deletes the orphan, returns the id of the operation deleting it
+type DeleteFunc func(ctx context.Context, o Orphan) (string, error)
+
+//Orphan volume or snapshot without attachment and owner
+type Orphan struct {
+	Kind      inventory.Kind
+	Provider  string
+	Account   string
+	Region    string
+	ID        string
+	Labels    map[string]string
+	CreatedAt time.Time
+	//Reason why the resource is considered orphaned
+	Reason string
+	//OperationID of the deletion, set when the orphan is deleted
+	OperationID string
+}
+
+//Collector finds the spawner tagged volumes and snapshots which are not attached, older than the grace period
+//and either have no owner at all or a cluster confirmed to be gone on the provider. Resources of a workspace
+//are never collected, workspaces often run on shared clusters and nothing tells that a workspace is gone
+type Collector struct {
+	logger    *zap.SugaredLogger
+	store     inventory.Store
+	volumes   VolumesFunc
+	snapshots SnapshotsFunc
+	cluster   ClusterFunc
+	remove    DeleteFunc
+	grace     time.Duration
+	//keepLabel resources carrying the label are never collected
+	keepLabel string
+	now       func() time.Time
+}
+
+//NewCollector returns the collector of the orphaned volumes and snapshots in the locations known to the store
+func NewCollector(logger *zap.SugaredLogger, store inventory.Store, volumes VolumesFunc, snapshots SnapshotsFunc, cluster ClusterFunc, remove DeleteFunc, grace time.Duration, keepLabel string) *Collector {
+	return &Collector{
+		logger:    logger,
+		store:     store,
+		volumes:   volumes,
+		snapshots: snapshots,
+		cluster:   cluster,
+		remove:    remove,
+		grace:     grace,
+		keepLabel: keepLabel,
+		now:       time.Now,
+	}
+}
+
+//Run collects the orphans every interval until ctx is done, orphans are only logged in dry run
+func (c *Collector) Run(ctx context.Context, interval time.Duration, dryRun bool) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		orphans, err := c.Collect(ctx, inventory.Filter{}, !dryRun)
+		if err != nil {
+			c.logger.Warnw("orphan collection incomplete", "error", err)
+		}
+		for _, o := range orphans {
+			c.logger.Infow("orphan found", "kind", o.Kind, "provider", o.Provider, "account", o.Account, "region", o.Region, "id", o.ID, "reason", o.Reason, "dryRun", dryRun, "operation", o.OperationID)
+		}
+	}
+}
+
+type location struct {
+	provider, account, region string
+}
+
+//owners live clusters of the provider account
+type owners struct {
+	clusters map[location]map[string]bool
+	//confirmed existence of the clusters not in the inventory, checked on the provider once per collection
+	confirmed map[location]map[string]bool
+}
+
+//locations and live owners of the resources in the inventory matching the filter
+func (c *Collector) locations(ctx context.Context, filter inventory.Filter) ([]location, owners, error) {
+	all, err := c.store.List(ctx, inventory.Filter{
+		Provider:       filter.Provider,
+		Account:        filter.Account,
+		IncludeDeleted: true,
+	})
+	if err != nil {
+		return nil, owners{}, err
+	}
+
+	live := owners{clusters: map[location]map[string]bool{}, confirmed: map[location]map[string]bool{}}
+	seen := map[location]bool{}
+	order := []location{}
+	for _, r := range all {
+		l := location{r.Provider, r.Account, r.Region}
+		if (filter.Region == "" || r.Region == filter.Region) && !seen[l] {
+			seen[l] = true
+			order = append(order, l)
+		}
+		if r.DeletedAt != nil || r.Kind != inventory.KindCluster {
+			continue
+		}
+		if live.clusters[l] == nil {
+			live.clusters[l] = map[string]bool{}
+		}
+		live.clusters[l][strings.ToLower(r.Name)] = true
+	}
+
+	//location asked for explicitly is scanned even when nothing was created there through spawner
+	l := location{filter.Provider, filter.Account, filter.Region}
+	if l.provider != "" && l.account != "" && l.region != "" && !seen[l] {
+		order = append(order, l)
+	}
+	return order, live, nil
+}
+
+//Find returns the orphans in the locations matching the filter, locations which could not be listed
+//are skipped and reported in the error
+func (c *Collector) Find(ctx context.Context, filter inventory.Filter) ([]Orphan, error) {
+	order, live, err := c.locations(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	orphans := []Orphan{}
+	failed := []string{}
+	for _, l := range order {
+		found, err := c.find(ctx, l, live)
+		if err != nil {
+			c.logger.Errorw("failed to list volumes and snapshots for orphan collection", "provider", l.provider, "account", l.account, "region", l.region, "error", err)
+			failed = append(failed, errors.Wrapf(err, "%s/%s/%s", l.provider, l.account, l.region).Error())
+			continue
+		}
+		orphans = append(orphans, found...)
+	}
+
+	if len(failed) > 0 {
+		return orphans, errors.Errorf("orphans not collected in %s", strings.Join(failed, "; "))
+	}
+	return orphans, nil
+}
+
+//Collect finds the orphans and deletes them when remove is set, orphans which could not be deleted are
+//returned without operation id
+func (c *Collector) Collect(ctx context.Context, filter inventory.Filter, remove bool) ([]Orphan, error) {
+	orphans, err := c.Find(ctx, filter)
+	if !remove {
+		return orphans, err
+	}
+	for i, o := range orphans {
+		id, derr := c.remove(ctx, o)
+		if derr != nil {
+			c.logger.Errorw("failed to delete orphan", "kind", o.Kind, "id", o.ID, "error", derr)
+			continue
+		}
+		orphans[i].OperationID = id
+	}
+	return orphans, err
+}
+
+func (c *Collector) find(ctx context.Context, l location, live owners) ([]Orphan, error) {
+	volumes, err := c.volumes(ctx, l.provider, l.account, l.region)
+	if err != nil {
+		return nil, err
+	}
+	snapshots, err := c.snapshots(ctx, l.provider, l.account, l.region)
+	if err != nil {
+		return nil, err
+	}
+
+	orphans := []Orphan{}
+	existing := map[string]bool{}
+	for _, v := range volumes {
+		existing[v.VolumeId] = true
+		if len(v.Attachments) > 0 {
+			continue
+		}
+		o := Orphan{Kind: inventory.KindVolume, ID: v.VolumeId, Labels: v.Labels}
+		if c.orphaned(ctx, &o, l, v.CreatedAt, live) {
+			o.Reason = "not attached, " + o.Reason
+			orphans = append(orphans, o)
+		}
+	}
+	for _, s := range snapshots {
+		//snapshot is in use as long as its volume is around
+		if s.VolumeId != "" && existing[s.VolumeId] {
+			continue
+		}
+		o := Orphan{Kind: inventory.KindSnapshot, ID: s.SnapshotId, Labels: s.Labels}
+		if c.orphaned(ctx, &o, l, s.CreatedAt, live) {
+			o.Reason = "source volume gone, " + o.Reason
+			orphans = append(orphans, o)
+		}
+	}
+	return orphans, nil
+}
+
+//clusterExists whether the cluster missing in the inventory exists on the provider, clusters created before
+//the inventory are only known to the provider. existence is assumed when the provider could not tell
+func (c *Collector) clusterExists(ctx context.Context, l location, name string, live owners) bool {
+	key := strings.ToLower(name)
+	if exists, ok := live.confirmed[l][key]; ok {
+		return exists
+	}
+	exists, err := c.cluster(ctx, l.provider, l.account, l.region, name)
+	if err != nil {
+		c.logger.Warnw("cluster existence unknown, its resources are not collected", "provider", l.provider, "account", l.account, "region", l.region, "cluster", name, "error", err)
+		exists = true
+	}
+	if live.confirmed[l] == nil {
+		live.confirmed[l] = map[string]bool{}
+	}
+	live.confirmed[l][key] = exists
+	return exists
+}
+
+//orphaned true when the resource is past the grace period, not kept, has no workspace and either has no
+//cluster or its cluster is confirmed gone, reason of the orphan is set
+func (c *Collector) orphaned(ctx context.Context, o *Orphan, l location, createdAt string, live owners) bool {
+	o.Provider, o.Account, o.Region = l.provider, l.account, l.region
+	if _, keep := o.Labels[c.keepLabel]; keep && c.keepLabel != "" {
+		return false
+	}
+
+	created, err := time.Parse(time.RFC3339, createdAt)
+	if err != nil {
+		//age unknown, never collected
+		c.logger.Debugw("orphan candidate without creation time", "kind", o.Kind, "id", o.ID, "createdAt", createdAt)
+		return false
+	}
+	o.CreatedAt = created
+	if c.now().Sub(created) < c.grace {
+		return false
+	}
+
+	if o.Labels[constants.WorkspaceLabel] != "" {
+		return false
+	}
+	cluster := o.Labels[constants.ClusterNameLabel]
+	if cluster != "" && (live.clusters[l][strings.ToLower(cluster)] || c.clusterExists(ctx, l, cluster, live)) {
+		return false
+	}
+
+	o.Reason = "no owning cluster or workspace"
+	if cluster != "" {
+		o.Reason = fmt.Sprintf("cluster '%s' not found", cluster)
+	}
+	o.Reason += fmt.Sprintf(", older than %s", c.grace)
+	return true
+}
@@ -0,0 +1,92 @@
+package gc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gitlab.com/netbook-devs/spawner-service/pkg/inventory"
+	"gitlab.com/netbook-devs/spawner-service/pkg/service/constants"
+	proto "gitlab.com/netbook-devs/spawner-service/proto/netbookai/spawner"
+	"go.uber.org/zap"
+)
+
+func Test_Collect(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2022, 5, 10, 10, 0, 0, 0, time.UTC)
+	old := now.Add(-100 * time.Hour).Format(time.RFC3339)
+	recent := now.Add(-time.Hour).Format(time.RFC3339)
+
+	store := inventory.NewMemoryStore()
+	deleted := now.Add(-80 * time.Hour)
+	require.NoError(t, store.Put(ctx, inventory.Resource{ID: "live", Kind: inventory.KindCluster, Provider: "fake", Account: "dev", Region: "local", Name: "live", Workspace: "ws-live"}))
+	require.NoError(t, store.Put(ctx, inventory.Resource{ID: "gone", Kind: inventory.KindCluster, Provider: "fake", Account: "dev", Region: "local", Name: "gone", Workspace: "ws-gone", DeletedAt: &deleted}))
+	require.NoError(t, store.Put(ctx, inventory.Resource{ID: "other", Kind: inventory.KindCluster, Provider: "fake", Account: "dev", Region: "remote", Name: "other"}))
+
+	volumes := []*proto.Volume{
+		{VolumeId: "attached", CreatedAt: old, Attachments: []string{"i-1"}},
+		{VolumeId: "owned", CreatedAt: old, Labels: map[string]string{constants.ClusterNameLabel: "live"}},
+		{VolumeId: "workspace", CreatedAt: old, Labels: map[string]string{constants.WorkspaceLabel: "WS-LIVE"}},
+		{VolumeId: "recent", CreatedAt: recent},
+		{VolumeId: "kept", CreatedAt: old, Labels: map[string]string{"spawner-keep": "true"}},
+		{VolumeId: "unknown-age"},
+		{VolumeId: "cluster-gone", CreatedAt: old, Labels: map[string]string{constants.ClusterNameLabel: "gone"}},
+		//workspace resources are never collected, even when nothing of the workspace is left in the inventory
+		{VolumeId: "workspace-gone", CreatedAt: old, Labels: map[string]string{constants.ClusterNameLabel: "gone", constants.WorkspaceLabel: "ws-gone"}},
+		{VolumeId: "unowned", CreatedAt: old},
+		//clusters missing in the inventory, created before it or unknown to the provider right now
+		{VolumeId: "legacy", CreatedAt: old, Labels: map[string]string{constants.ClusterNameLabel: "legacy"}},
+		{VolumeId: "flaky", CreatedAt: old, Labels: map[string]string{constants.ClusterNameLabel: "flaky"}},
+	}
+	snapshots := []*proto.Snapshot{
+		{SnapshotId: "in-use", VolumeId: "unowned", CreatedAt: old},
+		{SnapshotId: "source-gone", VolumeId: "vol-deleted", CreatedAt: old, Labels: map[string]string{constants.ClusterNameLabel: "gone"}},
+		{SnapshotId: "stopped-workspace", VolumeId: "vol-deleted", CreatedAt: old, Labels: map[string]string{constants.WorkspaceLabel: "ws-stopped"}},
+	}
+
+	removed := []string{}
+	c := NewCollector(zap.NewNop().Sugar(), store,
+		func(ctx context.Context, provider, account, region string) ([]*proto.Volume, error) {
+			if region == "remote" {
+				return nil, errors.New("unreachable")
+			}
+			return volumes, nil
+		},
+		func(ctx context.Context, provider, account, region string) ([]*proto.Snapshot, error) {
+			return snapshots, nil
+		},
+		func(ctx context.Context, provider, account, region, name string) (bool, error) {
+			switch name {
+			case "legacy":
+				return true, nil
+			case "flaky":
+				return false, errors.New("throttled")
+			}
+			return false, nil
+		},
+		func(ctx context.Context, o Orphan) (string, error) {
+			removed = append(removed, o.ID)
+			return "op-" + o.ID, nil
+		}, 72*time.Hour, "spawner-keep")
+	c.now = func() time.Time { return now }
+
+	orphans, err := c.Collect(ctx, inventory.Filter{Region: "local"}, false)
+	require.NoError(t, err)
+	got := []string{}
+	for _, o := range orphans {
+		got = append(got, string(o.Kind)+" "+o.ID)
+		assert.Empty(t, o.OperationID)
+		assert.NotEmpty(t, o.Reason)
+	}
+	assert.Equal(t, []string{"volume cluster-gone", "volume unowned", "snapshot source-gone"}, got)
+	assert.Empty(t, removed, "dry run must not delete")
+
+	orphans, err = c.Collect(ctx, inventory.Filter{}, true)
+	assert.Error(t, err, "unreachable region must be reported")
+	require.Len(t, orphans, 3)
+	assert.Equal(t, []string{"cluster-gone", "unowned", "source-gone"}, removed)
+	assert.Equal(t, "op-unowned", orphans[1].OperationID)
+}
@@ -0,0 +1,146 @@
+package idempotency
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"gitlab.com/netbook-devs/spawner-service/pkg/auth"
+	"go.uber.org/zap"
+	gproto "google.golang.org/protobuf/proto"
+)
+
+//MetadataKey grpc metadata carrying the idempotency key of the request
+const MetadataKey = "idempotency-key"
+
+//ReplayedKey response header set when the response is replayed from the cache
+const ReplayedKey = "idempotent-replayed"
+
+const (
+	defaultRetention = 24 * time.Hour
+	maxKeyLength     = 255
+)
+
+var (
+	ERR_KEY_REUSED  = errors.New("idempotency key was used with a different request")
+	ERR_IN_PROGRESS = errors.New("request with the idempotency key is still in progress")
+	ERR_INVALID_KEY = errors.New("invalid idempotency key")
+)
+
+type record struct {
+	fingerprint string
+	response    gproto.Message
+	done        bool
+	createdAt   time.Time
+}
+
+//Cache keeps the responses of the requests by their idempotency key, keys are scoped to the caller and the rpc
+//
+//only successful responses are kept, failed requests release the key so that they can be retried.
+//keys are dropped after the retention period. responses are kept in memory only, retry after restart runs the call again.
+type Cache struct {
+	logger    *zap.SugaredLogger
+	retention time.Duration
+	now       func() time.Time
+
+	mu      sync.Mutex
+	records map[string]*record
+}
+
+//NewCache returns empty cache, keys are kept for retention, defaults to 24h
+func NewCache(logger *zap.SugaredLogger, retention time.Duration) *Cache {
+	if retention <= 0 {
+		retention = defaultRetention
+	}
+	return &Cache{
+		logger:    logger,
+		retention: retention,
+		now:       time.Now,
+		records:   make(map[string]*record),
+	}
+}
+
+//fingerprint identifies the request payload, method is part of it so that same key can not be used across rpcs
+func fingerprint(method string, req gproto.Message) (string, error) {
+	b, err := gproto.MarshalOptions{Deterministic: true}.Marshal(req)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to marshal request")
+	}
+	h := sha256.New()
+	h.Write([]byte(method))
+	h.Write([]byte{0})
+	h.Write(b)
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+//scopedKey key of the record, same key sent by different callers or to different rpcs is a different request
+func scopedKey(subject, method, key string) string {
+	return subject + "\x00" + method + "\x00" + key
+}
+
+//subject of the caller, empty when authentication is disabled
+func subject(ctx context.Context) string {
+	if id := auth.FromContext(ctx); id != nil {
+		return id.Subject
+	}
+	return ""
+}
+
+//prune drops the keys past retention, must be called with lock held
+func (c *Cache) prune() {
+	now := c.now()
+	for k, r := range c.records {
+		if r.done && now.Sub(r.createdAt) > c.retention {
+			delete(c.records, k)
+		}
+	}
+}
+
+//Do runs fn once per key, replays of the same request get the response of the first call back.
+//
+//replayed is true when the response is served from the cache. request with the key already used by a
+//different request fails with ERR_KEY_REUSED, and with ERR_IN_PROGRESS while the first call is running.
+func (c *Cache) Do(ctx context.Context, key, method string, req gproto.Message, fn func(ctx context.Context) (gproto.Message, error)) (resp gproto.Message, replayed bool, err error) {
+	if len(key) > maxKeyLength {
+		return nil, false, errors.Wrapf(ERR_INVALID_KEY, "key must be at most %d chars", maxKeyLength)
+	}
+
+	fp, err := fingerprint(method, req)
+	if err != nil {
+		return nil, false, err
+	}
+
+	scoped := scopedKey(subject(ctx), method, key)
+	c.mu.Lock()
+	c.prune()
+	if r, ok := c.records[scoped]; ok {
+		c.mu.Unlock()
+		switch {
+		case r.fingerprint != fp:
+			return nil, false, errors.Wrapf(ERR_KEY_REUSED, "key '%s'", key)
+		case !r.done:
+			return nil, false, errors.Wrapf(ERR_IN_PROGRESS, "key '%s'", key)
+		}
+		c.logger.Infow("replaying response of idempotent request", "key", key, "method", method)
+		return gproto.Clone(r.response), true, nil
+	}
+	r := &record{fingerprint: fp}
+	c.records[scoped] = r
+	c.mu.Unlock()
+
+	resp, err = fn(ctx)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if err != nil {
+		delete(c.records, scoped)
+		return nil, false, err
+	}
+	r.response = gproto.Clone(resp)
+	r.done = true
+	r.createdAt = c.now()
+	return resp, false, nil
+}
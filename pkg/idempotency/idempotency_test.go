@@ -0,0 +1,120 @@
+package idempotency
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	proto "gitlab.com/netbook-devs/spawner-service/proto/netbookai/spawner"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	gproto "google.golang.org/protobuf/proto"
+)
+
+const createVolume = "/spawner.SpawnerService/CreateVolume"
+
+func Test_Interceptor(t *testing.T) {
+	c := NewCache(zap.NewNop().Sugar(), time.Hour)
+	intercept := UnaryServerInterceptor(c, []string{createVolume})
+	info := &grpc.UnaryServerInfo{FullMethod: createVolume}
+
+	calls := 0
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		calls++
+		return &proto.CreateVolumeResponse{Volumeid: "vol-1", OperationId: "op-1"}, nil
+	}
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(MetadataKey, "retry-1"))
+	req := &proto.CreateVolumeRequest{Provider: "fake", Region: "us-east-1", Size: 10}
+
+	first, err := intercept(ctx, req, info, handler)
+	require.NoError(t, err)
+
+	//replay returns the first response without calling the provider again
+	second, err := intercept(ctx, gproto.Clone(req), info, handler)
+	require.NoError(t, err)
+	assert.Equal(t, 1, calls)
+	assert.True(t, gproto.Equal(first.(gproto.Message), second.(gproto.Message)))
+
+	//same key, different payload
+	_, err = intercept(ctx, &proto.CreateVolumeRequest{Provider: "fake", Region: "us-east-1", Size: 20}, info, handler)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	assert.Equal(t, 1, calls)
+
+	//calls without the key are not deduped
+	_, err = intercept(context.Background(), req, info, handler)
+	require.NoError(t, err)
+	_, err = intercept(context.Background(), req, info, handler)
+	require.NoError(t, err)
+	assert.Equal(t, 3, calls)
+
+	//calls to other methods are not deduped
+	_, err = intercept(ctx, req, &grpc.UnaryServerInfo{FullMethod: "/spawner.SpawnerService/GetCluster"}, handler)
+	require.NoError(t, err)
+	assert.Equal(t, 4, calls)
+}
+
+func Test_CacheFailureReleasesKey(t *testing.T) {
+	ctx := context.Background()
+	c := NewCache(zap.NewNop().Sugar(), time.Hour)
+	req := &proto.NodeSpawnRequest{ClusterName: "dev"}
+
+	failed := errors.New("provider unavailable")
+	_, _, err := c.Do(ctx, "k", "AddNode", req, func(ctx context.Context) (gproto.Message, error) {
+		return nil, failed
+	})
+	assert.Equal(t, failed, err)
+
+	resp, replayed, err := c.Do(ctx, "k", "AddNode", req, func(ctx context.Context) (gproto.Message, error) {
+		return &proto.NodeSpawnResponse{OperationId: "op-2"}, nil
+	})
+	require.NoError(t, err)
+	assert.False(t, replayed)
+	assert.Equal(t, "op-2", resp.(*proto.NodeSpawnResponse).OperationId)
+
+	//same key on another rpc is a different request
+	resp, replayed, err = c.Do(ctx, "k", "DeleteNode", req, func(ctx context.Context) (gproto.Message, error) {
+		return &proto.NodeDeleteResponse{}, nil
+	})
+	require.NoError(t, err)
+	assert.False(t, replayed)
+
+	//same key of another caller is a different request
+	assert.NotEqual(t, scopedKey("team-a", "AddNode", "k"), scopedKey("team-b", "AddNode", "k"))
+}
+
+func Test_CacheInProgressAndRetention(t *testing.T) {
+	ctx := context.Background()
+	c := NewCache(zap.NewNop().Sugar(), time.Hour)
+	now := time.Now()
+	c.now = func() time.Time { return now }
+	req := &proto.CreateSnapshotRequest{Volumeid: "vol-1"}
+
+	_, _, err := c.Do(ctx, "k", "CreateSnapshot", req, func(ctx context.Context) (gproto.Message, error) {
+		_, _, err := c.Do(ctx, "k", "CreateSnapshot", req, func(ctx context.Context) (gproto.Message, error) {
+			return &proto.CreateSnapshotResponse{}, nil
+		})
+		assert.Equal(t, ERR_IN_PROGRESS, errors.Cause(err))
+		return &proto.CreateSnapshotResponse{Snapshotid: "snap-1"}, nil
+	})
+	require.NoError(t, err)
+
+	_, replayed, err := c.Do(ctx, "k", "CreateSnapshot", req, nil)
+	require.NoError(t, err)
+	assert.True(t, replayed)
+
+	//key is forgotten after retention
+	now = now.Add(2 * time.Hour)
+	resp, replayed, err := c.Do(ctx, "k", "CreateSnapshot", req, func(ctx context.Context) (gproto.Message, error) {
+		return &proto.CreateSnapshotResponse{Snapshotid: "snap-2"}, nil
+	})
+	require.NoError(t, err)
+	assert.False(t, replayed)
+	assert.Equal(t, "snap-2", resp.(*proto.CreateSnapshotResponse).Snapshotid)
+}
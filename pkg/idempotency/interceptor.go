@@ -0,0 +1,69 @@
+package idempotency
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	gproto "google.golang.org/protobuf/proto"
+)
+
+func keyFromContext(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+	if v := md.Get(MetadataKey); len(v) > 0 {
+		return v[0]
+	}
+	return ""
+}
+
+func statusError(err error) error {
+	switch errors.Cause(err) {
+	case ERR_KEY_REUSED, ERR_INVALID_KEY:
+		return status.Error(codes.InvalidArgument, err.Error())
+	case ERR_IN_PROGRESS:
+		return status.Error(codes.Aborted, err.Error())
+	}
+	return err
+}
+
+//UnaryServerInterceptor dedupes the calls to the given full method names carrying the idempotency key metadata,
+//calls without the key are passed through as is
+func UnaryServerInterceptor(c *Cache, methods []string) grpc.UnaryServerInterceptor {
+	idempotent := make(map[string]bool, len(methods))
+	for _, m := range methods {
+		idempotent[m] = true
+	}
+
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		key := keyFromContext(ctx)
+		msg, ok := req.(gproto.Message)
+		if key == "" || !ok || !idempotent[info.FullMethod] {
+			return handler(ctx, req)
+		}
+
+		resp, replayed, err := c.Do(ctx, key, info.FullMethod, msg, func(ctx context.Context) (gproto.Message, error) {
+			resp, err := handler(ctx, req)
+			if err != nil {
+				return nil, err
+			}
+			m, ok := resp.(gproto.Message)
+			if !ok {
+				return nil, status.Errorf(codes.Internal, "unexpected response type %T", resp)
+			}
+			return m, nil
+		})
+		if err != nil {
+			return nil, statusError(err)
+		}
+		if replayed {
+			grpc.SetHeader(ctx, metadata.Pairs(ReplayedKey, "true"))
+		}
+		return resp, nil
+	}
+}
@@ -0,0 +1,122 @@
+package inventory
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/pkg/errors"
+	bolt "go.etcd.io/bbolt"
+)
+
+var resourceBucket = []byte("resources")
+
+//BoltStore persists the inventory in the embedded bolt database
+type BoltStore struct {
+	db *bolt.DB
+}
+
+//NewBoltStore opens or creates the bolt database at path
+func NewBoltStore(path string) (*BoltStore, error) {
+	db, err := bolt.Open(path, 0600, &bolt.Options{Timeout: 5 * time.Second})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to open inventory at '%s'", path)
+	}
+
+	err = db.Update(func(tx *bolt.Tx) error {
+		_, err := tx.CreateBucketIfNotExists(resourceBucket)
+		return err
+	})
+	if err != nil {
+		db.Close()
+		return nil, errors.Wrap(err, "failed to create inventory bucket")
+	}
+	return &BoltStore{db: db}, nil
+}
+
+func get(b *bolt.Bucket, key string) (Resource, bool, error) {
+	var r Resource
+	v := b.Get([]byte(key))
+	if v == nil {
+		return r, false, nil
+	}
+	if err := json.Unmarshal(v, &r); err != nil {
+		return r, false, errors.Wrapf(err, "corrupted inventory record '%s'", key)
+	}
+	return r, true, nil
+}
+
+func put(b *bolt.Bucket, r Resource) error {
+	v, err := json.Marshal(r)
+	if err != nil {
+		return err
+	}
+	return b.Put([]byte(r.Key().String()), v)
+}
+
+func (s *BoltStore) Put(ctx context.Context, r Resource) error {
+	return s.db.Update(func(tx *bolt.Tx) error {
+		b := tx.Bucket(resourceBucket)
+		old, _, err := get(b, r.Key().String())
+		if err != nil {
+			return err
+		}
+		return put(b, merge(old, r))
+	})
+}
+
+func (s *BoltStore) Get(ctx context.Context, key Key) (Resource, error) {
+	var (
+		r     Resource
+		found bool
+	)
+	err := s.db.View(func(tx *bolt.Tx) error {
+		var err error
+		r, found, err = get(tx.Bucket(resourceBucket), key.String())
+		return err
+	})
+	if err != nil {
+		return r, err
+	}
+	if !found {
+		return r, ERR_NOT_FOUND
+	}
+	return r, nil
+}
+
+func (s *BoltStore) MarkDeleted(ctx context.Context, key Key, at time.Time) error {
+	return s.db.Update(func(tx *bolt.Tx) error {
+		b := tx.Bucket(resourceBucket)
+		r, found, err := get(b, key.String())
+		if err != nil {
+			return err
+		}
+		if !found {
+			return ERR_NOT_FOUND
+		}
+		r.DeletedAt = &at
+		return put(b, r)
+	})
+}
+
+func (s *BoltStore) List(ctx context.Context, f Filter) ([]Resource, error) {
+	res := []Resource{}
+	err := s.db.View(func(tx *bolt.Tx) error {
+		//keys are sorted by bolt, no need to sort again
+		return tx.Bucket(resourceBucket).ForEach(func(k, v []byte) error {
+			var r Resource
+			if err := json.Unmarshal(v, &r); err != nil {
+				return errors.Wrapf(err, "corrupted inventory record '%s'", string(k))
+			}
+			if f.Match(r) {
+				res = append(res, r)
+			}
+			return nil
+		})
+	})
+	return res, err
+}
+
+func (s *BoltStore) Close() error {
+	return s.db.Close()
+}
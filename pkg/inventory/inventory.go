@@ -0,0 +1,139 @@
+package inventory
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+//Kind type of the resource tracked in inventory
+type Kind string
+
+const (
+	KindCluster      Kind = "cluster"
+	KindNodePool     Kind = "nodepool"
+	KindVolume       Kind = "volume"
+	KindSnapshot     Kind = "snapshot"
+	KindIAMRole      Kind = "iam-role"
+	KindNetworkStack Kind = "network-stack"
+	KindDNSRecord    Kind = "dns-record"
+)
+
+//GlobalRegion region used for the resources which are not bound to any region, ex: IAM roles
+const GlobalRegion = "global"
+
+var ERR_NOT_FOUND = errors.New("resource not found in inventory")
+
+//Key uniquely identifies the resource in the inventory
+type Key struct {
+	Provider string
+	Account  string
+	Region   string
+	Kind     Kind
+	ID       string
+}
+
+func (k Key) String() string {
+	return fmt.Sprintf("%s/%s/%s/%s/%s", k.Provider, k.Account, k.Region, k.Kind, k.ID)
+}
+
+//Resource is the record of the resource created by spawner
+type Resource struct {
+	ID        string `json:"id"`
+	Kind      Kind   `json:"kind"`
+	Provider  string `json:"provider"`
+	Account   string `json:"account"`
+	Region    string `json:"region"`
+	Workspace string `json:"workspace,omitempty"`
+	//Cluster name of the cluster the resource belongs to, set for node pools
+	Cluster string            `json:"cluster,omitempty"`
+	Name    string            `json:"name,omitempty"`
+	Labels  map[string]string `json:"labels,omitempty"`
+	//Attributes provider specific details, ex: instance type, size, arn
+	Attributes map[string]string `json:"attributes,omitempty"`
+	CreatedAt  time.Time         `json:"createdAt"`
+	DeletedAt  *time.Time        `json:"deletedAt,omitempty"`
+}
+
+//Key of the resource
+func (r Resource) Key() Key {
+	return Key{
+		Provider: r.Provider,
+		Account:  r.Account,
+		Region:   r.Region,
+		Kind:     r.Kind,
+		ID:       r.ID,
+	}
+}
+
+//Deleted true when the resource is deleted, deleted resources are kept as tombstones
+func (r Resource) Deleted() bool {
+	return r.DeletedAt != nil
+}
+
+//Filter selects the resources in List, empty fields match everything
+type Filter struct {
+	Provider  string
+	Account   string
+	Region    string
+	Workspace string
+	Kind      Kind
+	Cluster   string
+	//Labels resource must have all the labels with same values
+	Labels         map[string]string
+	IncludeDeleted bool
+}
+
+//Match check if the resource satisfy the filter
+func (f Filter) Match(r Resource) bool {
+	if f.Provider != "" && f.Provider != r.Provider {
+		return false
+	}
+	if f.Account != "" && f.Account != r.Account {
+		return false
+	}
+	if f.Region != "" && f.Region != r.Region {
+		return false
+	}
+	if f.Workspace != "" && f.Workspace != r.Workspace {
+		return false
+	}
+	if f.Kind != "" && f.Kind != r.Kind {
+		return false
+	}
+	if f.Cluster != "" && f.Cluster != r.Cluster {
+		return false
+	}
+	if !f.IncludeDeleted && r.Deleted() {
+		return false
+	}
+	for k, v := range f.Labels {
+		if l, ok := r.Labels[k]; !ok || l != v {
+			return false
+		}
+	}
+	return true
+}
+
+//Store persists the inventory of the resources
+type Store interface {
+	//Put adds or updates the resource, creation time of the existing resource is retained
+	Put(ctx context.Context, r Resource) error
+	//Get returns the resource, ERR_NOT_FOUND if it was never recorded
+	Get(ctx context.Context, key Key) (Resource, error)
+	//MarkDeleted keeps the resource as tombstone with the deletion time
+	MarkDeleted(ctx context.Context, key Key, at time.Time) error
+	//List returns the resources matching the filter ordered by key
+	List(ctx context.Context, f Filter) ([]Resource, error)
+	Close() error
+}
+
+//Open opens the bolt store at the path, in-memory store is returned when path is empty
+func Open(path string) (Store, error) {
+	if path == "" {
+		return NewMemoryStore(), nil
+	}
+	return NewBoltStore(path)
+}
@@ -0,0 +1,60 @@
+package inventory
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func Test_BoltStore(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "inventory.db")
+
+	store, err := NewBoltStore(path)
+	require.NoError(t, err)
+
+	created := time.Date(2022, 4, 1, 10, 0, 0, 0, time.UTC)
+	rec := NewRecorder(store, "aws", zap.NewNop().Sugar())
+	rec.now = func() time.Time { return created }
+
+	rec.Created(ctx, Resource{ID: "dev-cluster", Kind: KindCluster, Account: "dev", Region: "us-west-2", Labels: map[string]string{"workspaceid": "ws-1", "team": "ml"}})
+	rec.Created(ctx, Resource{ID: NodePoolID("dev-cluster", "gpu"), Kind: KindNodePool, Account: "dev", Region: "us-west-2", Cluster: "dev-cluster"})
+	rec.Created(ctx, Resource{ID: "vol-1", Kind: KindVolume, Account: "dev", Region: "us-east-1", Labels: map[string]string{"workspaceid": "ws-2"}})
+	require.NoError(t, store.Close())
+
+	//reopen, inventory must survive restarts
+	store, err = NewBoltStore(path)
+	require.NoError(t, err)
+	defer store.Close()
+	rec.store = store
+
+	res, err := store.List(ctx, Filter{Workspace: "ws-1", Labels: map[string]string{"team": "ml"}})
+	require.NoError(t, err)
+	require.Len(t, res, 1)
+	assert.Equal(t, "dev-cluster", res[0].ID)
+	assert.Equal(t, "aws", res[0].Provider)
+	assert.Equal(t, created, res[0].CreatedAt)
+
+	res, err = store.List(ctx, Filter{Region: "us-west-2"})
+	require.NoError(t, err)
+	assert.Len(t, res, 2)
+
+	rec.now = func() time.Time { return created.Add(time.Hour) }
+	rec.Deleted(ctx, KindCluster, "dev", "us-west-2", "dev-cluster")
+	rec.Deleted(ctx, KindVolume, "dev", "us-east-1", "unknown")
+
+	res, err = store.List(ctx, Filter{Account: "dev"})
+	require.NoError(t, err)
+	require.Len(t, res, 1, "cluster and its node pool are deleted")
+	assert.Equal(t, "vol-1", res[0].ID)
+
+	pool, err := store.Get(ctx, Key{Provider: "aws", Account: "dev", Region: "us-west-2", Kind: KindNodePool, ID: "dev-cluster/gpu"})
+	require.NoError(t, err)
+	require.True(t, pool.Deleted())
+	assert.Equal(t, created.Add(time.Hour), *pool.DeletedAt)
+}
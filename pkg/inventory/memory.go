@@ -0,0 +1,86 @@
+package inventory
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+)
+
+//MemoryStore keeps the inventory in memory, used when no inventory path is configured and in tests
+type MemoryStore struct {
+	mu        sync.RWMutex
+	resources map[string]Resource
+}
+
+//NewMemoryStore returns empty in-memory store
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{
+		resources: make(map[string]Resource),
+	}
+}
+
+func (m *MemoryStore) Put(ctx context.Context, r Resource) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	k := r.Key().String()
+	m.resources[k] = merge(m.resources[k], r)
+	return nil
+}
+
+func (m *MemoryStore) Get(ctx context.Context, key Key) (Resource, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	r, ok := m.resources[key.String()]
+	if !ok {
+		return Resource{}, ERR_NOT_FOUND
+	}
+	return r, nil
+}
+
+func (m *MemoryStore) MarkDeleted(ctx context.Context, key Key, at time.Time) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	k := key.String()
+	r, ok := m.resources[k]
+	if !ok {
+		return ERR_NOT_FOUND
+	}
+	r.DeletedAt = &at
+	m.resources[k] = r
+	return nil
+}
+
+func (m *MemoryStore) List(ctx context.Context, f Filter) ([]Resource, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	keys := make([]string, 0, len(m.resources))
+	for k := range m.resources {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	res := []Resource{}
+	for _, k := range keys {
+		if r := m.resources[k]; f.Match(r) {
+			res = append(res, r)
+		}
+	}
+	return res, nil
+}
+
+func (m *MemoryStore) Close() error {
+	return nil
+}
+
+//merge retains the creation time of the existing live resource, recreated resource starts fresh
+func merge(old, r Resource) Resource {
+	if !old.CreatedAt.IsZero() && !old.Deleted() {
+		r.CreatedAt = old.CreatedAt
+	}
+	return r
+}
@@ -0,0 +1,137 @@
+package inventory
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"gitlab.com/netbook-devs/spawner-service/pkg/service/constants"
+	"go.uber.org/zap"
+)
+
+//Recorder records the resources created and deleted by a provider.
+//
+//failing to record never fails the provider call, resource already exists in the cloud at that point,
+//errors are logged instead. nil Recorder or Recorder without store is a no-op.
+type Recorder struct {
+	store    Store
+	provider string
+	logger   *zap.SugaredLogger
+	now      func() time.Time
+}
+
+//NewRecorder returns recorder writing the resources of the provider to the store
+func NewRecorder(store Store, provider string, logger *zap.SugaredLogger) *Recorder {
+	return &Recorder{
+		store:    store,
+		provider: provider,
+		logger:   logger,
+		now:      time.Now,
+	}
+}
+
+func (r *Recorder) enabled() bool {
+	return r != nil && r.store != nil
+}
+
+//Created records the resource, workspace is taken from the labels when not set
+func (r *Recorder) Created(ctx context.Context, res Resource) {
+	if !r.enabled() {
+		return
+	}
+
+	res.Provider = r.provider
+	if res.Workspace == "" {
+		res.Workspace = res.Labels[constants.WorkspaceLabel]
+	}
+	if res.CreatedAt.IsZero() {
+		res.CreatedAt = r.now().UTC()
+	}
+	res.DeletedAt = nil
+
+	if err := r.store.Put(ctx, res); err != nil {
+		r.logger.Errorw("failed to record resource in inventory", "kind", res.Kind, "id", res.ID, "error", err)
+	}
+}
+
+//Updated merges the labels and attributes into the recorded resource, resources missing in inventory or deleted are skipped
+func (r *Recorder) Updated(ctx context.Context, kind Kind, account, region, id string, labels, attributes map[string]string) {
+	if !r.enabled() {
+		return
+	}
+
+	key := Key{Provider: r.provider, Account: account, Region: region, Kind: kind, ID: id}
+	res, err := r.store.Get(ctx, key)
+	if err != nil && !errors.Is(err, ERR_NOT_FOUND) {
+		r.logger.Errorw("failed to get resource from inventory", "key", key.String(), "error", err)
+		return
+	}
+	if err != nil || res.Deleted() {
+		r.logger.Debugw("updated resource not found in inventory", "key", key.String())
+		return
+	}
+
+	if res.Labels == nil && len(labels) > 0 {
+		res.Labels = make(map[string]string, len(labels))
+	}
+	for k, v := range labels {
+		res.Labels[k] = v
+	}
+	if res.Attributes == nil && len(attributes) > 0 {
+		res.Attributes = make(map[string]string, len(attributes))
+	}
+	for k, v := range attributes {
+		res.Attributes[k] = v
+	}
+
+	if err := r.store.Put(ctx, res); err != nil {
+		r.logger.Errorw("failed to update resource in inventory", "key", key.String(), "error", err)
+	}
+}
+
+//Deleted marks the resource deleted, deleting a cluster marks its node pools deleted too
+func (r *Recorder) Deleted(ctx context.Context, kind Kind, account, region, id string) {
+	if !r.enabled() {
+		return
+	}
+
+	now := r.now().UTC()
+	key := Key{Provider: r.provider, Account: account, Region: region, Kind: kind, ID: id}
+	r.markDeleted(ctx, key, now)
+
+	if kind != KindCluster {
+		return
+	}
+
+	pools, err := r.store.List(ctx, Filter{Provider: r.provider, Account: account, Region: region, Kind: KindNodePool, Cluster: id})
+	if err != nil {
+		r.logger.Errorw("failed to list node pools of the cluster in inventory", "cluster", id, "error", err)
+		return
+	}
+	for _, p := range pools {
+		r.markDeleted(ctx, p.Key(), now)
+	}
+}
+
+func (r *Recorder) markDeleted(ctx context.Context, key Key, at time.Time) {
+	err := r.store.MarkDeleted(ctx, key, at)
+	if errors.Is(err, ERR_NOT_FOUND) {
+		//resource created before inventory was introduced
+		r.logger.Debugw("deleted resource not found in inventory", "key", key.String())
+		return
+	}
+	if err != nil {
+		r.logger.Errorw("failed to mark resource deleted in inventory", "key", key.String(), "error", err)
+	}
+}
+
+//NodePoolID id of the node pool in the inventory, node pool names are unique only within the cluster
+func NodePoolID(cluster, nodePool string) string {
+	return cluster + "/" + nodePool
+}
+
+//DNSRecordID id of the dns record in the inventory, record is unique by its zone, name and type
+func DNSRecordID(zone, name, recordType string) string {
+	return zone + "/" + strings.TrimSuffix(strings.ToLower(name), ".") + "/" + recordType
+}
@@ -0,0 +1,55 @@
+package jsonfile
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"sync"
+
+	"github.com/pkg/errors"
+)
+
+//File is the json file a store keeps all of its records in, whole file is rewritten on every change
+type File struct {
+	path string
+	//mu serializes the changes and the writes of the file
+	mu sync.Mutex
+}
+
+//Open decodes the records of the file at path into v, v is left as is when the file does not exist yet,
+//file is created on first write
+func Open(path string, v interface{}) (*File, error) {
+	f := &File{path: path}
+
+	b, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return f, nil
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to read '%s'", path)
+	}
+	if err := json.Unmarshal(b, v); err != nil {
+		return nil, errors.Wrapf(err, "invalid json file '%s'", path)
+	}
+	return f, nil
+}
+
+//Write applies the change and writes the records it returns to a temp file which is renamed over the file
+func (f *File) Write(change func() (interface{}, error)) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	v, err := change()
+	if err != nil {
+		return err
+	}
+	b, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return err
+	}
+	tmp := f.path + ".tmp"
+	if err := ioutil.WriteFile(tmp, b, 0600); err != nil {
+		return errors.Wrapf(err, "failed to write '%s'", f.path)
+	}
+	return errors.Wrapf(os.Rename(tmp, f.path), "failed to write '%s'", f.path)
+}
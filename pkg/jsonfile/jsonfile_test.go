@@ -0,0 +1,31 @@
+package jsonfile
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_File(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "records.json")
+
+	records := []string{}
+	f, err := Open(path, &records)
+	require.NoError(t, err, "missing file is created on first write")
+	assert.Empty(t, records)
+
+	require.NoError(t, f.Write(func() (interface{}, error) { return []string{"a", "b"}, nil }))
+	assert.Error(t, f.Write(func() (interface{}, error) { return nil, errors.New("change failed") }))
+
+	_, err = Open(path, &records)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"a", "b"}, records, "failed change is not written")
+
+	require.NoError(t, ioutil.WriteFile(path, []byte("{"), 0600))
+	_, err = Open(path, &records)
+	assert.Error(t, err)
+}
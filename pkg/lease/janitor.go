@@ -0,0 +1,191 @@
+package lease
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"gitlab.com/netbook-devs/spawner-service/pkg/inventory"
+	"go.uber.org/zap"
+)
+
+//event types sent to the notifier
+const (
+	EventExpiring = "LEASE_EXPIRING"
+	EventExpired  = "LEASE_EXPIRED"
+)
+
+//Event about the lease of the cluster or node pool
+type Event struct {
+	Type      string    `json:"type"`
+	Kind      string    `json:"kind"`
+	Provider  string    `json:"provider"`
+	Account   string    `json:"account"`
+	Region    string    `json:"region"`
+	Workspace string    `json:"workspace,omitempty"`
+	Cluster   string    `json:"cluster"`
+	Name      string    `json:"name"`
+	ExpiresAt time.Time `json:"expiresAt"`
+}
+
+//NotifyFunc delivers the lease event
+type NotifyFunc func(ctx context.Context, e Event) error
+
+//ExpireFunc deletes the expired cluster or node pool
+type ExpireFunc func(ctx context.Context, r inventory.Resource) error
+
+//Janitor periodically looks up the clusters and node pools in the inventory with expiry tag,
+//warns before they expire and deletes them once expired
+type Janitor struct {
+	logger     *zap.SugaredLogger
+	store      inventory.Store
+	notify     NotifyFunc
+	expire     ExpireFunc
+	warnBefore time.Duration
+	now        func() time.Time
+
+	mu sync.Mutex
+	//warned and expired hold the expiry acted on per resource, extended lease is acted on again
+	warned  map[inventory.Key]time.Time
+	expired map[inventory.Key]time.Time
+	//expiring holds the resources being deleted, not started again by the next sweep
+	expiring map[inventory.Key]bool
+	running  sync.WaitGroup
+}
+
+//NewJanitor returns the janitor of the expiring resources in store, clusters and node pools with expiry tag are
+//deleted once expired and warning is sent warnBefore the expiry
+func NewJanitor(logger *zap.SugaredLogger, store inventory.Store, notify NotifyFunc, expire ExpireFunc, warnBefore time.Duration) *Janitor {
+	return &Janitor{
+		logger:     logger,
+		store:      store,
+		notify:     notify,
+		expire:     expire,
+		warnBefore: warnBefore,
+		now:        time.Now,
+		warned:     make(map[inventory.Key]time.Time),
+		expired:    make(map[inventory.Key]time.Time),
+		expiring:   make(map[inventory.Key]bool),
+	}
+}
+
+//Run sweeps the expiring resources every interval until ctx is done
+func (j *Janitor) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		j.Sweep(ctx)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func event(t string, r inventory.Resource, expiry time.Time) Event {
+	cluster := r.Cluster
+	if r.Kind == inventory.KindCluster {
+		cluster = r.Name
+	}
+	return Event{
+		Type:      t,
+		Kind:      string(r.Kind),
+		Provider:  r.Provider,
+		Account:   r.Account,
+		Region:    r.Region,
+		Workspace: r.Workspace,
+		Cluster:   cluster,
+		Name:      r.Name,
+		ExpiresAt: expiry,
+	}
+}
+
+func (j *Janitor) send(ctx context.Context, e Event) {
+	j.logger.Warnw("lease event", "type", e.Type, "kind", e.Kind, "cluster", e.Cluster, "name", e.Name, "expiresAt", e.ExpiresAt)
+	if j.notify == nil {
+		return
+	}
+	if err := j.notify(ctx, e); err != nil {
+		j.logger.Errorw("failed to send lease event", "type", e.Type, "name", e.Name, "error", err)
+	}
+}
+
+//Sweep warns about the resources about to expire and starts the deletion of the expired ones without waiting for it,
+//node pools of the expired clusters are deleted along with the cluster
+func (j *Janitor) Sweep(ctx context.Context) {
+	now := j.now()
+	clusters := map[inventory.Key]bool{}
+
+	for _, kind := range []inventory.Kind{inventory.KindCluster, inventory.KindNodePool} {
+		resources, err := j.store.List(ctx, inventory.Filter{Kind: kind})
+		if err != nil {
+			j.logger.Errorw("failed to list resources for lease expiry", "kind", kind, "error", err)
+			return
+		}
+
+		for _, r := range resources {
+			expiry, ok := ExpiryOf(r.Labels)
+			if !ok {
+				continue
+			}
+			if kind == inventory.KindNodePool && clusters[inventory.Key{Provider: r.Provider, Account: r.Account, Region: r.Region, Kind: inventory.KindCluster, ID: r.Cluster}] {
+				continue
+			}
+
+			switch {
+			case !now.Before(expiry):
+				if kind == inventory.KindCluster {
+					clusters[r.Key()] = true
+				}
+				j.expireOnce(ctx, r, expiry)
+			case now.Add(j.warnBefore).After(expiry):
+				j.warnOnce(ctx, r, expiry)
+			}
+		}
+	}
+}
+
+func (j *Janitor) warnOnce(ctx context.Context, r inventory.Resource, expiry time.Time) {
+	j.mu.Lock()
+	if j.warned[r.Key()].Equal(expiry) {
+		j.mu.Unlock()
+		return
+	}
+	j.warned[r.Key()] = expiry
+	j.mu.Unlock()
+
+	j.send(ctx, event(EventExpiring, r, expiry))
+}
+
+func (j *Janitor) expireOnce(ctx context.Context, r inventory.Resource, expiry time.Time) {
+	key := r.Key()
+	j.mu.Lock()
+	if j.expiring[key] || j.expired[key].Equal(expiry) {
+		j.mu.Unlock()
+		return
+	}
+	j.expired[key] = expiry
+	j.expiring[key] = true
+	j.mu.Unlock()
+
+	j.running.Add(1)
+	go func() {
+		defer j.running.Done()
+		err := j.expire(ctx, r)
+
+		j.mu.Lock()
+		delete(j.expiring, key)
+		if err != nil {
+			//retried in next sweep
+			delete(j.expired, key)
+		}
+		j.mu.Unlock()
+
+		if err != nil {
+			j.logger.Errorw("failed to delete expired resource", "kind", r.Kind, "id", r.ID, "error", err)
+			return
+		}
+		j.send(ctx, event(EventExpired, r, expiry))
+	}()
+}
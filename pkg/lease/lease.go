@@ -0,0 +1,60 @@
+package lease
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+	"gitlab.com/netbook-devs/spawner-service/pkg/service/constants"
+)
+
+var ERR_INVALID_LEASE = errors.New("invalid lease")
+
+//Expiry time the resource expires at from ttl or explicit expiry, zero time when neither is set
+func Expiry(now time.Time, ttlMinutes int64, expiresAt string) (time.Time, error) {
+	switch {
+	case ttlMinutes != 0 && expiresAt != "":
+		return time.Time{}, errors.Wrap(ERR_INVALID_LEASE, "either ttl or expiry must be set, not both")
+	case ttlMinutes < 0:
+		return time.Time{}, errors.Wrap(ERR_INVALID_LEASE, "ttl must be positive")
+	case ttlMinutes > 0:
+		return now.Add(time.Duration(ttlMinutes) * time.Minute).UTC().Truncate(time.Second), nil
+	case expiresAt != "":
+		t, err := time.Parse(time.RFC3339, expiresAt)
+		if err != nil {
+			return time.Time{}, errors.Wrapf(ERR_INVALID_LEASE, "expiry must be RFC3339: %s", err.Error())
+		}
+		if !t.After(now) {
+			return time.Time{}, errors.Wrap(ERR_INVALID_LEASE, "expiry must be in future")
+		}
+		return t.UTC(), nil
+	}
+	return time.Time{}, nil
+}
+
+//WithExpiry returns copy of the labels with expiry tag, labels are returned as is for zero expiry.
+//expiry is stored in unix seconds, RFC3339 is not a valid kubernetes or gcp label value
+func WithExpiry(labels map[string]string, expiry time.Time) map[string]string {
+	if expiry.IsZero() {
+		return labels
+	}
+	res := make(map[string]string, len(labels)+1)
+	for k, v := range labels {
+		res[k] = v
+	}
+	res[constants.ExpiresAtLabel] = strconv.FormatInt(expiry.Unix(), 10)
+	return res
+}
+
+//ExpiryOf reads the expiry tag from the labels
+func ExpiryOf(labels map[string]string) (time.Time, bool) {
+	v, ok := labels[constants.ExpiresAtLabel]
+	if !ok {
+		return time.Time{}, false
+	}
+	sec, err := strconv.ParseInt(v, 10, 64)
+	if err != nil {
+		return time.Time{}, false
+	}
+	return time.Unix(sec, 0).UTC(), true
+}
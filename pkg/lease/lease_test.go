@@ -0,0 +1,134 @@
+package lease
+
+import (
+	"context"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gitlab.com/netbook-devs/spawner-service/pkg/inventory"
+	"go.uber.org/zap"
+)
+
+func Test_Expiry(t *testing.T) {
+	now := time.Date(2022, 4, 10, 10, 0, 0, 0, time.UTC)
+
+	expiry, err := Expiry(now, 90, "")
+	require.NoError(t, err)
+	assert.Equal(t, now.Add(90*time.Minute), expiry)
+
+	expiry, err = Expiry(now, 0, "2022-04-11T00:00:00Z")
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2022, 4, 11, 0, 0, 0, 0, time.UTC), expiry)
+
+	expiry, err = Expiry(now, 0, "")
+	require.NoError(t, err)
+	assert.True(t, expiry.IsZero())
+
+	for _, invalid := range []struct {
+		ttl    int64
+		expiry string
+	}{{-1, ""}, {10, "2022-04-11T00:00:00Z"}, {0, "tomorrow"}, {0, "2022-04-01T00:00:00Z"}} {
+		_, err := Expiry(now, invalid.ttl, invalid.expiry)
+		assert.True(t, errors.Is(err, ERR_INVALID_LEASE), "expected invalid lease %v", invalid)
+	}
+
+	labels := WithExpiry(map[string]string{"env": "dev"}, now)
+	got, ok := ExpiryOf(labels)
+	require.True(t, ok)
+	assert.Equal(t, now, got)
+	assert.Equal(t, "dev", labels["env"])
+}
+
+func Test_Janitor(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2022, 4, 10, 10, 0, 0, 0, time.UTC)
+	expiresAt := func(d time.Duration) map[string]string {
+		return map[string]string{"expires-at": strconv.FormatInt(now.Add(d).Unix(), 10)}
+	}
+
+	store := inventory.NewMemoryStore()
+	for _, r := range []inventory.Resource{
+		{ID: "dev", Kind: inventory.KindCluster, Provider: "fake", Name: "dev", Labels: expiresAt(-time.Minute)},
+		{ID: "dev/gpu", Kind: inventory.KindNodePool, Provider: "fake", Cluster: "dev", Name: "gpu", Labels: expiresAt(-time.Minute)},
+		{ID: "prod", Kind: inventory.KindCluster, Provider: "fake", Name: "prod"},
+		{ID: "prod/gpu", Kind: inventory.KindNodePool, Provider: "fake", Cluster: "prod", Name: "gpu", Labels: expiresAt(30 * time.Minute)},
+		{ID: "prod/cpu", Kind: inventory.KindNodePool, Provider: "fake", Cluster: "prod", Name: "cpu", Labels: expiresAt(2 * time.Hour)},
+	} {
+		require.NoError(t, store.Put(ctx, r))
+	}
+
+	var mu sync.Mutex
+	events := []string{}
+	expired := []string{}
+	started, release := make(chan struct{}, 1), make(chan struct{})
+	j := NewJanitor(zap.NewNop().Sugar(), store,
+		func(ctx context.Context, e Event) error {
+			mu.Lock()
+			defer mu.Unlock()
+			events = append(events, e.Type+" "+e.Cluster+"/"+e.Name)
+			return nil
+		},
+		func(ctx context.Context, r inventory.Resource) error {
+			mu.Lock()
+			expired = append(expired, r.ID)
+			mu.Unlock()
+			started <- struct{}{}
+			<-release
+			return nil
+		}, time.Hour)
+	j.now = func() time.Time { return now }
+
+	//sweep does not wait for the deletion, nor starts it again while running
+	j.Sweep(ctx)
+	<-started
+	j.Sweep(ctx)
+	mu.Lock()
+	assert.Equal(t, []string{"dev"}, expired, "node pools of expired cluster are deleted with the cluster")
+	assert.Equal(t, []string{"LEASE_EXPIRING prod/gpu"}, events)
+	mu.Unlock()
+
+	close(release)
+	j.running.Wait()
+	j.Sweep(ctx)
+	j.running.Wait()
+	assert.Equal(t, []string{"dev"}, expired)
+	assert.Equal(t, []string{"LEASE_EXPIRING prod/gpu", "LEASE_EXPIRED dev/dev"}, events)
+
+	//extended lease is warned again
+	r, err := store.Get(ctx, inventory.Key{Provider: "fake", Kind: inventory.KindNodePool, ID: "prod/gpu"})
+	require.NoError(t, err)
+	r.Labels = expiresAt(45 * time.Minute)
+	require.NoError(t, store.Put(ctx, r))
+	j.Sweep(ctx)
+	assert.Len(t, events, 3)
+}
+
+func Test_JanitorRetry(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2022, 4, 10, 10, 0, 0, 0, time.UTC)
+	store := inventory.NewMemoryStore()
+	require.NoError(t, store.Put(ctx, inventory.Resource{ID: "dev", Kind: inventory.KindCluster, Provider: "fake", Name: "dev",
+		Labels: map[string]string{"expires-at": strconv.FormatInt(now.Add(-time.Minute).Unix(), 10)}}))
+
+	attempts := 0
+	j := NewJanitor(zap.NewNop().Sugar(), store, nil,
+		func(ctx context.Context, r inventory.Resource) error {
+			attempts++
+			if attempts == 1 {
+				return errors.New("deletion failed")
+			}
+			return nil
+		}, time.Hour)
+	j.now = func() time.Time { return now }
+
+	for i := 0; i < 3; i++ {
+		j.Sweep(ctx)
+		j.running.Wait()
+	}
+	assert.Equal(t, 2, attempts, "failed deletion is retried once in next sweep")
+}
@@ -0,0 +1,43 @@
+package lease
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const webhookTimeout = 10 * time.Second
+
+//Webhook returns the notifier posting the events as json to url, nil when url is empty
+func Webhook(url string) NotifyFunc {
+	if url == "" {
+		return nil
+	}
+	client := &http.Client{Timeout: webhookTimeout}
+	return func(ctx context.Context, e Event) error {
+		body, err := json.Marshal(e)
+		if err != nil {
+			return err
+		}
+		req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+		if err != nil {
+			return errors.Wrap(err, "invalid lease webhook")
+		}
+		req.Header.Set("Content-Type", "application/json")
+
+		res, err := client.Do(req)
+		if err != nil {
+			return errors.Wrap(err, "lease webhook failed")
+		}
+		defer res.Body.Close()
+		if res.StatusCode >= http.StatusMultipleChoices {
+			return fmt.Errorf("lease webhook responded %s", res.Status)
+		}
+		return nil
+	}
+}
@@ -0,0 +1,43 @@
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var snapshotPolicyRuns = prometheus.NewCounterVec(
+	prometheus.CounterOpts{
+		Name: "spawner_snapshot_policy_runs_total",
+		Help: "Number of snapshot policy runs, per policy and result",
+	},
+	[]string{"policy", "result"},
+)
+
+var snapshotPolicyLastSuccess = prometheus.NewGaugeVec(
+	prometheus.GaugeOpts{
+		Name: "spawner_snapshot_policy_last_success_timestamp_seconds",
+		Help: "Unix time of the last successful run of the snapshot policy",
+	},
+	[]string{"policy"},
+)
+
+func init() {
+	prometheus.Register(snapshotPolicyRuns)
+	prometheus.Register(snapshotPolicyLastSuccess)
+}
+
+//ObserveSnapshotPolicyRun counts the run of the policy as success or failure, unix time of the successful runs is kept
+func ObserveSnapshotPolicyRun(policy string, success bool, at float64) {
+	if !success {
+		snapshotPolicyRuns.WithLabelValues(policy, "failure").Inc()
+		return
+	}
+	snapshotPolicyRuns.WithLabelValues(policy, "success").Inc()
+	snapshotPolicyLastSuccess.WithLabelValues(policy).Set(at)
+}
+
+//DeleteSnapshotPolicy removes the series of the deleted policy
+func DeleteSnapshotPolicy(policy string) {
+	snapshotPolicyRuns.DeleteLabelValues(policy, "success")
+	snapshotPolicyRuns.DeleteLabelValues(policy, "failure")
+	snapshotPolicyLastSuccess.DeleteLabelValues(policy)
+}
@@ -0,0 +1,60 @@
+package metrics
+
+import (
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var driftGauge = prometheus.NewGaugeVec(
+	prometheus.GaugeOpts{
+		Name: "spawner_drift",
+		Help: "Number of differences between the inventory and the provider, per cluster and drift type",
+	},
+	[]string{"provider", "account", "region", "cluster", "type"},
+)
+
+var (
+	driftMu sync.Mutex
+	//driftTypes drift types set per cluster, types no longer found are removed from the gauge
+	driftTypes = map[[4]string]map[string]bool{}
+)
+
+func init() {
+	prometheus.Register(driftGauge)
+}
+
+//SetDrift replaces the drift counts of the cluster
+func SetDrift(provider, account, region, cluster string, counts map[string]int) {
+	driftMu.Lock()
+	defer driftMu.Unlock()
+
+	key := [4]string{provider, account, region, cluster}
+	for t := range driftTypes[key] {
+		if _, ok := counts[t]; !ok {
+			driftGauge.DeleteLabelValues(provider, account, region, cluster, t)
+		}
+	}
+	types := map[string]bool{}
+	for t, n := range counts {
+		driftGauge.WithLabelValues(provider, account, region, cluster, t).Set(float64(n))
+		types[t] = true
+	}
+	driftTypes[key] = types
+}
+
+//RetainDrift removes the drift of the clusters keep returns false for, drift of the others is left as is
+func RetainDrift(keep func(provider, account, region, cluster string) bool) {
+	driftMu.Lock()
+	defer driftMu.Unlock()
+
+	for key, types := range driftTypes {
+		if keep(key[0], key[1], key[2], key[3]) {
+			continue
+		}
+		for t := range types {
+			driftGauge.DeleteLabelValues(key[0], key[1], key[2], key[3], t)
+		}
+		delete(driftTypes, key)
+	}
+}
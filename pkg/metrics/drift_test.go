@@ -0,0 +1,18 @@
+package metrics
+
+import (
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_RetainDrift(t *testing.T) {
+	SetDrift("fake", "dev", "local", "dev", map[string]int{"NODEPOOL_MISSING": 1, "LABEL_MISSING": 2})
+	SetDrift("fake", "dev", "local", "gone", map[string]int{"CLUSTER_MISSING": 1})
+	assert.Equal(t, 3, testutil.CollectAndCount(driftGauge))
+
+	RetainDrift(func(provider, account, region, cluster string) bool { return cluster != "gone" })
+	assert.Equal(t, 2, testutil.CollectAndCount(driftGauge), "drift of the other clusters is kept")
+	assert.Equal(t, 2.0, testutil.ToFloat64(driftGauge.WithLabelValues("fake", "dev", "local", "dev", "LABEL_MISSING")))
+}
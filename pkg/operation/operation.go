@@ -0,0 +1,351 @@
+package operation
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+)
+
+//Status of the operation
+type Status string
+
+const (
+	Pending   Status = "PENDING"
+	Running   Status = "RUNNING"
+	Succeeded Status = "SUCCEEDED"
+	Failed    Status = "FAILED"
+	Cancelled Status = "CANCELLED"
+)
+
+//Done true when operation reached the final state
+func (s Status) Done() bool {
+	return s == Succeeded || s == Failed || s == Cancelled
+}
+
+const defaultRetention = time.Hour
+
+var (
+	ERR_NOT_FOUND    = errors.New("operation not found")
+	ERR_ALREADY_DONE = errors.New("operation already completed")
+	ERR_CANCELLED    = errors.New("operation cancelled")
+)
+
+//Meta describes what the operation acts on
+type Meta struct {
+	//Type name of the rpc, ex: CreateCluster
+	Type     string
+	Provider string
+	Account  string
+	Region   string
+	//Target cluster, nodepool, volume or snapshot
+	Target string
+}
+
+//Step completed sub step of the operation
+type Step struct {
+	Name        string
+	CompletedAt time.Time
+}
+
+//Operation is a snapshot of the operation state
+type Operation struct {
+	Meta
+	ID     string
+	Status Status
+	Steps  []Step
+	Error  string
+	//Result response of the operation func, set when operation succeeded
+	Result    interface{}
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+//Func is the work done by the operation, must honour ctx cancellation
+type Func func(ctx context.Context) (interface{}, error)
+
+type operation struct {
+	Operation
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+//Manager runs operations in background and keeps their state
+//
+//finished operations are kept for the retention period and dropped after.
+type Manager struct {
+	logger    *zap.SugaredLogger
+	retention time.Duration
+	now       func() time.Time
+
+	mu  sync.Mutex
+	ops map[string]*operation
+}
+
+//NewManager returns the manager, finished operations are kept for retention, defaults to 1h
+func NewManager(logger *zap.SugaredLogger, retention time.Duration) *Manager {
+	if retention <= 0 {
+		retention = defaultRetention
+	}
+	return &Manager{
+		logger:    logger,
+		retention: retention,
+		now:       time.Now,
+		ops:       make(map[string]*operation),
+	}
+}
+
+func newID() string {
+	b := make([]byte, 8)
+	rand.Read(b)
+	return fmt.Sprintf("op-%s", hex.EncodeToString(b))
+}
+
+//detached keeps the values of parent (logger fields, identities) but not its deadline and cancellation,
+//operation outlives the rpc which started it
+type detached struct {
+	context.Context
+}
+
+func (detached) Deadline() (time.Time, bool) { return time.Time{}, false }
+func (detached) Done() <-chan struct{}       { return nil }
+func (detached) Err() error                  { return nil }
+
+type ctxKey struct{}
+
+//Start runs fn in background and returns the operation in pending state
+func (m *Manager) Start(parent context.Context, meta Meta, fn Func) Operation {
+	ctx, cancel := context.WithCancel(detached{parent})
+
+	now := m.now().UTC()
+	op := &operation{
+		Operation: Operation{
+			Meta:      meta,
+			ID:        newID(),
+			Status:    Pending,
+			CreatedAt: now,
+			UpdatedAt: now,
+		},
+		cancel: cancel,
+		done:   make(chan struct{}),
+	}
+
+	m.mu.Lock()
+	m.prune()
+	m.ops[op.ID] = op
+	snapshot := op.snapshot()
+	m.mu.Unlock()
+
+	ctx = context.WithValue(ctx, ctxKey{}, &stepper{m: m, id: op.ID})
+	go m.run(ctx, op, fn)
+
+	m.logger.Infow("operation started", "operation", op.ID, "type", meta.Type, "target", meta.Target)
+	return snapshot
+}
+
+func (m *Manager) run(ctx context.Context, op *operation, fn Func) {
+	defer close(op.done)
+	defer op.cancel()
+
+	m.update(op.ID, func(o *operation) {
+		o.Status = Running
+	})
+
+	result, err := func() (res interface{}, err error) {
+		defer func() {
+			if r := recover(); r != nil {
+				err = fmt.Errorf("operation panicked: %v", r)
+			}
+		}()
+		return fn(ctx)
+	}()
+
+	m.update(op.ID, func(o *operation) {
+		switch {
+		case ctx.Err() != nil:
+			o.Status = Cancelled
+			o.Error = ERR_CANCELLED.Error()
+			if err != nil {
+				o.Error = errors.Wrap(err, ERR_CANCELLED.Error()).Error()
+			}
+		case err != nil:
+			o.Status = Failed
+			o.Error = err.Error()
+		default:
+			o.Status = Succeeded
+			o.Result = result
+		}
+	})
+
+	if err != nil {
+		m.logger.Errorw("operation failed", "operation", op.ID, "type", op.Type, "error", err)
+		return
+	}
+	m.logger.Infow("operation completed", "operation", op.ID, "type", op.Type)
+}
+
+func (m *Manager) update(id string, fn func(o *operation)) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	o, ok := m.ops[id]
+	if !ok {
+		return
+	}
+	fn(o)
+	o.UpdatedAt = m.now().UTC()
+}
+
+//snapshot copy of the operation state, must be called with lock held
+func (o *operation) snapshot() Operation {
+	s := o.Operation
+	s.Steps = append([]Step(nil), o.Steps...)
+	return s
+}
+
+//prune drops the finished operations past retention, must be called with lock held
+func (m *Manager) prune() {
+	now := m.now()
+	for id, o := range m.ops {
+		if o.Status.Done() && now.Sub(o.UpdatedAt) > m.retention {
+			delete(m.ops, id)
+		}
+	}
+}
+
+//Get returns the operation state
+func (m *Manager) Get(id string) (Operation, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	o, ok := m.ops[id]
+	if !ok {
+		return Operation{}, errors.Wrapf(ERR_NOT_FOUND, "operation '%s'", id)
+	}
+	return o.snapshot(), nil
+}
+
+//Filter selects operations in List, empty fields match everything
+type Filter struct {
+	Meta
+	ActiveOnly bool
+}
+
+func (f Filter) match(o *operation) bool {
+	if f.Type != "" && f.Type != o.Type {
+		return false
+	}
+	if f.Provider != "" && f.Provider != o.Provider {
+		return false
+	}
+	if f.Account != "" && f.Account != o.Account {
+		return false
+	}
+	if f.Region != "" && f.Region != o.Region {
+		return false
+	}
+	if f.Target != "" && f.Target != o.Target {
+		return false
+	}
+	return !f.ActiveOnly || !o.Status.Done()
+}
+
+//List returns the operations matching filter, latest first
+func (m *Manager) List(f Filter) []Operation {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.prune()
+	ops := []Operation{}
+	for _, o := range m.ops {
+		if f.match(o) {
+			ops = append(ops, o.snapshot())
+		}
+	}
+	sort.Slice(ops, func(i, j int) bool {
+		if ops[i].CreatedAt.Equal(ops[j].CreatedAt) {
+			return ops[i].ID < ops[j].ID
+		}
+		return ops[i].CreatedAt.After(ops[j].CreatedAt)
+	})
+	return ops
+}
+
+//Cancel cancels the context of the running operation, operation moves to CANCELLED once its func returns.
+//
+//cancelling does not roll back the resources created so far.
+func (m *Manager) Cancel(id string) (Operation, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	o, ok := m.ops[id]
+	if !ok {
+		return Operation{}, errors.Wrapf(ERR_NOT_FOUND, "operation '%s'", id)
+	}
+	if o.Status.Done() {
+		return o.snapshot(), errors.Wrapf(ERR_ALREADY_DONE, "operation '%s' is %s", id, o.Status)
+	}
+	o.cancel()
+	m.logger.Infow("operation cancel requested", "operation", id)
+	return o.snapshot(), nil
+}
+
+//Wait blocks until the operation is done or ctx expires
+func (m *Manager) Wait(ctx context.Context, id string) (Operation, error) {
+	m.mu.Lock()
+	o, ok := m.ops[id]
+	m.mu.Unlock()
+	if !ok {
+		return Operation{}, errors.Wrapf(ERR_NOT_FOUND, "operation '%s'", id)
+	}
+
+	select {
+	case <-o.done:
+	case <-ctx.Done():
+		return Operation{}, ctx.Err()
+	}
+	return m.Get(id)
+}
+
+type stepper struct {
+	m  *Manager
+	id string
+}
+
+//Report records completed sub step of the operation running in ctx, no-op when ctx is not of an operation
+func Report(ctx context.Context, format string, args ...interface{}) {
+	s, ok := ctx.Value(ctxKey{}).(*stepper)
+	if !ok {
+		return
+	}
+	name := fmt.Sprintf(format, args...)
+	s.m.update(s.id, func(o *operation) {
+		o.Steps = append(o.Steps, Step{Name: name, CompletedAt: s.m.now().UTC()})
+	})
+}
+
+//SetTarget sets the target of the operation running in ctx once it is known, ex: id of the volume created by it.
+//no-op when ctx is not of an operation
+func SetTarget(ctx context.Context, target string) {
+	s, ok := ctx.Value(ctxKey{}).(*stepper)
+	if !ok || target == "" {
+		return
+	}
+	s.m.update(s.id, func(o *operation) {
+		o.Target = target
+	})
+}
+
+//ID returns the id of the operation running in ctx, empty when ctx is not of an operation
+func ID(ctx context.Context) string {
+	if s, ok := ctx.Value(ctxKey{}).(*stepper); ok {
+		return s.id
+	}
+	return ""
+}
@@ -0,0 +1,108 @@
+package operation
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func Test_Operation(t *testing.T) {
+	ctx := context.Background()
+	m := NewManager(zap.NewNop().Sugar(), time.Hour)
+
+	//operation must outlive the rpc context which started it
+	rpcCtx, cancel := context.WithCancel(ctx)
+	release := make(chan struct{})
+	op := m.Start(rpcCtx, Meta{Type: "CreateCluster", Provider: "fake", Target: "dev"}, func(ctx context.Context) (interface{}, error) {
+		Report(ctx, "network stack ready")
+		<-release
+		Report(ctx, "cluster %s created", "dev")
+		return "dev", nil
+	})
+	cancel()
+	assert.Equal(t, Pending, op.Status)
+
+	active := m.List(Filter{ActiveOnly: true})
+	require.Len(t, active, 1)
+	assert.Equal(t, op.ID, active[0].ID)
+
+	close(release)
+	done, err := m.Wait(ctx, op.ID)
+	require.NoError(t, err)
+	assert.Equal(t, Succeeded, done.Status)
+	assert.Equal(t, "dev", done.Result)
+	require.Len(t, done.Steps, 2)
+	assert.Equal(t, "network stack ready", done.Steps[0].Name)
+	assert.Equal(t, "cluster dev created", done.Steps[1].Name)
+
+	_, err = m.Cancel(op.ID)
+	assert.True(t, errors.Is(err, ERR_ALREADY_DONE))
+
+	failed := m.Start(ctx, Meta{Type: "DeleteCluster", Provider: "fake"}, func(ctx context.Context) (interface{}, error) {
+		return nil, errors.New("cluster not found")
+	})
+	done, err = m.Wait(ctx, failed.ID)
+	require.NoError(t, err)
+	assert.Equal(t, Failed, done.Status)
+	assert.Equal(t, "cluster not found", done.Error)
+
+	created := m.Start(ctx, Meta{Type: "CreateVolume", Provider: "fake"}, func(ctx context.Context) (interface{}, error) {
+		SetTarget(ctx, "vol-1")
+		return "vol-1", nil
+	})
+	done, err = m.Wait(ctx, created.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "vol-1", done.Target)
+
+	assert.Len(t, m.List(Filter{}), 3)
+	assert.Len(t, m.List(Filter{Meta: Meta{Type: "DeleteCluster"}}), 1)
+	assert.Empty(t, m.List(Filter{ActiveOnly: true}))
+
+	_, err = m.Get("op-missing")
+	assert.True(t, errors.Is(err, ERR_NOT_FOUND))
+}
+
+func Test_OperationCancel(t *testing.T) {
+	ctx := context.Background()
+	m := NewManager(zap.NewNop().Sugar(), time.Hour)
+
+	started := make(chan struct{})
+	op := m.Start(ctx, Meta{Type: "CreateVolume"}, func(ctx context.Context) (interface{}, error) {
+		close(started)
+		<-ctx.Done()
+		return nil, ctx.Err()
+	})
+	<-started
+
+	_, err := m.Cancel(op.ID)
+	require.NoError(t, err)
+
+	done, err := m.Wait(ctx, op.ID)
+	require.NoError(t, err)
+	assert.Equal(t, Cancelled, done.Status)
+	assert.Contains(t, done.Error, ERR_CANCELLED.Error())
+}
+
+func Test_OperationRetention(t *testing.T) {
+	ctx := context.Background()
+	m := NewManager(zap.NewNop().Sugar(), time.Minute)
+
+	now := time.Date(2022, 4, 1, 10, 0, 0, 0, time.UTC)
+	m.now = func() time.Time { return now }
+
+	op := m.Start(ctx, Meta{Type: "DeleteVolume"}, func(ctx context.Context) (interface{}, error) {
+		return nil, nil
+	})
+	_, err := m.Wait(ctx, op.ID)
+	require.NoError(t, err)
+
+	now = now.Add(2 * time.Minute)
+	assert.Empty(t, m.List(Filter{}))
+	_, err = m.Get(op.ID)
+	assert.True(t, errors.Is(err, ERR_NOT_FOUND))
+}
@@ -0,0 +1,79 @@
+package pricing
+
+import "gitlab.com/netbook-devs/spawner-service/pkg/service/constants"
+
+//DefaultTable approximate on-demand list prices in USD of the instance types in the builtin machine catalog,
+//same in every region. Region prices and other instance types come from the price table file.
+func DefaultTable() *Table {
+	return &Table{
+		Currency: "USD",
+		Providers: map[string]ProviderPrices{
+			constants.AwsLabel: {
+				SpotRatio: 0.3,
+				Prices: Prices{
+					ControlPlaneHourly: 0.10,
+					VolumeGiBMonthly:   map[string]float64{DefaultVolumeType: 0.10, "gp2": 0.10, "gp3": 0.08, "io1": 0.125, "st1": 0.045, "sc1": 0.015},
+					Instances: map[string]Price{
+						"t2.micro":    {OnDemand: 0.0116},
+						"m5.2xlarge":  {OnDemand: 0.384},
+						"m5.8xlarge":  {OnDemand: 1.536},
+						"m5.16xlarge": {OnDemand: 3.072},
+						"g4dn.xlarge": {OnDemand: 0.526},
+						"p2.xlarge":   {OnDemand: 0.90},
+						"p2.8xlarge":  {OnDemand: 7.20},
+						"p2.16xlarge": {OnDemand: 14.40},
+						"p3.xlarge":   {OnDemand: 3.06},
+						"p3.8xlarge":  {OnDemand: 12.24},
+						"p3.16xlarge": {OnDemand: 24.48},
+					},
+				},
+			},
+			constants.AzureLabel: {
+				SpotRatio: 0.2,
+				Prices: Prices{
+					//free tier control plane
+					ControlPlaneHourly: 0,
+					VolumeGiBMonthly:   map[string]float64{DefaultVolumeType: 0.075},
+					Instances: map[string]Price{
+						"Standard_B1s":         {OnDemand: 0.0104},
+						"Standard_F8s_v2":      {OnDemand: 0.338},
+						"Standard_F32s_v2":     {OnDemand: 1.353},
+						"Standard_F64_v2":      {OnDemand: 2.706},
+						"Standard_NC4as_T4_v3": {OnDemand: 0.526},
+						"Standard_NC6":         {OnDemand: 0.90},
+						"Standard_NC12":        {OnDemand: 1.80},
+						"Standard_NC24":        {OnDemand: 3.60},
+						"Standard_NC6s_v3":     {OnDemand: 3.06},
+						"Standard_NC12s_v3":    {OnDemand: 6.12},
+						"Standard_NC24s_v3":    {OnDemand: 12.24},
+					},
+				},
+			},
+			constants.GcpLabel: {
+				SpotRatio: 0.3,
+				Prices: Prices{
+					ControlPlaneHourly: 0.10,
+					VolumeGiBMonthly:   map[string]float64{DefaultVolumeType: 0.04, "pd-standard": 0.04, "pd-balanced": 0.10, "pd-ssd": 0.17},
+					Instances: map[string]Price{
+						"g1-small":             {OnDemand: 0.0257},
+						"e2-custom-8-32768":    {OnDemand: 0.268},
+						"e2-custom-32-131072":  {OnDemand: 1.072},
+						"n2-custom-64-262144":  {OnDemand: 3.261},
+						"n1-standard-4":        {OnDemand: 0.19},
+						"custom-8-53248":       {OnDemand: 0.497},
+						"custom-32-131072":     {OnDemand: 1.631},
+						"custom-64-212992-ext": {OnDemand: 4.11},
+						"custom-8-65536-ext":   {OnDemand: 0.72},
+						"custom-32-262144-ext": {OnDemand: 2.98},
+						"custom-64-524288-ext": {OnDemand: 5.96},
+					},
+					GPUs: map[string]Price{
+						"t4":   {OnDemand: 0.35, Spot: 0.11},
+						"k80":  {OnDemand: 0.45, Spot: 0.135},
+						"v100": {OnDemand: 2.48, Spot: 0.74},
+					},
+				},
+			},
+		},
+	}
+}
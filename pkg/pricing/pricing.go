@@ -0,0 +1,232 @@
+package pricing
+
+import (
+	"context"
+	"io/ioutil"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+	"gopkg.in/yaml.v2"
+)
+
+//HoursPerMonth average hours in a month the monthly estimates are based on
+const HoursPerMonth = 730
+
+//DefaultVolumeType key of the volume price used for the volume types without price and the node disks
+const DefaultVolumeType = "default"
+
+//Price hourly price of the instance, zero spot price is unknown
+type Price struct {
+	OnDemand float64 `yaml:"onDemand"`
+	Spot     float64 `yaml:"spot"`
+}
+
+//Prices of the provider or the region, region prices override the provider prices they set
+type Prices struct {
+	ControlPlaneHourly float64 `yaml:"controlPlaneHourly"`
+	//VolumeGiBMonthly monthly price of a GiB per volume type
+	VolumeGiBMonthly map[string]float64 `yaml:"volumeGiBMonthly"`
+	Instances        map[string]Price   `yaml:"instances"`
+	//GPUs hourly price per gpu model for the providers which attach the gpus to the instances, ex: gcp
+	GPUs map[string]Price `yaml:"gpus"`
+}
+
+//ProviderPrices prices of the provider with per region overrides
+type ProviderPrices struct {
+	Prices `yaml:",inline"`
+	//SpotRatio fraction of the on-demand price the spot instances are estimated at when there is no spot price
+	SpotRatio float64           `yaml:"spotRatio"`
+	Regions   map[string]Prices `yaml:"regions"`
+}
+
+//Table prices per provider
+type Table struct {
+	Currency  string                    `yaml:"currency"`
+	Providers map[string]ProviderPrices `yaml:"providers"`
+}
+
+//Load reads the yaml or json price table
+func Load(file string) (*Table, error) {
+	b, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read price table")
+	}
+	t := &Table{}
+	if err := yaml.UnmarshalStrict(b, t); err != nil {
+		return nil, errors.Wrapf(err, "invalid price table '%s'", file)
+	}
+	return t, nil
+}
+
+func mergePrices(p map[string]Price, o map[string]Price) map[string]Price {
+	merged := map[string]Price{}
+	for k, v := range p {
+		merged[k] = v
+	}
+	for k, v := range o {
+		merged[k] = v
+	}
+	return merged
+}
+
+func (p Prices) overlay(o Prices) Prices {
+	if o.ControlPlaneHourly != 0 {
+		p.ControlPlaneHourly = o.ControlPlaneHourly
+	}
+	volumes := map[string]float64{}
+	for k, v := range p.VolumeGiBMonthly {
+		volumes[k] = v
+	}
+	for k, v := range o.VolumeGiBMonthly {
+		volumes[k] = v
+	}
+	p.VolumeGiBMonthly = volumes
+	p.Instances = mergePrices(p.Instances, o.Instances)
+	p.GPUs = mergePrices(p.GPUs, o.GPUs)
+	return p
+}
+
+//Merge returns the table with the prices of o added, prices set in o override the existing ones
+func (t *Table) Merge(o *Table) *Table {
+	merged := &Table{Currency: t.Currency, Providers: map[string]ProviderPrices{}}
+	if o.Currency != "" {
+		merged.Currency = o.Currency
+	}
+	for name, p := range t.Providers {
+		merged.Providers[name] = p
+	}
+	for name, p := range o.Providers {
+		cur := merged.Providers[name]
+		regions := map[string]Prices{}
+		for r, v := range cur.Regions {
+			regions[r] = v
+		}
+		for r, v := range p.Regions {
+			regions[r] = regions[r].overlay(v)
+		}
+		spotRatio := cur.SpotRatio
+		if p.SpotRatio != 0 {
+			spotRatio = p.SpotRatio
+		}
+		merged.Providers[name] = ProviderPrices{Prices: cur.Prices.overlay(p.Prices), SpotRatio: spotRatio, Regions: regions}
+	}
+	return merged
+}
+
+//Region prices of the provider in the region, false when the provider has no prices
+func (t *Table) Region(provider, region string) (Prices, float64, bool) {
+	p, ok := t.Providers[provider]
+	if !ok {
+		return Prices{}, 0, false
+	}
+	prices := Prices{}.overlay(p.Prices)
+	if r, ok := p.Regions[region]; ok {
+		prices = prices.overlay(r)
+	}
+	return prices, p.SpotRatio, true
+}
+
+//Hourly price of the instance, spot price is estimated from the on-demand price and spot ratio when unknown.
+//false when the instance has no price
+func (p Prices) Hourly(instance string, spot bool, spotRatio float64) (float64, bool) {
+	price, ok := p.Instances[instance]
+	if !ok {
+		return 0, false
+	}
+	return price.hourly(spot, spotRatio), true
+}
+
+//GPUHourly price of the attached gpu, false when gpus of the model are not priced separately
+func (p Prices) GPUHourly(model string, spot bool, spotRatio float64) (float64, bool) {
+	price, ok := p.GPUs[model]
+	if !ok {
+		return 0, false
+	}
+	return price.hourly(spot, spotRatio), true
+}
+
+func (p Price) hourly(spot bool, spotRatio float64) float64 {
+	if !spot {
+		return p.OnDemand
+	}
+	if p.Spot > 0 {
+		return p.Spot
+	}
+	if spotRatio > 0 {
+		return p.OnDemand * spotRatio
+	}
+	return p.OnDemand
+}
+
+//VolumeMonthly monthly price of a GiB of the volume type, default volume price applies to unknown types
+func (p Prices) VolumeMonthly(volumeType string) float64 {
+	if price, ok := p.VolumeGiBMonthly[volumeType]; ok {
+		return price
+	}
+	return p.VolumeGiBMonthly[DefaultVolumeType]
+}
+
+//Book holds the price table, reloaded from the file so that the prices can be updated without restart
+type Book struct {
+	logger *zap.SugaredLogger
+	file   string
+
+	mu        sync.RWMutex
+	table     *Table
+	updatedAt time.Time
+}
+
+//NewBook returns the book with the default prices merged with the price table file, file is optional.
+//cost estimates use the prices of the book, file is reloaded by Run
+func NewBook(logger *zap.SugaredLogger, file string) (*Book, error) {
+	b := &Book{logger: logger, file: file, table: DefaultTable(), updatedAt: time.Now()}
+	if err := b.Reload(); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+//Reload reads the price table file again, prices are kept when the file is invalid
+func (b *Book) Reload() error {
+	if b.file == "" {
+		return nil
+	}
+	t, err := Load(b.file)
+	if err != nil {
+		return err
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.table = DefaultTable().Merge(t)
+	b.updatedAt = time.Now()
+	return nil
+}
+
+//Table current prices and the time they were loaded
+func (b *Book) Table() (*Table, time.Time) {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	return b.table, b.updatedAt
+}
+
+//Run reloads the price table every interval until the context is done
+func (b *Book) Run(ctx context.Context, interval time.Duration) {
+	if b.file == "" {
+		return
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		if err := b.Reload(); err != nil {
+			b.logger.Warnw("failed to reload price table, keeping the previous prices", "file", b.file, "error", err)
+		}
+	}
+}
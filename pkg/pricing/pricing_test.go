@@ -0,0 +1,71 @@
+package pricing
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func Test_TableRegion(t *testing.T) {
+	table := DefaultTable().Merge(&Table{Providers: map[string]ProviderPrices{
+		"aws": {
+			Prices: Prices{Instances: map[string]Price{"p4d.24xlarge": {OnDemand: 32.77, Spot: 9.83}}},
+			Regions: map[string]Prices{
+				"eu-north-1": {Instances: map[string]Price{"m5.2xlarge": {OnDemand: 0.408}}, VolumeGiBMonthly: map[string]float64{"gp3": 0.0836}},
+			},
+		},
+	}})
+
+	prices, ratio, ok := table.Region("aws", "eu-north-1")
+	require.True(t, ok)
+	hourly, ok := prices.Hourly("m5.2xlarge", false, ratio)
+	require.True(t, ok)
+	assert.Equal(t, 0.408, hourly, "region price")
+	hourly, _ = prices.Hourly("p4d.24xlarge", true, ratio)
+	assert.Equal(t, 9.83, hourly, "spot price of the provider")
+	hourly, _ = prices.Hourly("m5.8xlarge", true, ratio)
+	assert.InDelta(t, 1.536*0.3, hourly, 1e-9, "spot estimated from on-demand")
+	assert.Equal(t, 0.0836, prices.VolumeMonthly("gp3"))
+	assert.Equal(t, 0.10, prices.VolumeMonthly("unknown"), "default volume price")
+	assert.Equal(t, 0.10, prices.ControlPlaneHourly)
+
+	prices, _, _ = table.Region("aws", "us-west-2")
+	hourly, _ = prices.Hourly("m5.2xlarge", false, ratio)
+	assert.Equal(t, 0.384, hourly)
+	_, ok = prices.Hourly("x1.32xlarge", false, ratio)
+	assert.False(t, ok)
+
+	_, _, ok = table.Region("k8s", "")
+	assert.False(t, ok)
+}
+
+func Test_BookReload(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "prices.yaml")
+	require.NoError(t, ioutil.WriteFile(file, []byte(`
+providers:
+  gcp:
+    controlPlaneHourly: 0.12
+`), 0600))
+
+	book, err := NewBook(zap.NewNop().Sugar(), file)
+	require.NoError(t, err)
+	table, loaded := book.Table()
+	assert.Equal(t, 0.12, table.Providers["gcp"].ControlPlaneHourly)
+	assert.Equal(t, "USD", table.Currency)
+
+	require.NoError(t, ioutil.WriteFile(file, []byte(`{"currency": "EUR", "providers": {"gcp": {"controlPlaneHourly": 0.09}}}`), 0600))
+	require.NoError(t, book.Reload())
+	table, reloaded := book.Table()
+	assert.Equal(t, 0.09, table.Providers["gcp"].ControlPlaneHourly)
+	assert.Equal(t, "EUR", table.Currency)
+	assert.False(t, reloaded.Before(loaded))
+
+	require.NoError(t, ioutil.WriteFile(file, []byte(`providers: [`), 0600))
+	assert.Error(t, book.Reload())
+	table, _ = book.Table()
+	assert.Equal(t, 0.09, table.Providers["gcp"].ControlPlaneHourly, "prices are kept when the file is invalid")
+}
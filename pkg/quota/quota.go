@@ -0,0 +1,301 @@
+package quota
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/pkg/errors"
+	"gitlab.com/netbook-devs/spawner-service/pkg/inventory"
+	"gitlab.com/netbook-devs/spawner-service/pkg/service/common"
+	"go.uber.org/zap"
+	"gopkg.in/yaml.v2"
+)
+
+var ERR_QUOTA_EXCEEDED = errors.New("quota exceeded")
+
+//Resources amount of the resources under quota, used for limits, usage and requests
+type Resources struct {
+	Clusters int64 `yaml:"maxClusters"`
+	Nodes    int64 `yaml:"maxNodes"`
+	//GPUs nodes of gpu machine type, see common.IsGPU
+	GPUs      int64 `yaml:"maxGPUs"`
+	VolumeGiB int64 `yaml:"maxVolumeGiB"`
+}
+
+//Add sums the resources
+func (r Resources) Add(o Resources) Resources {
+	return Resources{
+		Clusters:  r.Clusters + o.Clusters,
+		Nodes:     r.Nodes + o.Nodes,
+		GPUs:      r.GPUs + o.GPUs,
+		VolumeGiB: r.VolumeGiB + o.VolumeGiB,
+	}
+}
+
+func (r Resources) sub(o Resources) Resources {
+	return r.Add(Resources{Clusters: -o.Clusters, Nodes: -o.Nodes, GPUs: -o.GPUs, VolumeGiB: -o.VolumeGiB})
+}
+
+//Config limits per account and workspace, zero limit is unlimited.
+//
+//Default applies to the accounts which are not listed, workspaces are limited only when listed.
+type Config struct {
+	Default    *Resources           `yaml:"default"`
+	Accounts   map[string]Resources `yaml:"accounts"`
+	Workspaces map[string]Resources `yaml:"workspaces"`
+}
+
+//Load reads the yaml quota file
+func Load(file string) (*Config, error) {
+	b, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read quota file")
+	}
+	c := &Config{}
+	if err := yaml.UnmarshalStrict(b, c); err != nil {
+		return nil, errors.Wrapf(err, "invalid quota file '%s'", file)
+	}
+	return c, nil
+}
+
+//Scope quota applies to
+type Scope string
+
+const (
+	ScopeAccount   Scope = "account"
+	ScopeWorkspace Scope = "workspace"
+)
+
+type scopeKey struct {
+	scope Scope
+	name  string
+}
+
+//Usage of the quota in the scope
+type Usage struct {
+	Scope  Scope
+	Name   string
+	Limits Resources
+	//Used by the live resources in the inventory
+	Used Resources
+	//Pending reserved by the requests in progress which are not in the inventory yet
+	Pending Resources
+}
+
+//Manager checks the requests against the limits, usage is computed from the inventory.
+//
+//nil Manager allows everything.
+type Manager struct {
+	logger *zap.SugaredLogger
+	store  inventory.Store
+	config Config
+
+	mu      sync.Mutex
+	pending map[scopeKey]Resources
+}
+
+//NewManager returns the manager enforcing the config, clusters, nodes and volumes are created within the limits
+func NewManager(logger *zap.SugaredLogger, store inventory.Store, config Config) *Manager {
+	return &Manager{
+		logger:  logger,
+		store:   store,
+		config:  config,
+		pending: make(map[scopeKey]Resources),
+	}
+}
+
+//NodeResources resources used by count nodes of the machine type
+func NodeResources(machineType string, gpuEnabled bool, count int64) Resources {
+	if count <= 0 {
+		count = 1
+	}
+	r := Resources{Nodes: count}
+	if common.IsGPU(machineType) || gpuEnabled {
+		r.GPUs = count
+	}
+	return r
+}
+
+//PoolResources resources used by count nodes of the node pool recorded in the inventory
+func PoolResources(r inventory.Resource, count int64) Resources {
+	return NodeResources(r.Attributes["machineType"], r.Attributes["gpuEnabled"] == "true", count)
+}
+
+func attrInt(r inventory.Resource, name string) int64 {
+	v, _ := strconv.ParseInt(r.Attributes[name], 10, 64)
+	return v
+}
+
+//resourceUsage of the resource recorded in inventory
+func resourceUsage(r inventory.Resource) Resources {
+	switch r.Kind {
+	case inventory.KindCluster:
+		return Resources{Clusters: 1}
+	case inventory.KindNodePool:
+		return PoolResources(r, attrInt(r, "count"))
+	case inventory.KindVolume:
+		return Resources{VolumeGiB: attrInt(r, "size")}
+	}
+	return Resources{}
+}
+
+func (m *Manager) used(ctx context.Context, key scopeKey) (Resources, error) {
+	f := inventory.Filter{}
+	if key.scope == ScopeAccount {
+		f.Account = key.name
+	} else {
+		f.Workspace = key.name
+	}
+
+	resources, err := m.store.List(ctx, f)
+	if err != nil {
+		return Resources{}, errors.Wrap(err, "failed to list resources from inventory")
+	}
+	used := Resources{}
+	for _, r := range resources {
+		used = used.Add(resourceUsage(r))
+	}
+	return used, nil
+}
+
+func (m *Manager) limits(key scopeKey) (Resources, bool) {
+	if key.scope == ScopeWorkspace {
+		l, ok := m.config.Workspaces[key.name]
+		return l, ok
+	}
+	if l, ok := m.config.Accounts[key.name]; ok {
+		return l, true
+	}
+	if m.config.Default != nil {
+		return *m.config.Default, true
+	}
+	return Resources{}, false
+}
+
+func (k scopeKey) String() string {
+	return fmt.Sprintf("%s '%s'", k.scope, k.name)
+}
+
+//exceeded lists the resources going over the limits
+func exceeded(key scopeKey, limits, used, requested Resources) []string {
+	total := used.Add(requested)
+	over := []string{}
+	check := func(name string, limit, total, used, requested int64) {
+		if limit > 0 && requested > 0 && total > limit {
+			over = append(over, fmt.Sprintf("%s: %d in use + %d requested exceeds limit %d", name, used, requested, limit))
+		}
+	}
+	check("clusters", limits.Clusters, total.Clusters, used.Clusters, requested.Clusters)
+	check("nodes", limits.Nodes, total.Nodes, used.Nodes, requested.Nodes)
+	check("gpus", limits.GPUs, total.GPUs, used.GPUs, requested.GPUs)
+	check("volume GiB", limits.VolumeGiB, total.VolumeGiB, used.VolumeGiB, requested.VolumeGiB)
+	return over
+}
+
+func scopes(account, workspace string) []scopeKey {
+	keys := []scopeKey{{scope: ScopeAccount, name: account}}
+	if workspace != "" {
+		keys = append(keys, scopeKey{scope: ScopeWorkspace, name: workspace})
+	}
+	return keys
+}
+
+//Reserve checks that the requested resources fit the account and workspace quotas and holds them
+//until release is called, call release once the created resources are recorded in the inventory or the request failed.
+//
+//over quota requests fail with ERR_QUOTA_EXCEEDED.
+func (m *Manager) Reserve(ctx context.Context, account, workspace string, requested Resources) (release func(), err error) {
+	if m == nil {
+		return func() {}, nil
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	keys := scopes(account, workspace)
+	for _, key := range keys {
+		limits, ok := m.limits(key)
+		if !ok {
+			continue
+		}
+		used, err := m.used(ctx, key)
+		if err != nil {
+			return nil, err
+		}
+		if over := exceeded(key, limits, used.Add(m.pending[key]), requested); len(over) > 0 {
+			m.logger.Infow("request rejected, over quota", "scope", key.scope, "name", key.name, "exceeded", over)
+			return nil, errors.Wrapf(ERR_QUOTA_EXCEEDED, "%s: %s", key, strings.Join(over, ", "))
+		}
+	}
+
+	for _, key := range keys {
+		m.pending[key] = m.pending[key].Add(requested)
+	}
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			m.mu.Lock()
+			defer m.mu.Unlock()
+			for _, key := range keys {
+				m.pending[key] = m.pending[key].sub(requested)
+				if m.pending[key] == (Resources{}) {
+					delete(m.pending, key)
+				}
+			}
+		})
+	}, nil
+}
+
+//Usage of the account and the workspace quotas, workspace is optional. all the quotas are listed when both are empty
+func (m *Manager) Usage(ctx context.Context, account, workspace string) ([]Usage, error) {
+	if m == nil {
+		return []Usage{}, nil
+	}
+
+	keys := []scopeKey{}
+	switch {
+	case account != "":
+		keys = scopes(account, workspace)
+	case workspace != "":
+		keys = []scopeKey{{scope: ScopeWorkspace, name: workspace}}
+	default:
+		for name := range m.config.Accounts {
+			keys = append(keys, scopeKey{scope: ScopeAccount, name: name})
+		}
+		for name := range m.config.Workspaces {
+			keys = append(keys, scopeKey{scope: ScopeWorkspace, name: name})
+		}
+		sort.Slice(keys, func(i, j int) bool {
+			if keys[i].scope == keys[j].scope {
+				return keys[i].name < keys[j].name
+			}
+			return keys[i].scope < keys[j].scope
+		})
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	res := []Usage{}
+	for _, key := range keys {
+		limits, _ := m.limits(key)
+		used, err := m.used(ctx, key)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, Usage{
+			Scope:   key.scope,
+			Name:    key.name,
+			Limits:  limits,
+			Used:    used,
+			Pending: m.pending[key],
+		})
+	}
+	return res, nil
+}
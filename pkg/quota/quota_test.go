@@ -0,0 +1,101 @@
+package quota
+
+import (
+	"context"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gitlab.com/netbook-devs/spawner-service/pkg/inventory"
+	"go.uber.org/zap"
+)
+
+const quotaYaml = `
+default:
+  maxClusters: 1
+accounts:
+  dev:
+    maxClusters: 2
+    maxNodes: 4
+    maxGPUs: 2
+    maxVolumeGiB: 100
+workspaces:
+  ws-1:
+    maxNodes: 3
+`
+
+func Test_Reserve(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "quota.yaml")
+	require.NoError(t, ioutil.WriteFile(path, []byte(quotaYaml), 0600))
+	conf, err := Load(path)
+	require.NoError(t, err)
+
+	store := inventory.NewMemoryStore()
+	rec := inventory.NewRecorder(store, "fake", zap.NewNop().Sugar())
+	m := NewManager(zap.NewNop().Sugar(), store, *conf)
+
+	rec.Created(ctx, inventory.Resource{ID: "c1", Kind: inventory.KindCluster, Account: "dev", Region: "local"})
+	rec.Created(ctx, inventory.Resource{
+		ID: "c1/gpu", Kind: inventory.KindNodePool, Account: "dev", Region: "local", Cluster: "c1",
+		Labels:     map[string]string{"workspaceid": "ws-1"},
+		Attributes: map[string]string{"count": "2", "machineType": "m+t4"},
+	})
+	rec.Created(ctx, inventory.Resource{ID: "vol-1", Kind: inventory.KindVolume, Account: "dev", Region: "local", Attributes: map[string]string{"size": "80"}})
+
+	//gpus: 2 in use
+	_, err = m.Reserve(ctx, "dev", "", NodeResources("m+v100", false, 1))
+	assert.Equal(t, ERR_QUOTA_EXCEEDED, errors.Cause(err))
+	assert.Contains(t, err.Error(), "gpus: 2 in use + 1 requested exceeds limit 2")
+
+	//workspace allows 3 nodes, 2 in use
+	_, err = m.Reserve(ctx, "dev", "ws-1", NodeResources("m", false, 2))
+	assert.Equal(t, ERR_QUOTA_EXCEEDED, errors.Cause(err))
+
+	_, err = m.Reserve(ctx, "dev", "", Resources{VolumeGiB: 30})
+	assert.Equal(t, ERR_QUOTA_EXCEEDED, errors.Cause(err))
+
+	//pending reservations count until released
+	release, err := m.Reserve(ctx, "dev", "", Resources{Clusters: 1})
+	require.NoError(t, err)
+	_, err = m.Reserve(ctx, "dev", "", Resources{Clusters: 1})
+	assert.Equal(t, ERR_QUOTA_EXCEEDED, errors.Cause(err))
+
+	usage, err := m.Usage(ctx, "dev", "ws-1")
+	require.NoError(t, err)
+	require.Len(t, usage, 2)
+	assert.Equal(t, Resources{Clusters: 1, Nodes: 2, GPUs: 2, VolumeGiB: 80}, usage[0].Used)
+	assert.Equal(t, Resources{Clusters: 1}, usage[0].Pending)
+	assert.Equal(t, Resources{Nodes: 2, GPUs: 2}, usage[1].Used)
+
+	release()
+	release()
+	_, err = m.Reserve(ctx, "dev", "", Resources{Clusters: 1})
+	assert.NoError(t, err)
+
+	//default quota for the accounts not listed
+	_, err = m.Reserve(ctx, "other", "", Resources{Clusters: 2})
+	assert.Equal(t, ERR_QUOTA_EXCEEDED, errors.Cause(err))
+
+	//deleted resources free the quota
+	rec.Deleted(ctx, inventory.KindVolume, "dev", "local", "vol-1")
+	_, err = m.Reserve(ctx, "dev", "", Resources{VolumeGiB: 100})
+	assert.NoError(t, err)
+
+	//nil manager allows everything
+	var none *Manager
+	_, err = none.Reserve(ctx, "dev", "", Resources{Clusters: 100})
+	assert.NoError(t, err)
+}
+
+func Test_PoolResources(t *testing.T) {
+	pool := inventory.Resource{Kind: inventory.KindNodePool, Attributes: map[string]string{"count": "3", "machineType": "m"}}
+	assert.Equal(t, Resources{Nodes: 3}, resourceUsage(pool))
+
+	pool.Attributes["gpuEnabled"] = "true"
+	assert.Equal(t, Resources{Nodes: 3, GPUs: 3}, resourceUsage(pool), "gpu requested on a cpu machine type is counted")
+	assert.Equal(t, Resources{Nodes: 2, GPUs: 2}, PoolResources(pool, 2))
+}
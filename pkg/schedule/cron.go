@@ -0,0 +1,176 @@
+package schedule
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+//lookback how far Prev searches for the last time the expression fired
+const lookback = 366
+
+//Cron parsed five field cron expression: minute hour day-of-month month day-of-week
+type Cron struct {
+	minute, hour, dom, month, dow uint64
+	//domAny and dowAny when the day fields are '*', cron matches either day field when both are restricted
+	domAny, dowAny bool
+}
+
+type field struct {
+	min, max int
+	names    map[string]int
+}
+
+var (
+	minuteField = field{min: 0, max: 59}
+	hourField   = field{min: 0, max: 23}
+	domField    = field{min: 1, max: 31}
+	monthField  = field{min: 1, max: 12, names: map[string]int{
+		"jan": 1, "feb": 2, "mar": 3, "apr": 4, "may": 5, "jun": 6,
+		"jul": 7, "aug": 8, "sep": 9, "oct": 10, "nov": 11, "dec": 12,
+	}}
+	//7 is sunday as well
+	dowField = field{min: 0, max: 7, names: map[string]int{
+		"sun": 0, "mon": 1, "tue": 2, "wed": 3, "thu": 4, "fri": 5, "sat": 6,
+	}}
+)
+
+//ParseCron parses the five field cron expression, fields accept '*', numbers, names of months and
+//week days, ranges 'a-b', steps '*/n' or 'a-b/n' and comma separated lists of them
+func ParseCron(expr string) (Cron, error) {
+	fields := strings.Fields(expr)
+	if len(fields) != 5 {
+		return Cron{}, errors.Wrapf(ERR_INVALID_SCHEDULE, "cron '%s' must have 5 fields", expr)
+	}
+
+	var c Cron
+	var err error
+	if c.minute, err = minuteField.parse(fields[0]); err != nil {
+		return Cron{}, errors.Wrapf(err, "cron '%s' minute", expr)
+	}
+	if c.hour, err = hourField.parse(fields[1]); err != nil {
+		return Cron{}, errors.Wrapf(err, "cron '%s' hour", expr)
+	}
+	if c.dom, err = domField.parse(fields[2]); err != nil {
+		return Cron{}, errors.Wrapf(err, "cron '%s' day of month", expr)
+	}
+	if c.month, err = monthField.parse(fields[3]); err != nil {
+		return Cron{}, errors.Wrapf(err, "cron '%s' month", expr)
+	}
+	if c.dow, err = dowField.parse(fields[4]); err != nil {
+		return Cron{}, errors.Wrapf(err, "cron '%s' day of week", expr)
+	}
+	if c.dow&(1<<7) != 0 {
+		c.dow |= 1
+	}
+	c.domAny = fields[2] == "*"
+	c.dowAny = fields[4] == "*"
+	return c, nil
+}
+
+func (f field) value(s string) (int, error) {
+	if v, ok := f.names[strings.ToLower(s)]; ok {
+		return v, nil
+	}
+	v, err := strconv.Atoi(s)
+	if err != nil || v < f.min || v > f.max {
+		return 0, errors.Wrapf(ERR_INVALID_SCHEDULE, "'%s' not in %d-%d", s, f.min, f.max)
+	}
+	return v, nil
+}
+
+//parse returns the bits of the values the field matches
+func (f field) parse(s string) (uint64, error) {
+	var bits uint64
+	for _, part := range strings.Split(s, ",") {
+		step := 1
+		if i := strings.Index(part, "/"); i >= 0 {
+			n, err := strconv.Atoi(part[i+1:])
+			if err != nil || n < 1 {
+				return 0, errors.Wrapf(ERR_INVALID_SCHEDULE, "invalid step '%s'", part)
+			}
+			step = n
+			part = part[:i]
+		}
+
+		lo, hi := f.min, f.max
+		switch {
+		case part == "*":
+		case strings.Contains(part, "-"):
+			bounds := strings.SplitN(part, "-", 2)
+			var err error
+			if lo, err = f.value(bounds[0]); err != nil {
+				return 0, err
+			}
+			if hi, err = f.value(bounds[1]); err != nil {
+				return 0, err
+			}
+			if lo > hi {
+				return 0, errors.Wrapf(ERR_INVALID_SCHEDULE, "invalid range '%s'", part)
+			}
+		default:
+			v, err := f.value(part)
+			if err != nil {
+				return 0, err
+			}
+			lo = v
+			//'n/step' runs from n to the end of the field
+			if step == 1 {
+				hi = v
+			}
+		}
+		for v := lo; v <= hi; v += step {
+			bits |= 1 << uint(v)
+		}
+	}
+	return bits, nil
+}
+
+func has(bits uint64, v int) bool {
+	return bits&(1<<uint(v)) != 0
+}
+
+func (c Cron) day(t time.Time) bool {
+	if !has(c.month, int(t.Month())) {
+		return false
+	}
+	dom := has(c.dom, t.Day())
+	dow := has(c.dow, int(t.Weekday()))
+	if !c.domAny && !c.dowAny {
+		return dom || dow
+	}
+	return dom && dow
+}
+
+//Prev returns the last time at or before t the expression fired, in the location of t.
+//false when it did not fire within the last year
+func (c Cron) Prev(t time.Time) (time.Time, bool) {
+	t = t.Truncate(time.Minute)
+	for d := 0; d <= lookback; d++ {
+		day := time.Date(t.Year(), t.Month(), t.Day()-d, 0, 0, 0, 0, t.Location())
+		if !c.day(day) {
+			continue
+		}
+		hour := 23
+		if d == 0 {
+			hour = t.Hour()
+		}
+		for h := hour; h >= 0; h-- {
+			if !has(c.hour, h) {
+				continue
+			}
+			minute := 59
+			if d == 0 && h == t.Hour() {
+				minute = t.Minute()
+			}
+			for m := minute; m >= 0; m-- {
+				if has(c.minute, m) {
+					return time.Date(day.Year(), day.Month(), day.Day(), h, m, 0, 0, t.Location()), true
+				}
+			}
+		}
+	}
+	return time.Time{}, false
+}
@@ -0,0 +1,134 @@
+package schedule
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+var (
+	ERR_NOT_FOUND        = errors.New("schedule not found")
+	ERR_INVALID_SCHEDULE = errors.New("invalid schedule")
+)
+
+//Rule sets the size of the node pool every time the cron expression fires
+type Rule struct {
+	Cron        string `json:"cron"`
+	DesiredSize int64  `json:"desiredSize"`
+	//MinSize and MaxSize autoscaling bounds, current bounds are widened to desired size when MaxSize is 0
+	MinSize int64 `json:"minSize"`
+	MaxSize int64 `json:"maxSize"`
+}
+
+func (r Rule) validate() error {
+	if _, err := ParseCron(r.Cron); err != nil {
+		return err
+	}
+	if r.DesiredSize < 0 || r.MinSize < 0 || r.MaxSize < 0 {
+		return errors.Wrapf(ERR_INVALID_SCHEDULE, "rule '%s' sizes can not be negative", r.Cron)
+	}
+	if r.MaxSize > 0 && (r.MinSize > r.DesiredSize || r.DesiredSize > r.MaxSize) {
+		return errors.Wrapf(ERR_INVALID_SCHEDULE, "rule '%s' desired size must be between min and max size", r.Cron)
+	}
+	return nil
+}
+
+//Schedule of the node pool, size of the last fired rule is kept until the next rule fires
+type Schedule struct {
+	Provider string `json:"provider"`
+	Account  string `json:"account"`
+	Region   string `json:"region"`
+	Cluster  string `json:"cluster"`
+	NodePool string `json:"nodePool"`
+	//Timezone IANA name of the zone the rules are evaluated in, defaults to UTC
+	Timezone  string    `json:"timezone"`
+	Rules     []Rule    `json:"rules"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+//Key of the node pool the schedule belongs to
+func Key(provider, account, region, cluster, nodePool string) string {
+	return strings.Join([]string{provider, account, region, cluster, nodePool}, "/")
+}
+
+//Key of the schedule
+func (s Schedule) Key() string {
+	return Key(s.Provider, s.Account, s.Region, s.Cluster, s.NodePool)
+}
+
+//Validate checks the node pool, time zone and rules of the schedule and fills the default time zone
+func (s *Schedule) Validate() error {
+	if s.Provider == "" || s.Account == "" || s.Region == "" || s.Cluster == "" || s.NodePool == "" {
+		return errors.Wrap(ERR_INVALID_SCHEDULE, "provider, account, region, cluster and node pool are required")
+	}
+	if s.Timezone == "" {
+		s.Timezone = "UTC"
+	}
+	if _, err := time.LoadLocation(s.Timezone); err != nil {
+		return errors.Wrapf(ERR_INVALID_SCHEDULE, "unknown time zone '%s'", s.Timezone)
+	}
+	if len(s.Rules) == 0 {
+		return errors.Wrap(ERR_INVALID_SCHEDULE, "at least one rule is required")
+	}
+	for _, r := range s.Rules {
+		if err := r.validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+//Active returns the rule which fired last at or before now and the time it fired,
+//false when none of the rules fired within the last year
+func (s Schedule) Active(now time.Time) (Rule, time.Time, bool) {
+	loc, err := time.LoadLocation(s.Timezone)
+	if err != nil {
+		return Rule{}, time.Time{}, false
+	}
+	now = now.In(loc)
+
+	var active Rule
+	var fired time.Time
+	for _, r := range s.Rules {
+		c, err := ParseCron(r.Cron)
+		if err != nil {
+			continue
+		}
+		//later rule wins when two fire at the same time
+		if t, ok := c.Prev(now); ok && !t.Before(fired) {
+			active, fired = r, t
+		}
+	}
+	return active, fired, !fired.IsZero()
+}
+
+//Status of the schedule, rule applied last
+type Status struct {
+	Schedule Schedule
+	//Applied rule started last, nil until a rule fired
+	Applied *Rule
+	//FiredAt time the applied rule fired
+	FiredAt     time.Time
+	OperationID string
+	//Error of the last attempt to apply the rule, rule is retried on next run
+	Error string
+}
+
+//Store persists the schedules
+type Store interface {
+	Put(ctx context.Context, s Schedule) error
+	Get(ctx context.Context, key string) (Schedule, error)
+	Delete(ctx context.Context, key string) error
+	List(ctx context.Context) ([]Schedule, error)
+	Close() error
+}
+
+//Open returns the file store at path, memory store when path is empty
+func Open(path string) (Store, error) {
+	if path == "" {
+		return NewMemoryStore(), nil
+	}
+	return NewFileStore(path)
+}
@@ -0,0 +1,156 @@
+package schedule
+
+import (
+	"context"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func Test_CronPrev(t *testing.T) {
+	//wednesday
+	now := time.Date(2022, 5, 11, 9, 30, 45, 0, time.UTC)
+	cases := map[string]string{
+		"* * * * *":          "2022-05-11 09:30",
+		"0 8 * * 1-5":        "2022-05-11 08:00",
+		"0 20 * * mon-fri":   "2022-05-10 20:00",
+		"*/15 * * * *":       "2022-05-11 09:30",
+		"0 0 * * 0":          "2022-05-08 00:00",
+		"0 0 * * 7":          "2022-05-08 00:00",
+		"5,50 10-23/2 * * *": "2022-05-10 22:50",
+		"0 12 1 jan *":       "2022-01-01 12:00",
+		//either day matches when both are restricted
+		"0 0 10 * 3": "2022-05-11 00:00",
+	}
+	for expr, want := range cases {
+		c, err := ParseCron(expr)
+		require.NoError(t, err, expr)
+		got, ok := c.Prev(now)
+		require.True(t, ok, expr)
+		assert.Equal(t, want, got.Format("2006-01-02 15:04"), expr)
+	}
+
+	for _, expr := range []string{"", "* * * *", "60 * * * *", "* * 0 * *", "5-1 * * * *", "*/0 * * * *", "* * * * fun"} {
+		_, err := ParseCron(expr)
+		assert.True(t, errors.Is(err, ERR_INVALID_SCHEDULE), "expected invalid cron '%s'", expr)
+	}
+}
+
+func Test_ScheduleValidate(t *testing.T) {
+	s := Schedule{Provider: "aws", Account: "netbook-aws", Region: "us-west-2", Cluster: "dev", NodePool: "gpu",
+		Rules: []Rule{{Cron: "0 20 * * *"}, {Cron: "0 8 * * *", DesiredSize: 2, MinSize: 1, MaxSize: 4}}}
+	require.NoError(t, s.Validate())
+	assert.Equal(t, "UTC", s.Timezone)
+
+	invalid := []Schedule{
+		{Provider: "aws", Account: "netbook-aws", Region: "us-west-2", Cluster: "dev", Rules: s.Rules},
+		{Provider: "aws", Account: "netbook-aws", Region: "us-west-2", Cluster: "dev", NodePool: "gpu"},
+		{Provider: "aws", Account: "netbook-aws", Region: "us-west-2", Cluster: "dev", NodePool: "gpu", Timezone: "Mars/Olympus", Rules: s.Rules},
+		{Provider: "aws", Account: "netbook-aws", Region: "us-west-2", Cluster: "dev", NodePool: "gpu", Rules: []Rule{{Cron: "0 8 * * *", DesiredSize: 5, MaxSize: 4}}},
+		{Provider: "aws", Account: "netbook-aws", Region: "us-west-2", Cluster: "dev", NodePool: "gpu", Rules: []Rule{{Cron: "0 8 * * *", DesiredSize: -1}}},
+	}
+	for _, s := range invalid {
+		assert.True(t, errors.Is(s.Validate(), ERR_INVALID_SCHEDULE), "expected invalid schedule %+v", s)
+	}
+}
+
+func Test_FileStore(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "schedules.json")
+
+	s, err := NewFileStore(path)
+	require.NoError(t, err)
+	a := Schedule{Provider: "fake", Account: "dev", Region: "local", Cluster: "dev", NodePool: "a", Rules: []Rule{{Cron: "0 8 * * *", DesiredSize: 1}}}
+	b := Schedule{Provider: "fake", Account: "dev", Region: "local", Cluster: "dev", NodePool: "b"}
+	require.NoError(t, s.Put(ctx, a))
+	require.NoError(t, s.Put(ctx, b))
+	require.NoError(t, s.Delete(ctx, b.Key()))
+
+	reopened, err := NewFileStore(path)
+	require.NoError(t, err)
+	schedules, err := reopened.List(ctx)
+	require.NoError(t, err)
+	require.Len(t, schedules, 1)
+	assert.Equal(t, a.Rules, schedules[0].Rules)
+
+	_, err = reopened.Get(ctx, b.Key())
+	assert.True(t, errors.Is(err, ERR_NOT_FOUND))
+}
+
+func Test_Scheduler(t *testing.T) {
+	ctx := context.Background()
+	applied := []int64{}
+	fail := false
+	s := NewScheduler(zap.NewNop().Sugar(), NewMemoryStore(), func(ctx context.Context, sc Schedule, r Rule) (string, error) {
+		if fail {
+			return "", errors.New("provider unavailable")
+		}
+		applied = append(applied, r.DesiredSize)
+		return "op", nil
+	})
+	//monday 21:00 in Berlin
+	now := time.Date(2022, 5, 9, 19, 0, 0, 0, time.UTC)
+	s.now = func() time.Time { return now }
+
+	sc, err := s.Set(ctx, Schedule{Provider: "aws", Account: "netbook-aws", Region: "us-west-2", Cluster: "dev", NodePool: "gpu", Timezone: "Europe/Berlin",
+		Rules: []Rule{{Cron: "0 20 * * *", DesiredSize: 0}, {Cron: "0 8 * * 1-5", DesiredSize: 2, MinSize: 1, MaxSize: 4}}})
+	require.NoError(t, err)
+
+	s.ApplyAll(ctx)
+	s.ApplyAll(ctx)
+	assert.Equal(t, []int64{0}, applied, "rule must be applied once per firing")
+
+	//tuesday 08:30 in Berlin, scaler fails first and is retried
+	now = time.Date(2022, 5, 10, 6, 30, 0, 0, time.UTC)
+	fail = true
+	st := s.Apply(ctx, sc)
+	assert.NotEmpty(t, st.Error)
+	fail = false
+	s.ApplyAll(ctx)
+	assert.Equal(t, []int64{0, 2}, applied)
+
+	st, err = s.Status(ctx, sc.Key())
+	require.NoError(t, err)
+	require.NotNil(t, st.Applied)
+	assert.Equal(t, int64(4), st.Applied.MaxSize)
+	assert.Equal(t, "op", st.OperationID)
+	assert.Empty(t, st.Error)
+
+	require.NoError(t, s.Delete(ctx, sc.Key()))
+	_, err = s.Status(ctx, sc.Key())
+	assert.True(t, errors.Is(err, ERR_NOT_FOUND))
+}
+
+func Test_SchedulerConcurrentApply(t *testing.T) {
+	ctx := context.Background()
+	var calls int32
+	s := NewScheduler(zap.NewNop().Sugar(), NewMemoryStore(), func(ctx context.Context, sc Schedule, r Rule) (string, error) {
+		atomic.AddInt32(&calls, 1)
+		time.Sleep(10 * time.Millisecond)
+		return "op", nil
+	})
+	now := time.Date(2022, 5, 9, 19, 0, 0, 0, time.UTC)
+	s.now = func() time.Time { return now }
+	sc, err := s.Set(ctx, Schedule{Provider: "aws", Account: "netbook-aws", Region: "us-west-2", Cluster: "dev", NodePool: "gpu",
+		Rules: []Rule{{Cron: "0 18 * * *", DesiredSize: 0}}})
+	require.NoError(t, err)
+
+	//SetNodePoolSchedule applies right away while the run ticks
+	var wg sync.WaitGroup
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			s.Apply(ctx, sc)
+		}()
+	}
+	wg.Wait()
+	assert.Equal(t, int32(1), atomic.LoadInt32(&calls), "rule fired last is applied once")
+}
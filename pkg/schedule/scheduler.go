@@ -0,0 +1,156 @@
+package schedule
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+//ScaleFunc sets the size of the node pool to the rule, returns the id of the scaling operation
+type ScaleFunc func(ctx context.Context, s Schedule, r Rule) (string, error)
+
+//Scheduler applies the rule of the node pool schedules every time one fires,
+//rule which fired while spawner was down is applied on next run
+type Scheduler struct {
+	logger *zap.SugaredLogger
+	store  Store
+	scale  ScaleFunc
+	now    func() time.Time
+
+	mu     sync.RWMutex
+	status map[string]Status
+	//applying serializes Apply per schedule so the rule fired last is applied once
+	applying map[string]*sync.Mutex
+}
+
+//NewScheduler returns the scheduler of the schedules in store, node pools are scaled when the rules of their schedules fire
+func NewScheduler(logger *zap.SugaredLogger, store Store, scale ScaleFunc) *Scheduler {
+	return &Scheduler{
+		logger: logger,
+		store:  store,
+		scale:  scale,
+		now:    time.Now,
+		status: make(map[string]Status),
+
+		applying: make(map[string]*sync.Mutex),
+	}
+}
+
+//Run applies the schedules every interval until ctx is done
+func (s *Scheduler) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		s.ApplyAll(ctx)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+//ApplyAll applies every schedule in the store
+func (s *Scheduler) ApplyAll(ctx context.Context) {
+	schedules, err := s.store.List(ctx)
+	if err != nil {
+		s.logger.Errorw("failed to list node pool schedules", "error", err)
+		return
+	}
+	for _, sc := range schedules {
+		s.Apply(ctx, sc)
+	}
+}
+
+//lock holds the apply lock of the schedule, returns its unlock
+func (s *Scheduler) lock(key string) func() {
+	s.mu.Lock()
+	l, ok := s.applying[key]
+	if !ok {
+		l = &sync.Mutex{}
+		s.applying[key] = l
+	}
+	s.mu.Unlock()
+
+	l.Lock()
+	return l.Unlock
+}
+
+//Apply scales the node pool to the rule fired last, unless it was applied already. Concurrent calls of the same
+//schedule wait for each other, the check of the applied rule and the scaling are done under one lock
+func (s *Scheduler) Apply(ctx context.Context, sc Schedule) Status {
+	key := sc.Key()
+	unlock := s.lock(key)
+	defer unlock()
+
+	s.mu.RLock()
+	prev := s.status[key]
+	s.mu.RUnlock()
+	prev.Schedule = sc
+
+	rule, fired, ok := sc.Active(s.now())
+	if !ok || (prev.Applied != nil && prev.FiredAt.Equal(fired) && prev.Error == "") {
+		return s.setStatus(key, prev)
+	}
+
+	st := Status{Schedule: sc, Applied: &rule, FiredAt: fired}
+	id, err := s.scale(ctx, sc, rule)
+	if err != nil {
+		s.logger.Errorw("failed to apply node pool schedule", "nodePool", key, "cron", rule.Cron, "error", err)
+		st.Error = err.Error()
+		return s.setStatus(key, st)
+	}
+	s.logger.Infow("applied node pool schedule", "nodePool", key, "cron", rule.Cron, "desired", rule.DesiredSize, "operation", id)
+	st.OperationID = id
+	return s.setStatus(key, st)
+}
+
+func (s *Scheduler) setStatus(key string, st Status) Status {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.status[key] = st
+	return st
+}
+
+//Set validates and stores the schedule, it is applied on next run
+func (s *Scheduler) Set(ctx context.Context, sc Schedule) (Schedule, error) {
+	if err := sc.Validate(); err != nil {
+		return Schedule{}, err
+	}
+	sc.UpdatedAt = s.now().UTC()
+	if err := s.store.Put(ctx, sc); err != nil {
+		return Schedule{}, err
+	}
+
+	//rules may have changed, the rule fired last is applied again
+	s.mu.Lock()
+	delete(s.status, sc.Key())
+	s.mu.Unlock()
+	return sc, nil
+}
+
+//Delete removes the schedule, node pool keeps its current size
+func (s *Scheduler) Delete(ctx context.Context, key string) error {
+	if err := s.store.Delete(ctx, key); err != nil {
+		return err
+	}
+	s.mu.Lock()
+	delete(s.status, key)
+	s.mu.Unlock()
+	return nil
+}
+
+//Status returns the schedule of the node pool and the rule applied last
+func (s *Scheduler) Status(ctx context.Context, key string) (Status, error) {
+	sc, err := s.store.Get(ctx, key)
+	if err != nil {
+		return Status{}, err
+	}
+	s.mu.RLock()
+	st := s.status[key]
+	s.mu.RUnlock()
+	st.Schedule = sc
+	return st, nil
+}
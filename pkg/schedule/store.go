@@ -0,0 +1,102 @@
+package schedule
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"github.com/pkg/errors"
+	"gitlab.com/netbook-devs/spawner-service/pkg/jsonfile"
+)
+
+//MemoryStore keeps the schedules in memory, schedules are lost on restart
+type MemoryStore struct {
+	mu        sync.RWMutex
+	schedules map[string]Schedule
+}
+
+//NewMemoryStore returns empty store
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{schedules: make(map[string]Schedule)}
+}
+
+func (m *MemoryStore) Put(ctx context.Context, s Schedule) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.schedules[s.Key()] = s
+	return nil
+}
+
+func (m *MemoryStore) Get(ctx context.Context, key string) (Schedule, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	s, ok := m.schedules[key]
+	if !ok {
+		return Schedule{}, errors.Wrapf(ERR_NOT_FOUND, "node pool '%s'", key)
+	}
+	return s, nil
+}
+
+func (m *MemoryStore) Delete(ctx context.Context, key string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if _, ok := m.schedules[key]; !ok {
+		return errors.Wrapf(ERR_NOT_FOUND, "node pool '%s'", key)
+	}
+	delete(m.schedules, key)
+	return nil
+}
+
+//List returns the schedules sorted by key
+func (m *MemoryStore) List(ctx context.Context) ([]Schedule, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	res := make([]Schedule, 0, len(m.schedules))
+	for _, s := range m.schedules {
+		res = append(res, s)
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i].Key() < res[j].Key() })
+	return res, nil
+}
+
+func (m *MemoryStore) Close() error {
+	return nil
+}
+
+//FileStore keeps the schedules in memory and writes all of them to the json file on every change
+type FileStore struct {
+	*MemoryStore
+	file *jsonfile.File
+}
+
+//NewFileStore loads the schedules from the file at path, file is created on first change
+func NewFileStore(path string) (*FileStore, error) {
+	schedules := []Schedule{}
+	file, err := jsonfile.Open(path, &schedules)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to load schedules")
+	}
+	s := &FileStore{MemoryStore: NewMemoryStore(), file: file}
+	for _, sc := range schedules {
+		s.MemoryStore.schedules[sc.Key()] = sc
+	}
+	return s, nil
+}
+
+func (s *FileStore) Put(ctx context.Context, sc Schedule) error {
+	return s.file.Write(func() (interface{}, error) {
+		if err := s.MemoryStore.Put(ctx, sc); err != nil {
+			return nil, err
+		}
+		return s.MemoryStore.List(ctx)
+	})
+}
+
+func (s *FileStore) Delete(ctx context.Context, key string) error {
+	return s.file.Write(func() (interface{}, error) {
+		if err := s.MemoryStore.Delete(ctx, key); err != nil {
+			return nil, err
+		}
+		return s.MemoryStore.List(ctx)
+	})
+}
@@ -0,0 +1,568 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"gitlab.com/netbook-devs/spawner-service/pkg/inventory"
+	"gitlab.com/netbook-devs/spawner-service/pkg/lease"
+	"gitlab.com/netbook-devs/spawner-service/pkg/operation"
+	"gitlab.com/netbook-devs/spawner-service/pkg/quota"
+	"gitlab.com/netbook-devs/spawner-service/pkg/service/common"
+	"gitlab.com/netbook-devs/spawner-service/pkg/service/constants"
+	proto "gitlab.com/netbook-devs/spawner-service/proto/netbookai/spawner"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+//changes planned by apply
+const (
+	ActionCreateCluster   = "CREATE_CLUSTER"
+	ActionAddNodePool     = "ADD_NODEPOOL"
+	ActionDeleteNodePool  = "DELETE_NODEPOOL"
+	ActionScaleNodePool   = "SCALE_NODEPOOL"
+	ActionUpdateLabels    = "UPDATE_NODEPOOL_LABELS"
+	ActionReplaceNodePool = "REPLACE_NODEPOOL"
+	ActionCreateVolume    = "CREATE_VOLUME"
+)
+
+//clusterActiveTimeout longest time apply waits for the new cluster to become active before adding node pools
+const clusterActiveTimeout = 30 * time.Minute
+
+//change planned by apply along with the desired state needed to make it
+type change struct {
+	action string
+	target string
+	detail string
+	node   *proto.NodeSpec
+	count  int64
+	//from count observed before the change
+	from   int64
+	labels map[string]string
+	volume *proto.VolumeSpec
+}
+
+func (c change) proto() *proto.PlannedChange {
+	return &proto.PlannedChange{Action: c.action, Target: c.target, Detail: c.detail}
+}
+
+//observedNodePool node pool as reported by GetCluster
+type observedNodePool struct {
+	instance string
+	count    int64
+	labels   map[string]string
+}
+
+//observedNodePools groups the GetCluster nodes by pool, providers listing each node separately are counted by nodes
+func observedNodePools(spec *proto.ClusterSpec) map[string]*observedNodePool {
+	pools := map[string]*observedNodePool{}
+	nodes := map[string]int64{}
+	for _, node := range spec.GetNodeSpec() {
+		if node.Name == "" {
+			continue
+		}
+		np, ok := pools[node.Name]
+		if !ok {
+			np = &observedNodePool{instance: node.Instance, labels: node.Labels}
+			pools[node.Name] = np
+		}
+		if node.Count > np.count {
+			np.count = node.Count
+		}
+		nodes[node.Name]++
+	}
+	for name, np := range pools {
+		if np.count == 0 {
+			np.count = nodes[name]
+		}
+	}
+	return pools
+}
+
+//addRecordedNodePools adds the node pools of the cluster recorded in the inventory which GetCluster does not list,
+//node pool scaled to zero has no nodes to list
+func addRecordedNodePools(pools map[string]*observedNodePool, recorded []inventory.Resource) {
+	for _, r := range recorded {
+		if _, ok := pools[r.Name]; ok || r.Name == "" {
+			continue
+		}
+		count, _ := strconv.ParseInt(r.Attributes["count"], 10, 64)
+		pools[r.Name] = &observedNodePool{instance: r.Attributes["instance"], count: count, labels: r.Labels}
+	}
+}
+
+//desiredInstance instance type of the node spec, empty when it can not be resolved for the provider
+func desiredInstance(provider, region string, node *proto.NodeSpec) string {
+	if node.Instance != "" {
+		return node.Instance
+	}
+	if provider == constants.FakeLabel {
+		//fake provider uses the aws machine types
+		provider = constants.AwsLabel
+	}
+	return common.GetRegionInstance(provider, region, node.MachineType)
+}
+
+func nodeCount(node *proto.NodeSpec) int64 {
+	if node.Count > 0 {
+		return node.Count
+	}
+	return 1
+}
+
+//planNodePools diff the desired node pools against the observed ones
+func planNodePools(req *proto.ApplyClusterSpecRequest, observed map[string]*observedNodePool) []change {
+	changes := []change{}
+	desired := map[string]bool{}
+
+	for _, node := range req.NodePools {
+		desired[node.Name] = true
+		target := inventory.NodePoolID(req.ClusterName, node.Name)
+		np, ok := observed[node.Name]
+		if !ok {
+			changes = append(changes, change{
+				action: ActionAddNodePool,
+				target: target,
+				detail: fmt.Sprintf("%d x %s", nodeCount(node), desiredInstance(req.Provider, req.Region, node)),
+				node:   node,
+			})
+			continue
+		}
+
+		instance := desiredInstance(req.Provider, req.Region, node)
+		if node.CapacityType != proto.CapacityType_SPOT && instance != "" && np.instance != "" && instance != np.instance {
+			changes = append(changes, change{
+				action: ActionReplaceNodePool,
+				target: target,
+				detail: fmt.Sprintf("instance type %s -> %s, node pool must be recreated", np.instance, instance),
+				node:   node,
+			})
+			continue
+		}
+
+		if count := nodeCount(node); count != np.count {
+			changes = append(changes, change{
+				action: ActionScaleNodePool,
+				target: target,
+				detail: fmt.Sprintf("%d -> %d nodes", np.count, count),
+				node:   node,
+				count:  count,
+				from:   np.count,
+			})
+		}
+
+		labels := map[string]string{}
+		keys := []string{}
+		for k, v := range node.Labels {
+			if cur, ok := np.labels[k]; !ok || cur != v {
+				labels[k] = v
+				keys = append(keys, k)
+			}
+		}
+		if len(labels) > 0 {
+			sort.Strings(keys)
+			changes = append(changes, change{
+				action: ActionUpdateLabels,
+				target: target,
+				detail: strings.Join(keys, ","),
+				node:   node,
+				labels: labels,
+			})
+		}
+	}
+
+	removed := []string{}
+	for name := range observed {
+		if !desired[name] {
+			removed = append(removed, name)
+		}
+	}
+	sort.Strings(removed)
+	for _, name := range removed {
+		changes = append(changes, change{
+			action: ActionDeleteNodePool,
+			target: inventory.NodePoolID(req.ClusterName, name),
+			node:   &proto.NodeSpec{Name: name},
+		})
+	}
+	return changes
+}
+
+//planVolumes volumes of the spec which are not found in the inventory
+func planVolumes(req *proto.ApplyClusterSpecRequest, existing []inventory.Resource) []change {
+	names := map[string]bool{}
+	for _, v := range existing {
+		names[v.Labels[constants.VolumeNameLabel]] = true
+	}
+
+	changes := []change{}
+	for _, v := range req.Volumes {
+		if names[v.Name] {
+			continue
+		}
+		changes = append(changes, change{
+			action: ActionCreateVolume,
+			target: v.Name,
+			detail: fmt.Sprintf("%d GiB %s", v.Size, v.Volumetype),
+			volume: v,
+		})
+	}
+	return changes
+}
+
+func validateClusterSpec(req *proto.ApplyClusterSpecRequest) error {
+	if req.ClusterName == "" {
+		return errors.New("clusterName is required")
+	}
+	if len(req.NodePools) == 0 {
+		return errors.New("at least one node pool is required")
+	}
+	seen := map[string]bool{}
+	for _, node := range req.NodePools {
+		if node.GetName() == "" {
+			return errors.New("node pool name is required")
+		}
+		if seen[node.Name] {
+			return errors.Errorf("node pool '%s' is repeated", node.Name)
+		}
+		seen[node.Name] = true
+		if _, err := lease.Expiry(time.Now(), node.TtlMinutes, node.ExpiresAt); err != nil {
+			return errors.Wrapf(err, "node pool '%s'", node.Name)
+		}
+	}
+	seen = map[string]bool{}
+	for _, v := range req.Volumes {
+		if v.GetName() == "" {
+			return errors.New("volume name is required")
+		}
+		if seen[v.Name] {
+			return errors.Errorf("volume '%s' is repeated", v.Name)
+		}
+		seen[v.Name] = true
+	}
+	return nil
+}
+
+func isActive(status string) bool {
+	return strings.EqualFold(status, constants.Active)
+}
+
+//clusterState returns whether the cluster exists and its node pools when it is active, node pools listed by GetCluster
+//along with the ones recorded in the inventory
+func (s *spawnerService) clusterState(ctx context.Context, provider Controller, req *proto.ApplyClusterSpecRequest) (bool, map[string]*observedNodePool, error) {
+	stat, err := provider.ClusterStatus(ctx, &proto.ClusterStatusRequest{
+		Provider:    req.Provider,
+		Region:      req.Region,
+		AccountName: req.AccountName,
+		ClusterName: req.ClusterName,
+	})
+	if isClusterNotFound(err) {
+		return false, nil, nil
+	}
+	if err != nil {
+		s.logger.Errorw("failed to get cluster status", "cluster", req.ClusterName, "error", err)
+		return false, nil, err
+	}
+	if !isActive(stat.Status) {
+		return true, nil, status.Errorf(codes.FailedPrecondition, "cluster '%s' is %s, apply once it is active", req.ClusterName, stat.Status)
+	}
+
+	spec, err := provider.GetCluster(ctx, &proto.GetClusterRequest{
+		Provider:    req.Provider,
+		Region:      req.Region,
+		AccountName: req.AccountName,
+		ClusterName: req.ClusterName,
+	})
+	if err != nil {
+		return true, nil, err
+	}
+	recorded, err := s.inventory.List(ctx, inventory.Filter{
+		Provider: req.Provider,
+		Account:  req.AccountName,
+		Region:   req.Region,
+		Kind:     inventory.KindNodePool,
+		Cluster:  req.ClusterName,
+	})
+	if err != nil {
+		return true, nil, err
+	}
+	pools := observedNodePools(spec)
+	addRecordedNodePools(pools, recorded)
+	return true, pools, nil
+}
+
+func (s *spawnerService) specVolumes(ctx context.Context, req *proto.ApplyClusterSpecRequest) ([]inventory.Resource, error) {
+	return s.inventory.List(ctx, inventory.Filter{
+		Provider: req.Provider,
+		Account:  req.AccountName,
+		Region:   req.Region,
+		Kind:     inventory.KindVolume,
+		Labels:   map[string]string{constants.ClusterNameLabel: req.ClusterName},
+	})
+}
+
+//planClusterSpec changes needed to bring the cluster to the spec
+func (s *spawnerService) planClusterSpec(ctx context.Context, provider Controller, req *proto.ApplyClusterSpecRequest) ([]change, error) {
+	exists, observed, err := s.clusterState(ctx, provider, req)
+	if err != nil {
+		return nil, err
+	}
+
+	changes := []change{}
+	if !exists {
+		changes = append(changes, change{action: ActionCreateCluster, target: req.ClusterName, detail: req.Region})
+		observed = map[string]*observedNodePool{}
+	}
+	changes = append(changes, planNodePools(req, observed)...)
+
+	volumes, err := s.specVolumes(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return append(changes, planVolumes(req, volumes)...), nil
+}
+
+//plannedResources resources the changes add, used for quota
+func plannedResources(changes []change) quota.Resources {
+	r := quota.Resources{}
+	for _, c := range changes {
+		switch c.action {
+		case ActionCreateCluster:
+			r = r.Add(quota.Resources{Clusters: 1})
+		case ActionAddNodePool:
+			r = r.Add(quota.NodeResources(c.node.MachineType, c.node.GpuEnabled, nodeCount(c.node)))
+		case ActionScaleNodePool:
+			if added := c.count - c.from; added > 0 {
+				r = r.Add(quota.NodeResources(c.node.MachineType, c.node.GpuEnabled, added))
+			}
+		case ActionCreateVolume:
+			r = r.Add(quota.Resources{VolumeGiB: c.volume.Size})
+		}
+	}
+	return r
+}
+
+//waitClusterActive polls the cluster status until it is active
+func (s *spawnerService) waitClusterActive(ctx context.Context, provider Controller, req *proto.ApplyClusterSpecRequest) error {
+	ctx, cancel := context.WithTimeout(ctx, clusterActiveTimeout)
+	defer cancel()
+
+	ticker := time.NewTicker(watchInterval(0))
+	defer ticker.Stop()
+	for {
+		stat, err := provider.ClusterStatus(ctx, &proto.ClusterStatusRequest{
+			Provider:    req.Provider,
+			Region:      req.Region,
+			AccountName: req.AccountName,
+			ClusterName: req.ClusterName,
+		})
+		if err == nil && isActive(stat.Status) {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return errors.Wrapf(ctx.Err(), "cluster '%s' did not become active", req.ClusterName)
+		case <-ticker.C:
+		}
+	}
+}
+
+//applyChange makes the change through the provider
+func (s *spawnerService) applyChange(ctx context.Context, provider Controller, req *proto.ApplyClusterSpecRequest, c change) error {
+	var err error
+	switch c.action {
+	case ActionAddNodePool:
+		node, err := nodeWithLease(c.node)
+		if err != nil {
+			return err
+		}
+		_, err = provider.AddNode(ctx, &proto.NodeSpawnRequest{
+			Provider:    req.Provider,
+			Region:      req.Region,
+			AccountName: req.AccountName,
+			ClusterName: req.ClusterName,
+			NodeSpec:    node,
+		})
+		return errors.Wrapf(err, "%s '%s' failed", c.action, c.target)
+	case ActionDeleteNodePool:
+		_, err = provider.DeleteNode(ctx, &proto.NodeDeleteRequest{
+			Provider:      req.Provider,
+			Region:        req.Region,
+			AccountName:   req.AccountName,
+			ClusterName:   req.ClusterName,
+			NodeGroupName: c.node.Name,
+		})
+	case ActionScaleNodePool:
+		_, err = provider.ScaleNodePool(ctx, &proto.ScaleNodePoolRequest{
+			Provider:      req.Provider,
+			Region:        req.Region,
+			AccountName:   req.AccountName,
+			ClusterName:   req.ClusterName,
+			NodeGroupName: c.node.Name,
+			Count:         c.count,
+		})
+	case ActionUpdateLabels:
+		_, err = provider.UpdateNodePool(ctx, &proto.UpdateNodePoolRequest{
+			Provider:      req.Provider,
+			Region:        req.Region,
+			AccountName:   req.AccountName,
+			ClusterName:   req.ClusterName,
+			NodeGroupName: c.node.Name,
+			Labels:        c.labels,
+		})
+	case ActionCreateVolume:
+		labels := map[string]string{}
+		for k, v := range c.volume.Labels {
+			labels[k] = v
+		}
+		labels[constants.ClusterNameLabel] = req.ClusterName
+		labels[constants.VolumeNameLabel] = c.volume.Name
+		_, err = provider.CreateVolume(ctx, &proto.CreateVolumeRequest{
+			Provider:         req.Provider,
+			Region:           req.Region,
+			AccountName:      req.AccountName,
+			Availabilityzone: c.volume.Availabilityzone,
+			Volumetype:       c.volume.Volumetype,
+			Size:             c.volume.Size,
+			Labels:           labels,
+		})
+	default:
+		return errors.Errorf("unsupported change %s of '%s'", c.action, c.target)
+	}
+	return errors.Wrapf(err, "%s '%s' failed", c.action, c.target)
+}
+
+//applyClusterSpec makes the planned changes in order, new cluster is created with the first node pool
+//and the node pools still missing once it is active are added
+func (s *spawnerService) applyClusterSpec(ctx context.Context, provider Controller, req *proto.ApplyClusterSpecRequest, changes []change) (*proto.ApplyClusterSpecResponse, error) {
+	res := &proto.ApplyClusterSpecResponse{}
+
+	created := false
+	for _, c := range changes {
+		if c.action == ActionCreateCluster {
+			node, err := nodeWithLease(req.NodePools[0])
+			if err != nil {
+				return res, err
+			}
+			_, err = provider.CreateCluster(ctx, &proto.ClusterRequest{
+				Provider:    req.Provider,
+				Region:      req.Region,
+				AccountName: req.AccountName,
+				ClusterName: req.ClusterName,
+				Node:        node,
+				Labels:      req.Labels,
+			})
+			if err != nil {
+				return res, errors.Wrapf(err, "%s '%s' failed", c.action, c.target)
+			}
+			operation.Report(ctx, "cluster '%s' created, waiting for it to become active", req.ClusterName)
+			res.Changes = append(res.Changes, c.proto())
+
+			if err := s.waitClusterActive(ctx, provider, req); err != nil {
+				return res, err
+			}
+			created = true
+			break
+		}
+	}
+
+	if created {
+		//providers creating the cluster with the first node pool already have it
+		exists, observed, err := s.clusterState(ctx, provider, req)
+		if err != nil {
+			return res, err
+		}
+		if !exists {
+			return res, errors.Errorf("cluster '%s' not found after creation", req.ClusterName)
+		}
+		remaining := []change{}
+		for _, c := range changes {
+			if c.action == ActionAddNodePool && observed[c.node.Name] != nil {
+				continue
+			}
+			if c.action != ActionCreateCluster {
+				remaining = append(remaining, c)
+			}
+		}
+		changes = remaining
+	}
+
+	for _, c := range changes {
+		if c.action == ActionCreateCluster || c.action == ActionReplaceNodePool {
+			continue
+		}
+		if err := s.applyChange(ctx, provider, req, c); err != nil {
+			return res, err
+		}
+		operation.Report(ctx, "%s '%s' done", c.action, c.target)
+		res.Changes = append(res.Changes, c.proto())
+	}
+	return res, nil
+}
+
+//ApplyClusterSpec reconciles the cluster with the declarative spec, the spec is compared to GetCluster and
+//only the differences are applied: cluster is created when missing, node pools are added, removed, scaled
+//or labelled and missing volumes are created, existing volumes are never removed or resized.
+//dry run returns the plan without making any change.
+func (s *spawnerService) ApplyClusterSpec(ctx context.Context, req *proto.ApplyClusterSpecRequest) (*proto.ApplyClusterSpecResponse, error) {
+	if err := validateClusterSpec(req); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	required := []Capability{CapClusters, CapNodePools}
+	poolLabels := []map[string]string{req.Labels}
+	for _, node := range req.NodePools {
+		required = append(required, nodeCapabilities(node)...)
+		poolLabels = append(poolLabels, node.Labels)
+	}
+	if len(req.Volumes) > 0 {
+		required = append(required, CapVolumes)
+	}
+	provider, err := s.controller(req.Provider, required...)
+	if err != nil {
+		return nil, err
+	}
+
+	changes, err := s.planClusterSpec(ctx, provider, req)
+	if err != nil {
+		return nil, err
+	}
+	res := &proto.ApplyClusterSpecResponse{}
+	for _, c := range changes {
+		res.Changes = append(res.Changes, c.proto())
+	}
+	if req.DryRun || len(changes) == 0 {
+		return res, nil
+	}
+
+	for _, c := range changes {
+		if c.action == ActionReplaceNodePool {
+			return nil, status.Errorf(codes.FailedPrecondition, "node pool '%s' can not be changed in place: %s, rename the node pool to replace it", c.target, c.detail)
+		}
+	}
+
+	ws := workspace(poolLabels...)
+	requested := plannedResources(changes)
+	if requested != (quota.Resources{}) {
+		if err := s.checkBudget(ws); err != nil {
+			return nil, err
+		}
+	}
+	release, err := s.reserveQuota(ctx, req.AccountName, ws, requested)
+	if err != nil {
+		return nil, err
+	}
+
+	op := s.operations.Start(ctx, operationMeta("ApplyClusterSpec", req.Provider, req.AccountName, req.Region, req.ClusterName), func(ctx context.Context) (interface{}, error) {
+		defer release()
+		return s.applyClusterSpec(ctx, provider, req, changes)
+	})
+	res.OperationId = op.ID
+	return res, nil
+}
@@ -0,0 +1,115 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gitlab.com/netbook-devs/spawner-service/pkg/inventory"
+	"gitlab.com/netbook-devs/spawner-service/pkg/quota"
+	"gitlab.com/netbook-devs/spawner-service/pkg/service/fake"
+	proto "gitlab.com/netbook-devs/spawner-service/proto/netbookai/spawner"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+//unavailableController fails the cluster status as the provider api being down
+type unavailableController struct {
+	Controller
+}
+
+func (unavailableController) ClusterStatus(ctx context.Context, req *proto.ClusterStatusRequest) (*proto.ClusterStatusResponse, error) {
+	return nil, status.Error(codes.Unavailable, "provider unavailable")
+}
+
+func Test_ObservedNodePools(t *testing.T) {
+	//aws lists every node of the pool, others list the pool with its count
+	pools := observedNodePools(&proto.ClusterSpec{NodeSpec: []*proto.NodeSpec{
+		{Name: "general", Instance: "m5.xlarge", Labels: map[string]string{"env": "dev"}},
+		{Name: "general", Instance: "m5.xlarge"},
+		{Name: "gpu", Instance: "g4dn.xlarge", Count: 3},
+		{Instance: "t2.micro"},
+	}})
+	require.Len(t, pools, 2)
+	assert.Equal(t, int64(2), pools["general"].count)
+	assert.Equal(t, "dev", pools["general"].labels["env"])
+	assert.Equal(t, int64(3), pools["gpu"].count)
+
+	//node pool scaled to zero has no nodes listed, it is known from the inventory
+	addRecordedNodePools(pools, []inventory.Resource{
+		{Name: "gpu", Attributes: map[string]string{"instance": "g4dn.xlarge", "count": "1"}},
+		{Name: "idle", Attributes: map[string]string{"instance": "t2.micro", "count": "0"}, Labels: map[string]string{"env": "dev"}},
+	})
+	require.Len(t, pools, 3)
+	assert.Equal(t, int64(3), pools["gpu"].count, "listed node pool is kept")
+	assert.Equal(t, &observedNodePool{instance: "t2.micro", labels: map[string]string{"env": "dev"}}, pools["idle"])
+}
+
+func Test_PlanNodePools(t *testing.T) {
+	req := &proto.ApplyClusterSpecRequest{
+		Provider:    "aws",
+		ClusterName: "dev",
+		NodePools: []*proto.NodeSpec{
+			{Name: "general", Instance: "m5.xlarge", Count: 3, Labels: map[string]string{"env": "dev", "team": "ml"}},
+			{Name: "same", Instance: "t2.micro"},
+			{Name: "resized", Instance: "m5.large"},
+			{Name: "new", Instance: "t2.micro", Count: 2},
+		},
+	}
+	observed := map[string]*observedNodePool{
+		"general": {instance: "m5.xlarge", count: 1, labels: map[string]string{"env": "dev"}},
+		"same":    {instance: "t2.micro", count: 1},
+		"resized": {instance: "m5.xlarge", count: 1},
+		"old":     {instance: "t2.micro", count: 1},
+	}
+
+	changes := planNodePools(req, observed)
+	actions := map[string]string{}
+	for _, c := range changes {
+		actions[c.action+" "+c.target] = c.detail
+	}
+	assert.Equal(t, map[string]string{
+		"SCALE_NODEPOOL dev/general":         "1 -> 3 nodes",
+		"UPDATE_NODEPOOL_LABELS dev/general": "team",
+		"REPLACE_NODEPOOL dev/resized":       "instance type m5.xlarge -> m5.large, node pool must be recreated",
+		"ADD_NODEPOOL dev/new":               "2 x t2.micro",
+		"DELETE_NODEPOOL dev/old":            "",
+	}, actions)
+
+	assert.Equal(t, quota.Resources{Nodes: 4}, plannedResources(changes))
+}
+
+func Test_PlanVolumes(t *testing.T) {
+	req := &proto.ApplyClusterSpecRequest{
+		ClusterName: "dev",
+		Volumes:     []*proto.VolumeSpec{{Name: "data", Size: 10}, {Name: "models", Size: 20}},
+	}
+	existing := []inventory.Resource{{Labels: map[string]string{"cluster-name": "dev", "volume-name": "data"}}}
+
+	changes := planVolumes(req, existing)
+	require.Len(t, changes, 1)
+	assert.Equal(t, ActionCreateVolume, changes[0].action)
+	assert.Equal(t, "models", changes[0].target)
+}
+
+func Test_ValidateClusterSpec(t *testing.T) {
+	assert.Error(t, validateClusterSpec(&proto.ApplyClusterSpecRequest{ClusterName: "dev"}))
+	assert.Error(t, validateClusterSpec(&proto.ApplyClusterSpecRequest{ClusterName: "dev", NodePools: []*proto.NodeSpec{{Name: "a"}, {Name: "a"}}}))
+	assert.NoError(t, validateClusterSpec(&proto.ApplyClusterSpecRequest{ClusterName: "dev", NodePools: []*proto.NodeSpec{{Name: "a"}}}))
+}
+
+func Test_ClusterState(t *testing.T) {
+	ctx := context.Background()
+	logger := zap.NewNop().Sugar()
+	s := &spawnerService{logger: logger}
+	req := &proto.ApplyClusterSpecRequest{Provider: "fake", Region: "local", AccountName: "dev", ClusterName: "dev"}
+
+	exists, _, err := s.clusterState(ctx, fake.NewController(logger, inventory.NewMemoryStore()), req)
+	require.NoError(t, err)
+	assert.False(t, exists, "missing cluster is planned for creation")
+
+	_, _, err = s.clusterState(ctx, unavailableController{}, req)
+	assert.Equal(t, codes.Unavailable, status.Code(err), "provider errors are not taken as missing cluster")
+}
@@ -0,0 +1,106 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	"gitlab.com/netbook-devs/spawner-service/pkg/audit"
+	proto "gitlab.com/netbook-devs/spawner-service/proto/netbookai/spawner"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func auditEntryProto(e audit.Entry) *proto.AuditEntry {
+	return &proto.AuditEntry{
+		Sequence:    e.Sequence,
+		Time:        e.Time.Format(time.RFC3339Nano),
+		Subject:     e.Subject,
+		AuthMethod:  e.AuthMethod,
+		Method:      e.Method,
+		Provider:    e.Provider,
+		AccountName: e.Account,
+		Region:      e.Region,
+		Target:      e.Target,
+		Request:     e.Request,
+		Outcome:     e.Outcome,
+		Error:       e.Error,
+		DurationMs:  e.DurationMs,
+		OperationId: e.OperationID,
+		PrevHash:    e.PrevHash,
+		Hash:        e.Hash,
+	}
+}
+
+func parseTime(name, value string) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, nil
+	}
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return t, status.Errorf(codes.InvalidArgument, "%s must be RFC3339 time, got '%s'", name, value)
+	}
+	return t, nil
+}
+
+//QueryAuditLog returns the audit entries of the mutating calls, oldest first
+func (s *spawnerService) QueryAuditLog(ctx context.Context, req *proto.QueryAuditLogRequest) (*proto.QueryAuditLogResponse, error) {
+	if s.audit == nil {
+		return nil, status.Error(codes.Unimplemented, "audit log is not enabled")
+	}
+
+	since, err := parseTime("since", req.GetSince())
+	if err != nil {
+		return nil, err
+	}
+	until, err := parseTime("until", req.GetUntil())
+	if err != nil {
+		return nil, err
+	}
+
+	entries, err := s.audit.Query(audit.Filter{
+		Subject:  req.GetSubject(),
+		Method:   req.GetMethod(),
+		Provider: req.GetProvider(),
+		Account:  req.GetAccountName(),
+		Target:   req.GetTarget(),
+		Since:    since,
+		Until:    until,
+		Limit:    int(req.GetLimit()),
+	})
+	if err != nil {
+		s.logger.Errorw("failed to query audit log", "error", err)
+		return nil, err
+	}
+
+	res := &proto.QueryAuditLogResponse{
+		Entries: make([]*proto.AuditEntry, 0, len(entries)),
+	}
+	for _, e := range entries {
+		res.Entries = append(res.Entries, auditEntryProto(e))
+	}
+
+	if req.GetVerify() {
+		err := s.audit.Verify()
+		if err != nil && errors.Cause(err) != audit.ERR_CHAIN_BROKEN {
+			s.logger.Errorw("failed to verify audit log", "error", err)
+			return nil, err
+		}
+		res.Verified = err == nil
+		if err != nil {
+			s.logger.Errorw("audit log verification failed", "error", err)
+			res.VerifyError = err.Error()
+		}
+	}
+	return res, nil
+}
+
+//auditSystemCall records the call spawner made on its own in the audit log, these calls do not pass the grpc interceptor
+func (s *spawnerService) auditSystemCall(ctx context.Context, method string, req interface{}, start time.Time, operationID string, err error) {
+	if s.audit == nil {
+		return
+	}
+	if _, aerr := s.audit.RecordCall(ctx, audit.SystemSubject, method, req, start, operationID, err); aerr != nil {
+		s.logger.Errorw("failed to record audit entry", "method", method, "subject", audit.SystemSubject, "error", aerr)
+	}
+}
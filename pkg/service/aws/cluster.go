@@ -3,11 +3,15 @@ package aws
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/eks"
 	"github.com/pkg/errors"
+	"gitlab.com/netbook-devs/spawner-service/pkg/inventory"
+	"gitlab.com/netbook-devs/spawner-service/pkg/operation"
+	"gitlab.com/netbook-devs/spawner-service/pkg/service/common"
 	"gitlab.com/netbook-devs/spawner-service/pkg/service/constants"
 	"gitlab.com/netbook-devs/spawner-service/pkg/service/labels"
 	proto "gitlab.com/netbook-devs/spawner-service/proto/netbookai/spawner"
@@ -39,6 +43,7 @@ func (svc AWSController) createClusterInternal(ctx context.Context, session *Ses
 			subnetIds = append(subnetIds, subn.SubnetId)
 		}
 		svc.logger.Infow("got network stack for region", "vpc", awsRegionNetworkStack.Vpc.VpcId, "subnets", subnetIds)
+		operation.Report(ctx, "network stack ready")
 	} else {
 		awsRegionNetworkStack, err = CreateRegionWkspNetworkStack(session)
 		if err != nil {
@@ -55,6 +60,17 @@ func (svc AWSController) createClusterInternal(ctx context.Context, session *Ses
 			subnetIds = append(subnetIds, subn.SubnetId)
 		}
 		svc.logger.Infow("created network stack for region", "vpc", awsRegionNetworkStack.Vpc.VpcId, "subnets", subnetIds)
+		operation.Report(ctx, "network stack created")
+		svc.inventory.Created(ctx, inventory.Resource{
+			ID:      *awsRegionNetworkStack.Vpc.VpcId,
+			Kind:    inventory.KindNetworkStack,
+			Account: session.TeamId,
+			Region:  region,
+			Name:    fmt.Sprintf(vpcNameFmt, region),
+			Attributes: map[string]string{
+				"subnets": strings.Join(aws.StringValueSlice(subnetIds), ","),
+			},
+		})
 	}
 
 	tags := labels.DefaultTags()
@@ -76,6 +92,8 @@ func (svc AWSController) createClusterInternal(ctx context.Context, session *Ses
 	}
 
 	if newRole {
+		svc.recordRole(ctx, session, eksRole)
+
 		err = svc.attachPolicy(ctx, iamClient, roleName, EKS_CLUSTER_POLICY_ARN)
 		if err != nil {
 			svc.logger.Errorf("failed to attach policy '%s' to role '%s' %w", EKS_CLUSTER_POLICY_ARN, roleName, err)
@@ -89,6 +107,8 @@ func (svc AWSController) createClusterInternal(ctx context.Context, session *Ses
 		}
 	}
 
+	operation.Report(ctx, "cluster role ready")
+
 	clusterInput := &eks.CreateClusterInput{
 		Name: &clusterName,
 		ResourcesVpcConfig: &eks.VpcConfigRequest{
@@ -96,10 +116,12 @@ func (svc AWSController) createClusterInternal(ctx context.Context, session *Ses
 			EndpointPublicAccess:  aws.Bool(true),
 			EndpointPrivateAccess: aws.Bool(false),
 		},
-		Tags: tags,
-		//		Version: &constants.KubeVersion,
+		Tags:    tags,
 		RoleArn: eksRole.Arn,
 	}
+	if req.KubernetesVersion != "" {
+		clusterInput.Version = &req.KubernetesVersion
+	}
 
 	client := session.getEksClient()
 	createClusterOutput, err := client.CreateClusterWithContext(ctx, clusterInput)
@@ -107,6 +129,7 @@ func (svc AWSController) createClusterInternal(ctx context.Context, session *Ses
 		svc.logger.Errorw("failed to create cluster", "error", err)
 		return nil, err
 	}
+	operation.Report(ctx, "cluster creation requested")
 
 	return createClusterOutput.Cluster, nil
 
@@ -167,6 +190,15 @@ func (ctrl AWSController) CreateCluster(ctx context.Context, req *proto.ClusterR
 	}
 
 	ctrl.logger.Infow("cluster is in creating state, it might take some time, please check AWS console for status", "cluster", clusterName)
+	ctrl.inventory.Created(ctx, inventory.Resource{
+		ID:         clusterName,
+		Kind:       inventory.KindCluster,
+		Account:    accountName,
+		Region:     region,
+		Name:       clusterName,
+		Labels:     req.Labels,
+		Attributes: map[string]string{"arn": aws.StringValue(cluster.Arn)},
+	})
 
 	return &proto.ClusterResponse{
 		ClusterName: *cluster.Name,
@@ -252,8 +284,13 @@ func (ctrl AWSController) GetClusters(ctx context.Context, req *proto.GetCluster
 			if nodeGroupDetails.Nodegroup.DiskSize != nil {
 				node.DiskSize = int32(*nodeGroupDetails.Nodegroup.DiskSize)
 			}
+			if nodeGroupDetails.Nodegroup.ScalingConfig != nil {
+				node.Count = aws.Int64Value(nodeGroupDetails.Nodegroup.ScalingConfig.DesiredSize)
+			}
+			node.Labels = aws.StringValueMap(nodeGroupDetails.Nodegroup.Labels)
 
 			node.Health = healthProto(nodeGroupDetails.Nodegroup.Health)
+			node.Fallbacks = fallbacksFromTags(nodeGroupDetails.Nodegroup.Tags)
 			nodes = append(nodes, node)
 		}
 
@@ -294,6 +331,7 @@ func (ctrl AWSController) GetCluster(ctx context.Context, req *proto.GetClusterR
 	}
 	nodeList, err := k8sClient.CoreV1().Nodes().List(ctx, metav1.ListOptions{})
 	response.Name = clusterName
+	response.KubernetesVersion = aws.StringValue(cluster.Version)
 
 	if err != nil {
 		ctrl.logger.Error(" Failed to query node list ", err)
@@ -327,11 +365,13 @@ func (ctrl AWSController) GetCluster(ctx context.Context, req *proto.GetClusterR
 
 		//get node health
 		var nodeHealth *proto.Health
-		health, err := ctrl.getNodeHealth(ctx, client, clusterName, nodeGroupName)
+		var fallbacks []string
+		nodegroup, err := ctrl.getNodegroup(ctx, client, clusterName, nodeGroupName)
 		if err != nil {
 			ctrl.logger.Errorw("failed to get the health check", "error", err)
 		} else {
-			nodeHealth = healthProto(health)
+			nodeHealth = healthProto(nodegroup.Health)
+			fallbacks = fallbacksFromTags(nodegroup.Tags)
 		}
 
 		//we will use MB for the disk size, int32 is too small for bytes
@@ -348,6 +388,9 @@ func (ctrl AWSController) GetCluster(ctx context.Context, req *proto.GetClusterR
 			Labels:           node.Labels,
 			Availabilityzone: node.Labels["topology.kubernetes.io/zone"],
 			Health:           nodeHealth,
+			//kubelet version, ex: v1.21.5-eks-bc4871b
+			KubernetesVersion: node.Status.NodeInfo.KubeletVersion,
+			Fallbacks:         fallbacks,
 		})
 	}
 	response.NodeSpec = nodeSpecList
@@ -370,6 +413,9 @@ func (ctrl AWSController) ClusterStatus(ctx context.Context, req *proto.ClusterS
 
 	if err != nil {
 		ctrl.logger.Errorw("failed to fetch cluster status", "error", err, "cluster", clusterName, "region", region)
+		if aerr, ok := err.(awserr.Error); ok && aerr.Code() == eks.ErrCodeResourceNotFoundException {
+			err = errors.Wrap(common.ERR_CLUSTER_NOT_FOUND, aerr.Message())
+		}
 		return &proto.ClusterStatusResponse{
 			Error: err.Error(),
 		}, err
@@ -421,6 +467,7 @@ func (ctrl AWSController) DeleteCluster(ctx context.Context, req *proto.ClusterD
 			return nil, err
 		}
 		ctrl.logger.Infow("done waiting for all nodegroups to delete", "cluster", clusterName)
+		operation.Report(ctx, "nodegroups deleted")
 	}
 
 	deleteOut, err := client.DeleteClusterWithContext(ctx, &eks.DeleteClusterInput{
@@ -435,6 +482,7 @@ func (ctrl AWSController) DeleteCluster(ctx context.Context, req *proto.ClusterD
 	}
 
 	ctrl.logger.Infof("requested cluster '%s' to be deleted, Status :%s. It might take some time, check AWS console for more.", clusterName, *deleteOut.Cluster.Status)
+	ctrl.inventory.Deleted(ctx, inventory.KindCluster, req.AccountName, region, clusterName)
 
 	return &proto.ClusterDeleteResponse{}, nil
 }
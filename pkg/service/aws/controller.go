@@ -4,6 +4,8 @@ import (
 	"context"
 
 	"github.com/pkg/errors"
+	"gitlab.com/netbook-devs/spawner-service/pkg/inventory"
+	"gitlab.com/netbook-devs/spawner-service/pkg/service/constants"
 	proto "gitlab.com/netbook-devs/spawner-service/proto/netbookai/spawner"
 	"go.uber.org/zap"
 )
@@ -30,13 +32,15 @@ var (
 )
 
 type AWSController struct {
-	logger *zap.SugaredLogger
+	logger    *zap.SugaredLogger
+	inventory *inventory.Recorder
 }
 
 //NewAWSController
-func NewAWSController(logger *zap.SugaredLogger) *AWSController {
+func NewAWSController(logger *zap.SugaredLogger, store inventory.Store) *AWSController {
 	return &AWSController{
-		logger: logger,
+		logger:    logger,
+		inventory: inventory.NewRecorder(store, constants.AwsLabel, logger),
 	}
 }
 
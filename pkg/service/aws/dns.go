@@ -0,0 +1,223 @@
+package aws
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/route53"
+	"github.com/pkg/errors"
+	"gitlab.com/netbook-devs/spawner-service/pkg/config"
+	"gitlab.com/netbook-devs/spawner-service/pkg/inventory"
+	"gitlab.com/netbook-devs/spawner-service/pkg/operation"
+	proto "gitlab.com/netbook-devs/spawner-service/proto/netbookai/spawner"
+)
+
+//hostedZone requested hosted zone id, spawner hosted zone when not requested
+func hostedZone(zone string) string {
+	if zone == "" {
+		zone = config.Get().AwsRoute53HostedZoneID
+	}
+	return strings.TrimPrefix(zone, "/hostedzone/")
+}
+
+//fqdn route53 returns the record names fully qualified with the trailing dot
+func fqdn(name string) string {
+	return strings.ToLower(strings.TrimSuffix(name, ".")) + "."
+}
+
+//recordValue TXT values has to be quoted in route53
+func recordValue(recordType, value string) string {
+	if recordType == route53.RRTypeTxt && !strings.HasPrefix(value, `"`) {
+		return strconv.Quote(value)
+	}
+	return value
+}
+
+func recordProto(zone string, set *route53.ResourceRecordSet) *proto.DNSRecord {
+	r := &proto.DNSRecord{
+		Zone: zone,
+		Name: strings.TrimSuffix(aws.StringValue(set.Name), "."),
+		Type: aws.StringValue(set.Type),
+		Ttl:  aws.Int64Value(set.TTL),
+	}
+	for _, rr := range set.ResourceRecords {
+		v := aws.StringValue(rr.Value)
+		if r.Type == route53.RRTypeTxt {
+			if u, err := strconv.Unquote(v); err == nil {
+				v = u
+			}
+		}
+		r.Values = append(r.Values, v)
+	}
+	//alias records created by the former AddRoute53Record points to the load balancer
+	if set.AliasTarget != nil {
+		r.Values = append(r.Values, aws.StringValue(set.AliasTarget.DNSName))
+	}
+	return r
+}
+
+//findRecordSet record set of the name and type in the hosted zone, nil when not found
+func findRecordSet(ctx context.Context, client *route53.Route53, zone, name, recordType string) (*route53.ResourceRecordSet, error) {
+	out, err := client.ListResourceRecordSetsWithContext(ctx, &route53.ListResourceRecordSetsInput{
+		HostedZoneId:    aws.String(zone),
+		StartRecordName: aws.String(fqdn(name)),
+		StartRecordType: aws.String(recordType),
+		MaxItems:        aws.String("1"),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(out.ResourceRecordSets) == 0 {
+		return nil, nil
+	}
+	set := out.ResourceRecordSets[0]
+	if aws.StringValue(set.Name) != fqdn(name) || aws.StringValue(set.Type) != recordType {
+		return nil, nil
+	}
+	return set, nil
+}
+
+//changeRecord applies the change to the hosted zone and waits for the change to be propagated
+func (svc AWSController) changeRecord(ctx context.Context, client *route53.Route53, zone string, change *route53.Change) (string, error) {
+	out, err := client.ChangeResourceRecordSetsWithContext(ctx, &route53.ChangeResourceRecordSetsInput{
+		HostedZoneId: aws.String(zone),
+		ChangeBatch:  &route53.ChangeBatch{Changes: []*route53.Change{change}},
+	})
+	if err != nil {
+		logError("ChangeResourceRecordSets", svc.logger, err)
+		return "", err
+	}
+	changeId := aws.StringValue(out.ChangeInfo.Id)
+	operation.Report(ctx, "record change '%s' submitted", changeId)
+
+	err = client.WaitUntilResourceRecordSetsChangedWithContext(ctx, &route53.GetChangeInput{Id: out.ChangeInfo.Id})
+	if err != nil {
+		logError("WaitUntilResourceRecordSetsChanged", svc.logger, err)
+		return "", err
+	}
+	operation.Report(ctx, "record change '%s' in sync", changeId)
+	return changeId, nil
+}
+
+//UpsertDNSRecord create or replace the record set in the hosted zone
+func (svc AWSController) UpsertDNSRecord(ctx context.Context, req *proto.UpsertDNSRecordRequest) (*proto.UpsertDNSRecordResponse, error) {
+	r := req.Record
+	zone := hostedZone(r.Zone)
+	if zone == "" {
+		return nil, errors.New("hosted zone is required")
+	}
+
+	session, err := NewSession(ctx, req.Region, req.AccountName)
+	if err != nil {
+		svc.logger.Errorw("Can't start AWS session", "error", err)
+		return nil, err
+	}
+
+	records := make([]*route53.ResourceRecord, 0, len(r.Values))
+	for _, v := range r.Values {
+		records = append(records, &route53.ResourceRecord{Value: aws.String(recordValue(r.Type, v))})
+	}
+	changeId, err := svc.changeRecord(ctx, session.getRoute53Client(), zone, &route53.Change{
+		Action: aws.String(route53.ChangeActionUpsert),
+		ResourceRecordSet: &route53.ResourceRecordSet{
+			Name:            aws.String(fqdn(r.Name)),
+			Type:            aws.String(r.Type),
+			TTL:             aws.Int64(r.Ttl),
+			ResourceRecords: records,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	svc.inventory.Created(ctx, inventory.Resource{
+		ID:      inventory.DNSRecordID(zone, r.Name, r.Type),
+		Kind:    inventory.KindDNSRecord,
+		Account: req.AccountName,
+		Region:  req.Region,
+		Cluster: r.ClusterName,
+		Name:    r.Name,
+		Attributes: map[string]string{
+			"zone":     zone,
+			"type":     r.Type,
+			"values":   strings.Join(r.Values, ","),
+			"changeId": changeId,
+		},
+	})
+
+	record := &proto.DNSRecord{Zone: zone, Name: r.Name, Type: r.Type, Values: r.Values, Ttl: r.Ttl, ClusterName: r.ClusterName}
+	return &proto.UpsertDNSRecordResponse{Record: record, ChangeId: changeId}, nil
+}
+
+//DeleteDNSRecord delete the record set of the name and type from the hosted zone
+func (svc AWSController) DeleteDNSRecord(ctx context.Context, req *proto.DeleteDNSRecordRequest) (*proto.DeleteDNSRecordResponse, error) {
+	zone := hostedZone(req.Zone)
+	if zone == "" {
+		return nil, errors.New("hosted zone is required")
+	}
+
+	session, err := NewSession(ctx, req.Region, req.AccountName)
+	if err != nil {
+		svc.logger.Errorw("Can't start AWS session", "error", err)
+		return nil, err
+	}
+	client := session.getRoute53Client()
+
+	//route53 deletes the record set only when it matches exactly, current set is read first
+	set, err := findRecordSet(ctx, client, zone, req.Name, req.Type)
+	if err != nil {
+		logError("ListResourceRecordSets", svc.logger, err)
+		return nil, err
+	}
+	if set == nil {
+		return nil, errors.Errorf("%s record '%s' not found in hosted zone '%s'", req.Type, req.Name, zone)
+	}
+
+	_, err = svc.changeRecord(ctx, client, zone, &route53.Change{
+		Action:            aws.String(route53.ChangeActionDelete),
+		ResourceRecordSet: set,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	svc.inventory.Deleted(ctx, inventory.KindDNSRecord, req.AccountName, req.Region, inventory.DNSRecordID(zone, req.Name, req.Type))
+	return &proto.DeleteDNSRecordResponse{Deleted: true}, nil
+}
+
+//ListDNSRecords A, CNAME and TXT record sets of the hosted zone
+func (svc AWSController) ListDNSRecords(ctx context.Context, req *proto.ListDNSRecordsRequest) (*proto.ListDNSRecordsResponse, error) {
+	zone := hostedZone(req.Zone)
+	if zone == "" {
+		return nil, errors.New("hosted zone is required")
+	}
+
+	session, err := NewSession(ctx, req.Region, req.AccountName)
+	if err != nil {
+		svc.logger.Errorw("Can't start AWS session", "error", err)
+		return nil, err
+	}
+
+	records := []*proto.DNSRecord{}
+	err = session.getRoute53Client().ListResourceRecordSetsPagesWithContext(ctx, &route53.ListResourceRecordSetsInput{HostedZoneId: aws.String(zone)},
+		func(out *route53.ListResourceRecordSetsOutput, last bool) bool {
+			for _, set := range out.ResourceRecordSets {
+				t := aws.StringValue(set.Type)
+				if t != route53.RRTypeA && t != route53.RRTypeCname && t != route53.RRTypeTxt {
+					continue
+				}
+				if req.Type != "" && t != req.Type {
+					continue
+				}
+				records = append(records, recordProto(zone, set))
+			}
+			return true
+		})
+	if err != nil {
+		logError("ListResourceRecordSets", svc.logger, err)
+		return nil, err
+	}
+	return &proto.ListDNSRecordsResponse{Records: records}, nil
+}
@@ -0,0 +1,159 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/eks"
+	"github.com/pkg/errors"
+	"gitlab.com/netbook-devs/spawner-service/pkg/operation"
+	"gitlab.com/netbook-devs/spawner-service/pkg/service/common"
+	"gitlab.com/netbook-devs/spawner-service/pkg/service/constants"
+	proto "gitlab.com/netbook-devs/spawner-service/proto/netbookai/spawner"
+)
+
+//fallbackPollInterval how often nodegroup health is checked while waiting for spot capacity
+const fallbackPollInterval = 30 * time.Second
+
+//capacityIssue check if any of the nodegroup health issues reports missing capacity
+func capacityIssue(health *eks.NodegroupHealth) bool {
+	if health == nil {
+		return false
+	}
+	for _, is := range health.Issues {
+		if common.IsCapacityError(aws.StringValue(is.Code) + " " + aws.StringValue(is.Message)) {
+			return true
+		}
+	}
+	return false
+}
+
+//fallbackTags one tag per fallback, tag values can not hold a list
+func fallbackTags(fallbacks []string) map[string]*string {
+	tags := map[string]*string{}
+	for i, f := range fallbacks {
+		tags[fmt.Sprintf("%s-%d", constants.SpotFallbackLabel, i+1)] = aws.String(f)
+	}
+	return tags
+}
+
+//fallbacksFromTags fallbacks recorded on the nodegroup, in the order they were taken
+func fallbacksFromTags(tags map[string]*string) []string {
+	type fallback struct {
+		n    int
+		desc string
+	}
+	found := []fallback{}
+	prefix := constants.SpotFallbackLabel + "-"
+	for k, v := range tags {
+		if !strings.HasPrefix(k, prefix) {
+			continue
+		}
+		n, err := strconv.Atoi(strings.TrimPrefix(k, prefix))
+		if err != nil {
+			continue
+		}
+		found = append(found, fallback{n, aws.StringValue(v)})
+	}
+	sort.Slice(found, func(i, j int) bool { return found[i].n < found[j].n })
+
+	fallbacks := make([]string, 0, len(found))
+	for _, f := range found {
+		fallbacks = append(fallbacks, f.desc)
+	}
+	return fallbacks
+}
+
+//waitForCapacity waits until the nodegroup is active. Spot nodegroup fails with ERR_NO_SPOT_CAPACITY when its health
+//reports missing capacity or it is not active within wait, on-demand nodegroup fails with ERR_CAPACITY_TIMEOUT when it is not active within wait
+func (ctrl AWSController) waitForCapacity(ctx context.Context, client *eks.EKS, cluster, node string, attempt common.CapacityAttempt, wait time.Duration) error {
+	deadline := time.Now().Add(wait)
+	ticker := time.NewTicker(fallbackPollInterval)
+	defer ticker.Stop()
+
+	for {
+		ng, err := ctrl.getNodegroup(ctx, client, cluster, node)
+		if err != nil {
+			return err
+		}
+		status := aws.StringValue(ng.Status)
+		if status == eks.NodegroupStatusActive {
+			return nil
+		}
+		if capacityIssue(ng.Health) {
+			if !attempt.Spot {
+				return errors.Errorf("nodegroup '%s' has no capacity for %s", node, attempt)
+			}
+			return errors.Wrapf(common.ERR_NO_SPOT_CAPACITY, "nodegroup '%s'", node)
+		}
+		if status == eks.NodegroupStatusCreateFailed || status == eks.NodegroupStatusDegraded {
+			return errors.Errorf("nodegroup '%s' is %s", node, status)
+		}
+		if time.Now().After(deadline) {
+			if !attempt.Spot {
+				return errors.Wrapf(common.ERR_CAPACITY_TIMEOUT, "nodegroup '%s' not active after %s", node, wait)
+			}
+			return errors.Wrapf(common.ERR_NO_SPOT_CAPACITY, "nodegroup '%s' not active after %s", node, wait)
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+//createWithFallback creates the nodegroup with the spot instances, falls back to the alternate instances and on-demand
+//of the policy when the nodegroup does not get spot capacity, returns the fallbacks taken
+func (ctrl AWSController) createWithFallback(ctx context.Context, client *eks.EKS, region string, input *eks.CreateNodegroupInput, nodeSpec *proto.NodeSpec, created func(*eks.CreateNodegroupInput)) ([]string, error) {
+	cluster, name := aws.StringValue(input.ClusterName), aws.StringValue(input.NodegroupName)
+	labels := input.Tags
+	attempts := common.FallbackAttempts(nodeSpec, onDemandInstance(region, nodeSpec))
+	wait := common.FallbackWait(nodeSpec.SpotFallback)
+
+	_, fallbacks, err := common.RunFallback(ctx, attempts, common.FallbackFuncs{
+		Create: func(ctx context.Context, attempt common.CapacityAttempt, fallbacks []string) error {
+			capacityType := eks.CapacityTypesOnDemand
+			if attempt.Spot {
+				capacityType = eks.CapacityTypesSpot
+			}
+			input.CapacityType = &capacityType
+			input.InstanceTypes = aws.StringSlice(attempt.Instances)
+
+			//tags are shared with the node labels, fallback tags are not valid labels
+			input.Tags = map[string]*string{}
+			for k, v := range labels {
+				input.Tags[k] = v
+			}
+			for k, v := range fallbackTags(fallbacks) {
+				input.Tags[k] = v
+			}
+
+			if _, err := client.CreateNodegroupWithContext(ctx, input); err != nil {
+				return err
+			}
+			operation.Report(ctx, "nodegroup '%s' creation requested with %s", name, attempt)
+			created(input)
+			return nil
+		},
+		Wait: func(ctx context.Context, attempt common.CapacityAttempt) error {
+			return ctrl.waitForCapacity(ctx, client, cluster, name, attempt, wait)
+		},
+		Remove: func(ctx context.Context) error {
+			if err := ctrl.deleteNode(ctx, client, cluster, name); err != nil {
+				return err
+			}
+			return client.WaitUntilNodegroupDeletedWithContext(ctx, &eks.DescribeNodegroupInput{
+				ClusterName:   &cluster,
+				NodegroupName: &name,
+			})
+		},
+	})
+	return fallbacks, err
+}
@@ -3,19 +3,24 @@ package aws
 import (
 	"context"
 	"fmt"
+	"strconv"
+	"strings"
 	"sync"
 	"time"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/eks"
 	"github.com/pkg/errors"
 	"gitlab.com/netbook-devs/spawner-service/pkg/config"
+	"gitlab.com/netbook-devs/spawner-service/pkg/inventory"
+	"gitlab.com/netbook-devs/spawner-service/pkg/operation"
 	"gitlab.com/netbook-devs/spawner-service/pkg/service/common"
 	"gitlab.com/netbook-devs/spawner-service/pkg/service/constants"
 	"gitlab.com/netbook-devs/spawner-service/pkg/service/labels"
 	proto "gitlab.com/netbook-devs/spawner-service/proto/netbookai/spawner"
 )
 
-func (ctrl AWSController) getNodeHealth(ctx context.Context, client *eks.EKS, cluster, nodeName string) (*eks.NodegroupHealth, error) {
+func (ctrl AWSController) getNodegroup(ctx context.Context, client *eks.EKS, cluster, nodeName string) (*eks.Nodegroup, error) {
 
 	node, err := client.DescribeNodegroupWithContext(ctx, &eks.DescribeNodegroupInput{
 		ClusterName:   &cluster,
@@ -25,7 +30,7 @@ func (ctrl AWSController) getNodeHealth(ctx context.Context, client *eks.EKS, cl
 	if err != nil {
 		return nil, err
 	}
-	return node.Nodegroup.Health, nil
+	return node.Nodegroup, nil
 }
 
 func healthProto(health *eks.NodegroupHealth) *proto.Health {
@@ -78,27 +83,30 @@ func (ctrl AWSController) getDefaultNode(ctx context.Context, client *eks.EKS, c
 
 }
 
-func getInstance(nodeSpec *proto.NodeSpec) (string, []*string, error) {
+//onDemandInstance instance type of the machine type, instance requested by the user takes precedence
+func onDemandInstance(region string, nodeSpec *proto.NodeSpec) string {
+	instance := ""
+	if nodeSpec.MachineType != "" {
+		instance = common.GetRegionInstance(constants.AwsLabel, region, nodeSpec.MachineType)
+	}
+
+	//if user has specified the Instance, we will override previous ask
+	if nodeSpec.Instance != "" {
+		instance = nodeSpec.Instance
+	}
+	return instance
+}
+
+func getInstance(region string, nodeSpec *proto.NodeSpec) (string, []*string, error) {
 
 	capacityType := eks.CapacityTypesOnDemand
 	instanceTypes := []*string{}
 
 	if nodeSpec.CapacityType == proto.CapacityType_SPOT {
 		capacityType = eks.CapacityTypesSpot
-		for _, i := range nodeSpec.SpotInstances {
-			instanceTypes = append(instanceTypes, &i)
-		}
+		instanceTypes = aws.StringSlice(nodeSpec.SpotInstances)
 	} else {
-		instance := ""
-		if nodeSpec.MachineType != "" {
-			instance = common.GetInstance(constants.AwsLabel, nodeSpec.MachineType)
-		}
-
-		//if user has specified the Instance, we will override previous ask
-		if nodeSpec.Instance != "" {
-			instance = nodeSpec.Instance
-		}
-
+		instance := onDemandInstance(region, nodeSpec)
 		if instance == "" {
 			return "", nil, errors.New(constants.InvalidInstanceOrMachineType)
 		}
@@ -108,7 +116,7 @@ func getInstance(nodeSpec *proto.NodeSpec) (string, []*string, error) {
 }
 
 //buildNodegroupInput build a new node group request
-func (a *AWSController) buildNodegroupInput(clusterName *string, nodeSpec *proto.NodeSpec, subnetIds []*string, nodeRoleArn *string) (*eks.CreateNodegroupInput, error) {
+func (a *AWSController) buildNodegroupInput(region string, clusterName *string, nodeSpec *proto.NodeSpec, subnetIds []*string, nodeRoleArn *string) (*eks.CreateNodegroupInput, error) {
 
 	diskSize := int64(nodeSpec.DiskSize)
 
@@ -119,7 +127,7 @@ func (a *AWSController) buildNodegroupInput(clusterName *string, nodeSpec *proto
 		count = nodeSpec.Count
 	}
 
-	capacityType, instanceTypes, err := getInstance(nodeSpec)
+	capacityType, instanceTypes, err := getInstance(region, nodeSpec)
 
 	if err != nil {
 		return nil, err
@@ -139,7 +147,7 @@ func (a *AWSController) buildNodegroupInput(clusterName *string, nodeSpec *proto
 	}
 	a.logger.Debugw("building node group input", "name", nodeSpec.Name, "instance ", instanceTypes, "machine_type", nodeSpec.MachineType)
 
-	return &eks.CreateNodegroupInput{
+	input := &eks.CreateNodegroupInput{
 		AmiType:       &amiType,
 		CapacityType:  &capacityType,
 		NodeRole:      nodeRoleArn,
@@ -155,7 +163,12 @@ func (a *AWSController) buildNodegroupInput(clusterName *string, nodeSpec *proto
 			MaxSize:     &count,
 		},
 		Tags: labels,
-	}, nil
+	}
+	//nodegroup gets the cluster version when none is given
+	if nodeSpec.KubernetesVersion != "" {
+		input.Version = &nodeSpec.KubernetesVersion
+	}
+	return input, nil
 }
 
 func (ctrl AWSController) getNewNodeGroupSpecFromCluster(ctx context.Context, session *Session, cluster *eks.Cluster, nodeSpec *proto.NodeSpec) (*eks.CreateNodegroupInput, error) {
@@ -171,6 +184,7 @@ func (ctrl AWSController) getNewNodeGroupSpecFromCluster(ctx context.Context, se
 	}
 
 	if newRole {
+		ctrl.recordRole(ctx, session, nodeRole)
 
 		err = ctrl.attachPolicy(ctx, iamClient, *nodeRole.RoleName, EKS_WORKER_NODE_POLICY_ARN)
 
@@ -194,7 +208,9 @@ func (ctrl AWSController) getNewNodeGroupSpecFromCluster(ctx context.Context, se
 		}
 	}
 
-	input, err := ctrl.buildNodegroupInput(cluster.Name, nodeSpec, cluster.ResourcesVpcConfig.SubnetIds, nodeRole.Arn)
+	operation.Report(ctx, "nodegroup role ready")
+
+	input, err := ctrl.buildNodegroupInput(session.Region, cluster.Name, nodeSpec, cluster.ResourcesVpcConfig.SubnetIds, nodeRole.Arn)
 	if err != nil {
 		return nil, errors.Wrap(err, "getNewNodeGroupSpecFromCluster:")
 	}
@@ -202,9 +218,9 @@ func (ctrl AWSController) getNewNodeGroupSpecFromCluster(ctx context.Context, se
 
 }
 
-func (ctrl AWSController) getNodeSpecFromDefault(defaultNode *eks.Nodegroup, clusterName string, nodeSpec *proto.NodeSpec) (*eks.CreateNodegroupInput, error) {
+func (ctrl AWSController) getNodeSpecFromDefault(region string, defaultNode *eks.Nodegroup, clusterName string, nodeSpec *proto.NodeSpec) (*eks.CreateNodegroupInput, error) {
 
-	input, err := ctrl.buildNodegroupInput(&clusterName, nodeSpec, defaultNode.Subnets, defaultNode.NodeRole)
+	input, err := ctrl.buildNodegroupInput(region, &clusterName, nodeSpec, defaultNode.Subnets, defaultNode.NodeRole)
 	if err != nil {
 		return nil, errors.Wrap(err, "getNodeSpecFromDefault")
 	}
@@ -253,18 +269,50 @@ func (ctrl AWSController) AddNode(ctx context.Context, req *proto.NodeSpawnReque
 		}
 	} else {
 		ctrl.logger.Infof("found default nodegroup '%s' in cluster '%s', creating NodegroupRequest from default node config", *defaultNode.NodegroupName, clusterName)
-		newNodeGroupInput, err = ctrl.getNodeSpecFromDefault(defaultNode, clusterName, nodeSpec)
+		newNodeGroupInput, err = ctrl.getNodeSpecFromDefault(region, defaultNode, clusterName, nodeSpec)
 		if err != nil {
 			return nil, err
 		}
 	}
 
+	record := func(input *eks.CreateNodegroupInput) {
+		ctrl.inventory.Created(ctx, inventory.Resource{
+			ID:      inventory.NodePoolID(clusterName, nodeSpec.Name),
+			Kind:    inventory.KindNodePool,
+			Account: req.AccountName,
+			Region:  region,
+			Cluster: clusterName,
+			Name:    nodeSpec.Name,
+			Labels:  aws.StringValueMap(input.Labels),
+			Attributes: map[string]string{
+				"instance":     strings.Join(aws.StringValueSlice(input.InstanceTypes), ","),
+				"capacityType": aws.StringValue(input.CapacityType),
+				"count":        strconv.FormatInt(aws.Int64Value(input.ScalingConfig.DesiredSize), 10),
+				"machineType":  nodeSpec.MachineType,
+				"gpuEnabled":   strconv.FormatBool(nodeSpec.GpuEnabled),
+				"fallbacks":    strings.Join(fallbacksFromTags(input.Tags), ";"),
+			},
+		})
+	}
+
+	if nodeSpec.SpotFallback != nil && nodeSpec.CapacityType == proto.CapacityType_SPOT {
+		fallbacks, err := ctrl.createWithFallback(ctx, client, region, newNodeGroupInput, nodeSpec, record)
+		if err != nil {
+			ctrl.logger.Errorw("failed to add a node with spot fallback", "nodegroup", nodeSpec.Name, "fallbacks", fallbacks, "error", err)
+			return nil, err
+		}
+		ctrl.logger.Infow("created nodegroup with spot fallback", "nodegroup", nodeSpec.Name, "cluster", clusterName, "fallbacks", fallbacks)
+		return &proto.NodeSpawnResponse{}, nil
+	}
+
 	out, err := client.CreateNodegroupWithContext(ctx, newNodeGroupInput)
 	if err != nil {
 		ctrl.logger.Errorf("failed to add a node '%s': %w", nodeSpec.Name, err)
 		return nil, err
 	}
 	ctrl.logger.Infof("creating nodegroup '%s' on cluster '%s', Status : %s, it might take some time. Please check AWS console.", nodeSpec.Name, clusterName, *out.Nodegroup.Status)
+	operation.Report(ctx, "nodegroup '%s' creation requested", nodeSpec.Name)
+	record(newNodeGroupInput)
 	return &proto.NodeSpawnResponse{}, err
 }
 
@@ -406,6 +454,166 @@ func (ctrl AWSController) DeleteNode(ctx context.Context, req *proto.NodeDeleteR
 		ctrl.logger.Errorw("failed to delete nodegroup", "nodename", nodeName)
 		return &proto.NodeDeleteResponse{Error: err.Error()}, err
 	}
+	ctrl.inventory.Deleted(ctx, inventory.KindNodePool, req.AccountName, region, inventory.NodePoolID(clusterName, nodeName))
 
 	return &proto.NodeDeleteResponse{}, nil
 }
+
+//scopedNodegroup describes the nodegroup, fails when the nodegroup is not created by spawner in this scope
+func (ctrl AWSController) scopedNodegroup(ctx context.Context, client *eks.EKS, cluster, node string) (*eks.Nodegroup, error) {
+	out, err := client.DescribeNodegroupWithContext(ctx, &eks.DescribeNodegroupInput{
+		ClusterName:   &cluster,
+		NodegroupName: &node,
+	})
+	if err != nil {
+		ctrl.logger.Errorw("failed to get nodegroup details", "error", err)
+		return nil, err
+	}
+
+	if scope, ok := out.Nodegroup.Tags[constants.Scope]; !ok || *scope != labels.ScopeTag() {
+		ctrl.logger.Errorw("nodegroup is not available in scope", "scope", labels.ScopeTag())
+		return nil, fmt.Errorf("nodegroup '%s' not available in scope '%s'", node, labels.ScopeTag())
+	}
+	return out.Nodegroup, nil
+}
+
+//ScaleNodePool sets the desired size of the nodegroup, min and max size are set when max count is given
+//otherwise they are widened to include it
+func (ctrl AWSController) ScaleNodePool(ctx context.Context, req *proto.ScaleNodePoolRequest) (*proto.ScaleNodePoolResponse, error) {
+	session, err := NewSession(ctx, req.Region, req.AccountName)
+	if err != nil {
+		return nil, err
+	}
+	client := session.getEksClient()
+
+	nodeGroup, err := ctrl.scopedNodegroup(ctx, client, req.ClusterName, req.NodeGroupName)
+	if err != nil {
+		return nil, err
+	}
+
+	scaling := &eks.NodegroupScalingConfig{
+		DesiredSize: aws.Int64(req.Count),
+		MinSize:     aws.Int64(req.Count),
+		MaxSize:     aws.Int64(req.Count),
+	}
+	if req.MaxCount > 0 {
+		scaling.MinSize = aws.Int64(req.MinCount)
+		scaling.MaxSize = aws.Int64(req.MaxCount)
+	} else if cur := nodeGroup.ScalingConfig; cur != nil {
+		if aws.Int64Value(cur.MinSize) < req.Count {
+			scaling.MinSize = cur.MinSize
+		}
+		if aws.Int64Value(cur.MaxSize) > req.Count {
+			scaling.MaxSize = cur.MaxSize
+		}
+	}
+	//eks requires at least one node as max size
+	if aws.Int64Value(scaling.MaxSize) < 1 {
+		scaling.MaxSize = aws.Int64(1)
+	}
+
+	out, err := client.UpdateNodegroupConfigWithContext(ctx, &eks.UpdateNodegroupConfigInput{
+		ClusterName:   &req.ClusterName,
+		NodegroupName: &req.NodeGroupName,
+		ScalingConfig: scaling,
+	})
+	if err != nil {
+		ctrl.logger.Errorw("failed to scale nodegroup", "nodegroup", req.NodeGroupName, "error", err)
+		return nil, err
+	}
+	ctrl.logger.Infow("requested nodegroup scaling", "nodegroup", req.NodeGroupName, "count", req.Count, "update", aws.StringValue(out.Update.Id))
+	operation.Report(ctx, "nodegroup '%s' scaling to %d requested", req.NodeGroupName, req.Count)
+	ctrl.inventory.Updated(ctx, inventory.KindNodePool, req.AccountName, req.Region, inventory.NodePoolID(req.ClusterName, req.NodeGroupName), nil,
+		map[string]string{"count": strconv.FormatInt(req.Count, 10)})
+	return &proto.ScaleNodePoolResponse{}, nil
+}
+
+//eksTaintEffects kubernetes taint effects in eks api
+var eksTaintEffects = map[string]string{
+	"NoSchedule":       eks.TaintEffectNoSchedule,
+	"PreferNoSchedule": eks.TaintEffectPreferNoSchedule,
+	"NoExecute":        eks.TaintEffectNoExecute,
+}
+
+//UpdateNodePool adds or updates the kubernetes labels and taints of the nodegroup, then upgrades its
+//kubernetes version or AMI release version and waits for the upgrade, eks runs one update of the nodegroup at a time
+func (ctrl AWSController) UpdateNodePool(ctx context.Context, req *proto.UpdateNodePoolRequest) (*proto.UpdateNodePoolResponse, error) {
+	session, err := NewSession(ctx, req.Region, req.AccountName)
+	if err != nil {
+		return nil, err
+	}
+	client := session.getEksClient()
+
+	if _, err := ctrl.scopedNodegroup(ctx, client, req.ClusterName, req.NodeGroupName); err != nil {
+		return nil, err
+	}
+
+	taints := make([]*eks.Taint, 0, len(req.Taints))
+	for _, t := range req.Taints {
+		effect, ok := eksTaintEffects[t.Effect]
+		if !ok {
+			return nil, fmt.Errorf("invalid taint effect '%s'", t.Effect)
+		}
+		taints = append(taints, &eks.Taint{Key: aws.String(t.Key), Value: aws.String(t.Value), Effect: aws.String(effect)})
+	}
+
+	if len(req.Labels) > 0 || len(taints) > 0 {
+		input := &eks.UpdateNodegroupConfigInput{
+			ClusterName:   &req.ClusterName,
+			NodegroupName: &req.NodeGroupName,
+		}
+		if len(req.Labels) > 0 {
+			input.Labels = &eks.UpdateLabelsPayload{AddOrUpdateLabels: aws.StringMap(req.Labels)}
+		}
+		if len(taints) > 0 {
+			input.Taints = &eks.UpdateTaintsPayload{AddOrUpdateTaints: taints}
+		}
+		out, err := client.UpdateNodegroupConfigWithContext(ctx, input)
+		if err != nil {
+			ctrl.logger.Errorw("failed to update nodegroup", "nodegroup", req.NodeGroupName, "error", err)
+			return nil, err
+		}
+		ctrl.logger.Infow("requested nodegroup update", "nodegroup", req.NodeGroupName, "update", aws.StringValue(out.Update.Id))
+		operation.Report(ctx, "nodegroup '%s' update requested", req.NodeGroupName)
+	}
+
+	attributes := map[string]string{}
+	if req.KubernetesVersion != "" || req.ImageVersion != "" {
+		describe := &eks.DescribeNodegroupInput{ClusterName: &req.ClusterName, NodegroupName: &req.NodeGroupName}
+		if err := client.WaitUntilNodegroupActiveWithContext(ctx, describe); err != nil {
+			ctrl.logger.Errorw("nodegroup did not become active", "nodegroup", req.NodeGroupName, "error", err)
+			return nil, errors.Wrapf(err, "nodegroup '%s' not active for version update", req.NodeGroupName)
+		}
+
+		input := &eks.UpdateNodegroupVersionInput{
+			ClusterName:   &req.ClusterName,
+			NodegroupName: &req.NodeGroupName,
+		}
+		if req.KubernetesVersion != "" {
+			input.Version = aws.String(req.KubernetesVersion)
+			attributes["kubernetesVersion"] = req.KubernetesVersion
+		}
+		if req.ImageVersion != "" {
+			input.ReleaseVersion = aws.String(req.ImageVersion)
+			attributes["imageVersion"] = req.ImageVersion
+		}
+		out, err := client.UpdateNodegroupVersionWithContext(ctx, input)
+		if err != nil {
+			ctrl.logger.Errorw("failed to upgrade nodegroup", "nodegroup", req.NodeGroupName, "error", err)
+			return nil, err
+		}
+		ctrl.logger.Infow("requested nodegroup upgrade", "nodegroup", req.NodeGroupName, "update", aws.StringValue(out.Update.Id))
+		operation.Report(ctx, "nodegroup '%s' upgrade requested", req.NodeGroupName)
+
+		//nodes are replaced after the update is accepted, version is recorded once they are
+		err = waitForUpdate(ctx, client, req.ClusterName, req.NodeGroupName, aws.StringValue(out.Update.Id))
+		if err != nil {
+			ctrl.logger.Errorw("nodegroup upgrade did not succeed", "nodegroup", req.NodeGroupName, "error", err)
+			return nil, errors.Wrapf(err, "nodegroup '%s' upgrade did not complete", req.NodeGroupName)
+		}
+		operation.Report(ctx, "nodegroup '%s' upgraded", req.NodeGroupName)
+	}
+
+	ctrl.inventory.Updated(ctx, inventory.KindNodePool, req.AccountName, req.Region, inventory.NodePoolID(req.ClusterName, req.NodeGroupName), req.Labels, attributes)
+	return &proto.UpdateNodePoolResponse{}, nil
+}
@@ -6,10 +6,23 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/iam"
+	"gitlab.com/netbook-devs/spawner-service/pkg/inventory"
 	"gitlab.com/netbook-devs/spawner-service/pkg/service/constants"
 	"gitlab.com/netbook-devs/spawner-service/pkg/service/labels"
 )
 
+//recordRole records the role created by spawner, roles are global and not bound to any region
+func (svc AWSController) recordRole(ctx context.Context, session *Session, role *iam.Role) {
+	svc.inventory.Created(ctx, inventory.Resource{
+		ID:         *role.RoleName,
+		Kind:       inventory.KindIAMRole,
+		Account:    session.TeamId,
+		Region:     inventory.GlobalRegion,
+		Name:       *role.RoleName,
+		Attributes: map[string]string{"arn": aws.StringValue(role.Arn)},
+	})
+}
+
 //createRoleOrGetExisting creates a role if it does not exist
 func (svc AWSController) createRoleOrGetExisting(ctx context.Context, iamClient *iam.IAM, roleName string, description string, assumeRoleDoc string) (*iam.Role, bool, error) {
 
@@ -0,0 +1,135 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/pkg/errors"
+	"gitlab.com/netbook-devs/spawner-service/pkg/operation"
+	proto "gitlab.com/netbook-devs/spawner-service/proto/netbookai/spawner"
+)
+
+//defaultRestoreVolumeType volume type of the restored volume when not requested, same as the EC2 default
+const defaultRestoreVolumeType = "gp2"
+
+//snapshotLabels tags of the snapshot with the extra labels added, aws reserved tags can not be set on other resources
+func snapshotLabels(tags []*ec2.Tag, extra map[string]string) map[string]string {
+	label := map[string]string{}
+	for k, v := range ec2TagMap(tags) {
+		if !strings.HasPrefix(k, "aws:") {
+			label[k] = v
+		}
+	}
+	for k, v := range extra {
+		label[k] = v
+	}
+	return label
+}
+
+func describeSnapshot(ctx context.Context, client *ec2.EC2, snapshotId string) (*ec2.Snapshot, error) {
+	out, err := client.DescribeSnapshotsWithContext(ctx, &ec2.DescribeSnapshotsInput{SnapshotIds: []*string{aws.String(snapshotId)}})
+	if err != nil {
+		return nil, err
+	}
+	if len(out.Snapshots) == 0 {
+		return nil, errors.Errorf("snapshot '%s' not found", snapshotId)
+	}
+	return out.Snapshots[0], nil
+}
+
+//RestoreSnapshot create EBS volume from the snapshot in the requested availability zone
+func (svc AWSController) RestoreSnapshot(ctx context.Context, req *proto.RestoreSnapshotRequest) (*proto.RestoreSnapshotResponse, error) {
+	if req.Availabilityzone == "" {
+		return nil, errors.New("availability zone is required to restore the snapshot")
+	}
+
+	session, err := NewSession(ctx, req.Region, req.AccountName)
+	if err != nil {
+		svc.logger.Errorw("Can't start AWS session", "error", err)
+		return nil, err
+	}
+
+	snap, err := describeSnapshot(ctx, session.getEC2Client(), req.SnapshotId)
+	if err != nil {
+		logError("DescribeSnapshots", svc.logger, err)
+		return nil, err
+	}
+
+	size := req.Size
+	if size == 0 {
+		size = aws.Int64Value(snap.VolumeSize)
+	}
+	volumeType := req.Volumetype
+	if volumeType == "" {
+		volumeType = defaultRestoreVolumeType
+	}
+
+	res, err := svc.CreateVolume(ctx, &proto.CreateVolumeRequest{
+		Provider:         req.Provider,
+		Region:           req.Region,
+		AccountName:      req.AccountName,
+		Availabilityzone: req.Availabilityzone,
+		Volumetype:       volumeType,
+		Size:             size,
+		Snapshotid:       req.SnapshotId,
+		Labels:           snapshotLabels(snap.Tags, req.Labels),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &proto.RestoreSnapshotResponse{VolumeId: res.Volumeid}, nil
+}
+
+//CopySnapshot copy EBS snapshot to the destination region, copy is tagged with the tags of the source snapshot
+func (svc AWSController) CopySnapshot(ctx context.Context, req *proto.CopySnapshotRequest) (*proto.CopySnapshotResponse, error) {
+	source, err := NewSession(ctx, req.Region, req.AccountName)
+	if err != nil {
+		svc.logger.Errorw("Can't start AWS session", "error", err)
+		return nil, err
+	}
+	snap, err := describeSnapshot(ctx, source.getEC2Client(), req.SnapshotId)
+	if err != nil {
+		logError("DescribeSnapshots", svc.logger, err)
+		return nil, err
+	}
+
+	//copy is requested in the destination region
+	destination, err := NewSession(ctx, req.DestinationRegion, req.AccountName)
+	if err != nil {
+		svc.logger.Errorw("Can't start AWS session", "error", err)
+		return nil, err
+	}
+	client := destination.getEC2Client()
+
+	labels := snapshotLabels(snap.Tags, req.Labels)
+	out, err := client.CopySnapshotWithContext(ctx, &ec2.CopySnapshotInput{
+		SourceRegion:     aws.String(req.Region),
+		SourceSnapshotId: aws.String(req.SnapshotId),
+		Description:      aws.String(fmt.Sprintf("copy of %s from %s", req.SnapshotId, req.Region)),
+		TagSpecifications: []*ec2.TagSpecification{
+			{
+				ResourceType: aws.String(ec2.ResourceTypeSnapshot),
+				Tags:         awsTags(labels),
+			},
+		},
+	})
+	if err != nil {
+		logError("CopySnapshot", svc.logger, err)
+		return nil, err
+	}
+	id := aws.StringValue(out.SnapshotId)
+	operation.Report(ctx, "snapshot '%s' copy to %s requested as '%s'", req.SnapshotId, req.DestinationRegion, id)
+
+	err = client.WaitUntilSnapshotCompletedWithContext(ctx, &ec2.DescribeSnapshotsInput{SnapshotIds: []*string{out.SnapshotId}})
+	if err != nil {
+		logError("WaitForSnapshotCompleted", svc.logger, err)
+		return nil, err
+	}
+	operation.Report(ctx, "snapshot '%s' completed", id)
+
+	svc.recordSnapshot(ctx, req.AccountName, req.DestinationRegion, id, aws.StringValue(snap.VolumeId), labels)
+	return &proto.CopySnapshotResponse{SnapshotId: id, Region: req.DestinationRegion}, nil
+}
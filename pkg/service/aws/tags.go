@@ -6,7 +6,9 @@ import (
 	"gitlab.com/netbook-devs/spawner-service/pkg/service/labels"
 	proto "gitlab.com/netbook-devs/spawner-service/proto/netbookai/spawner"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/eks"
 	"github.com/pkg/errors"
 )
 
@@ -114,3 +116,43 @@ func (a *AWSController) TagNodeInstance(ctx context.Context, req *proto.TagNodeI
 
 	return &proto.TagNodeInstanceResponse{}, nil
 }
+
+//TagCluster adds or replaces the tags of the EKS cluster
+func (a *AWSController) TagCluster(ctx context.Context, account, region, cluster string, tags map[string]string) error {
+	session, err := NewSession(ctx, region, account)
+	if err != nil {
+		return err
+	}
+	client := session.getEksClient()
+
+	c, err := getClusterSpec(ctx, client, cluster)
+	if err != nil {
+		return errors.Wrapf(err, "cluster '%s'", cluster)
+	}
+	_, err = client.TagResourceWithContext(ctx, &eks.TagResourceInput{ResourceArn: c.Arn, Tags: aws.StringMap(tags)})
+	if err != nil {
+		a.logger.Errorw("failed to tag cluster", "cluster", cluster, "error", err)
+		return err
+	}
+	return nil
+}
+
+//TagNodePool adds or replaces the tags of the EKS nodegroup, UpdateNodePool changes the kubernetes labels instead
+func (a *AWSController) TagNodePool(ctx context.Context, account, region, cluster, nodePool string, tags map[string]string) error {
+	session, err := NewSession(ctx, region, account)
+	if err != nil {
+		return err
+	}
+	client := session.getEksClient()
+
+	ng, err := a.getNodegroup(ctx, client, cluster, nodePool)
+	if err != nil {
+		return errors.Wrapf(err, "nodegroup '%s'", nodePool)
+	}
+	_, err = client.TagResourceWithContext(ctx, &eks.TagResourceInput{ResourceArn: ng.NodegroupArn, Tags: aws.StringMap(tags)})
+	if err != nil {
+		a.logger.Errorw("failed to tag nodegroup", "cluster", cluster, "nodegroup", nodePool, "error", err)
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,146 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/eks"
+	"github.com/pkg/errors"
+	"gitlab.com/netbook-devs/spawner-service/pkg/config"
+	"gitlab.com/netbook-devs/spawner-service/pkg/inventory"
+	"gitlab.com/netbook-devs/spawner-service/pkg/operation"
+	"gitlab.com/netbook-devs/spawner-service/pkg/service/constants"
+	"gitlab.com/netbook-devs/spawner-service/pkg/service/labels"
+	proto "gitlab.com/netbook-devs/spawner-service/proto/netbookai/spawner"
+)
+
+//eksVersions kubernetes versions supported by EKS used when EKS_VERSIONS is not set, oldest first. EKS has no api
+//listing them, see https://docs.aws.amazon.com/eks/latest/userguide/kubernetes-versions.html
+var eksVersions = []string{"1.19", "1.20", "1.21", "1.22"}
+
+//eksDefaultVersion version EKS creates the cluster with when none is requested and EKS_DEFAULT_VERSION is not set
+const eksDefaultVersion = "1.21"
+
+const (
+	//upgradeTimeout control plane or nodegroup upgrade takes up to an hour
+	upgradeTimeout      = time.Hour
+	upgradePollInterval = 30 * time.Second
+)
+
+//kubernetesVersions configured EKS versions and default version, builtin ones when not configured
+func kubernetesVersions(conf config.Config) ([]string, string) {
+	versions := []string{}
+	for _, v := range strings.Split(conf.EksVersions, ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			versions = append(versions, v)
+		}
+	}
+	if len(versions) == 0 {
+		versions = eksVersions
+	}
+	def := conf.EksDefaultVersion
+	if def == "" {
+		def = eksDefaultVersion
+	}
+	return versions, def
+}
+
+//ListKubernetesVersions kubernetes versions EKS clusters can be created with or upgraded to, same in every region
+func (ctrl AWSController) ListKubernetesVersions(ctx context.Context, req *proto.ListKubernetesVersionsRequest) (*proto.ListKubernetesVersionsResponse, error) {
+	versions, def := kubernetesVersions(config.Get())
+	return &proto.ListKubernetesVersionsResponse{
+		Versions:       versions,
+		DefaultVersion: def,
+	}, nil
+}
+
+//updateDone reports whether the EKS update finished, failed and cancelled updates end with their errors
+func updateDone(u *eks.Update) (bool, error) {
+	switch aws.StringValue(u.Status) {
+	case eks.UpdateStatusSuccessful:
+		return true, nil
+	case eks.UpdateStatusFailed, eks.UpdateStatusCancelled:
+		msgs := []string{}
+		for _, e := range u.Errors {
+			msgs = append(msgs, fmt.Sprintf("%s: %s", aws.StringValue(e.ErrorCode), aws.StringValue(e.ErrorMessage)))
+		}
+		return true, errors.Errorf("update '%s' %s: %s", aws.StringValue(u.Id), strings.ToLower(aws.StringValue(u.Status)), strings.Join(msgs, "; "))
+	}
+	return false, nil
+}
+
+//waitForUpdate polls the update of the cluster, or of its nodegroup when set, until it is successful,
+//cluster turns active before the update completes
+func waitForUpdate(ctx context.Context, client *eks.EKS, cluster, nodegroup, updateId string) error {
+	ctx, cancel := context.WithTimeout(ctx, upgradeTimeout)
+	defer cancel()
+	ticker := time.NewTicker(upgradePollInterval)
+	defer ticker.Stop()
+
+	for {
+		input := &eks.DescribeUpdateInput{
+			Name:     aws.String(cluster),
+			UpdateId: aws.String(updateId),
+		}
+		if nodegroup != "" {
+			input.NodegroupName = aws.String(nodegroup)
+		}
+		out, err := client.DescribeUpdateWithContext(ctx, input)
+		if err != nil {
+			return err
+		}
+		if done, err := updateDone(out.Update); done {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+//UpgradeCluster upgrades the control plane to the requested version and waits until the cluster is active again
+func (ctrl AWSController) UpgradeCluster(ctx context.Context, req *proto.UpgradeClusterRequest) (*proto.UpgradeClusterResponse, error) {
+	session, err := NewSession(ctx, req.Region, req.AccountName)
+	if err != nil {
+		return nil, err
+	}
+	client := session.getEksClient()
+
+	cluster, err := getClusterSpec(ctx, client, req.ClusterName)
+	if err != nil {
+		ctrl.logger.Errorw("failed to get cluster details", "cluster", req.ClusterName, "error", err)
+		return nil, err
+	}
+	if scope, ok := cluster.Tags[constants.Scope]; !ok || *scope != labels.ScopeTag() {
+		return nil, fmt.Errorf("cluster '%s' not available in scope '%s'", req.ClusterName, labels.ScopeTag())
+	}
+
+	out, err := client.UpdateClusterVersionWithContext(ctx, &eks.UpdateClusterVersionInput{
+		Name:    &req.ClusterName,
+		Version: &req.KubernetesVersion,
+	})
+	if err != nil {
+		ctrl.logger.Errorw("failed to upgrade cluster", "cluster", req.ClusterName, "version", req.KubernetesVersion, "error", err)
+		return nil, err
+	}
+	ctrl.logger.Infow("requested cluster upgrade", "cluster", req.ClusterName, "version", req.KubernetesVersion, "update", aws.StringValue(out.Update.Id))
+	operation.Report(ctx, "control plane upgrade to %s requested", req.KubernetesVersion)
+
+	//node groups can only be upgraded once the update is successful
+	err = waitForUpdate(ctx, client, req.ClusterName, "", aws.StringValue(out.Update.Id))
+	if err != nil {
+		ctrl.logger.Errorw("cluster upgrade did not succeed", "cluster", req.ClusterName, "error", err)
+		return nil, errors.Wrapf(err, "cluster '%s' upgrade did not complete", req.ClusterName)
+	}
+	operation.Report(ctx, "control plane upgraded to %s", req.KubernetesVersion)
+
+	ctrl.inventory.Updated(ctx, inventory.KindCluster, req.AccountName, req.Region, req.ClusterName, nil,
+		map[string]string{"kubernetesVersion": req.KubernetesVersion})
+	return &proto.UpgradeClusterResponse{}, nil
+}
@@ -0,0 +1,38 @@
+package aws
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/eks"
+	"github.com/stretchr/testify/assert"
+	"gitlab.com/netbook-devs/spawner-service/pkg/config"
+)
+
+func Test_KubernetesVersions(t *testing.T) {
+	versions, def := kubernetesVersions(config.Config{})
+	assert.Equal(t, eksVersions, versions)
+	assert.Equal(t, eksDefaultVersion, def)
+
+	versions, def = kubernetesVersions(config.Config{EksVersions: "1.22, 1.23,1.24", EksDefaultVersion: "1.23"})
+	assert.Equal(t, []string{"1.22", "1.23", "1.24"}, versions)
+	assert.Equal(t, "1.23", def)
+}
+
+func Test_UpdateDone(t *testing.T) {
+	done, err := updateDone(&eks.Update{Status: aws.String(eks.UpdateStatusInProgress)})
+	assert.False(t, done)
+	assert.NoError(t, err)
+
+	done, err = updateDone(&eks.Update{Status: aws.String(eks.UpdateStatusSuccessful)})
+	assert.True(t, done)
+	assert.NoError(t, err)
+
+	done, err = updateDone(&eks.Update{
+		Id:     aws.String("u-1"),
+		Status: aws.String(eks.UpdateStatusFailed),
+		Errors: []*eks.ErrorDetail{{ErrorCode: aws.String(eks.ErrorCodeInsufficientFreeAddresses), ErrorMessage: aws.String("subnet is full")}},
+	})
+	assert.True(t, done)
+	assert.EqualError(t, err, "update 'u-1' failed: InsufficientFreeAddresses: subnet is full")
+}
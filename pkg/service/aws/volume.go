@@ -2,11 +2,15 @@ package aws
 
 import (
 	"context"
+	"strconv"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/pkg/errors"
+	"gitlab.com/netbook-devs/spawner-service/pkg/inventory"
+	"gitlab.com/netbook-devs/spawner-service/pkg/operation"
 	"gitlab.com/netbook-devs/spawner-service/pkg/service/labels"
 	proto "gitlab.com/netbook-devs/spawner-service/proto/netbookai/spawner"
 
@@ -93,18 +97,34 @@ func (svc AWSController) CreateVolume(ctx context.Context, req *proto.CreateVolu
 		return &proto.CreateVolumeResponse{}, err
 	}
 
-	err = ec2Client.WaitUntilVolumeAvailable(&ec2.DescribeVolumesInput{
+	operation.Report(ctx, "volume '%s' created", *result.VolumeId)
+
+	err = ec2Client.WaitUntilVolumeAvailableWithContext(ctx, &ec2.DescribeVolumesInput{
 		VolumeIds: []*string{result.VolumeId},
 	})
 	if err != nil {
 		logError("WaitForVolumeAvailable", logger, err)
 		return &proto.CreateVolumeResponse{}, err
 	}
+	operation.Report(ctx, "volume '%s' available", *result.VolumeId)
 
 	res := &proto.CreateVolumeResponse{
 		Volumeid: *result.VolumeId,
 		Error:    "",
 	}
+	svc.inventory.Created(ctx, inventory.Resource{
+		ID:      *result.VolumeId,
+		Kind:    inventory.KindVolume,
+		Account: req.AccountName,
+		Region:  region,
+		Labels:  labels,
+		Attributes: map[string]string{
+			"size":             strconv.FormatInt(size, 10),
+			"volumeType":       volumeType,
+			"availabilityZone": availabilityZone,
+			"snapshotId":       snapshotId,
+		},
+	})
 
 	//if delete requested,nuke em
 	if req.DeleteSnapshot {
@@ -123,8 +143,10 @@ func (svc AWSController) CreateVolume(ctx context.Context, req *proto.CreateVolu
 			if err != nil {
 				//we will silently log error and return here for now, we dont want to tell the user that volume creation failed in this case.
 				svc.logger.Errorw("failed to delete the snapshot", "error", err)
+				return
 			}
 			svc.logger.Infow("snapshot deleted", "ID", snapshotId)
+			svc.inventory.Deleted(ctx, inventory.KindSnapshot, req.AccountName, region, snapshotId)
 		}()
 	}
 
@@ -168,6 +190,8 @@ func (svc AWSController) DeleteVolume(ctx context.Context, req *proto.DeleteVolu
 		return &proto.DeleteVolumeResponse{}, err
 	}
 
+	svc.inventory.Deleted(ctx, inventory.KindVolume, req.AccountName, region, volumeid)
+
 	//note: since now err is nil so assigning deleted = true
 	res := &proto.DeleteVolumeResponse{
 		Deleted: true,
@@ -176,6 +200,17 @@ func (svc AWSController) DeleteVolume(ctx context.Context, req *proto.DeleteVolu
 	return res, nil
 }
 
+func (svc AWSController) recordSnapshot(ctx context.Context, account, region, snapshotId, volumeId string, labels map[string]string) {
+	svc.inventory.Created(ctx, inventory.Resource{
+		ID:         snapshotId,
+		Kind:       inventory.KindSnapshot,
+		Account:    account,
+		Region:     region,
+		Labels:     labels,
+		Attributes: map[string]string{"volumeId": volumeId},
+	})
+}
+
 //CreateSnapshot create volume snapshot
 func (svc AWSController) CreateSnapshot(ctx context.Context, req *proto.CreateSnapshotRequest) (*proto.CreateSnapshotResponse, error) {
 	//Creates a Snapshot of a volume
@@ -220,7 +255,7 @@ func (svc AWSController) CreateSnapshot(ctx context.Context, req *proto.CreateSn
 		return &proto.CreateSnapshotResponse{}, err
 	}
 
-	err = ec2Client.WaitUntilSnapshotCompleted(&ec2.DescribeSnapshotsInput{
+	err = ec2Client.WaitUntilSnapshotCompletedWithContext(ctx, &ec2.DescribeSnapshotsInput{
 		SnapshotIds: []*string{result.SnapshotId},
 	})
 	if err != nil {
@@ -228,6 +263,9 @@ func (svc AWSController) CreateSnapshot(ctx context.Context, req *proto.CreateSn
 		return &proto.CreateSnapshotResponse{}, err
 	}
 
+	svc.recordSnapshot(ctx, req.AccountName, region, *result.SnapshotId, volumeid, labels)
+	operation.Report(ctx, "snapshot '%s' completed", *result.SnapshotId)
+
 	res := &proto.CreateSnapshotResponse{
 		Snapshotid: *result.SnapshotId,
 	}
@@ -278,7 +316,7 @@ func (svc AWSController) CreateSnapshotAndDelete(ctx context.Context, req *proto
 		return &proto.CreateSnapshotAndDeleteResponse{}, err
 	}
 
-	err = ec2Client.WaitUntilSnapshotCompleted(&ec2.DescribeSnapshotsInput{
+	err = ec2Client.WaitUntilSnapshotCompletedWithContext(ctx, &ec2.DescribeSnapshotsInput{
 		SnapshotIds: []*string{resultSnapshot.SnapshotId},
 	})
 	if err != nil {
@@ -286,6 +324,9 @@ func (svc AWSController) CreateSnapshotAndDelete(ctx context.Context, req *proto
 		return &proto.CreateSnapshotAndDeleteResponse{}, err
 	}
 
+	svc.recordSnapshot(ctx, req.AccountName, region, *resultSnapshot.SnapshotId, volumeid, labels)
+	operation.Report(ctx, "snapshot '%s' completed", *resultSnapshot.SnapshotId)
+
 	//inputs for deleteing volume
 	inputDelete := &ec2.DeleteVolumeInput{
 		VolumeId: aws.String(volumeid),
@@ -305,6 +346,8 @@ func (svc AWSController) CreateSnapshotAndDelete(ctx context.Context, req *proto
 		}, err
 	}
 
+	svc.inventory.Deleted(ctx, inventory.KindVolume, req.AccountName, region, volumeid)
+
 	//note: since now err is nil so assigning deleted = true
 	res := &proto.CreateSnapshotAndDeleteResponse{
 		Snapshotid: *resultSnapshot.SnapshotId,
@@ -313,3 +356,282 @@ func (svc AWSController) CreateSnapshotAndDelete(ctx context.Context, req *proto
 
 	return res, nil
 }
+
+//spawnerTagFilters ec2 filters selecting the resources tagged by spawner in scope with the labels
+func spawnerTagFilters(label map[string]string) []*ec2.Filter {
+	filters := []*ec2.Filter{}
+	for k, v := range labels.DefaultTags() {
+		filters = append(filters, &ec2.Filter{Name: aws.String("tag:" + k), Values: []*string{v}})
+	}
+	for k, v := range label {
+		filters = append(filters, &ec2.Filter{Name: aws.String("tag:" + k), Values: []*string{aws.String(v)}})
+	}
+	return filters
+}
+
+func ec2TagMap(tags []*ec2.Tag) map[string]string {
+	m := make(map[string]string, len(tags))
+	for _, t := range tags {
+		m[aws.StringValue(t.Key)] = aws.StringValue(t.Value)
+	}
+	return m
+}
+
+func volumeProto(region string, v *ec2.Volume) *proto.Volume {
+	attachments := []string{}
+	for _, a := range v.Attachments {
+		attachments = append(attachments, aws.StringValue(a.InstanceId))
+	}
+	return &proto.Volume{
+		VolumeId:         aws.StringValue(v.VolumeId),
+		Region:           region,
+		Availabilityzone: aws.StringValue(v.AvailabilityZone),
+		Volumetype:       aws.StringValue(v.VolumeType),
+		Size:             aws.Int64Value(v.Size),
+		State:            aws.StringValue(v.State),
+		Attachments:      attachments,
+		Labels:           ec2TagMap(v.Tags),
+		CreatedAt:        aws.TimeValue(v.CreateTime).UTC().Format(time.RFC3339),
+		SnapshotId:       aws.StringValue(v.SnapshotId),
+	}
+}
+
+//ListVolumes list spawner created EBS volumes in the region
+func (svc AWSController) ListVolumes(ctx context.Context, req *proto.ListVolumesRequest) (*proto.ListVolumesResponse, error) {
+	session, err := NewSession(ctx, req.Region, req.AccountName)
+	if err != nil {
+		svc.logger.Errorw("Can't start AWS session", "error", err)
+		return nil, err
+	}
+
+	res := &proto.ListVolumesResponse{Volumes: []*proto.Volume{}}
+	input := &ec2.DescribeVolumesInput{Filters: spawnerTagFilters(req.Labels)}
+	err = session.getEC2Client().DescribeVolumesPagesWithContext(ctx, input, func(out *ec2.DescribeVolumesOutput, last bool) bool {
+		for _, v := range out.Volumes {
+			res.Volumes = append(res.Volumes, volumeProto(req.Region, v))
+		}
+		return true
+	})
+	if err != nil {
+		logError("DescribeVolumes", svc.logger, err)
+		return nil, err
+	}
+	return res, nil
+}
+
+//ListSnapshots list spawner created EBS snapshots owned by the account in the region
+func (svc AWSController) ListSnapshots(ctx context.Context, req *proto.ListSnapshotsRequest) (*proto.ListSnapshotsResponse, error) {
+	session, err := NewSession(ctx, req.Region, req.AccountName)
+	if err != nil {
+		svc.logger.Errorw("Can't start AWS session", "error", err)
+		return nil, err
+	}
+
+	res := &proto.ListSnapshotsResponse{Snapshots: []*proto.Snapshot{}}
+	input := &ec2.DescribeSnapshotsInput{
+		OwnerIds: []*string{aws.String("self")},
+		Filters:  spawnerTagFilters(req.Labels),
+	}
+	err = session.getEC2Client().DescribeSnapshotsPagesWithContext(ctx, input, func(out *ec2.DescribeSnapshotsOutput, last bool) bool {
+		for _, s := range out.Snapshots {
+			res.Snapshots = append(res.Snapshots, &proto.Snapshot{
+				SnapshotId: aws.StringValue(s.SnapshotId),
+				Region:     req.Region,
+				VolumeId:   aws.StringValue(s.VolumeId),
+				Size:       aws.Int64Value(s.VolumeSize),
+				State:      aws.StringValue(s.State),
+				Labels:     ec2TagMap(s.Tags),
+				CreatedAt:  aws.TimeValue(s.StartTime).UTC().Format(time.RFC3339),
+			})
+		}
+		return true
+	})
+	if err != nil {
+		logError("DescribeSnapshots", svc.logger, err)
+		return nil, err
+	}
+	return res, nil
+}
+
+//DeleteSnapshot delete EBS snapshot
+func (svc AWSController) DeleteSnapshot(ctx context.Context, req *proto.DeleteSnapshotRequest) (*proto.DeleteSnapshotResponse, error) {
+	session, err := NewSession(ctx, req.Region, req.AccountName)
+	if err != nil {
+		svc.logger.Errorw("Can't start AWS session", "error", err)
+		return nil, err
+	}
+
+	_, err = session.getEC2Client().DeleteSnapshotWithContext(ctx, &ec2.DeleteSnapshotInput{SnapshotId: aws.String(req.SnapshotId)})
+	if err != nil {
+		logError("DeleteSnapshot", svc.logger, err)
+		return &proto.DeleteSnapshotResponse{}, err
+	}
+	svc.inventory.Deleted(ctx, inventory.KindSnapshot, req.AccountName, req.Region, req.SnapshotId)
+	return &proto.DeleteSnapshotResponse{Deleted: true}, nil
+}
+
+//volumeModificationPollInterval how often the size modification of a volume is checked
+const volumeModificationPollInterval = 5 * time.Second
+
+func describeVolume(ctx context.Context, client *ec2.EC2, volumeId string) (*ec2.Volume, error) {
+	out, err := client.DescribeVolumesWithContext(ctx, &ec2.DescribeVolumesInput{VolumeIds: []*string{aws.String(volumeId)}})
+	if err != nil {
+		return nil, err
+	}
+	if len(out.Volumes) == 0 {
+		return nil, errors.Errorf("volume '%s' not found", volumeId)
+	}
+	return out.Volumes[0], nil
+}
+
+//GetVolume describe EBS volume
+func (svc AWSController) GetVolume(ctx context.Context, req *proto.GetVolumeRequest) (*proto.Volume, error) {
+	session, err := NewSession(ctx, req.Region, req.AccountName)
+	if err != nil {
+		svc.logger.Errorw("Can't start AWS session", "error", err)
+		return nil, err
+	}
+
+	v, err := describeVolume(ctx, session.getEC2Client(), req.VolumeId)
+	if err != nil {
+		logError("DescribeVolumes", svc.logger, err)
+		return nil, err
+	}
+	return volumeProto(req.Region, v), nil
+}
+
+//waitForModification waits until the new size is usable, volume stays in optimizing state for hours after that
+func waitForModification(ctx context.Context, client *ec2.EC2, volumeId string) error {
+	ticker := time.NewTicker(volumeModificationPollInterval)
+	defer ticker.Stop()
+
+	for {
+		out, err := client.DescribeVolumesModificationsWithContext(ctx, &ec2.DescribeVolumesModificationsInput{
+			VolumeIds: []*string{aws.String(volumeId)},
+		})
+		if err != nil {
+			return err
+		}
+		for _, m := range out.VolumesModifications {
+			switch aws.StringValue(m.ModificationState) {
+			case ec2.VolumeModificationStateOptimizing, ec2.VolumeModificationStateCompleted:
+				return nil
+			case ec2.VolumeModificationStateFailed:
+				return errors.Errorf("volume '%s' modification failed: %s", volumeId, aws.StringValue(m.StatusMessage))
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+//ResizeVolume grow EBS volume, file system on the volume has to be extended on the instance
+func (svc AWSController) ResizeVolume(ctx context.Context, req *proto.ResizeVolumeRequest) (*proto.ResizeVolumeResponse, error) {
+	session, err := NewSession(ctx, req.Region, req.AccountName)
+	if err != nil {
+		svc.logger.Errorw("Can't start AWS session", "error", err)
+		return nil, err
+	}
+	client := session.getEC2Client()
+
+	v, err := describeVolume(ctx, client, req.VolumeId)
+	if err != nil {
+		logError("DescribeVolumes", svc.logger, err)
+		return nil, err
+	}
+	if req.Size <= aws.Int64Value(v.Size) {
+		return nil, errors.Errorf("volume '%s' is %dGiB, new size must be larger", req.VolumeId, aws.Int64Value(v.Size))
+	}
+
+	_, err = client.ModifyVolumeWithContext(ctx, &ec2.ModifyVolumeInput{
+		VolumeId: aws.String(req.VolumeId),
+		Size:     aws.Int64(req.Size),
+	})
+	if err != nil {
+		logError("ModifyVolume", svc.logger, err)
+		return nil, err
+	}
+	operation.Report(ctx, "volume '%s' resize to %dGiB requested", req.VolumeId, req.Size)
+
+	if err := waitForModification(ctx, client, req.VolumeId); err != nil {
+		logError("DescribeVolumesModifications", svc.logger, err)
+		return nil, err
+	}
+	operation.Report(ctx, "volume '%s' resized", req.VolumeId)
+
+	svc.inventory.Updated(ctx, inventory.KindVolume, req.AccountName, req.Region, req.VolumeId, nil,
+		map[string]string{"size": strconv.FormatInt(req.Size, 10)})
+	return &proto.ResizeVolumeResponse{VolumeId: req.VolumeId, Size: req.Size}, nil
+}
+
+//AttachVolume attach EBS volume to the instance as the requested device
+func (svc AWSController) AttachVolume(ctx context.Context, req *proto.AttachVolumeRequest) (*proto.AttachVolumeResponse, error) {
+	if req.Device == "" {
+		return nil, errors.New("device name is required to attach the volume, ex: /dev/sdf")
+	}
+
+	session, err := NewSession(ctx, req.Region, req.AccountName)
+	if err != nil {
+		svc.logger.Errorw("Can't start AWS session", "error", err)
+		return nil, err
+	}
+	client := session.getEC2Client()
+
+	_, err = client.AttachVolumeWithContext(ctx, &ec2.AttachVolumeInput{
+		VolumeId:   aws.String(req.VolumeId),
+		InstanceId: aws.String(req.Instance),
+		Device:     aws.String(req.Device),
+	})
+	if err != nil {
+		logError("AttachVolume", svc.logger, err)
+		return nil, err
+	}
+	operation.Report(ctx, "volume '%s' attach to '%s' requested", req.VolumeId, req.Instance)
+
+	err = client.WaitUntilVolumeInUseWithContext(ctx, &ec2.DescribeVolumesInput{VolumeIds: []*string{aws.String(req.VolumeId)}})
+	if err != nil {
+		logError("WaitForVolumeInUse", svc.logger, err)
+		return nil, err
+	}
+	operation.Report(ctx, "volume '%s' attached", req.VolumeId)
+
+	svc.inventory.Updated(ctx, inventory.KindVolume, req.AccountName, req.Region, req.VolumeId, nil,
+		map[string]string{"instance": req.Instance})
+	return &proto.AttachVolumeResponse{VolumeId: req.VolumeId, Instance: req.Instance, Device: req.Device}, nil
+}
+
+//DetachVolume detach EBS volume from the instance
+func (svc AWSController) DetachVolume(ctx context.Context, req *proto.DetachVolumeRequest) (*proto.DetachVolumeResponse, error) {
+	session, err := NewSession(ctx, req.Region, req.AccountName)
+	if err != nil {
+		svc.logger.Errorw("Can't start AWS session", "error", err)
+		return nil, err
+	}
+	client := session.getEC2Client()
+
+	input := &ec2.DetachVolumeInput{VolumeId: aws.String(req.VolumeId), Force: aws.Bool(req.Force)}
+	if req.Instance != "" {
+		input.InstanceId = aws.String(req.Instance)
+	}
+	_, err = client.DetachVolumeWithContext(ctx, input)
+	if err != nil {
+		logError("DetachVolume", svc.logger, err)
+		return nil, err
+	}
+	operation.Report(ctx, "volume '%s' detach requested", req.VolumeId)
+
+	err = client.WaitUntilVolumeAvailableWithContext(ctx, &ec2.DescribeVolumesInput{VolumeIds: []*string{aws.String(req.VolumeId)}})
+	if err != nil {
+		logError("WaitForVolumeAvailable", svc.logger, err)
+		return nil, err
+	}
+	operation.Report(ctx, "volume '%s' detached", req.VolumeId)
+
+	svc.inventory.Updated(ctx, inventory.KindVolume, req.AccountName, req.Region, req.VolumeId, nil,
+		map[string]string{"instance": ""})
+	return &proto.DetachVolumeResponse{Detached: true}, nil
+}
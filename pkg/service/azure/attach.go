@@ -0,0 +1,232 @@
+package azure
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/latest/compute/mgmt/compute"
+	"github.com/Azure/go-autorest/autorest/to"
+	"github.com/pkg/errors"
+	"gitlab.com/netbook-devs/spawner-service/pkg/inventory"
+	"gitlab.com/netbook-devs/spawner-service/pkg/operation"
+	"gitlab.com/netbook-devs/spawner-service/pkg/service/system"
+	proto "gitlab.com/netbook-devs/spawner-service/proto/netbookai/spawner"
+)
+
+//vmRef virtual machine or scale set instance the disks are attached to, aks nodes are scale set instances
+type vmRef struct {
+	group    string
+	scaleSet string
+	//name of the virtual machine or instance id of the scale set vm
+	name string
+}
+
+func (r vmRef) String() string {
+	if r.scaleSet != "" {
+		return r.scaleSet + "/" + r.name
+	}
+	return r.name
+}
+
+//parseVMRef instance is either the vm name in the credential resource group or the resource id of the vm
+//or the scale set vm, ex: /subscriptions/../resourceGroups/mc_rg/providers/Microsoft.Compute/virtualMachineScaleSets/ss/virtualMachines/0
+func parseVMRef(instance, group string) (vmRef, error) {
+	if instance == "" {
+		return vmRef{}, errors.New("instance is required")
+	}
+	if !strings.HasPrefix(instance, "/") {
+		return vmRef{group: group, name: instance}, nil
+	}
+
+	ref := vmRef{}
+	segments := strings.Split(strings.Trim(instance, "/"), "/")
+	for i := 0; i+1 < len(segments); i += 2 {
+		switch strings.ToLower(segments[i]) {
+		case "resourcegroups":
+			ref.group = segments[i+1]
+		case "virtualmachinescalesets":
+			ref.scaleSet = segments[i+1]
+		case "virtualmachines":
+			ref.name = segments[i+1]
+		}
+	}
+	if ref.group == "" || ref.name == "" {
+		return vmRef{}, errors.Errorf("invalid vm resource id '%s'", instance)
+	}
+	return ref, nil
+}
+
+//freeLun lowest lun not used by the data disks
+func freeLun(disks []compute.DataDisk) int32 {
+	used := map[int32]bool{}
+	for _, d := range disks {
+		used[to.Int32(d.Lun)] = true
+	}
+	lun := int32(0)
+	for used[lun] {
+		lun++
+	}
+	return lun
+}
+
+//updateDataDisks applies the change to the data disks of the vm and waits for the vm update to complete
+func (a *AzureController) updateDataDisks(ctx context.Context, cred *system.AzureCredential, ref vmRef, change func([]compute.DataDisk) ([]compute.DataDisk, error)) error {
+	if ref.scaleSet != "" {
+		vc, err := getScaleSetVMClient(cred)
+		if err != nil {
+			return err
+		}
+		//Doc : https://docs.microsoft.com/en-us/rest/api/compute/virtual-machine-scale-set-vms/update
+		vm, err := vc.Get(ctx, ref.group, ref.scaleSet, ref.name, "")
+		if err != nil {
+			return errors.Wrapf(err, "failed to get scale set vm '%s'", ref)
+		}
+		if vm.VirtualMachineScaleSetVMProperties == nil || vm.StorageProfile == nil {
+			return errors.Errorf("scale set vm '%s' has no storage profile", ref)
+		}
+		disks, err := change(dataDisks(vm.StorageProfile))
+		if err != nil {
+			return err
+		}
+		vm.StorageProfile.DataDisks = &disks
+		future, err := vc.Update(ctx, ref.group, ref.scaleSet, ref.name, vm)
+		if err != nil {
+			return errors.Wrapf(err, "failed to update scale set vm '%s'", ref)
+		}
+		return future.WaitForCompletionRef(ctx, vc.Client)
+	}
+
+	vc, err := getVMClient(cred)
+	if err != nil {
+		return err
+	}
+	//Doc : https://docs.microsoft.com/en-us/rest/api/compute/virtual-machines/create-or-update
+	vm, err := vc.Get(ctx, ref.group, ref.name, "")
+	if err != nil {
+		return errors.Wrapf(err, "failed to get vm '%s'", ref)
+	}
+	if vm.VirtualMachineProperties == nil || vm.StorageProfile == nil {
+		return errors.Errorf("vm '%s' has no storage profile", ref)
+	}
+	disks, err := change(dataDisks(vm.StorageProfile))
+	if err != nil {
+		return err
+	}
+	vm.StorageProfile.DataDisks = &disks
+	future, err := vc.CreateOrUpdate(ctx, ref.group, ref.name, vm)
+	if err != nil {
+		return errors.Wrapf(err, "failed to update vm '%s'", ref)
+	}
+	return future.WaitForCompletionRef(ctx, vc.Client)
+}
+
+func dataDisks(p *compute.StorageProfile) []compute.DataDisk {
+	if p.DataDisks == nil {
+		return []compute.DataDisk{}
+	}
+	return *p.DataDisks
+}
+
+func (a *AzureController) attachVolume(ctx context.Context, req *proto.AttachVolumeRequest) (*proto.AttachVolumeResponse, error) {
+	cred, err := getCredentials(ctx, req.AccountName)
+	if err != nil {
+		return nil, err
+	}
+	ref, err := parseVMRef(req.Instance, cred.ResourceGroup)
+	if err != nil {
+		return nil, err
+	}
+	disksClient, err := getDisksClient(cred)
+	if err != nil {
+		a.logger.Errorw("failed to get the disk client", "error", err)
+		return nil, err
+	}
+	disk, err := disksClient.Get(ctx, cred.ResourceGroup, req.VolumeId)
+	if err != nil {
+		return nil, errors.Wrapf(err, "attachVolume: failed to get disk '%s'", req.VolumeId)
+	}
+
+	var lun int32
+	err = a.updateDataDisks(ctx, cred, ref, func(disks []compute.DataDisk) ([]compute.DataDisk, error) {
+		lun = freeLun(disks)
+		if req.Device != "" {
+			l, err := strconv.ParseInt(req.Device, 10, 32)
+			if err != nil {
+				return nil, errors.Errorf("invalid lun '%s'", req.Device)
+			}
+			lun = int32(l)
+		}
+		return append(disks, compute.DataDisk{
+			Lun:          &lun,
+			Name:         disk.Name,
+			CreateOption: compute.DiskCreateOptionTypesAttach,
+			ManagedDisk:  &compute.ManagedDiskParameters{ID: disk.ID},
+		}), nil
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "attachVolume")
+	}
+	operation.Report(ctx, "disk '%s' attached to '%s' at lun %d", req.VolumeId, ref, lun)
+
+	a.inventory.Updated(ctx, inventory.KindVolume, req.AccountName, req.Region, req.VolumeId, nil,
+		map[string]string{"instance": req.Instance})
+	return &proto.AttachVolumeResponse{VolumeId: req.VolumeId, Instance: req.Instance, Device: strconv.Itoa(int(lun))}, nil
+}
+
+func (a *AzureController) detachVolume(ctx context.Context, req *proto.DetachVolumeRequest) (*proto.DetachVolumeResponse, error) {
+	cred, err := getCredentials(ctx, req.AccountName)
+	if err != nil {
+		return nil, err
+	}
+	instance := req.Instance
+	if instance == "" {
+		disksClient, err := getDisksClient(cred)
+		if err != nil {
+			a.logger.Errorw("failed to get the disk client", "error", err)
+			return nil, err
+		}
+		disk, err := disksClient.Get(ctx, cred.ResourceGroup, req.VolumeId)
+		if err != nil {
+			return nil, errors.Wrapf(err, "detachVolume: failed to get disk '%s'", req.VolumeId)
+		}
+		if disk.ManagedBy == nil {
+			return nil, errors.Errorf("disk '%s' is not attached", req.VolumeId)
+		}
+		instance = *disk.ManagedBy
+	}
+	ref, err := parseVMRef(instance, cred.ResourceGroup)
+	if err != nil {
+		return nil, err
+	}
+
+	err = a.updateDataDisks(ctx, cred, ref, func(disks []compute.DataDisk) ([]compute.DataDisk, error) {
+		kept := []compute.DataDisk{}
+		found := false
+		for _, d := range disks {
+			if !strings.EqualFold(to.String(d.Name), req.VolumeId) {
+				kept = append(kept, d)
+				continue
+			}
+			found = true
+			if req.Force {
+				//force detached disks are removed by azure once the vm releases them
+				d.ToBeDetached = to.BoolPtr(true)
+				d.DetachOption = compute.DiskDetachOptionTypesForceDetach
+				kept = append(kept, d)
+			}
+		}
+		if !found {
+			return nil, errors.Errorf("disk '%s' is not attached to '%s'", req.VolumeId, ref)
+		}
+		return kept, nil
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "detachVolume")
+	}
+	operation.Report(ctx, "disk '%s' detached from '%s'", req.VolumeId, ref)
+
+	a.inventory.Updated(ctx, inventory.KindVolume, req.AccountName, req.Region, req.VolumeId, nil,
+		map[string]string{"instance": ""})
+	return &proto.DetachVolumeResponse{Detached: true}, nil
+}
@@ -0,0 +1,31 @@
+package azure
+
+import (
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/latest/compute/mgmt/compute"
+	"github.com/Azure/go-autorest/autorest/to"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ParseVMRef(t *testing.T) {
+	ref, err := parseVMRef("worker-1", "spawner")
+	require.NoError(t, err)
+	assert.Equal(t, vmRef{group: "spawner", name: "worker-1"}, ref)
+
+	ref, err = parseVMRef("/subscriptions/sub/resourcegroups/MC_spawner/providers/Microsoft.Compute/virtualMachineScaleSets/aks-gpu-123/virtualMachines/2", "spawner")
+	require.NoError(t, err)
+	assert.Equal(t, vmRef{group: "MC_spawner", scaleSet: "aks-gpu-123", name: "2"}, ref)
+	assert.Equal(t, "aks-gpu-123/2", ref.String())
+
+	_, err = parseVMRef("/subscriptions/sub/providers/Microsoft.Compute/virtualMachines/vm", "spawner")
+	assert.Error(t, err, "resource group is missing")
+	_, err = parseVMRef("", "spawner")
+	assert.Error(t, err)
+}
+
+func Test_FreeLun(t *testing.T) {
+	assert.Equal(t, int32(0), freeLun(nil))
+	assert.Equal(t, int32(1), freeLun([]compute.DataDisk{{Lun: to.Int32Ptr(0)}, {Lun: to.Int32Ptr(2)}}))
+}
@@ -5,6 +5,8 @@ import (
 
 	"github.com/Azure/azure-sdk-for-go/profiles/latest/compute/mgmt/compute"
 	"github.com/Azure/azure-sdk-for-go/profiles/latest/containerservice/mgmt/containerservice"
+	"github.com/Azure/azure-sdk-for-go/profiles/latest/dns/mgmt/dns"
+	orchestrators "github.com/Azure/azure-sdk-for-go/services/containerservice/mgmt/2020-09-01/containerservice"
 	"github.com/Azure/azure-sdk-for-go/services/costmanagement/mgmt/2019-11-01/costmanagement"
 	"gitlab.com/netbook-devs/spawner-service/pkg/service/azure/iam"
 	"gitlab.com/netbook-devs/spawner-service/pkg/service/constants"
@@ -25,6 +27,19 @@ func getAKSClient(c *system.AzureCredential) (*containerservice.ManagedClustersC
 	return &aksClient, nil
 }
 
+//getOrchestratorsClient client of the container services api, newer api versions no longer list the orchestrator versions
+func getOrchestratorsClient(c *system.AzureCredential) (*orchestrators.ContainerServicesClient, error) {
+
+	csClient := orchestrators.NewContainerServicesClient(c.SubscriptionID)
+	auth, err := iam.GetResourceManagementAuthorizer(c)
+	if err != nil {
+		return nil, err
+	}
+	csClient.Authorizer = auth
+	csClient.AddToUserAgent(constants.SpawnerServiceLabel)
+	return &csClient, nil
+}
+
 func getCostManagementClient(c *system.AzureCredential) (*costmanagement.QueryClient, error) {
 
 	costmgmtClient := costmanagement.NewQueryClient(c.SubscriptionID)
@@ -75,3 +90,39 @@ func getSnapshotClient(c *system.AzureCredential) (*compute.SnapshotsClient, err
 	sc.AddToUserAgent(constants.SpawnerServiceLabel)
 	return &sc, nil
 }
+
+func getVMClient(c *system.AzureCredential) (*compute.VirtualMachinesClient, error) {
+	vc := compute.NewVirtualMachinesClient(c.SubscriptionID)
+	a, err := iam.GetResourceManagementAuthorizer(c)
+
+	if err != nil {
+		return nil, err
+	}
+	vc.Authorizer = a
+	vc.AddToUserAgent(constants.SpawnerServiceLabel)
+	return &vc, nil
+}
+
+func getScaleSetVMClient(c *system.AzureCredential) (*compute.VirtualMachineScaleSetVMsClient, error) {
+	vc := compute.NewVirtualMachineScaleSetVMsClient(c.SubscriptionID)
+	a, err := iam.GetResourceManagementAuthorizer(c)
+
+	if err != nil {
+		return nil, err
+	}
+	vc.Authorizer = a
+	vc.AddToUserAgent(constants.SpawnerServiceLabel)
+	return &vc, nil
+}
+
+func getRecordSetsClient(c *system.AzureCredential) (*dns.RecordSetsClient, error) {
+	rc := dns.NewRecordSetsClient(c.SubscriptionID)
+	a, err := iam.GetResourceManagementAuthorizer(c)
+
+	if err != nil {
+		return nil, err
+	}
+	rc.Authorizer = a
+	rc.AddToUserAgent(constants.SpawnerServiceLabel)
+	return &rc, nil
+}
@@ -4,11 +4,14 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"strconv"
 
 	"github.com/Azure/azure-sdk-for-go/profiles/latest/containerservice/mgmt/containerservice"
 	"github.com/Azure/go-autorest/autorest/to"
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/pkg/errors"
+	"gitlab.com/netbook-devs/spawner-service/pkg/inventory"
+	"gitlab.com/netbook-devs/spawner-service/pkg/operation"
 	"gitlab.com/netbook-devs/spawner-service/pkg/service/common"
 	"gitlab.com/netbook-devs/spawner-service/pkg/service/constants"
 	"gitlab.com/netbook-devs/spawner-service/pkg/service/labels"
@@ -54,7 +57,7 @@ func (a *AzureController) createCluster(ctx context.Context, req *proto.ClusterR
 
 	instance := ""
 	if req.Node.MachineType != "" {
-		instance = common.GetInstance(constants.AzureLabel, req.Node.MachineType)
+		instance = common.GetRegionInstance(constants.AzureLabel, req.Region, req.Node.MachineType)
 	} else {
 		instance = req.Node.Instance
 	}
@@ -78,7 +81,6 @@ func (a *AzureController) createCluster(ctx context.Context, req *proto.ClusterR
 					NodeLabels:   nodeTags,
 					Tags:         nodeTags,
 					Mode:         containerservice.AgentPoolModeSystem,
-					//					OrchestratorVersion: &constants.AzureKubeVersion,
 				},
 			},
 			ServicePrincipalProfile: &containerservice.ManagedClusterServicePrincipalProfile{
@@ -88,6 +90,11 @@ func (a *AzureController) createCluster(ctx context.Context, req *proto.ClusterR
 		},
 	}
 
+	if req.KubernetesVersion != "" {
+		mc.KubernetesVersion = &req.KubernetesVersion
+		(*mc.AgentPoolProfiles)[0].OrchestratorVersion = &req.KubernetesVersion
+	}
+
 	future, err := aksClient.CreateOrUpdate(
 		ctx,
 		groupName,
@@ -100,6 +107,7 @@ func (a *AzureController) createCluster(ctx context.Context, req *proto.ClusterR
 	}
 
 	a.logger.Infow("waiting on the future completion")
+	operation.Report(ctx, "cluster creation requested")
 	err = future.WaitForCompletionRef(ctx, aksClient.Client)
 	if err != nil {
 		a.logger.Errorw("failed to get the future response", "error", err)
@@ -107,6 +115,32 @@ func (a *AzureController) createCluster(ctx context.Context, req *proto.ClusterR
 	}
 
 	//return future.Result(aksClient)
+	operation.Report(ctx, "cluster created")
+
+	a.inventory.Created(ctx, inventory.Resource{
+		ID:         clusterName,
+		Kind:       inventory.KindCluster,
+		Account:    account,
+		Region:     region,
+		Name:       clusterName,
+		Labels:     req.Labels,
+		Attributes: map[string]string{"resourceGroup": groupName},
+	})
+	a.inventory.Created(ctx, inventory.Resource{
+		ID:      inventory.NodePoolID(clusterName, req.Node.Name),
+		Kind:    inventory.KindNodePool,
+		Account: account,
+		Region:  region,
+		Cluster: clusterName,
+		Name:    req.Node.Name,
+		Labels:  aws.StringValueMap(nodeTags),
+		Attributes: map[string]string{
+			"instance":    instance,
+			"count":       strconv.Itoa(int(count)),
+			"machineType": req.Node.MachineType,
+			"gpuEnabled":  strconv.FormatBool(req.Node.GpuEnabled),
+		},
+	})
 
 	return &proto.ClusterResponse{ClusterName: clusterName}, nil
 }
@@ -136,7 +170,8 @@ func (a *AzureController) getCluster(ctx context.Context, req *proto.GetClusterR
 	}
 
 	response := &proto.ClusterSpec{
-		Name: clusterName,
+		Name:              clusterName,
+		KubernetesVersion: aws.StringValue(clstr.KubernetesVersion),
 	}
 	var nodeSpecList []*proto.NodeSpec
 
@@ -147,11 +182,15 @@ func (a *AzureController) getCluster(ctx context.Context, req *proto.GetClusterR
 		}
 
 		nodeSpec := proto.NodeSpec{
-			Name:     *node.Name,
-			Instance: *node.VMSize,
-			Labels:   aws.StringValueMap(node.NodeLabels),
-			DiskSize: *node.OsDiskSizeGB,
-			State:    state,
+			Name:              *node.Name,
+			Instance:          *node.VMSize,
+			Labels:            aws.StringValueMap(node.NodeLabels),
+			DiskSize:          *node.OsDiskSizeGB,
+			State:             state,
+			KubernetesVersion: aws.StringValue(node.OrchestratorVersion),
+		}
+		if node.Count != nil {
+			nodeSpec.Count = int64(*node.Count)
 		}
 		nodeSpecList = append(nodeSpecList, &nodeSpec)
 	}
@@ -186,6 +225,7 @@ func (a *AzureController) deleteCluster(ctx context.Context, req *proto.ClusterD
 		return nil, err
 	}
 
+	operation.Report(ctx, "cluster deletion requested")
 	err = future.WaitForCompletionRef(ctx, aksClient.Client)
 	if err != nil {
 		a.logger.Errorw("failed to get the future response", "error", err)
@@ -197,6 +237,7 @@ func (a *AzureController) deleteCluster(ctx context.Context, req *proto.ClusterD
 	}
 
 	a.logger.Infow("cluster deleted successfully", "cluster", clusterName, "response", future.Status())
+	a.inventory.Deleted(ctx, inventory.KindCluster, account, req.Region, clusterName)
 
 	return &proto.ClusterDeleteResponse{}, nil
 
@@ -250,6 +291,7 @@ func (a *AzureController) getClusters(ctx context.Context, req *proto.GetCluster
 				Availabilityzone: zones,
 				ClusterId:        *cl.ID,
 				Labels:           aws.StringValueMap(app.Tags),
+				Count:            int64(aws.Int32Value(app.Count)),
 				GpuEnabled:       false,
 				//TODO: get health
 				Health: &proto.Health{},
@@ -289,6 +331,9 @@ func (a *AzureController) clusterStatus(ctx context.Context, req *proto.ClusterS
 	clstr, err := aksClient.Get(ctx, groupName, clusterName)
 	if err != nil {
 		a.logger.Errorw("failed to get cluster information", "error", err)
+		if clstr.Response.Response != nil && clstr.StatusCode == http.StatusNotFound {
+			return nil, errors.Wrapf(common.ERR_CLUSTER_NOT_FOUND, "cluster '%s'", clusterName)
+		}
 		return nil, err
 	}
 
@@ -297,6 +342,7 @@ func (a *AzureController) clusterStatus(ctx context.Context, req *proto.ClusterS
 		state = constants.Active
 	}
 	return &proto.ClusterStatusResponse{
-		Status: state,
+		Status:            state,
+		ProvisioningState: to.String(clstr.ProvisioningState),
 	}, nil
 }
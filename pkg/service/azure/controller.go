@@ -3,17 +3,21 @@ package azure
 import (
 	"context"
 
+	"gitlab.com/netbook-devs/spawner-service/pkg/inventory"
+	"gitlab.com/netbook-devs/spawner-service/pkg/service/constants"
 	proto "gitlab.com/netbook-devs/spawner-service/proto/netbookai/spawner"
 	"go.uber.org/zap"
 )
 
 type AzureController struct {
-	logger *zap.SugaredLogger
+	logger    *zap.SugaredLogger
+	inventory *inventory.Recorder
 }
 
-func NewController(logger *zap.SugaredLogger) *AzureController {
+func NewController(logger *zap.SugaredLogger, store inventory.Store) *AzureController {
 	return &AzureController{
-		logger: logger,
+		logger:    logger,
+		inventory: inventory.NewRecorder(store, constants.AzureLabel, logger),
 	}
 }
 
@@ -80,3 +84,67 @@ func (a *AzureController) GetKubeConfig(ctx context.Context, req *proto.GetKubeC
 func (a *AzureController) TagNodeInstance(ctx context.Context, req *proto.TagNodeInstanceRequest) (*proto.TagNodeInstanceResponse, error) {
 	return &proto.TagNodeInstanceResponse{}, nil
 }
+
+func (a *AzureController) ScaleNodePool(ctx context.Context, req *proto.ScaleNodePoolRequest) (*proto.ScaleNodePoolResponse, error) {
+	return a.scaleNodePool(ctx, req)
+}
+
+func (a *AzureController) UpdateNodePool(ctx context.Context, req *proto.UpdateNodePoolRequest) (*proto.UpdateNodePoolResponse, error) {
+	return a.updateNodePool(ctx, req)
+}
+
+func (a *AzureController) ListVolumes(ctx context.Context, req *proto.ListVolumesRequest) (*proto.ListVolumesResponse, error) {
+	return a.listVolumes(ctx, req)
+}
+
+func (a *AzureController) ListSnapshots(ctx context.Context, req *proto.ListSnapshotsRequest) (*proto.ListSnapshotsResponse, error) {
+	return a.listSnapshots(ctx, req)
+}
+
+func (a *AzureController) DeleteSnapshot(ctx context.Context, req *proto.DeleteSnapshotRequest) (*proto.DeleteSnapshotResponse, error) {
+	return a.deleteVolumeSnapshot(ctx, req)
+}
+
+func (a *AzureController) ListKubernetesVersions(ctx context.Context, req *proto.ListKubernetesVersionsRequest) (*proto.ListKubernetesVersionsResponse, error) {
+	return a.listKubernetesVersions(ctx, req)
+}
+
+func (a *AzureController) UpgradeCluster(ctx context.Context, req *proto.UpgradeClusterRequest) (*proto.UpgradeClusterResponse, error) {
+	return a.upgradeCluster(ctx, req)
+}
+
+func (a *AzureController) GetVolume(ctx context.Context, req *proto.GetVolumeRequest) (*proto.Volume, error) {
+	return a.getVolume(ctx, req)
+}
+
+func (a *AzureController) ResizeVolume(ctx context.Context, req *proto.ResizeVolumeRequest) (*proto.ResizeVolumeResponse, error) {
+	return a.resizeVolume(ctx, req)
+}
+
+func (a *AzureController) AttachVolume(ctx context.Context, req *proto.AttachVolumeRequest) (*proto.AttachVolumeResponse, error) {
+	return a.attachVolume(ctx, req)
+}
+
+func (a *AzureController) DetachVolume(ctx context.Context, req *proto.DetachVolumeRequest) (*proto.DetachVolumeResponse, error) {
+	return a.detachVolume(ctx, req)
+}
+
+func (a *AzureController) RestoreSnapshot(ctx context.Context, req *proto.RestoreSnapshotRequest) (*proto.RestoreSnapshotResponse, error) {
+	return a.restoreSnapshot(ctx, req)
+}
+
+func (a *AzureController) CopySnapshot(ctx context.Context, req *proto.CopySnapshotRequest) (*proto.CopySnapshotResponse, error) {
+	return a.copySnapshot(ctx, req)
+}
+
+func (a *AzureController) UpsertDNSRecord(ctx context.Context, req *proto.UpsertDNSRecordRequest) (*proto.UpsertDNSRecordResponse, error) {
+	return a.upsertDNSRecord(ctx, req)
+}
+
+func (a *AzureController) DeleteDNSRecord(ctx context.Context, req *proto.DeleteDNSRecordRequest) (*proto.DeleteDNSRecordResponse, error) {
+	return a.deleteDNSRecord(ctx, req)
+}
+
+func (a *AzureController) ListDNSRecords(ctx context.Context, req *proto.ListDNSRecordsRequest) (*proto.ListDNSRecordsResponse, error) {
+	return a.listDNSRecords(ctx, req)
+}
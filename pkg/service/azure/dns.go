@@ -0,0 +1,210 @@
+package azure
+
+import (
+	"context"
+	"path"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/latest/dns/mgmt/dns"
+	"github.com/Azure/go-autorest/autorest/to"
+	"github.com/pkg/errors"
+	"gitlab.com/netbook-devs/spawner-service/pkg/inventory"
+	"gitlab.com/netbook-devs/spawner-service/pkg/operation"
+	"gitlab.com/netbook-devs/spawner-service/pkg/service/constants"
+	proto "gitlab.com/netbook-devs/spawner-service/proto/netbookai/spawner"
+)
+
+//relativeName record set name relative to the zone, azure names the zone apex '@'
+func relativeName(zone, name string) (string, error) {
+	zone = strings.ToLower(strings.TrimSuffix(zone, "."))
+	name = strings.ToLower(strings.TrimSuffix(name, "."))
+	if name == zone || name == "@" {
+		return "@", nil
+	}
+	if strings.HasSuffix(name, "."+zone) {
+		return strings.TrimSuffix(name, "."+zone), nil
+	}
+	if !strings.Contains(name, ".") {
+		return name, nil
+	}
+	return "", errors.Errorf("record '%s' is not in the zone '%s'", name, zone)
+}
+
+func recordSet(r *proto.DNSRecord) dns.RecordSet {
+	props := &dns.RecordSetProperties{
+		TTL: to.Int64Ptr(r.Ttl),
+		Metadata: map[string]*string{
+			constants.CreatorLabel: to.StringPtr(constants.SpawnerServiceLabel),
+		},
+	}
+	if r.ClusterName != "" {
+		props.Metadata[constants.ClusterNameLabel] = to.StringPtr(r.ClusterName)
+	}
+
+	switch dns.RecordType(r.Type) {
+	case dns.A:
+		records := make([]dns.ARecord, 0, len(r.Values))
+		for _, v := range r.Values {
+			records = append(records, dns.ARecord{Ipv4Address: to.StringPtr(v)})
+		}
+		props.ARecords = &records
+	case dns.CNAME:
+		props.CnameRecord = &dns.CnameRecord{Cname: to.StringPtr(r.Values[0])}
+	case dns.TXT:
+		records := make([]dns.TxtRecord, 0, len(r.Values))
+		for _, v := range r.Values {
+			records = append(records, dns.TxtRecord{Value: &[]string{v}})
+		}
+		props.TxtRecords = &records
+	}
+	return dns.RecordSet{RecordSetProperties: props}
+}
+
+func recordProto(zone string, set dns.RecordSet) *proto.DNSRecord {
+	r := &proto.DNSRecord{
+		Zone: zone,
+		Name: strings.TrimSuffix(to.String(set.Name)+"."+zone, "."),
+		//set type is the resource type, ex: Microsoft.Network/dnszones/A
+		Type: path.Base(to.String(set.Type)),
+	}
+	if to.String(set.Name) == "@" {
+		r.Name = zone
+	}
+	p := set.RecordSetProperties
+	if p == nil {
+		return r
+	}
+	r.Ttl = to.Int64(p.TTL)
+	if p.Fqdn != nil {
+		r.Name = strings.TrimSuffix(*p.Fqdn, ".")
+	}
+	if c, ok := p.Metadata[constants.ClusterNameLabel]; ok {
+		r.ClusterName = to.String(c)
+	}
+	if p.ARecords != nil {
+		for _, a := range *p.ARecords {
+			r.Values = append(r.Values, to.String(a.Ipv4Address))
+		}
+	}
+	if p.CnameRecord != nil {
+		r.Values = append(r.Values, to.String(p.CnameRecord.Cname))
+	}
+	if p.TxtRecords != nil {
+		for _, t := range *p.TxtRecords {
+			if t.Value != nil {
+				r.Values = append(r.Values, strings.Join(*t.Value, ""))
+			}
+		}
+	}
+	return r
+}
+
+func (a *AzureController) upsertDNSRecord(ctx context.Context, req *proto.UpsertDNSRecordRequest) (*proto.UpsertDNSRecordResponse, error) {
+	r := req.Record
+	if r.Zone == "" {
+		return nil, errors.New("dns zone is required")
+	}
+	name, err := relativeName(r.Zone, r.Name)
+	if err != nil {
+		return nil, err
+	}
+	cred, err := getCredentials(ctx, req.AccountName)
+	if err != nil {
+		return nil, err
+	}
+	rc, err := getRecordSetsClient(cred)
+	if err != nil {
+		a.logger.Errorw("failed to get the record sets client", "error", err)
+		return nil, err
+	}
+
+	//Doc : https://docs.microsoft.com/en-us/rest/api/dns/record-sets/create-or-update
+	set, err := rc.CreateOrUpdate(ctx, cred.ResourceGroup, r.Zone, name, dns.RecordType(r.Type), recordSet(r), "", "")
+	if err != nil {
+		return nil, errors.Wrapf(err, "upsertDNSRecord: failed to create %s record '%s'", r.Type, r.Name)
+	}
+	operation.Report(ctx, "%s record '%s' updated in zone '%s'", r.Type, name, r.Zone)
+
+	a.inventory.Created(ctx, inventory.Resource{
+		ID:      inventory.DNSRecordID(r.Zone, r.Name, r.Type),
+		Kind:    inventory.KindDNSRecord,
+		Account: req.AccountName,
+		Region:  req.Region,
+		Cluster: r.ClusterName,
+		Name:    r.Name,
+		Attributes: map[string]string{
+			"zone":   r.Zone,
+			"type":   r.Type,
+			"values": strings.Join(r.Values, ","),
+			"etag":   to.String(set.Etag),
+		},
+	})
+	return &proto.UpsertDNSRecordResponse{Record: recordProto(r.Zone, set), ChangeId: to.String(set.Etag)}, nil
+}
+
+func (a *AzureController) deleteDNSRecord(ctx context.Context, req *proto.DeleteDNSRecordRequest) (*proto.DeleteDNSRecordResponse, error) {
+	if req.Zone == "" {
+		return nil, errors.New("dns zone is required")
+	}
+	name, err := relativeName(req.Zone, req.Name)
+	if err != nil {
+		return nil, err
+	}
+	cred, err := getCredentials(ctx, req.AccountName)
+	if err != nil {
+		return nil, err
+	}
+	rc, err := getRecordSetsClient(cred)
+	if err != nil {
+		a.logger.Errorw("failed to get the record sets client", "error", err)
+		return nil, err
+	}
+
+	//Doc : https://docs.microsoft.com/en-us/rest/api/dns/record-sets/delete
+	_, err = rc.Delete(ctx, cred.ResourceGroup, req.Zone, name, dns.RecordType(req.Type), "")
+	if err != nil {
+		return nil, errors.Wrapf(err, "deleteDNSRecord: failed to delete %s record '%s'", req.Type, req.Name)
+	}
+	operation.Report(ctx, "%s record '%s' deleted from zone '%s'", req.Type, name, req.Zone)
+
+	a.inventory.Deleted(ctx, inventory.KindDNSRecord, req.AccountName, req.Region, inventory.DNSRecordID(req.Zone, req.Name, req.Type))
+	return &proto.DeleteDNSRecordResponse{Deleted: true}, nil
+}
+
+func (a *AzureController) listDNSRecords(ctx context.Context, req *proto.ListDNSRecordsRequest) (*proto.ListDNSRecordsResponse, error) {
+	if req.Zone == "" {
+		return nil, errors.New("dns zone is required")
+	}
+	cred, err := getCredentials(ctx, req.AccountName)
+	if err != nil {
+		return nil, err
+	}
+	rc, err := getRecordSetsClient(cred)
+	if err != nil {
+		a.logger.Errorw("failed to get the record sets client", "error", err)
+		return nil, err
+	}
+
+	records := []*proto.DNSRecord{}
+	it, err := rc.ListAllByDNSZoneComplete(ctx, cred.ResourceGroup, req.Zone, nil, "")
+	if err != nil {
+		return nil, errors.Wrapf(err, "listDNSRecords: failed to list records of zone '%s'", req.Zone)
+	}
+	for ; it.NotDone(); err = it.NextWithContext(ctx) {
+		if err != nil {
+			return nil, errors.Wrapf(err, "listDNSRecords: failed to list records of zone '%s'", req.Zone)
+		}
+		r := recordProto(req.Zone, it.Value())
+		if r.Type != string(dns.A) && r.Type != string(dns.CNAME) && r.Type != string(dns.TXT) {
+			continue
+		}
+		if req.Type != "" && r.Type != req.Type {
+			continue
+		}
+		records = append(records, r)
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "listDNSRecords: failed to list records of zone '%s'", req.Zone)
+	}
+	return &proto.ListDNSRecordsResponse{Records: records}, nil
+}
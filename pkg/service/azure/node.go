@@ -4,9 +4,14 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"strconv"
+	"strings"
 
 	"github.com/Azure/azure-sdk-for-go/profiles/latest/containerservice/mgmt/containerservice"
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/pkg/errors"
+	"gitlab.com/netbook-devs/spawner-service/pkg/inventory"
+	"gitlab.com/netbook-devs/spawner-service/pkg/operation"
 	"gitlab.com/netbook-devs/spawner-service/pkg/service/common"
 	"gitlab.com/netbook-devs/spawner-service/pkg/service/constants"
 	"gitlab.com/netbook-devs/spawner-service/pkg/service/labels"
@@ -71,7 +76,7 @@ func (a AzureController) addNode(ctx context.Context, req *proto.NodeSpawnReques
 
 	instance := ""
 	if req.NodeSpec.MachineType != "" {
-		instance = common.GetInstance(constants.AzureLabel, req.NodeSpec.MachineType)
+		instance = common.GetRegionInstance(constants.AzureLabel, req.Region, req.NodeSpec.MachineType)
 	} else {
 		instance = req.NodeSpec.Instance
 	}
@@ -82,14 +87,16 @@ func (a AzureController) addNode(ctx context.Context, req *proto.NodeSpawnReques
 
 	mcappp := containerservice.ManagedClusterAgentPoolProfileProperties{
 
-		Count:      &count,
-		VMSize:     &instance,
-		NodeLabels: nodeTags,
-		Tags:       nodeTags,
-		Mode:       containerservice.AgentPoolModeUser,
-		//	OrchestratorVersion: &constants.AzureKubeVersion,
+		Count:        &count,
+		VMSize:       &instance,
+		NodeLabels:   nodeTags,
+		Tags:         nodeTags,
+		Mode:         containerservice.AgentPoolModeUser,
 		OsDiskSizeGB: &req.NodeSpec.DiskSize,
 	}
+	if req.NodeSpec.KubernetesVersion != "" {
+		mcappp.OrchestratorVersion = &req.NodeSpec.KubernetesVersion
+	}
 
 	isGpu := common.IsGPU(req.NodeSpec.MachineType) || req.NodeSpec.GpuEnabled
 
@@ -112,11 +119,29 @@ func (a AzureController) addNode(ctx context.Context, req *proto.NodeSpawnReques
 	}
 
 	a.logger.Infow("requested to add new node, waiting on completion")
+	operation.Report(ctx, "nodepool '%s' creation requested", nodeName)
 	err = future.WaitForCompletionRef(ctx, aksClient.Client)
 	if err != nil {
 		a.logger.Errorw("failed to add node", "error", err)
 		return nil, errors.Wrapf(err, "failed to add node to the cluster")
 	}
+	operation.Report(ctx, "nodepool '%s' created", nodeName)
+
+	a.inventory.Created(ctx, inventory.Resource{
+		ID:      inventory.NodePoolID(clusterName, nodeName),
+		Kind:    inventory.KindNodePool,
+		Account: account,
+		Region:  req.Region,
+		Cluster: clusterName,
+		Name:    nodeName,
+		Labels:  aws.StringValueMap(nodeTags),
+		Attributes: map[string]string{
+			"instance":    instance,
+			"count":       strconv.Itoa(int(count)),
+			"machineType": req.NodeSpec.MachineType,
+			"gpuEnabled":  strconv.FormatBool(isGpu),
+		},
+	})
 
 	return &proto.NodeSpawnResponse{}, nil
 }
@@ -146,6 +171,7 @@ func (a *AzureController) deleteNode(ctx context.Context, req *proto.NodeDeleteR
 		a.logger.Errorw("failed to delete the node pool", "error", err)
 		return nil, err
 	}
+	operation.Report(ctx, "nodepool '%s' deletion requested", node)
 	err = future.WaitForCompletionRef(ctx, apc.Client)
 
 	if err != nil {
@@ -158,5 +184,177 @@ func (a *AzureController) deleteNode(ctx context.Context, req *proto.NodeDeleteR
 	}
 
 	a.logger.Infow("delete node successfully", "status", future.Response().Status)
+	a.inventory.Deleted(ctx, inventory.KindNodePool, account, req.Region, inventory.NodePoolID(cluster, node))
 	return &proto.NodeDeleteResponse{}, nil
 }
+
+//updateAgentPool reads the agent pool, applies the change and waits for the update to complete
+func (a *AzureController) updateAgentPool(ctx context.Context, account, cluster, node string, change func(p *containerservice.ManagedClusterAgentPoolProfileProperties)) error {
+	cred, err := getCredentials(ctx, account)
+	if err != nil {
+		return err
+	}
+	groupName := cred.ResourceGroup
+	apc, err := getAgentPoolClient(cred)
+	if err != nil {
+		a.logger.Errorw("failed to get agent pool client", "error", err)
+		return err
+	}
+
+	//Doc : https://docs.microsoft.com/en-us/rest/api/aks/agent-pools/get
+	pool, err := apc.Get(ctx, groupName, cluster, node)
+	if err != nil {
+		a.logger.Errorw("failed to get the node pool", "error", err)
+		return errors.Wrapf(err, "failed to get nodepool '%s' in cluster '%s'", node, cluster)
+	}
+	if pool.ManagedClusterAgentPoolProfileProperties == nil {
+		return fmt.Errorf("nodepool '%s' in cluster '%s' has no properties", node, cluster)
+	}
+	change(pool.ManagedClusterAgentPoolProfileProperties)
+
+	//Doc : https://docs.microsoft.com/en-us/rest/api/aks/agent-pools/create-or-update
+	future, err := apc.CreateOrUpdate(ctx, groupName, cluster, node, pool)
+	if err != nil {
+		a.logger.Errorw("failed to update the node pool", "error", err)
+		return errors.Wrapf(err, "failed to update nodepool '%s'", node)
+	}
+	operation.Report(ctx, "nodepool '%s' update requested", node)
+	err = future.WaitForCompletionRef(ctx, apc.Client)
+	if err != nil {
+		a.logger.Errorw("failed to update the node pool", "error", err)
+		return errors.Wrapf(err, "failed to update nodepool '%s'", node)
+	}
+	operation.Report(ctx, "nodepool '%s' updated", node)
+	return nil
+}
+
+//scaleNodePool sets the node count, autoscaler bounds are replaced when max count is given and autoscaling is enabled
+func (a *AzureController) scaleNodePool(ctx context.Context, req *proto.ScaleNodePoolRequest) (*proto.ScaleNodePoolResponse, error) {
+	count := int32(req.Count)
+	err := a.updateAgentPool(ctx, req.AccountName, req.ClusterName, req.NodeGroupName, func(p *containerservice.ManagedClusterAgentPoolProfileProperties) {
+		p.Count = &count
+		if req.MaxCount > 0 && p.EnableAutoScaling != nil && *p.EnableAutoScaling {
+			min, max := int32(req.MinCount), int32(req.MaxCount)
+			p.MinCount, p.MaxCount = &min, &max
+			return
+		}
+		//autoscaler bounds must contain the new count
+		if p.MinCount != nil && *p.MinCount > count {
+			p.MinCount = &count
+		}
+		if p.MaxCount != nil && *p.MaxCount < count {
+			p.MaxCount = &count
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+	a.inventory.Updated(ctx, inventory.KindNodePool, req.AccountName, req.Region, inventory.NodePoolID(req.ClusterName, req.NodeGroupName), nil,
+		map[string]string{"count": strconv.Itoa(int(count))})
+	return &proto.ScaleNodePoolResponse{}, nil
+}
+
+//aksTaint taint in aks format key=value:effect
+func aksTaint(t *proto.Taint) string {
+	return fmt.Sprintf("%s=%s:%s", t.Key, t.Value, t.Effect)
+}
+
+//mergeTaints replaces the taints with the same key and appends the new ones
+func mergeTaints(current []string, taints []*proto.Taint) []string {
+	res := make([]string, 0, len(current)+len(taints))
+	replaced := map[string]bool{}
+	for _, t := range taints {
+		replaced[t.Key] = true
+	}
+	for _, c := range current {
+		key := strings.SplitN(strings.SplitN(c, ":", 2)[0], "=", 2)[0]
+		if !replaced[key] {
+			res = append(res, c)
+		}
+	}
+	for _, t := range taints {
+		res = append(res, aksTaint(t))
+	}
+	return res
+}
+
+//updateNodePool updates the labels, taints and orchestrator version of the agent pool, node image is upgraded
+//separately, aks always upgrades to the newest node image
+func (a *AzureController) updateNodePool(ctx context.Context, req *proto.UpdateNodePoolRequest) (*proto.UpdateNodePoolResponse, error) {
+	if req.ImageVersion != "" && req.ImageVersion != "latest" {
+		return nil, fmt.Errorf("aks node image can only be upgraded to 'latest', got '%s'", req.ImageVersion)
+	}
+
+	attributes := map[string]string{}
+	if len(req.Labels) > 0 || len(req.Taints) > 0 || req.KubernetesVersion != "" {
+		err := a.updateAgentPool(ctx, req.AccountName, req.ClusterName, req.NodeGroupName, func(p *containerservice.ManagedClusterAgentPoolProfileProperties) {
+			if p.NodeLabels == nil {
+				p.NodeLabels = map[string]*string{}
+			}
+			for k, v := range req.Labels {
+				p.NodeLabels[k] = aws.String(v)
+			}
+			if len(req.Taints) > 0 {
+				var current []string
+				if p.NodeTaints != nil {
+					current = *p.NodeTaints
+				}
+				taints := mergeTaints(current, req.Taints)
+				p.NodeTaints = &taints
+			}
+			if req.KubernetesVersion != "" {
+				p.OrchestratorVersion = aws.String(req.KubernetesVersion)
+			}
+		})
+		if err != nil {
+			return nil, err
+		}
+		if req.KubernetesVersion != "" {
+			attributes["kubernetesVersion"] = req.KubernetesVersion
+		}
+	}
+
+	if req.ImageVersion != "" {
+		image, err := a.upgradeNodeImage(ctx, req.AccountName, req.ClusterName, req.NodeGroupName)
+		if err != nil {
+			return nil, err
+		}
+		attributes["imageVersion"] = image
+	}
+
+	a.inventory.Updated(ctx, inventory.KindNodePool, req.AccountName, req.Region, inventory.NodePoolID(req.ClusterName, req.NodeGroupName), req.Labels, attributes)
+	return &proto.UpdateNodePoolResponse{}, nil
+}
+
+//upgradeNodeImage upgrades the agent pool to the newest node image, returns the image version
+func (a *AzureController) upgradeNodeImage(ctx context.Context, account, cluster, node string) (string, error) {
+	cred, err := getCredentials(ctx, account)
+	if err != nil {
+		return "", err
+	}
+	apc, err := getAgentPoolClient(cred)
+	if err != nil {
+		a.logger.Errorw("failed to get agent pool client", "error", err)
+		return "", err
+	}
+
+	//Doc : https://docs.microsoft.com/en-us/rest/api/aks/agent-pools/upgrade-node-image-version
+	future, err := apc.UpgradeNodeImageVersion(ctx, cred.ResourceGroup, cluster, node)
+	if err != nil {
+		a.logger.Errorw("failed to upgrade node image", "error", err)
+		return "", errors.Wrapf(err, "failed to upgrade node image of nodepool '%s'", node)
+	}
+	operation.Report(ctx, "nodepool '%s' node image upgrade requested", node)
+	err = future.WaitForCompletionRef(ctx, apc.Client)
+	if err != nil {
+		a.logger.Errorw("failed to upgrade node image", "error", err)
+		return "", errors.Wrapf(err, "failed to upgrade node image of nodepool '%s'", node)
+	}
+	operation.Report(ctx, "nodepool '%s' node image upgraded", node)
+
+	pool, err := apc.Get(ctx, cred.ResourceGroup, cluster, node)
+	if err != nil || pool.ManagedClusterAgentPoolProfileProperties == nil {
+		return "latest", nil
+	}
+	return aws.StringValue(pool.NodeImageVersion), nil
+}
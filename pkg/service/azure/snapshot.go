@@ -7,7 +7,11 @@ import (
 	"net/http"
 
 	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2021-12-01/compute"
+	"github.com/Azure/go-autorest/autorest/to"
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/pkg/errors"
+	"gitlab.com/netbook-devs/spawner-service/pkg/inventory"
+	"gitlab.com/netbook-devs/spawner-service/pkg/operation"
 	"gitlab.com/netbook-devs/spawner-service/pkg/service/labels"
 	proto "gitlab.com/netbook-devs/spawner-service/proto/netbookai/spawner"
 )
@@ -39,6 +43,7 @@ func (a *AzureController) createDiskSnapshot(ctx context.Context, sc *compute.Sn
 	}
 
 	a.logger.Debugw("waiting on the future response")
+	operation.Report(ctx, "snapshot '%s' creation requested", name)
 	err = future.WaitForCompletionRef(ctx, sc.Client)
 	if err != nil {
 		return "", errors.Wrap(err, "cannot get the creeate snapshot response")
@@ -58,6 +63,21 @@ func (a *AzureController) createDiskSnapshot(ctx context.Context, sc *compute.Sn
 	return *res.ID, nil
 }
 
+func (a *AzureController) recordSnapshot(ctx context.Context, account, region, name, uri, volumeId string, labels map[string]string) {
+	a.inventory.Created(ctx, inventory.Resource{
+		ID:      name,
+		Kind:    inventory.KindSnapshot,
+		Account: account,
+		Region:  region,
+		Name:    name,
+		Labels:  labels,
+		Attributes: map[string]string{
+			"volumeId":    volumeId,
+			"snapshotUri": uri,
+		},
+	})
+}
+
 func (a *AzureController) createSnapshot(ctx context.Context, req *proto.CreateSnapshotRequest) (*proto.CreateSnapshotResponse, error) {
 
 	name := fmt.Sprintf("%s-snapshot", req.Volumeid)
@@ -98,6 +118,8 @@ func (a *AzureController) createSnapshot(ctx context.Context, req *proto.CreateS
 		return nil, err
 	}
 
+	a.recordSnapshot(ctx, account, region, name, uri, req.Volumeid, req.Labels)
+
 	return &proto.CreateSnapshotResponse{Snapshotid: name, SnapshotUri: uri}, nil
 }
 
@@ -141,11 +163,14 @@ func (a *AzureController) createSnapshotAndDelete(ctx context.Context, req *prot
 	if err != nil {
 		return nil, err
 	}
+	a.recordSnapshot(ctx, account, region, name, uri, req.Volumeid, req.Labels)
+
 	a.logger.Infow("snapshot created, deleting source disk", "source", *disk.Name)
 	err = a.deleteDisk(ctx, dc, cred.ResourceGroup, req.Volumeid)
 	if err != nil {
 		return nil, err
 	}
+	a.inventory.Deleted(ctx, inventory.KindVolume, account, region, req.Volumeid)
 
 	return &proto.CreateSnapshotAndDeleteResponse{Snapshotid: name, SnapshotUri: uri}, nil
 }
@@ -153,6 +178,9 @@ func (a *AzureController) createSnapshotAndDelete(ctx context.Context, req *prot
 func (a *AzureController) deleteSnapshot(ctx context.Context, sc *compute.SnapshotsClient, groupName, snapshotId string) error {
 
 	future, err := sc.Delete(ctx, groupName, snapshotId)
+	if err != nil {
+		return errors.Wrap(err, "deleteSnapshot: aks call failed")
+	}
 	a.logger.Debugw("waiting on the delete snapshot future response")
 	err = future.WaitForCompletionRef(ctx, sc.Client)
 	if err != nil {
@@ -170,3 +198,180 @@ func (a *AzureController) deleteSnapshot(ctx context.Context, sc *compute.Snapsh
 
 	return nil
 }
+
+func (a *AzureController) listSnapshots(ctx context.Context, req *proto.ListSnapshotsRequest) (*proto.ListSnapshotsResponse, error) {
+	cred, err := getCredentials(ctx, req.AccountName)
+	if err != nil {
+		return nil, err
+	}
+	sc, err := getSnapshotClient(cred)
+	if err != nil {
+		a.logger.Errorw("failed to get the snapshot client", "error", err)
+		return nil, err
+	}
+
+	//Doc : https://docs.microsoft.com/en-us/rest/api/compute/snapshots/list-by-resource-group
+	it, err := sc.ListByResourceGroupComplete(ctx, cred.ResourceGroup)
+	if err != nil {
+		return nil, errors.Wrap(err, "listSnapshots: failed to list snapshots")
+	}
+
+	res := &proto.ListSnapshotsResponse{Snapshots: []*proto.Snapshot{}}
+	for ; it.NotDone(); err = it.NextWithContext(ctx) {
+		if err != nil {
+			return nil, errors.Wrap(err, "listSnapshots: failed to list snapshots")
+		}
+		snap := it.Value()
+		tags := aws.StringValueMap(snap.Tags)
+		if to.String(snap.Location) != req.Region || !labels.SpawnerOwned(tags) || !labels.Match(tags, req.Labels) {
+			continue
+		}
+
+		s := &proto.Snapshot{
+			SnapshotId: to.String(snap.Name),
+			Region:     req.Region,
+			Labels:     tags,
+		}
+		if p := snap.SnapshotProperties; p != nil {
+			s.Size = int64(to.Int32(p.DiskSizeGB))
+			s.State = to.String(p.ProvisioningState)
+			s.CreatedAt = diskTime(p.TimeCreated)
+			s.VolumeId = sourceID(p.CreationData)
+		}
+		res.Snapshots = append(res.Snapshots, s)
+	}
+	return res, nil
+}
+
+func (a *AzureController) deleteVolumeSnapshot(ctx context.Context, req *proto.DeleteSnapshotRequest) (*proto.DeleteSnapshotResponse, error) {
+	cred, err := getCredentials(ctx, req.AccountName)
+	if err != nil {
+		return nil, err
+	}
+	sc, err := getSnapshotClient(cred)
+	if err != nil {
+		a.logger.Errorw("failed to get the snapshot client", "error", err)
+		return nil, err
+	}
+
+	a.logger.Infow("deleting snapshot", "name", req.SnapshotId)
+	err = a.deleteSnapshot(ctx, sc, cred.ResourceGroup, req.SnapshotId)
+	if err != nil {
+		return nil, err
+	}
+	a.inventory.Deleted(ctx, inventory.KindSnapshot, req.AccountName, req.Region, req.SnapshotId)
+	return &proto.DeleteSnapshotResponse{Deleted: true}, nil
+}
+
+//defaultRestoreDiskType sku of the restored disk when not requested
+const defaultRestoreDiskType = "Standard_LRS"
+
+//snapshotTags tags of the snapshot with the extra labels added
+func snapshotTags(snap compute.Snapshot, extra map[string]string) map[string]string {
+	tags := aws.StringValueMap(snap.Tags)
+	for k, v := range extra {
+		tags[k] = v
+	}
+	return tags
+}
+
+func (a *AzureController) restoreSnapshot(ctx context.Context, req *proto.RestoreSnapshotRequest) (*proto.RestoreSnapshotResponse, error) {
+	cred, err := getCredentials(ctx, req.AccountName)
+	if err != nil {
+		return nil, err
+	}
+	sc, err := getSnapshotClient(cred)
+	if err != nil {
+		a.logger.Errorw("failed to get the snapshot client", "error", err)
+		return nil, err
+	}
+
+	//Doc : https://docs.microsoft.com/en-us/rest/api/compute/snapshots/get
+	snap, err := sc.Get(ctx, cred.ResourceGroup, req.SnapshotId)
+	if err != nil {
+		return nil, errors.Wrapf(err, "restoreSnapshot: failed to get snapshot '%s'", req.SnapshotId)
+	}
+
+	size := req.Size
+	if size == 0 && snap.SnapshotProperties != nil {
+		size = int64(to.Int32(snap.DiskSizeGB))
+	}
+	volumeType := req.Volumetype
+	if volumeType == "" {
+		volumeType = defaultRestoreDiskType
+	}
+
+	res, err := a.createVolume(ctx, &proto.CreateVolumeRequest{
+		Provider:    req.Provider,
+		Region:      req.Region,
+		AccountName: req.AccountName,
+		Volumetype:  volumeType,
+		Size:        size,
+		Snapshotid:  req.SnapshotId,
+		SnapshotUri: to.String(snap.ID),
+		Labels:      snapshotTags(snap, req.Labels),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &proto.RestoreSnapshotResponse{VolumeId: res.Volumeid, ResourceUri: res.ResourceUri}, nil
+}
+
+//copySnapshot copies the snapshot to the destination region, azure copies only incremental snapshots across regions
+func (a *AzureController) copySnapshot(ctx context.Context, req *proto.CopySnapshotRequest) (*proto.CopySnapshotResponse, error) {
+	cred, err := getCredentials(ctx, req.AccountName)
+	if err != nil {
+		return nil, err
+	}
+	sc, err := getSnapshotClient(cred)
+	if err != nil {
+		a.logger.Errorw("failed to get the snapshot client", "error", err)
+		return nil, err
+	}
+
+	snap, err := sc.Get(ctx, cred.ResourceGroup, req.SnapshotId)
+	if err != nil {
+		return nil, errors.Wrapf(err, "copySnapshot: failed to get snapshot '%s'", req.SnapshotId)
+	}
+
+	name := fmt.Sprintf("%s-%s", req.SnapshotId, req.DestinationRegion)
+	label := snapshotTags(snap, req.Labels)
+	tags := labels.DefaultTags()
+	for k, v := range label {
+		v := v
+		tags[k] = &v
+	}
+
+	// Doc : https://docs.microsoft.com/en-us/azure/virtual-machines/disks-copy-incremental-snapshot-across-regions
+	future, err := sc.CreateOrUpdate(ctx, cred.ResourceGroup, name, compute.Snapshot{
+		Location: to.StringPtr(req.DestinationRegion),
+		Tags:     tags,
+		SnapshotProperties: &compute.SnapshotProperties{
+			Incremental: to.BoolPtr(true),
+			CreationData: &compute.CreationData{
+				CreateOption:     compute.DiskCreateOptionCopyStart,
+				SourceResourceID: snap.ID,
+			},
+		},
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "copySnapshot: aks call failed")
+	}
+	operation.Report(ctx, "snapshot '%s' copy to %s requested as '%s'", req.SnapshotId, req.DestinationRegion, name)
+	err = future.WaitForCompletionRef(ctx, sc.Client)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot get the copy snapshot response")
+	}
+	res, err := future.Result(*sc)
+	if err != nil {
+		return nil, err
+	}
+
+	volumeId := ""
+	if snap.SnapshotProperties != nil {
+		volumeId = sourceID(snap.CreationData)
+	}
+	uri := to.String(res.ID)
+	a.recordSnapshot(ctx, req.AccountName, req.DestinationRegion, name, uri, volumeId, label)
+	return &proto.CopySnapshotResponse{SnapshotId: name, Region: req.DestinationRegion, SnapshotUri: uri}, nil
+}
@@ -0,0 +1,89 @@
+package azure
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/pkg/errors"
+	"gitlab.com/netbook-devs/spawner-service/pkg/inventory"
+	"gitlab.com/netbook-devs/spawner-service/pkg/operation"
+	"gitlab.com/netbook-devs/spawner-service/pkg/service/common"
+	proto "gitlab.com/netbook-devs/spawner-service/proto/netbookai/spawner"
+)
+
+func (a *AzureController) listKubernetesVersions(ctx context.Context, req *proto.ListKubernetesVersionsRequest) (*proto.ListKubernetesVersionsResponse, error) {
+	cred, err := getCredentials(ctx, req.AccountName)
+	if err != nil {
+		return nil, err
+	}
+	client, err := getOrchestratorsClient(cred)
+	if err != nil {
+		return nil, errors.Wrap(err, "listKubernetesVersions: cannot get container services client")
+	}
+
+	//Doc : https://docs.microsoft.com/en-us/rest/api/container-service/container-services/list-orchestrators
+	result, err := client.ListOrchestrators(ctx, req.Region, "managedClusters")
+	if err != nil {
+		a.logger.Errorw("failed to list orchestrators", "region", req.Region, "error", err)
+		return nil, err
+	}
+
+	res := &proto.ListKubernetesVersionsResponse{}
+	if result.OrchestratorVersionProfileProperties == nil || result.Orchestrators == nil {
+		return res, nil
+	}
+	for _, o := range *result.Orchestrators {
+		if aws.StringValue(o.OrchestratorType) != "Kubernetes" || aws.BoolValue(o.IsPreview) {
+			continue
+		}
+		res.Versions = append(res.Versions, aws.StringValue(o.OrchestratorVersion))
+		if aws.BoolValue(o.Default) {
+			res.DefaultVersion = aws.StringValue(o.OrchestratorVersion)
+		}
+	}
+	common.SortVersions(res.Versions)
+	return res, nil
+}
+
+//upgradeCluster upgrades the control plane, agent pools keep their orchestrator version
+func (a *AzureController) upgradeCluster(ctx context.Context, req *proto.UpgradeClusterRequest) (*proto.UpgradeClusterResponse, error) {
+	cred, err := getCredentials(ctx, req.AccountName)
+	if err != nil {
+		return nil, err
+	}
+	aksClient, err := getAKSClient(cred)
+	if err != nil {
+		return nil, errors.Wrap(err, "upgradeCluster: cannot get AKS client")
+	}
+
+	mc, err := aksClient.Get(ctx, cred.ResourceGroup, req.ClusterName)
+	if err != nil {
+		a.logger.Errorw("failed to get cluster", "cluster", req.ClusterName, "error", err)
+		return nil, err
+	}
+	if mc.ManagedClusterProperties == nil {
+		return nil, fmt.Errorf("cluster '%s' has no properties", req.ClusterName)
+	}
+
+	//control plane only is upgraded as long as agent pool profiles keep their orchestrator version
+	//Doc : https://docs.microsoft.com/en-us/azure/aks/upgrade-cluster
+	mc.KubernetesVersion = &req.KubernetesVersion
+	future, err := aksClient.CreateOrUpdate(ctx, cred.ResourceGroup, req.ClusterName, mc)
+	if err != nil {
+		a.logger.Errorw("failed to upgrade cluster", "cluster", req.ClusterName, "version", req.KubernetesVersion, "error", err)
+		return nil, errors.Wrapf(err, "failed to upgrade cluster '%s'", req.ClusterName)
+	}
+	operation.Report(ctx, "control plane upgrade to %s requested", req.KubernetesVersion)
+
+	err = future.WaitForCompletionRef(ctx, aksClient.Client)
+	if err != nil {
+		a.logger.Errorw("failed to upgrade cluster", "cluster", req.ClusterName, "error", err)
+		return nil, errors.Wrapf(err, "failed to upgrade cluster '%s'", req.ClusterName)
+	}
+	operation.Report(ctx, "control plane upgraded to %s", req.KubernetesVersion)
+
+	a.inventory.Updated(ctx, inventory.KindCluster, req.AccountName, req.Region, req.ClusterName, nil,
+		map[string]string{"kubernetesVersion": req.KubernetesVersion})
+	return &proto.UpgradeClusterResponse{}, nil
+}
@@ -4,11 +4,17 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"path"
+	"strconv"
 	"time"
 
 	"github.com/Azure/azure-sdk-for-go/profiles/latest/compute/mgmt/compute"
+	"github.com/Azure/go-autorest/autorest/date"
 	"github.com/Azure/go-autorest/autorest/to"
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/pkg/errors"
+	"gitlab.com/netbook-devs/spawner-service/pkg/inventory"
+	"gitlab.com/netbook-devs/spawner-service/pkg/operation"
 	"gitlab.com/netbook-devs/spawner-service/pkg/service/labels"
 	proto "gitlab.com/netbook-devs/spawner-service/proto/netbookai/spawner"
 )
@@ -48,6 +54,10 @@ func (a *AzureController) createVolume(ctx context.Context, req *proto.CreateVol
 	size := int32(req.GetSize())
 	name := diskName(size)
 	tags := labels.DefaultTags()
+	for k, v := range req.Labels {
+		v := v
+		tags[k] = &v
+	}
 
 	a.logger.Infow("creating disk", "name", name, "size", req.Size)
 
@@ -91,6 +101,7 @@ func (a *AzureController) createVolume(ctx context.Context, req *proto.CreateVol
 	}
 
 	a.logger.Debugw("waiting on the future response")
+	operation.Report(ctx, "disk '%s' creation requested", name)
 	err = future.WaitForCompletionRef(ctx, disksClient.Client)
 	if err != nil {
 		return nil, errors.Wrap(err, "cannot get the disk create or update future response")
@@ -105,6 +116,20 @@ func (a *AzureController) createVolume(ctx context.Context, req *proto.CreateVol
 		ResourceUri: *res.ID,
 		Volumeid:    name,
 	}
+	a.inventory.Created(ctx, inventory.Resource{
+		ID:      name,
+		Kind:    inventory.KindVolume,
+		Account: account,
+		Region:  req.Region,
+		Name:    name,
+		Labels:  req.Labels,
+		Attributes: map[string]string{
+			"size":        strconv.Itoa(int(size)),
+			"volumeType":  req.Volumetype,
+			"resourceUri": *res.ID,
+			"snapshotId":  req.Snapshotid,
+		},
+	})
 
 	if req.DeleteSnapshot {
 		//spawn a routine and let it delete
@@ -127,6 +152,7 @@ func (a *AzureController) createVolume(ctx context.Context, req *proto.CreateVol
 				return
 			}
 			a.logger.Infow("snapshot deleted", "ID", req.Snapshotid)
+			a.inventory.Deleted(ctx, inventory.KindSnapshot, account, req.Region, req.Snapshotid)
 		}()
 	}
 	return ret, nil
@@ -183,5 +209,146 @@ func (a *AzureController) deleteVolume(ctx context.Context, req *proto.DeleteVol
 	if err != nil {
 		return nil, err
 	}
+	a.inventory.Deleted(ctx, inventory.KindVolume, account, req.Region, name)
 	return &proto.DeleteVolumeResponse{Deleted: true}, nil
 }
+
+//diskTime creation time of the disk or snapshot, empty when not reported
+func diskTime(t *date.Time) string {
+	if t == nil {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339)
+}
+
+//sourceID name of the disk or snapshot the resource is created from
+func sourceID(c *compute.CreationData) string {
+	if c == nil {
+		return ""
+	}
+	if c.SourceResourceID != nil {
+		return path.Base(*c.SourceResourceID)
+	}
+	return path.Base(to.String(c.SourceURI))
+}
+
+func (a *AzureController) listVolumes(ctx context.Context, req *proto.ListVolumesRequest) (*proto.ListVolumesResponse, error) {
+	cred, err := getCredentials(ctx, req.AccountName)
+	if err != nil {
+		return nil, err
+	}
+	disksClient, err := getDisksClient(cred)
+	if err != nil {
+		a.logger.Errorw("failed to get the disk client", "error", err)
+		return nil, err
+	}
+
+	//Doc : https://docs.microsoft.com/en-us/rest/api/compute/disks/list-by-resource-group
+	it, err := disksClient.ListByResourceGroupComplete(ctx, cred.ResourceGroup)
+	if err != nil {
+		return nil, errors.Wrap(err, "listVolumes: failed to list disks")
+	}
+
+	res := &proto.ListVolumesResponse{Volumes: []*proto.Volume{}}
+	for ; it.NotDone(); err = it.NextWithContext(ctx) {
+		if err != nil {
+			return nil, errors.Wrap(err, "listVolumes: failed to list disks")
+		}
+		disk := it.Value()
+		tags := aws.StringValueMap(disk.Tags)
+		if to.String(disk.Location) != req.Region || !labels.SpawnerOwned(tags) || !labels.Match(tags, req.Labels) {
+			continue
+		}
+
+		res.Volumes = append(res.Volumes, volumeProto(disk))
+	}
+	return res, nil
+}
+
+func volumeProto(disk compute.Disk) *proto.Volume {
+	v := &proto.Volume{
+		VolumeId:    to.String(disk.Name),
+		Region:      to.String(disk.Location),
+		Labels:      aws.StringValueMap(disk.Tags),
+		Attachments: []string{},
+	}
+	if disk.Zones != nil && len(*disk.Zones) > 0 {
+		v.Availabilityzone = (*disk.Zones)[0]
+	}
+	if disk.Sku != nil {
+		v.Volumetype = string(disk.Sku.Name)
+	}
+	if disk.ManagedBy != nil {
+		v.Attachments = append(v.Attachments, path.Base(*disk.ManagedBy))
+	}
+	if p := disk.DiskProperties; p != nil {
+		v.Size = int64(to.Int32(p.DiskSizeGB))
+		v.State = string(p.DiskState)
+		v.CreatedAt = diskTime(p.TimeCreated)
+		v.SnapshotId = sourceID(p.CreationData)
+	}
+	return v
+}
+
+func (a *AzureController) getVolume(ctx context.Context, req *proto.GetVolumeRequest) (*proto.Volume, error) {
+	cred, err := getCredentials(ctx, req.AccountName)
+	if err != nil {
+		return nil, err
+	}
+	disksClient, err := getDisksClient(cred)
+	if err != nil {
+		a.logger.Errorw("failed to get the disk client", "error", err)
+		return nil, err
+	}
+
+	//Doc : https://docs.microsoft.com/en-us/rest/api/compute/disks/get
+	disk, err := disksClient.Get(ctx, cred.ResourceGroup, req.VolumeId)
+	if err != nil {
+		return nil, errors.Wrapf(err, "getVolume: failed to get disk '%s'", req.VolumeId)
+	}
+	return volumeProto(disk), nil
+}
+
+func (a *AzureController) resizeVolume(ctx context.Context, req *proto.ResizeVolumeRequest) (*proto.ResizeVolumeResponse, error) {
+	cred, err := getCredentials(ctx, req.AccountName)
+	if err != nil {
+		return nil, err
+	}
+	disksClient, err := getDisksClient(cred)
+	if err != nil {
+		a.logger.Errorw("failed to get the disk client", "error", err)
+		return nil, err
+	}
+
+	disk, err := disksClient.Get(ctx, cred.ResourceGroup, req.VolumeId)
+	if err != nil {
+		return nil, errors.Wrapf(err, "resizeVolume: failed to get disk '%s'", req.VolumeId)
+	}
+	current := int64(0)
+	if disk.DiskProperties != nil {
+		current = int64(to.Int32(disk.DiskProperties.DiskSizeGB))
+	}
+	if req.Size <= current {
+		return nil, errors.Errorf("disk '%s' is %dGiB, new size must be larger", req.VolumeId, current)
+	}
+
+	size := int32(req.Size)
+	a.logger.Infow("resizing disk", "name", req.VolumeId, "size", size)
+	//Doc : https://docs.microsoft.com/en-us/rest/api/compute/disks/update
+	future, err := disksClient.Update(ctx, cred.ResourceGroup, req.VolumeId, compute.DiskUpdate{
+		DiskUpdateProperties: &compute.DiskUpdateProperties{DiskSizeGB: &size},
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "resizeVolume: aks call failed")
+	}
+
+	operation.Report(ctx, "disk '%s' resize to %dGiB requested", req.VolumeId, size)
+	err = future.WaitForCompletionRef(ctx, disksClient.Client)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot get the disk update response")
+	}
+
+	a.inventory.Updated(ctx, inventory.KindVolume, req.AccountName, req.Region, req.VolumeId, nil,
+		map[string]string{"size": strconv.FormatInt(req.Size, 10)})
+	return &proto.ResizeVolumeResponse{VolumeId: req.VolumeId, Size: req.Size}, nil
+}
@@ -0,0 +1,197 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	"gitlab.com/netbook-devs/spawner-service/pkg/auth"
+	"gitlab.com/netbook-devs/spawner-service/pkg/backup"
+	proto "gitlab.com/netbook-devs/spawner-service/proto/netbookai/spawner"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const defaultSnapshotPolicyCheckInterval = 5 * time.Minute
+
+//policyBackend runs the snapshot policies through the snapshot rpcs, snapshots taken, copied and deleted show up as operations
+type policyBackend struct {
+	s *spawnerService
+}
+
+//Volumes volumes of the policy region matching its selector
+func (b policyBackend) Volumes(ctx context.Context, p backup.Policy) ([]*proto.Volume, error) {
+	res, err := b.s.ListVolumes(ctx, &proto.ListVolumesRequest{Provider: p.Provider, Region: p.Region, AccountName: p.Account, Labels: p.Selector})
+	if err != nil {
+		return nil, err
+	}
+	return res.Volumes, nil
+}
+
+func (b policyBackend) Snapshots(ctx context.Context, p backup.Policy, region string) ([]*proto.Snapshot, error) {
+	res, err := b.s.ListSnapshots(ctx, &proto.ListSnapshotsRequest{Provider: p.Provider, Region: region, AccountName: p.Account,
+		Labels: map[string]string{backup.PolicyLabel: p.Name}})
+	if err != nil {
+		return nil, err
+	}
+	return res.Snapshots, nil
+}
+
+func (b policyBackend) Snapshot(ctx context.Context, p backup.Policy, v *proto.Volume, labels map[string]string) (string, error) {
+	res, err := b.s.CreateSnapshot(ctx, &proto.CreateSnapshotRequest{Provider: p.Provider, Region: p.Region, AccountName: p.Account, Volumeid: v.VolumeId, Labels: labels})
+	if err != nil {
+		return "", err
+	}
+	result, err := b.s.waitOperation(ctx, res.OperationId)
+	if err != nil {
+		return "", err
+	}
+	return result.(*proto.CreateSnapshotResponse).GetSnapshotid(), nil
+}
+
+func (b policyBackend) Copy(ctx context.Context, p backup.Policy, snapshotId string) (string, error) {
+	res, err := b.s.CopySnapshot(ctx, &proto.CopySnapshotRequest{Provider: p.Provider, Region: p.Region, AccountName: p.Account, SnapshotId: snapshotId, DestinationRegion: p.CopyRegion})
+	if err != nil {
+		return "", err
+	}
+	result, err := b.s.waitOperation(ctx, res.OperationId)
+	if err != nil {
+		return "", err
+	}
+	return result.(*proto.CopySnapshotResponse).GetSnapshotId(), nil
+}
+
+func (b policyBackend) Delete(ctx context.Context, p backup.Policy, region, snapshotId string) error {
+	res, err := b.s.DeleteSnapshot(ctx, &proto.DeleteSnapshotRequest{Provider: p.Provider, Region: region, AccountName: p.Account, SnapshotId: snapshotId})
+	if err != nil {
+		return err
+	}
+	_, err = b.s.waitOperation(ctx, res.OperationId)
+	return err
+}
+
+func snapshotPolicyError(err error) error {
+	switch {
+	case errors.Is(err, backup.ERR_NOT_FOUND):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, backup.ERR_INVALID_POLICY):
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	return err
+}
+
+func snapshotPolicyProto(p backup.Policy) *proto.SnapshotPolicy {
+	res := &proto.SnapshotPolicy{
+		Name:        p.Name,
+		Provider:    p.Provider,
+		Region:      p.Region,
+		AccountName: p.Account,
+		Selector:    p.Selector,
+		Frequency:   string(p.Frequency),
+		RetainCount: int32(p.RetainCount),
+		RetainHours: p.RetainHours,
+		CopyRegion:  p.CopyRegion,
+	}
+	if !p.UpdatedAt.IsZero() {
+		res.UpdatedAt = p.UpdatedAt.Format(time.RFC3339)
+	}
+	return res
+}
+
+func snapshotPolicyStatusProto(st backup.Status) *proto.SnapshotPolicyStatus {
+	res := &proto.SnapshotPolicyStatus{
+		Policy:    snapshotPolicyProto(st.Policy),
+		Snapshots: st.Snapshots,
+		Copies:    st.Copies,
+		Pruned:    st.Pruned,
+		Error:     st.Error,
+	}
+	if !st.LastRunAt.IsZero() {
+		res.LastRunAt = st.LastRunAt.Format(time.RFC3339)
+	}
+	if next := st.NextRunAt(); !next.IsZero() {
+		res.NextRunAt = next.Format(time.RFC3339)
+	}
+	return res
+}
+
+//CreateSnapshotPolicy creates or replaces the snapshot policy, policy runs right away unless its period has not passed
+func (s *spawnerService) CreateSnapshotPolicy(ctx context.Context, req *proto.CreateSnapshotPolicyRequest) (*proto.SnapshotPolicy, error) {
+	p := req.GetPolicy()
+	if p == nil {
+		return nil, status.Error(codes.InvalidArgument, "policy is required")
+	}
+	if err := auth.Authorize(ctx, "CreateSnapshotPolicy", p.Provider, p.AccountName); err != nil {
+		return nil, err
+	}
+	if _, err := s.controller(p.Provider, CapVolumes, CapSnapshots); err != nil {
+		return nil, err
+	}
+	saved, err := s.snapshotPolicies.Set(ctx, backup.Policy{
+		Name:        p.Name,
+		Provider:    p.Provider,
+		Account:     p.AccountName,
+		Region:      p.Region,
+		Selector:    p.Selector,
+		Frequency:   backup.Frequency(p.Frequency),
+		RetainCount: int(p.RetainCount),
+		RetainHours: p.RetainHours,
+		CopyRegion:  p.CopyRegion,
+	})
+	if err != nil {
+		s.logger.Errorw("failed to create snapshot policy", "policy", p.Name, "error", err)
+		return nil, snapshotPolicyError(err)
+	}
+
+	go s.snapshotPolicies.Check(context.Background(), saved)
+	return snapshotPolicyProto(saved), nil
+}
+
+//DeleteSnapshotPolicy removes the snapshot policy, snapshots taken by it are kept
+func (s *spawnerService) DeleteSnapshotPolicy(ctx context.Context, req *proto.DeleteSnapshotPolicyRequest) (*proto.DeleteSnapshotPolicyResponse, error) {
+	st, err := s.snapshotPolicies.Status(ctx, req.GetName())
+	if err != nil {
+		return nil, snapshotPolicyError(err)
+	}
+	if err := auth.Authorize(ctx, "DeleteSnapshotPolicy", st.Policy.Provider, st.Policy.Account); err != nil {
+		return nil, err
+	}
+
+	if err := s.snapshotPolicies.Delete(ctx, req.GetName()); err != nil {
+		return nil, snapshotPolicyError(err)
+	}
+	return &proto.DeleteSnapshotPolicyResponse{}, nil
+}
+
+//ListSnapshotPolicies returns the snapshot policies and the result of their last run, all policies when name is not given
+func (s *spawnerService) ListSnapshotPolicies(ctx context.Context, req *proto.ListSnapshotPoliciesRequest) (*proto.ListSnapshotPoliciesResponse, error) {
+	var statuses []backup.Status
+	if req.GetName() != "" {
+		st, err := s.snapshotPolicies.Status(ctx, req.GetName())
+		if err != nil {
+			return nil, snapshotPolicyError(err)
+		}
+		if err := auth.Authorize(ctx, "ListSnapshotPolicies", st.Policy.Provider, st.Policy.Account); err != nil {
+			return nil, err
+		}
+		statuses = []backup.Status{st}
+	} else {
+		var err error
+		statuses, err = s.snapshotPolicies.List(ctx)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	res := &proto.ListSnapshotPoliciesResponse{
+		Policies: make([]*proto.SnapshotPolicyStatus, 0, len(statuses)),
+	}
+	for _, st := range statuses {
+		//policies of the accounts caller can not access are left out
+		if auth.Authorize(ctx, "ListSnapshotPolicies", st.Policy.Provider, st.Policy.Account) != nil {
+			continue
+		}
+		res.Policies = append(res.Policies, snapshotPolicyStatusProto(st))
+	}
+	return res, nil
+}
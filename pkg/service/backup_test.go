@@ -0,0 +1,55 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gitlab.com/netbook-devs/spawner-service/pkg/backup"
+	proto "gitlab.com/netbook-devs/spawner-service/proto/netbookai/spawner"
+)
+
+func Test_SnapshotPolicyBackend(t *testing.T) {
+	s, controller := newTestService(t, testOptions{})
+	ctx := context.Background()
+
+	_, err := controller.CreateVolume(ctx, &proto.CreateVolumeRequest{Provider: "fake", Region: "local", AccountName: "dev", Availabilityzone: "local-a", Size: 10,
+		Labels: map[string]string{"backup": "true", "workspaceid": "ws-1"}})
+	require.NoError(t, err)
+	_, err = controller.CreateVolume(ctx, &proto.CreateVolumeRequest{Provider: "fake", Region: "local", AccountName: "dev", Availabilityzone: "local-a", Size: 10})
+	require.NoError(t, err)
+
+	runner := backup.NewRunner(s.logger, backup.NewMemoryStore(), policyBackend{s: s})
+	p, err := runner.Set(ctx, backup.Policy{Name: "nightly", Provider: "fake", Account: "dev", Region: "local", CopyRegion: "remote",
+		Selector: map[string]string{"backup": "true"}, Frequency: backup.Daily, RetainCount: 1})
+	require.NoError(t, err)
+
+	st := runner.Execute(ctx, p)
+	require.Empty(t, st.Error)
+	require.Len(t, st.Snapshots, 1, "only the selected volume is snapshotted")
+	require.Len(t, st.Copies, 1)
+
+	res, err := s.ListSnapshots(ctx, &proto.ListSnapshotsRequest{Provider: "fake", Region: "remote", AccountName: "dev", Workspace: "ws-1"})
+	require.NoError(t, err)
+	require.Len(t, res.Snapshots, 1)
+	assert.Equal(t, "nightly", res.Snapshots[0].Labels[backup.PolicyLabel])
+
+	//previous snapshots are over the retention count
+	st = runner.Execute(ctx, p)
+	require.Empty(t, st.Error)
+	assert.ElementsMatch(t, []string{st.Snapshots[0], st.Copies[0]}, snapshotIds(t, s, "local", "remote"))
+	assert.Len(t, st.Pruned, 2)
+}
+
+func snapshotIds(t *testing.T, s *spawnerService, regions ...string) []string {
+	ids := []string{}
+	for _, region := range regions {
+		res, err := s.ListSnapshots(context.Background(), &proto.ListSnapshotsRequest{Provider: "fake", Region: region, AccountName: "dev"})
+		require.NoError(t, err)
+		for _, snap := range res.Snapshots {
+			ids = append(ids, snap.SnapshotId)
+		}
+	}
+	return ids
+}
@@ -0,0 +1,220 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	"gitlab.com/netbook-devs/spawner-service/pkg/auth"
+	"gitlab.com/netbook-devs/spawner-service/pkg/budget"
+	"gitlab.com/netbook-devs/spawner-service/pkg/inventory"
+	"gitlab.com/netbook-devs/spawner-service/pkg/quota"
+	proto "gitlab.com/netbook-devs/spawner-service/proto/netbookai/spawner"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const defaultBudgetEvaluationInterval = time.Hour
+
+//scaledDownFromAttribute node pool size before it was scaled to zero by the budget enforcement
+const scaledDownFromAttribute = "scaledDownFrom"
+
+func budgetEvaluationInterval(minutes int) time.Duration {
+	if minutes > 0 {
+		return time.Duration(minutes) * time.Minute
+	}
+	return defaultBudgetEvaluationInterval
+}
+
+//workspaceCost month to date cost of the budgeted workspace from the provider cost api
+func (s *spawnerService) workspaceCost(ctx context.Context, b budget.Budget, start, end time.Time) (float64, error) {
+	provider, err := s.controller(b.Provider, CapCost)
+	if err != nil {
+		return 0, err
+	}
+	res, err := provider.GetWorkspacesCost(ctx, &proto.GetWorkspacesCostRequest{
+		WorkspaceIds: []string{b.Workspace},
+		Provider:     b.Provider,
+		AccountName:  b.Account,
+		StartDate:    start.Format("2006-01-02"),
+		EndDate:      end.Format("2006-01-02"),
+		Granularity:  "MONTHLY",
+		CostType:     "UnblendedCost",
+		GroupBy:      &proto.GroupBy{Type: "DIMENSION", Key: "SERVICE"},
+	})
+	if err != nil {
+		return 0, err
+	}
+	return res.TotalCost, nil
+}
+
+//scaleDownGPU scales the gpu node pools of the workspace recorded in the inventory to zero as ScaleNodePool operations,
+//node pools are kept and their previous size is recorded as `scaledDownFrom` attribute so that it can be restored
+func (s *spawnerService) scaleDownGPU(ctx context.Context, b budget.Budget) ([]string, error) {
+	pools, err := s.inventory.List(ctx, inventory.Filter{Workspace: b.Workspace, Kind: inventory.KindNodePool})
+	if err != nil {
+		return nil, err
+	}
+
+	scaled := []string{}
+	for _, np := range pools {
+		if quota.PoolResources(np, 1).GPUs == 0 || np.Attributes["count"] == "0" {
+			continue
+		}
+		provider, err := s.controller(np.Provider, CapNodePools)
+		if err != nil {
+			return scaled, err
+		}
+		inventory.NewRecorder(s.inventory, np.Provider, s.logger).Updated(ctx, inventory.KindNodePool, np.Account, np.Region, np.ID, nil,
+			map[string]string{scaledDownFromAttribute: np.Attributes["count"]})
+
+		req := &proto.ScaleNodePoolRequest{
+			Provider:      np.Provider,
+			Region:        np.Region,
+			AccountName:   np.Account,
+			ClusterName:   np.Cluster,
+			NodeGroupName: np.Name,
+			Count:         0,
+			MinCount:      0,
+		}
+		s.operations.Start(ctx, operationMeta("ScaleNodePool", np.Provider, np.Account, np.Region, np.ID), func(ctx context.Context) (interface{}, error) {
+			return provider.ScaleNodePool(ctx, req)
+		})
+		scaled = append(scaled, np.ID)
+	}
+	return scaled, nil
+}
+
+//checkBudget refuses new resources in the workspace over its hard budget threshold
+func (s *spawnerService) checkBudget(workspace string) error {
+	if err := s.budgets.Check(workspace); err != nil {
+		return status.Error(codes.FailedPrecondition, err.Error())
+	}
+	return nil
+}
+
+func budgetError(err error) error {
+	switch {
+	case errors.Is(err, budget.ERR_NOT_FOUND):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, budget.ERR_INVALID_BUDGET):
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	return err
+}
+
+func budgetProto(b budget.Budget) *proto.Budget {
+	res := &proto.Budget{
+		Workspace:     b.Workspace,
+		Provider:      b.Provider,
+		AccountName:   b.Account,
+		MonthlyLimit:  b.MonthlyLimit,
+		SoftThreshold: b.SoftThreshold,
+		HardThreshold: b.HardThreshold,
+		ScaleDownGPU:  b.ScaleDownGPU,
+	}
+	if !b.UpdatedAt.IsZero() {
+		res.UpdatedAt = b.UpdatedAt.Format(time.RFC3339)
+	}
+	return res
+}
+
+func budgetStatusProto(st budget.Status) *proto.BudgetStatus {
+	res := &proto.BudgetStatus{
+		Budget:     budgetProto(st.Budget),
+		State:      string(st.State),
+		Spent:      st.Spent,
+		Error:      st.Error,
+		ScaledDown: st.ScaledDown,
+	}
+	if !st.EvaluatedAt.IsZero() {
+		res.EvaluatedAt = st.EvaluatedAt.Format(time.RFC3339)
+	}
+	return res
+}
+
+//SetBudget creates or replaces the monthly budget of the workspace
+func (s *spawnerService) SetBudget(ctx context.Context, req *proto.SetBudgetRequest) (*proto.Budget, error) {
+	b := req.GetBudget()
+	if b == nil {
+		return nil, status.Error(codes.InvalidArgument, "budget is required")
+	}
+	if err := auth.Authorize(ctx, "SetBudget", b.Provider, b.AccountName); err != nil {
+		return nil, err
+	}
+	saved, err := s.budgets.Set(ctx, budget.Budget{
+		Workspace:     b.Workspace,
+		Provider:      b.Provider,
+		Account:       b.AccountName,
+		MonthlyLimit:  b.MonthlyLimit,
+		SoftThreshold: b.SoftThreshold,
+		HardThreshold: b.HardThreshold,
+		ScaleDownGPU:  b.ScaleDownGPU,
+	})
+	if err != nil {
+		s.logger.Errorw("failed to set budget", "workspace", b.Workspace, "error", err)
+		return nil, budgetError(err)
+	}
+
+	//evaluate right away so that the new limits are enforced without waiting for next run
+	go s.budgets.Evaluate(context.Background(), saved)
+	return budgetProto(saved), nil
+}
+
+//authorizedBudget status of the workspace budget the caller may call method on. Missing budget is reported only
+//to the callers allowed on every provider account, others are denied so that they can not probe the workspaces
+func (s *spawnerService) authorizedBudget(ctx context.Context, method, workspace string) (budget.Status, error) {
+	st, err := s.budgets.Status(ctx, workspace)
+	if err != nil {
+		if err := auth.Authorize(ctx, method, "", ""); err != nil {
+			return budget.Status{}, err
+		}
+		return budget.Status{}, budgetError(err)
+	}
+	if err := auth.Authorize(ctx, method, st.Budget.Provider, st.Budget.Account); err != nil {
+		return budget.Status{}, err
+	}
+	return st, nil
+}
+
+//DeleteBudget removes the budget of the workspace, workspace is no longer limited
+func (s *spawnerService) DeleteBudget(ctx context.Context, req *proto.DeleteBudgetRequest) (*proto.DeleteBudgetResponse, error) {
+	if _, err := s.authorizedBudget(ctx, "DeleteBudget", req.GetWorkspace()); err != nil {
+		return nil, err
+	}
+
+	if err := s.budgets.Delete(ctx, req.GetWorkspace()); err != nil {
+		return nil, budgetError(err)
+	}
+	return &proto.DeleteBudgetResponse{}, nil
+}
+
+//GetBudgetStatus returns the spend and state of the workspace budget, all budgets when workspace is not given
+func (s *spawnerService) GetBudgetStatus(ctx context.Context, req *proto.GetBudgetStatusRequest) (*proto.GetBudgetStatusResponse, error) {
+	var statuses []budget.Status
+	if req.GetWorkspace() != "" {
+		st, err := s.authorizedBudget(ctx, "GetBudgetStatus", req.GetWorkspace())
+		if err != nil {
+			return nil, err
+		}
+		statuses = []budget.Status{st}
+	} else {
+		var err error
+		statuses, err = s.budgets.List(ctx)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	res := &proto.GetBudgetStatusResponse{
+		Status: make([]*proto.BudgetStatus, 0, len(statuses)),
+	}
+	for _, st := range statuses {
+		//budgets of the accounts caller can not access are left out
+		if auth.Authorize(ctx, "GetBudgetStatus", st.Budget.Provider, st.Budget.Account) != nil {
+			continue
+		}
+		res.Status = append(res.Status, budgetStatusProto(st))
+	}
+	return res, nil
+}
@@ -0,0 +1,71 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gitlab.com/netbook-devs/spawner-service/pkg/auth"
+	"gitlab.com/netbook-devs/spawner-service/pkg/budget"
+	"gitlab.com/netbook-devs/spawner-service/pkg/inventory"
+	"gitlab.com/netbook-devs/spawner-service/pkg/operation"
+	proto "gitlab.com/netbook-devs/spawner-service/proto/netbookai/spawner"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func Test_ScaleDownGPU(t *testing.T) {
+	ctx := context.Background()
+	store := inventory.NewMemoryStore()
+	for name, machineType := range map[string]string{"gpu": "m+t4", "cpu": "m"} {
+		require.NoError(t, store.Put(ctx, inventory.Resource{
+			ID: inventory.NodePoolID("dev", name), Kind: inventory.KindNodePool, Provider: "fake", Account: "dev", Region: "local",
+			Cluster: "dev", Name: name, Workspace: "ws-1", Attributes: map[string]string{"count": "2", "machineType": machineType},
+		}))
+	}
+	s, _ := newTestService(t, testOptions{store: store})
+
+	scaled, err := s.scaleDownGPU(ctx, budget.Budget{Workspace: "ws-1", Provider: "fake", Account: "dev"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"dev/gpu"}, scaled)
+
+	ops := s.operations.List(operation.Filter{})
+	require.Len(t, ops, 1)
+	assert.Equal(t, "ScaleNodePool", ops[0].Type, "gpu node pools are scaled to zero, not deleted")
+
+	np, err := store.Get(ctx, inventory.Key{Provider: "fake", Account: "dev", Region: "local", Kind: inventory.KindNodePool, ID: "dev/gpu"})
+	require.NoError(t, err)
+	assert.False(t, np.Deleted())
+	assert.Equal(t, "2", np.Attributes[scaledDownFromAttribute])
+}
+
+//subjectAuthenticator authenticates every call as the subject
+type subjectAuthenticator string
+
+func (a subjectAuthenticator) Authenticate(ctx context.Context) (*auth.Identity, error) {
+	return &auth.Identity{Subject: string(a), Method: "test"}, nil
+}
+
+func Test_DeleteBudgetAuthorization(t *testing.T) {
+	logger := zap.NewNop().Sugar()
+	s := &spawnerService{budgets: budget.NewEvaluator(logger, budget.NewMemoryStore(), nil, nil), logger: logger}
+	policy := &auth.Policy{Rules: []auth.Rule{
+		{Subjects: []string{"team"}, Providers: []string{"aws"}, Accounts: []string{"dev"}, Methods: []string{"DeleteBudget"}},
+		{Subjects: []string{"admin"}, Providers: []string{"*"}, Accounts: []string{"*"}, Methods: []string{"*"}},
+	}}
+	deleteAs := func(subject string) error {
+		guard := auth.NewGuard(logger, policy, []auth.Authenticator{subjectAuthenticator(subject)})
+		_, err := guard.UnaryServerInterceptor()(context.Background(), &proto.DeleteBudgetRequest{Workspace: "ws-missing"},
+			&grpc.UnaryServerInfo{FullMethod: "/spawnerservice.SpawnerService/DeleteBudget"},
+			func(ctx context.Context, req interface{}) (interface{}, error) {
+				return s.DeleteBudget(ctx, req.(*proto.DeleteBudgetRequest))
+			})
+		return err
+	}
+
+	assert.Equal(t, codes.PermissionDenied, status.Code(deleteAs("team")), "missing budget is not revealed to account scoped callers")
+	assert.Equal(t, codes.NotFound, status.Code(deleteAs("admin")))
+}
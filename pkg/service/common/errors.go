@@ -0,0 +1,14 @@
+package common
+
+import "github.com/pkg/errors"
+
+//errors shared by the provider controllers, matched by the service with errors.Is
+
+//ERR_CLUSTER_NOT_FOUND cluster does not exist on the provider, other errors of ClusterStatus leave the existence unknown
+var ERR_CLUSTER_NOT_FOUND = errors.New("cluster not found")
+
+//ERR_NO_SPOT_CAPACITY node pool could not get spot nodes for its instance types
+var ERR_NO_SPOT_CAPACITY = errors.New("no spot capacity")
+
+//ERR_CAPACITY_TIMEOUT on-demand node pool did not get its nodes within the fallback wait, there is nothing left to fall back to
+var ERR_CAPACITY_TIMEOUT = errors.New("node pool not ready in time")
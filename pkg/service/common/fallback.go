@@ -0,0 +1,113 @@
+package common
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"gitlab.com/netbook-devs/spawner-service/pkg/operation"
+	proto "gitlab.com/netbook-devs/spawner-service/proto/netbookai/spawner"
+)
+
+//DefaultFallbackWait time the node pool may stay without nodes when the policy sets no wait
+const DefaultFallbackWait = 10 * time.Minute
+
+//capacityErrors reported by the providers when the instances can not be launched for the lack of capacity
+var capacityErrors = []string{
+	"InsufficientInstanceCapacity",
+	"no Spot capacity",
+	"SpotMaxPriceTooLow",
+	"MaxSpotInstanceCountExceeded",
+	"UnfulfillableCapacity",
+}
+
+//IsCapacityError check if the health issue or error message reports missing capacity
+func IsCapacityError(msg string) bool {
+	for _, e := range capacityErrors {
+		if strings.Contains(msg, e) {
+			return true
+		}
+	}
+	return false
+}
+
+//CapacityAttempt capacity type and instance types the node pool is created with
+type CapacityAttempt struct {
+	Spot      bool
+	Instances []string
+}
+
+func (a CapacityAttempt) String() string {
+	kind := "on-demand"
+	if a.Spot {
+		kind = "spot"
+	}
+	return fmt.Sprintf("%s %s", kind, strings.Join(a.Instances, "/"))
+}
+
+//FallbackAttempts requested spot instances followed by the alternate spot instances and on-demand instance of the fallback policy,
+//instance is the on-demand instance type of the spec, first spot instance is used when it is empty
+func FallbackAttempts(spec *proto.NodeSpec, instance string) []CapacityAttempt {
+	attempts := []CapacityAttempt{{Spot: true, Instances: spec.SpotInstances}}
+	policy := spec.GetSpotFallback()
+	for _, i := range policy.GetAlternateInstances() {
+		attempts = append(attempts, CapacityAttempt{Spot: true, Instances: []string{i}})
+	}
+	if policy.GetOnDemand() {
+		if instance == "" && len(spec.SpotInstances) > 0 {
+			instance = spec.SpotInstances[0]
+		}
+		attempts = append(attempts, CapacityAttempt{Instances: []string{instance}})
+	}
+	return attempts
+}
+
+//FallbackWait time the node pool may stay without nodes before falling back
+func FallbackWait(policy *proto.SpotFallback) time.Duration {
+	if policy.GetWaitMinutes() > 0 {
+		return time.Duration(policy.GetWaitMinutes()) * time.Minute
+	}
+	return DefaultFallbackWait
+}
+
+//FallbackFuncs provider calls the fallback is made of
+type FallbackFuncs struct {
+	//Create requests the node pool with the attempt, fallbacks taken so far are recorded with the node pool
+	Create func(ctx context.Context, attempt CapacityAttempt, fallbacks []string) error
+	//Wait blocks until the node pool created with the attempt has nodes, ERR_NO_SPOT_CAPACITY when the spot attempt
+	//did not get capacity, ERR_CAPACITY_TIMEOUT when the on-demand attempt is not ready in time
+	Wait func(ctx context.Context, attempt CapacityAttempt) error
+	//Remove deletes the node pool and waits until it is gone, so it can be created again with same name
+	Remove func(ctx context.Context) error
+}
+
+//RunFallback creates the node pool with the attempts one after another until one of them gets capacity,
+//returns the attempt the node pool was created with and the fallbacks taken. Only spot attempts fall back,
+//the on-demand node pool is left in place when it fails
+func RunFallback(ctx context.Context, attempts []CapacityAttempt, f FallbackFuncs) (CapacityAttempt, []string, error) {
+	fallbacks := []string{}
+	for i, attempt := range attempts {
+		if i > 0 {
+			fallback := fmt.Sprintf("%s to %s", attempts[i-1], attempt)
+			operation.Report(ctx, "no capacity for %s, falling back to %s", attempts[i-1], attempt)
+			if err := f.Remove(ctx); err != nil {
+				return attempt, fallbacks, errors.Wrapf(err, "failed to remove node pool before falling back to %s", attempt)
+			}
+			fallbacks = append(fallbacks, fallback)
+		}
+
+		if err := f.Create(ctx, attempt, fallbacks); err != nil {
+			return attempt, fallbacks, err
+		}
+		err := f.Wait(ctx, attempt)
+		if err == nil {
+			return attempt, fallbacks, nil
+		}
+		if !attempt.Spot || !errors.Is(err, ERR_NO_SPOT_CAPACITY) {
+			return attempt, fallbacks, err
+		}
+	}
+	return attempts[len(attempts)-1], fallbacks, errors.Wrapf(ERR_NO_SPOT_CAPACITY, "no capacity for any of %d fallback attempts", len(attempts))
+}
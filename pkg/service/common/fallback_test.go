@@ -0,0 +1,90 @@
+package common
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	proto "gitlab.com/netbook-devs/spawner-service/proto/netbookai/spawner"
+)
+
+func Test_FallbackAttempts(t *testing.T) {
+	spec := &proto.NodeSpec{
+		CapacityType:  proto.CapacityType_SPOT,
+		SpotInstances: []string{"m5.xlarge", "m5a.xlarge"},
+		SpotFallback:  &proto.SpotFallback{AlternateInstances: []string{"c5.xlarge"}, OnDemand: true},
+	}
+	attempts := FallbackAttempts(spec, "")
+	require.Len(t, attempts, 3)
+	assert.Equal(t, "spot m5.xlarge/m5a.xlarge", attempts[0].String())
+	assert.Equal(t, "spot c5.xlarge", attempts[1].String())
+	assert.Equal(t, "on-demand m5.xlarge", attempts[2].String(), "first spot instance is used on-demand")
+
+	assert.Equal(t, "on-demand m5.2xlarge", FallbackAttempts(spec, "m5.2xlarge")[2].String())
+	assert.Len(t, FallbackAttempts(&proto.NodeSpec{SpotInstances: []string{"m5.xlarge"}}, ""), 1, "no policy, no fallback")
+
+	assert.True(t, IsCapacityError("Could not launch Spot Instances. InsufficientInstanceCapacity - There is no Spot capacity available"))
+	assert.False(t, IsCapacityError("Instances failed to join the kubernetes cluster"))
+}
+
+func Test_RunFallback(t *testing.T) {
+	ctx := context.Background()
+	attempts := []CapacityAttempt{
+		{Spot: true, Instances: []string{"m5.xlarge"}},
+		{Spot: true, Instances: []string{"c5.xlarge"}},
+		{Instances: []string{"m5.xlarge"}},
+	}
+
+	created := []string{}
+	removed := 0
+	capacity := map[string]bool{"on-demand m5.xlarge": true}
+	current := ""
+	funcs := FallbackFuncs{
+		Create: func(ctx context.Context, a CapacityAttempt, fallbacks []string) error {
+			assert.Len(t, fallbacks, len(created), "fallbacks taken before the attempt")
+			current = a.String()
+			created = append(created, current)
+			return nil
+		},
+		Wait: func(ctx context.Context, a CapacityAttempt) error {
+			if capacity[current] {
+				return nil
+			}
+			if !a.Spot {
+				return ERR_CAPACITY_TIMEOUT
+			}
+			return ERR_NO_SPOT_CAPACITY
+		},
+		Remove: func(ctx context.Context) error {
+			removed++
+			return nil
+		},
+	}
+
+	used, fallbacks, err := RunFallback(ctx, attempts, funcs)
+	require.NoError(t, err)
+	assert.Equal(t, attempts[2], used)
+	assert.Equal(t, []string{"spot m5.xlarge", "spot c5.xlarge", "on-demand m5.xlarge"}, created)
+	assert.Equal(t, 2, removed)
+	assert.Equal(t, []string{"spot m5.xlarge to spot c5.xlarge", "spot c5.xlarge to on-demand m5.xlarge"}, fallbacks)
+
+	created = nil
+	removed = 0
+	capacity = map[string]bool{}
+	_, _, err = RunFallback(ctx, attempts, funcs)
+	assert.True(t, errors.Is(err, ERR_CAPACITY_TIMEOUT), "on-demand attempt times out")
+	assert.Equal(t, 2, removed, "on-demand node pool is not removed")
+
+	created = nil
+	_, _, err = RunFallback(ctx, attempts[:2], funcs)
+	assert.True(t, errors.Is(err, ERR_NO_SPOT_CAPACITY), "every spot attempt out of capacity")
+
+	created = nil
+	funcs.Wait = func(ctx context.Context, a CapacityAttempt) error { return errors.New("nodegroup failed") }
+	_, fallbacks, err = RunFallback(ctx, attempts, funcs)
+	assert.EqualError(t, err, "nodegroup failed", "other failures do not fall back")
+	assert.Empty(t, fallbacks)
+	assert.Len(t, created, 1)
+}
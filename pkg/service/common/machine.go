@@ -1,7 +1,13 @@
 package common
 
 import (
+	"io/ioutil"
+	"sort"
+	"sync"
+
+	"github.com/pkg/errors"
 	"gitlab.com/netbook-devs/spawner-service/pkg/service/constants"
+	"gopkg.in/yaml.v2"
 )
 
 //machine type constants
@@ -18,95 +24,304 @@ const Mv100 = "m+v100"
 const Lv100 = "l+v100"
 const XLv100 = "xl+v100"
 
-type InstanceSizeMap map[string]string
+//MachineSpec resources of the machine, zero values are unknown
+type MachineSpec struct {
+	VCPU      int32   `yaml:"vcpu"`
+	MemoryGiB float32 `yaml:"memoryGiB"`
+	//GPUModel gpu without vendor prefix, ex: t4, v100, a100
+	GPUModel string `yaml:"gpuModel"`
+	GPUCount int32  `yaml:"gpuCount"`
+}
 
-var providerInstanceType map[string]InstanceSizeMap
-var gpus map[string]struct{}
+//overlay fields set in o replace the fields of s
+func (s MachineSpec) overlay(o MachineSpec) MachineSpec {
+	if o.VCPU != 0 {
+		s.VCPU = o.VCPU
+	}
+	if o.MemoryGiB != 0 {
+		s.MemoryGiB = o.MemoryGiB
+	}
+	if o.GPUModel != "" {
+		s.GPUModel = o.GPUModel
+	}
+	if o.GPUCount != 0 {
+		s.GPUCount = o.GPUCount
+	}
+	return s
+}
 
-func azure() InstanceSizeMap {
-	m := InstanceSizeMap{
+//MachineOverride instance type and resources of the machine type on the provider or in the region,
+//zero fields keep the values they override
+type MachineOverride struct {
+	Instance    string `yaml:"instance"`
+	MachineSpec `yaml:",inline"`
+}
 
-		S:      "Standard_B1s",
-		M:      "Standard_F8s_v2",
-		L:      "Standard_F32s_v2",
-		XL:     "Standard_F64_v2",
-		MT4:    "Standard_NC4as_T4_v3",
-		Mk80:   "Standard_NC6",
-		Lk80:   "Standard_NC12",
-		XLk80:  "Standard_NC24",
-		Mv100:  "Standard_NC6s_v3",
-		Lv100:  "Standard_NC12s_v3",
-		XLv100: "Standard_NC24s_v3",
+func (m MachineOverride) overlay(o MachineOverride) MachineOverride {
+	if o.Instance != "" {
+		m.Instance = o.Instance
 	}
+	m.MachineSpec = m.MachineSpec.overlay(o.MachineSpec)
 	return m
 }
 
-func aws() InstanceSizeMap {
-	m := InstanceSizeMap{
-		S:      "t2.micro",
-		M:      "m5.2xlarge",
-		L:      "m5.8xlarge",
-		XL:     "m5.16xlarge",
-		MT4:    "g4dn.xlarge",
-		Mk80:   "p2.xlarge",
-		Lk80:   "p2.8xlarge",
-		XLk80:  "p2.16xlarge",
-		Mv100:  "p3.xlarge",
-		Lv100:  "p3.8xlarge",
-		XLv100: "p3.16xlarge",
+//ProviderMachine machine type on the provider with per region overrides
+type ProviderMachine struct {
+	MachineOverride `yaml:",inline"`
+	Regions         map[string]MachineOverride `yaml:"regions"`
+}
+
+//MachineType size requested with NodeSpec.machineType, the spec applies to the providers unless they override it
+type MachineType struct {
+	Name        string `yaml:"name"`
+	MachineSpec `yaml:",inline"`
+	Providers   map[string]ProviderMachine `yaml:"providers"`
+}
+
+//gpu machine type has gpus on any of the providers
+func (m MachineType) gpu() bool {
+	if m.GPUCount > 0 {
+		return true
 	}
-	return m
+	for _, p := range m.Providers {
+		if p.GPUCount > 0 {
+			return true
+		}
+	}
+	return false
 }
 
-func gcp() InstanceSizeMap {
-	// https://github.com/iterative/terraform-provider-iterative/blob/master/iterative/gcp/provider.go#L415
-	m := InstanceSizeMap{
-		S:      "g1-small",
-		M:      "e2-custom-8-32768",
-		L:      "e2-custom-32-131072",
-		XL:     "n2-custom-64-262144",
-		MT4:    "n1-standard-4",
-		Mk80:   "custom-8-53248",
-		Lk80:   "custom-32-131072",
-		XLk80:  "custom-64-212992-ext",
-		Mv100:  "custom-8-65536-ext",
-		Lv100:  "custom-32-262144-ext",
-		XLv100: "custom-64-524288-ext",
+//Machine machine type resolved for the provider and region
+type Machine struct {
+	Name     string
+	Provider string
+	Instance string
+	MachineSpec
+}
+
+//Catalog machine types in the order they are listed
+type Catalog struct {
+	MachineTypes []MachineType `yaml:"machineTypes"`
+}
+
+//LoadCatalog reads the yaml or json machine catalog file
+func LoadCatalog(file string) (*Catalog, error) {
+	b, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read machine catalog file")
 	}
-	return m
+	c := &Catalog{}
+	if err := yaml.UnmarshalStrict(b, c); err != nil {
+		return nil, errors.Wrapf(err, "invalid machine catalog file '%s'", file)
+	}
+	seen := map[string]bool{}
+	for _, m := range c.MachineTypes {
+		if m.Name == "" {
+			return nil, errors.Errorf("invalid machine catalog file '%s': machine type without name", file)
+		}
+		if seen[m.Name] {
+			return nil, errors.Errorf("invalid machine catalog file '%s': machine type '%s' listed twice", file, m.Name)
+		}
+		seen[m.Name] = true
+	}
+	return c, nil
+}
+
+func (c *Catalog) find(name string) (int, bool) {
+	for i, m := range c.MachineTypes {
+		if m.Name == name {
+			return i, true
+		}
+	}
+	return 0, false
+}
+
+//Merge returns the catalog with the machine types of o added, fields set in o override the existing machine types
+//down to the single region of a provider
+func (c *Catalog) Merge(o *Catalog) *Catalog {
+	merged := &Catalog{MachineTypes: make([]MachineType, 0, len(c.MachineTypes)+len(o.MachineTypes))}
+	merged.MachineTypes = append(merged.MachineTypes, c.MachineTypes...)
+
+	for _, m := range o.MachineTypes {
+		i, ok := merged.find(m.Name)
+		if !ok {
+			merged.MachineTypes = append(merged.MachineTypes, m)
+			continue
+		}
+
+		existing := merged.MachineTypes[i]
+		providers := map[string]ProviderMachine{}
+		for name, p := range existing.Providers {
+			providers[name] = p
+		}
+		for name, p := range m.Providers {
+			cur := providers[name]
+			regions := map[string]MachineOverride{}
+			for r, o := range cur.Regions {
+				regions[r] = o
+			}
+			for r, o := range p.Regions {
+				regions[r] = regions[r].overlay(o)
+			}
+			providers[name] = ProviderMachine{MachineOverride: cur.MachineOverride.overlay(p.MachineOverride), Regions: regions}
+		}
+		merged.MachineTypes[i] = MachineType{
+			Name:        m.Name,
+			MachineSpec: existing.MachineSpec.overlay(m.MachineSpec),
+			Providers:   providers,
+		}
+	}
+	return merged
 }
 
-func init() {
-	providerInstanceType = make(map[string]InstanceSizeMap)
-	providerInstanceType[constants.AzureLabel] = azure()
-	providerInstanceType[constants.AwsLabel] = aws()
-	providerInstanceType[constants.GcpLabel] = gcp()
+func resolve(m MachineType, provider, region string) (Machine, bool) {
+	p, ok := m.Providers[provider]
+	if !ok {
+		return Machine{}, false
+	}
+	o := MachineOverride{MachineSpec: m.MachineSpec}.overlay(p.MachineOverride)
+	if r, ok := p.Regions[region]; ok {
+		o = o.overlay(r)
+	}
+	if o.Instance == "" {
+		return Machine{}, false
+	}
+	return Machine{Name: m.Name, Provider: provider, Instance: o.Instance, MachineSpec: o.MachineSpec}, true
+}
+
+//Resolve the machine type on the provider, region overrides apply when region is set
+func (c *Catalog) Resolve(provider, region, name string) (Machine, bool) {
+	i, ok := c.find(name)
+	if !ok {
+		return Machine{}, false
+	}
+	return resolve(c.MachineTypes[i], provider, region)
+}
 
-	gpus = map[string]struct{}{
-		MT4:    {},
-		Mk80:   {},
-		Lk80:   {},
-		XLk80:  {},
-		Mv100:  {},
-		Lv100:  {},
-		XLv100: {},
+//List machine types available on the provider, on every provider of the catalog when provider is empty
+func (c *Catalog) List(provider, region string) []Machine {
+	machines := []Machine{}
+	for _, m := range c.MachineTypes {
+		providers := []string{provider}
+		if provider == "" {
+			providers = make([]string, 0, len(m.Providers))
+			for p := range m.Providers {
+				providers = append(providers, p)
+			}
+			sort.Strings(providers)
+		}
+		for _, p := range providers {
+			if r, ok := resolve(m, p, region); ok {
+				machines = append(machines, r)
+			}
+		}
 	}
+	return machines
+}
+
+func provider(instance string, spec MachineSpec) ProviderMachine {
+	return ProviderMachine{MachineOverride: MachineOverride{Instance: instance, MachineSpec: spec}}
+}
+
+//DefaultCatalog machine types shipped with the service
+func DefaultCatalog() *Catalog {
+	// https://github.com/iterative/terraform-provider-iterative/blob/master/iterative/gcp/provider.go#L415
+	// gcp machine types do not come with gpus, they are attached as accelerators of the gpu model and count
+	return &Catalog{MachineTypes: []MachineType{
+		{Name: S, Providers: map[string]ProviderMachine{
+			constants.AwsLabel:   provider("t2.micro", MachineSpec{VCPU: 1, MemoryGiB: 1}),
+			constants.AzureLabel: provider("Standard_B1s", MachineSpec{VCPU: 1, MemoryGiB: 1}),
+			constants.GcpLabel:   provider("g1-small", MachineSpec{VCPU: 1, MemoryGiB: 1.7}),
+		}},
+		{Name: M, Providers: map[string]ProviderMachine{
+			constants.AwsLabel:   provider("m5.2xlarge", MachineSpec{VCPU: 8, MemoryGiB: 32}),
+			constants.AzureLabel: provider("Standard_F8s_v2", MachineSpec{VCPU: 8, MemoryGiB: 16}),
+			constants.GcpLabel:   provider("e2-custom-8-32768", MachineSpec{VCPU: 8, MemoryGiB: 32}),
+		}},
+		{Name: L, Providers: map[string]ProviderMachine{
+			constants.AwsLabel:   provider("m5.8xlarge", MachineSpec{VCPU: 32, MemoryGiB: 128}),
+			constants.AzureLabel: provider("Standard_F32s_v2", MachineSpec{VCPU: 32, MemoryGiB: 64}),
+			constants.GcpLabel:   provider("e2-custom-32-131072", MachineSpec{VCPU: 32, MemoryGiB: 128}),
+		}},
+		{Name: XL, Providers: map[string]ProviderMachine{
+			constants.AwsLabel:   provider("m5.16xlarge", MachineSpec{VCPU: 64, MemoryGiB: 256}),
+			constants.AzureLabel: provider("Standard_F64_v2", MachineSpec{VCPU: 64, MemoryGiB: 128}),
+			constants.GcpLabel:   provider("n2-custom-64-262144", MachineSpec{VCPU: 64, MemoryGiB: 256}),
+		}},
+		{Name: MT4, MachineSpec: MachineSpec{GPUModel: "t4", GPUCount: 1}, Providers: map[string]ProviderMachine{
+			constants.AwsLabel:   provider("g4dn.xlarge", MachineSpec{VCPU: 4, MemoryGiB: 16}),
+			constants.AzureLabel: provider("Standard_NC4as_T4_v3", MachineSpec{VCPU: 4, MemoryGiB: 28}),
+			constants.GcpLabel:   provider("n1-standard-4", MachineSpec{VCPU: 4, MemoryGiB: 15}),
+		}},
+		{Name: Mk80, MachineSpec: MachineSpec{GPUModel: "k80", GPUCount: 1}, Providers: map[string]ProviderMachine{
+			constants.AwsLabel:   provider("p2.xlarge", MachineSpec{VCPU: 4, MemoryGiB: 61}),
+			constants.AzureLabel: provider("Standard_NC6", MachineSpec{VCPU: 6, MemoryGiB: 56}),
+			constants.GcpLabel:   provider("custom-8-53248", MachineSpec{VCPU: 8, MemoryGiB: 52}),
+		}},
+		{Name: Lk80, MachineSpec: MachineSpec{GPUModel: "k80"}, Providers: map[string]ProviderMachine{
+			constants.AwsLabel:   provider("p2.8xlarge", MachineSpec{VCPU: 32, MemoryGiB: 488, GPUCount: 8}),
+			constants.AzureLabel: provider("Standard_NC12", MachineSpec{VCPU: 12, MemoryGiB: 112, GPUCount: 2}),
+			constants.GcpLabel:   provider("custom-32-131072", MachineSpec{VCPU: 32, MemoryGiB: 128, GPUCount: 4}),
+		}},
+		{Name: XLk80, MachineSpec: MachineSpec{GPUModel: "k80"}, Providers: map[string]ProviderMachine{
+			constants.AwsLabel:   provider("p2.16xlarge", MachineSpec{VCPU: 64, MemoryGiB: 732, GPUCount: 16}),
+			constants.AzureLabel: provider("Standard_NC24", MachineSpec{VCPU: 24, MemoryGiB: 224, GPUCount: 4}),
+			constants.GcpLabel:   provider("custom-64-212992-ext", MachineSpec{VCPU: 64, MemoryGiB: 208, GPUCount: 8}),
+		}},
+		{Name: Mv100, MachineSpec: MachineSpec{GPUModel: "v100", GPUCount: 1}, Providers: map[string]ProviderMachine{
+			constants.AwsLabel:   provider("p3.xlarge", MachineSpec{VCPU: 8, MemoryGiB: 61}),
+			constants.AzureLabel: provider("Standard_NC6s_v3", MachineSpec{VCPU: 6, MemoryGiB: 112}),
+			constants.GcpLabel:   provider("custom-8-65536-ext", MachineSpec{VCPU: 8, MemoryGiB: 64}),
+		}},
+		{Name: Lv100, MachineSpec: MachineSpec{GPUModel: "v100"}, Providers: map[string]ProviderMachine{
+			constants.AwsLabel:   provider("p3.8xlarge", MachineSpec{VCPU: 32, MemoryGiB: 244, GPUCount: 4}),
+			constants.AzureLabel: provider("Standard_NC12s_v3", MachineSpec{VCPU: 12, MemoryGiB: 224, GPUCount: 2}),
+			constants.GcpLabel:   provider("custom-32-262144-ext", MachineSpec{VCPU: 32, MemoryGiB: 256, GPUCount: 4}),
+		}},
+		{Name: XLv100, MachineSpec: MachineSpec{GPUModel: "v100"}, Providers: map[string]ProviderMachine{
+			constants.AwsLabel:   provider("p3.16xlarge", MachineSpec{VCPU: 64, MemoryGiB: 488, GPUCount: 8}),
+			constants.AzureLabel: provider("Standard_NC24s_v3", MachineSpec{VCPU: 24, MemoryGiB: 448, GPUCount: 4}),
+			constants.GcpLabel:   provider("custom-64-524288-ext", MachineSpec{VCPU: 64, MemoryGiB: 512, GPUCount: 8}),
+		}},
+	}}
+}
+
+var (
+	catalogMu sync.RWMutex
+	catalog   = DefaultCatalog()
+)
+
+//SetCatalog replaces the catalog the machine types are resolved from
+func SetCatalog(c *Catalog) {
+	catalogMu.Lock()
+	defer catalogMu.Unlock()
+	catalog = c
+}
+
+//GetCatalog catalog the machine types are resolved from
+func GetCatalog() *Catalog {
+	catalogMu.RLock()
+	defer catalogMu.RUnlock()
+	return catalog
 }
 
 //GetInstance given machine size return the exact instance type for the provider
 func GetInstance(provider, machine string) string {
+	return GetRegionInstance(provider, "", machine)
+}
 
-	p, ok := providerInstanceType[provider]
+//GetRegionInstance instance type of the machine size for the provider with the overrides of the region
+func GetRegionInstance(provider, region, machine string) string {
+	m, ok := GetCatalog().Resolve(provider, region, machine)
 	if !ok {
 		return ""
 	}
-	if t, ok := p[machine]; ok {
-		return t
-	}
-	return ""
+	return m.Instance
 }
 
+//IsGPU check if the machine type has gpus
 func IsGPU(m string) bool {
-	_, ok := gpus[m]
-	return ok
+	c := GetCatalog()
+	i, ok := c.find(m)
+	return ok && c.MachineTypes[i].gpu()
 }
@@ -1,9 +1,12 @@
 package common
 
 import (
+	"io/ioutil"
+	"path/filepath"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func Test_GetInstance(t *testing.T) {
@@ -26,3 +29,62 @@ func Test_GetInstance(t *testing.T) {
 	assert.True(t, IsGPU(Lk80), "expected gpu machine")
 	assert.False(t, IsGPU(M), "expected non-gpu machine")
 }
+
+func Test_Catalog(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "machines.yaml")
+	require.NoError(t, ioutil.WriteFile(path, []byte(`
+machineTypes:
+  - name: m+a100
+    gpuModel: a100
+    gpuCount: 1
+    providers:
+      aws:
+        instance: p4d.24xlarge
+        vcpu: 96
+        memoryGiB: 1152
+        gpuCount: 8
+      gcp:
+        instance: a2-highgpu-1g
+        vcpu: 12
+        memoryGiB: 85
+  - name: m
+    providers:
+      aws:
+        regions:
+          eu-north-1:
+            instance: m6g.2xlarge
+`), 0600))
+
+	extra, err := LoadCatalog(path)
+	require.NoError(t, err)
+	c := DefaultCatalog().Merge(extra)
+
+	m, ok := c.Resolve("aws", "eu-north-1", "m")
+	require.True(t, ok)
+	assert.Equal(t, "m6g.2xlarge", m.Instance, "region override")
+	assert.Equal(t, int32(8), m.VCPU, "region keeps the provider spec")
+	assert.Equal(t, "m5.2xlarge", GetInstance("aws", "m"), "default catalog is unchanged")
+
+	m, ok = c.Resolve("aws", "us-west-2", "m")
+	require.True(t, ok)
+	assert.Equal(t, "m5.2xlarge", m.Instance)
+
+	m, ok = c.Resolve("gcp", "", "m+a100")
+	require.True(t, ok)
+	assert.Equal(t, MachineSpec{VCPU: 12, MemoryGiB: 85, GPUModel: "a100", GPUCount: 1}, m.MachineSpec)
+	_, ok = c.Resolve("azure", "", "m+a100")
+	assert.False(t, ok, "machine type not on the provider")
+
+	assert.Len(t, c.List("aws", ""), 12)
+	assert.Len(t, c.List("", ""), 35)
+	assert.Equal(t, "m+a100", c.List("gcp", "")[11].Name, "new machine types are listed last")
+
+	SetCatalog(c)
+	defer SetCatalog(DefaultCatalog())
+	assert.True(t, IsGPU("m+a100"))
+	assert.Equal(t, "m6g.2xlarge", GetRegionInstance("aws", "eu-north-1", "m"))
+
+	require.NoError(t, ioutil.WriteFile(path, []byte(`{"machineTypes": [{"name": "s"}, {"name": "s"}]}`), 0600))
+	_, err = LoadCatalog(path)
+	assert.Error(t, err, "duplicate machine type")
+}
@@ -0,0 +1,71 @@
+package common
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+//KubeVersion major and minor part of a kubernetes version, patch and provider suffix are ignored
+type KubeVersion struct {
+	Major int
+	Minor int
+}
+
+//ParseKubeVersion parses versions the providers report, ex: 1.22, 1.22.6, v1.21.5-eks-bc4871b or 1.21.6-gke.1500
+func ParseKubeVersion(v string) (KubeVersion, error) {
+	parts := strings.SplitN(strings.TrimPrefix(strings.TrimSpace(v), "v"), ".", 3)
+	if len(parts) < 2 {
+		return KubeVersion{}, fmt.Errorf("invalid kubernetes version '%s'", v)
+	}
+	major, err := strconv.Atoi(parts[0])
+	if err != nil {
+		return KubeVersion{}, fmt.Errorf("invalid kubernetes version '%s'", v)
+	}
+	minor, err := strconv.Atoi(strings.SplitN(parts[1], "-", 2)[0])
+	if err != nil {
+		return KubeVersion{}, fmt.Errorf("invalid kubernetes version '%s'", v)
+	}
+	return KubeVersion{Major: major, Minor: minor}, nil
+}
+
+func (k KubeVersion) String() string {
+	return fmt.Sprintf("%d.%d", k.Major, k.Minor)
+}
+
+//MinorsSince number of minor versions k is ahead of o, negative when it is behind
+func (k KubeVersion) MinorsSince(o KubeVersion) int {
+	if k.Major != o.Major {
+		//kubernetes has had a single major version, treat a major bump as far apart
+		return (k.Major - o.Major) * 100
+	}
+	return k.Minor - o.Minor
+}
+
+//versionParts numeric parts of the dotted version, non numeric suffix of each part is dropped
+func versionParts(v string) []int {
+	var res []int
+	for _, p := range strings.Split(strings.TrimPrefix(v, "v"), ".") {
+		end := 0
+		for end < len(p) && p[end] >= '0' && p[end] <= '9' {
+			end++
+		}
+		n, _ := strconv.Atoi(p[:end])
+		res = append(res, n)
+	}
+	return res
+}
+
+//SortVersions sorts the dotted versions oldest first
+func SortVersions(versions []string) {
+	sort.SliceStable(versions, func(i, j int) bool {
+		a, b := versionParts(versions[i]), versionParts(versions[j])
+		for n := 0; n < len(a) && n < len(b); n++ {
+			if a[n] != b[n] {
+				return a[n] < b[n]
+			}
+		}
+		return len(a) < len(b)
+	})
+}
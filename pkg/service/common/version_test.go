@@ -0,0 +1,35 @@
+package common
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_ParseKubeVersion(t *testing.T) {
+	for v, want := range map[string]KubeVersion{
+		"1.22":                 {1, 22},
+		"1.22.6":               {1, 22},
+		"v1.21.5-eks-bc4871b":  {1, 21},
+		"1.21.6-gke.1500":      {1, 21},
+		"1.20-eks-placeholder": {1, 20},
+	} {
+		got, err := ParseKubeVersion(v)
+		assert.NoError(t, err, v)
+		assert.Equal(t, want, got, v)
+	}
+
+	for _, v := range []string{"", "1", "latest", "1.x"} {
+		_, err := ParseKubeVersion(v)
+		assert.Error(t, err, v)
+	}
+
+	assert.Equal(t, 2, KubeVersion{1, 22}.MinorsSince(KubeVersion{1, 20}))
+	assert.Equal(t, -1, KubeVersion{1, 21}.MinorsSince(KubeVersion{1, 22}))
+}
+
+func Test_SortVersions(t *testing.T) {
+	versions := []string{"1.22.6", "1.9.1", "1.21.9", "1.22.4", "1.21.6-gke.1500"}
+	SortVersions(versions)
+	assert.Equal(t, []string{"1.9.1", "1.21.6-gke.1500", "1.21.9", "1.22.4", "1.22.6"}, versions)
+}
@@ -11,6 +11,9 @@ var (
 
 	ProvisionerLabel         = "provisioner"
 	ClusterNameLabel         = "cluster-name"
+	VolumeNameLabel          = "volume-name"
+	ExpiresAtLabel           = "expires-at"
+	SpotFallbackLabel        = "spot-fallback"
 	WorkspaceLabel           = "workspaceid"
 	NodeNameLabel            = "node-name"
 	InstanceLabel            = "instance"
@@ -22,6 +25,7 @@ var (
 	WorkspaceId              = "workspaceid"
 	AzureLabel               = "azure"
 	GcpLabel                 = "gcp"
+	FakeLabel                = "fake"
 )
 
 type CloudProvider string
@@ -30,6 +34,7 @@ const (
 	AwsCloud   CloudProvider = "aws"
 	AzureCloud CloudProvider = "azure"
 	GcpCloud   CloudProvider = "gcp"
+	FakeCloud  CloudProvider = "fake"
 )
 
 const (
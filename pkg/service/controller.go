@@ -3,7 +3,11 @@ package service
 import (
 	"context"
 
+	"github.com/pkg/errors"
+	"gitlab.com/netbook-devs/spawner-service/pkg/service/common"
 	proto "gitlab.com/netbook-devs/spawner-service/proto/netbookai/spawner"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 //Controller defines the set of the functions which each provider controller must implement to support spawner functionality
@@ -24,4 +28,42 @@ type Controller interface {
 	GetWorkspacesCost(context.Context, *proto.GetWorkspacesCostRequest) (*proto.GetWorkspacesCostResponse, error)
 	GetKubeConfig(ctx context.Context, in *proto.GetKubeConfigRequest) (*proto.GetKubeConfigResponse, error)
 	TagNodeInstance(ctx context.Context, req *proto.TagNodeInstanceRequest) (*proto.TagNodeInstanceResponse, error)
+	ScaleNodePool(ctx context.Context, req *proto.ScaleNodePoolRequest) (*proto.ScaleNodePoolResponse, error)
+	UpdateNodePool(ctx context.Context, req *proto.UpdateNodePoolRequest) (*proto.UpdateNodePoolResponse, error)
+	ListVolumes(ctx context.Context, req *proto.ListVolumesRequest) (*proto.ListVolumesResponse, error)
+	ListSnapshots(ctx context.Context, req *proto.ListSnapshotsRequest) (*proto.ListSnapshotsResponse, error)
+	DeleteSnapshot(ctx context.Context, req *proto.DeleteSnapshotRequest) (*proto.DeleteSnapshotResponse, error)
+	ListKubernetesVersions(ctx context.Context, req *proto.ListKubernetesVersionsRequest) (*proto.ListKubernetesVersionsResponse, error)
+	//UpgradeCluster upgrades the control plane only, node pools are upgraded with UpdateNodePool
+	UpgradeCluster(ctx context.Context, req *proto.UpgradeClusterRequest) (*proto.UpgradeClusterResponse, error)
+	GetVolume(ctx context.Context, req *proto.GetVolumeRequest) (*proto.Volume, error)
+	ResizeVolume(ctx context.Context, req *proto.ResizeVolumeRequest) (*proto.ResizeVolumeResponse, error)
+	AttachVolume(ctx context.Context, req *proto.AttachVolumeRequest) (*proto.AttachVolumeResponse, error)
+	//DetachVolume detaches the volume from the requested instance or the instance it is attached to
+	DetachVolume(ctx context.Context, req *proto.DetachVolumeRequest) (*proto.DetachVolumeResponse, error)
+	//RestoreSnapshot creates a volume from the snapshot, volume carries the snapshot labels and the requested ones
+	RestoreSnapshot(ctx context.Context, req *proto.RestoreSnapshotRequest) (*proto.RestoreSnapshotResponse, error)
+	CopySnapshot(ctx context.Context, req *proto.CopySnapshotRequest) (*proto.CopySnapshotResponse, error)
+}
+
+//DNSProvider manages the A, CNAME and TXT records of the dns zones hosted by the provider,
+//records are recorded in the inventory with the cluster they are tied to
+type DNSProvider interface {
+	//UpsertDNSRecord creates or replaces the record and returns once the change is applied
+	UpsertDNSRecord(ctx context.Context, req *proto.UpsertDNSRecordRequest) (*proto.UpsertDNSRecordResponse, error)
+	DeleteDNSRecord(ctx context.Context, req *proto.DeleteDNSRecordRequest) (*proto.DeleteDNSRecordResponse, error)
+	ListDNSRecords(ctx context.Context, req *proto.ListDNSRecordsRequest) (*proto.ListDNSRecordsResponse, error)
+}
+
+//ResourceTagger updates the provider tags of the clusters and node pools in place
+type ResourceTagger interface {
+	//TagCluster adds or replaces the tags of the cluster
+	TagCluster(ctx context.Context, account, region, cluster string, tags map[string]string) error
+	//TagNodePool adds or replaces the tags of the node pool, kubernetes labels of the nodes are left as is
+	TagNodePool(ctx context.Context, account, region, cluster, nodePool string, tags map[string]string) error
+}
+
+//isClusterNotFound whether ClusterStatus failed because the cluster does not exist, plugins report it as grpc NotFound
+func isClusterNotFound(err error) bool {
+	return errors.Is(err, common.ERR_CLUSTER_NOT_FOUND) || status.Code(err) == codes.NotFound
 }
@@ -0,0 +1,165 @@
+package service
+
+import (
+	"context"
+	"net"
+	"strings"
+
+	"gitlab.com/netbook-devs/spawner-service/pkg/inventory"
+	"gitlab.com/netbook-devs/spawner-service/pkg/operation"
+	proto "gitlab.com/netbook-devs/spawner-service/proto/netbookai/spawner"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const defaultDNSTTL = 300
+
+var dnsRecordTypes = map[string]bool{
+	"A":     true,
+	"CNAME": true,
+	"TXT":   true,
+}
+
+func validateDNSType(t string) error {
+	if !dnsRecordTypes[t] {
+		return status.Errorf(codes.InvalidArgument, "invalid record type '%s', must be A, CNAME or TXT", t)
+	}
+	return nil
+}
+
+//validateDNSRecord checks the record, type is upper cased and default ttl filled
+func validateDNSRecord(r *proto.DNSRecord) error {
+	if r == nil {
+		return status.Error(codes.InvalidArgument, "record is required")
+	}
+	if r.Name == "" {
+		return status.Error(codes.InvalidArgument, "record name is required")
+	}
+	r.Type = strings.ToUpper(r.Type)
+	if err := validateDNSType(r.Type); err != nil {
+		return err
+	}
+	if len(r.Values) == 0 {
+		return status.Error(codes.InvalidArgument, "at least one record value is required")
+	}
+	switch r.Type {
+	case "A":
+		for _, v := range r.Values {
+			if ip := net.ParseIP(v); ip == nil || ip.To4() == nil {
+				return status.Errorf(codes.InvalidArgument, "invalid ipv4 address '%s'", v)
+			}
+		}
+	case "CNAME":
+		if len(r.Values) > 1 {
+			return status.Error(codes.InvalidArgument, "CNAME record takes a single value")
+		}
+	}
+	if r.Ttl < 0 {
+		return status.Errorf(codes.InvalidArgument, "invalid ttl %d", r.Ttl)
+	}
+	if r.Ttl == 0 {
+		r.Ttl = defaultDNSTTL
+	}
+	return nil
+}
+
+//dnsProvider looks up the registered provider which manages dns records
+func (s *spawnerService) dnsProvider(provider string) (DNSProvider, error) {
+	c, err := s.controller(provider)
+	if err != nil {
+		return nil, err
+	}
+	d, ok := c.(DNSProvider)
+	if !ok {
+		return nil, status.Errorf(codes.Unimplemented, "provider '%s' does not support dns records", provider)
+	}
+	return d, nil
+}
+
+//UpsertDNSRecord creates or replaces the record, record tied to a cluster is deleted with the cluster
+func (s *spawnerService) UpsertDNSRecord(ctx context.Context, req *proto.UpsertDNSRecordRequest) (*proto.UpsertDNSRecordResponse, error) {
+	if err := validateDNSRecord(req.Record); err != nil {
+		return nil, err
+	}
+	provider, err := s.dnsProvider(req.Provider)
+	if err != nil {
+		return nil, err
+	}
+	op := s.operations.Start(ctx, operationMeta("UpsertDNSRecord", req.Provider, req.AccountName, req.Region, req.Record.Name), func(ctx context.Context) (interface{}, error) {
+		return provider.UpsertDNSRecord(ctx, req)
+	})
+	return &proto.UpsertDNSRecordResponse{Record: req.Record, OperationId: op.ID}, nil
+}
+
+//DeleteDNSRecord deletes the record of the type
+func (s *spawnerService) DeleteDNSRecord(ctx context.Context, req *proto.DeleteDNSRecordRequest) (*proto.DeleteDNSRecordResponse, error) {
+	if req.Name == "" {
+		return nil, status.Error(codes.InvalidArgument, "record name is required")
+	}
+	req.Type = strings.ToUpper(req.Type)
+	if err := validateDNSType(req.Type); err != nil {
+		return nil, err
+	}
+	provider, err := s.dnsProvider(req.Provider)
+	if err != nil {
+		return nil, err
+	}
+	op := s.operations.Start(ctx, operationMeta("DeleteDNSRecord", req.Provider, req.AccountName, req.Region, req.Name), func(ctx context.Context) (interface{}, error) {
+		return provider.DeleteDNSRecord(ctx, req)
+	})
+	return &proto.DeleteDNSRecordResponse{OperationId: op.ID}, nil
+}
+
+//ListDNSRecords A, CNAME and TXT records of the zone, optionally of a single type
+func (s *spawnerService) ListDNSRecords(ctx context.Context, req *proto.ListDNSRecordsRequest) (*proto.ListDNSRecordsResponse, error) {
+	if req.Type != "" {
+		req.Type = strings.ToUpper(req.Type)
+		if err := validateDNSType(req.Type); err != nil {
+			return nil, err
+		}
+	}
+	provider, err := s.dnsProvider(req.Provider)
+	if err != nil {
+		return nil, err
+	}
+	return provider.ListDNSRecords(ctx, req)
+}
+
+//deleteClusterRecords deletes the dns records tied to the deleted cluster, records which could not be deleted are logged
+func (s *spawnerService) deleteClusterRecords(ctx context.Context, req *proto.ClusterDeleteRequest) {
+	records, err := s.inventory.List(ctx, inventory.Filter{
+		Provider: req.Provider,
+		Account:  req.AccountName,
+		Region:   req.Region,
+		Kind:     inventory.KindDNSRecord,
+		Cluster:  req.ClusterName,
+	})
+	if err != nil {
+		s.logger.Errorw("failed to list dns records of the cluster", "cluster", req.ClusterName, "error", err)
+		return
+	}
+	if len(records) == 0 {
+		return
+	}
+	provider, err := s.dnsProvider(req.Provider)
+	if err != nil {
+		s.logger.Errorw("failed to delete dns records of the cluster", "cluster", req.ClusterName, "error", err)
+		return
+	}
+
+	for _, r := range records {
+		_, err := provider.DeleteDNSRecord(ctx, &proto.DeleteDNSRecordRequest{
+			Provider:    req.Provider,
+			Region:      req.Region,
+			AccountName: req.AccountName,
+			Zone:        r.Attributes["zone"],
+			Name:        r.Name,
+			Type:        r.Attributes["type"],
+		})
+		if err != nil {
+			s.logger.Errorw("failed to delete dns record of the cluster", "cluster", req.ClusterName, "record", r.ID, "error", err)
+			continue
+		}
+		operation.Report(ctx, "dns record '%s' deleted", r.ID)
+	}
+}
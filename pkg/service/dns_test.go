@@ -0,0 +1,84 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gitlab.com/netbook-devs/spawner-service/pkg/inventory"
+	proto "gitlab.com/netbook-devs/spawner-service/proto/netbookai/spawner"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func Test_ValidateDNSRecord(t *testing.T) {
+	r := &proto.DNSRecord{Name: "app.example.com", Type: "a", Values: []string{"10.0.0.1", "10.0.0.2"}}
+	require.NoError(t, validateDNSRecord(r))
+	assert.Equal(t, "A", r.Type)
+	assert.Equal(t, int64(defaultDNSTTL), r.Ttl)
+
+	assert.NoError(t, validateDNSRecord(&proto.DNSRecord{Name: "www.example.com", Type: "CNAME", Values: []string{"app.example.com"}, Ttl: 60}))
+	assert.NoError(t, validateDNSRecord(&proto.DNSRecord{Name: "example.com", Type: "TXT", Values: []string{"v=spf1 -all", "verify"}}))
+
+	for _, r := range []*proto.DNSRecord{
+		nil,
+		{Type: "A", Values: []string{"10.0.0.1"}},
+		{Name: "app.example.com", Type: "MX", Values: []string{"mail.example.com"}},
+		{Name: "app.example.com", Type: "A"},
+		{Name: "app.example.com", Type: "A", Values: []string{"app.example.com"}},
+		{Name: "app.example.com", Type: "A", Values: []string{"2001:db8::1"}},
+		{Name: "www.example.com", Type: "CNAME", Values: []string{"a.example.com", "b.example.com"}},
+		{Name: "app.example.com", Type: "A", Values: []string{"10.0.0.1"}, Ttl: -1},
+	} {
+		assert.Equal(t, codes.InvalidArgument, status.Code(validateDNSRecord(r)), "expected invalid %v", r)
+	}
+}
+
+func Test_DeleteClusterRecords(t *testing.T) {
+	s, controller := newTestService(t, testOptions{})
+	ctx := context.Background()
+
+	_, err := controller.CreateCluster(ctx, &proto.ClusterRequest{Provider: "fake", Region: "local", AccountName: "dev", ClusterName: "dev"})
+	require.NoError(t, err)
+
+	upsert := func(name, cluster string) {
+		res, err := s.UpsertDNSRecord(ctx, &proto.UpsertDNSRecordRequest{Provider: "fake", Region: "local", AccountName: "dev",
+			Record: &proto.DNSRecord{Zone: "example.com", Name: name, Type: "A", Values: []string{"10.0.0.1"}, ClusterName: cluster}})
+		require.NoError(t, err)
+		op, err := s.operations.Wait(ctx, res.OperationId)
+		require.NoError(t, err)
+		require.Empty(t, op.Error)
+	}
+	upsert("app.example.com", "dev")
+	upsert("docs.example.com", "")
+
+	res, err := s.DeleteCluster(ctx, &proto.ClusterDeleteRequest{Provider: "fake", Region: "local", AccountName: "dev", ClusterName: "dev"})
+	require.NoError(t, err)
+	op, err := s.operations.Wait(ctx, res.OperationId)
+	require.NoError(t, err)
+	require.Empty(t, op.Error)
+
+	records, err := s.ListDNSRecords(ctx, &proto.ListDNSRecordsRequest{Provider: "fake", Region: "local", AccountName: "dev", Zone: "example.com"})
+	require.NoError(t, err)
+	require.Len(t, records.Records, 1, "record of the deleted cluster is removed")
+	assert.Equal(t, "docs.example.com", records.Records[0].Name)
+}
+
+func Test_AddRoute53Record(t *testing.T) {
+	s, _ := newTestService(t, testOptions{})
+	ctx := context.Background()
+
+	assert.Equal(t, "A", route53RecordType("10.0.0.1"))
+	assert.Equal(t, "CNAME", route53RecordType("lb-1.us-west-2.elb.amazonaws.com"))
+
+	_, err := s.addRoute53Record(ctx, "fake", "example.com", &proto.AddRoute53RecordRequest{Region: "local", AccountName: "dev", ClusterName: "dev",
+		DnsName: "lb-1.us-west-2.elb.amazonaws.com", RecordName: "app.example.com"})
+	require.NoError(t, err)
+
+	//record is tied to the cluster so that it is deleted with it
+	records, err := s.inventory.List(ctx, inventory.Filter{Provider: "fake", Kind: inventory.KindDNSRecord, Cluster: "dev"})
+	require.NoError(t, err)
+	require.Len(t, records, 1)
+	assert.Equal(t, inventory.DNSRecordID("example.com", "app.example.com", "CNAME"), records[0].ID)
+}
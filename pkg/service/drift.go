@@ -0,0 +1,79 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"gitlab.com/netbook-devs/spawner-service/pkg/drift"
+	"gitlab.com/netbook-devs/spawner-service/pkg/inventory"
+	proto "gitlab.com/netbook-devs/spawner-service/proto/netbookai/spawner"
+)
+
+const defaultDriftDetectionInterval = 15 * time.Minute
+
+func driftDetectionInterval(minutes int) time.Duration {
+	if minutes > 0 {
+		return time.Duration(minutes) * time.Minute
+	}
+	return defaultDriftDetectionInterval
+}
+
+//driftClusters spawner created clusters on the provider, GetClusters lists only the active clusters tagged by spawner in scope
+func (s *spawnerService) driftClusters(ctx context.Context, provider, account, region string) ([]*proto.ClusterSpec, error) {
+	p, err := s.controller(provider, CapClusters)
+	if err != nil {
+		return nil, err
+	}
+	res, err := p.GetClusters(ctx, &proto.GetClustersRequest{Provider: provider, Region: region, AccountName: account})
+	if err != nil {
+		return nil, err
+	}
+	return res.Clusters, nil
+}
+
+func (s *spawnerService) driftStatus(ctx context.Context, c inventory.Resource) (string, error) {
+	p, err := s.controller(c.Provider, CapClusters)
+	if err != nil {
+		return "", err
+	}
+	res, err := p.ClusterStatus(ctx, &proto.ClusterStatusRequest{Provider: c.Provider, Region: c.Region, AccountName: c.Account, ClusterName: c.Name})
+	if err != nil {
+		return "", err
+	}
+	return res.Status, nil
+}
+
+func driftProto(d drift.Drift) *proto.Drift {
+	return &proto.Drift{
+		Provider:    d.Provider,
+		AccountName: d.Account,
+		Region:      d.Region,
+		ClusterName: d.Cluster,
+		Resource:    d.Resource,
+		Type:        string(d.Type),
+		Expected:    d.Expected,
+		Actual:      d.Actual,
+	}
+}
+
+//GetDrift compares the clusters in the inventory with the spawner created clusters on the provider
+func (s *spawnerService) GetDrift(ctx context.Context, req *proto.GetDriftRequest) (*proto.GetDriftResponse, error) {
+	drifts, err := s.drift.Detect(ctx, inventory.Filter{
+		Provider: req.GetProvider(),
+		Account:  req.GetAccountName(),
+		Region:   req.GetRegion(),
+		Cluster:  req.GetClusterName(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	res := &proto.GetDriftResponse{
+		Drifts:     make([]*proto.Drift, 0, len(drifts)),
+		DetectedAt: time.Now().UTC().Format(time.RFC3339),
+	}
+	for _, d := range drifts {
+		res.Drifts = append(res.Drifts, driftProto(d))
+	}
+	return res, nil
+}
@@ -0,0 +1,152 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"gitlab.com/netbook-devs/spawner-service/pkg/pricing"
+	"gitlab.com/netbook-devs/spawner-service/pkg/service/common"
+	"gitlab.com/netbook-devs/spawner-service/pkg/service/constants"
+	proto "gitlab.com/netbook-devs/spawner-service/proto/netbookai/spawner"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+//defaultPriceRefreshInterval how often the price table file is reloaded
+const defaultPriceRefreshInterval = time.Hour
+
+//cost item kinds
+const (
+	costControlPlane = "control-plane"
+	costNodePool     = "node-pool"
+	costNodeDisk     = "node-disk"
+	costVolume       = "volume"
+)
+
+func hourlyCost(item *proto.CostItem, hourly float64) *proto.CostItem {
+	item.Hourly = hourly
+	item.Monthly = hourly * pricing.HoursPerMonth
+	return item
+}
+
+func monthlyCost(item *proto.CostItem, monthly float64) *proto.CostItem {
+	item.Hourly = monthly / pricing.HoursPerMonth
+	item.Monthly = monthly
+	return item
+}
+
+//nodeHourly hourly price of a node, gpus are added for the providers pricing them separately from the instance
+func nodeHourly(prices pricing.Prices, spotRatio float64, provider, region string, node *proto.NodeSpec, instance string, spot bool) (float64, bool) {
+	hourly, ok := prices.Hourly(instance, spot, spotRatio)
+	if !ok {
+		return 0, false
+	}
+	if m, known := common.GetCatalog().Resolve(provider, region, node.MachineType); known && m.GPUCount > 0 {
+		if gpu, ok := prices.GPUHourly(m.GPUModel, spot, spotRatio); ok {
+			hourly += gpu * float64(m.GPUCount)
+		}
+	}
+	return hourly, true
+}
+
+//estimateNodePool cost of the nodes and their disks, spot node pools are priced with the most expensive of the spot instances
+func estimateNodePool(prices pricing.Prices, spotRatio float64, provider, region string, node *proto.NodeSpec) []*proto.CostItem {
+	count := nodeCount(node)
+	spot := node.CapacityType == proto.CapacityType_SPOT
+	capacityType := proto.CapacityType_ONDEMAND
+	instances := []string{desiredInstance(provider, region, node)}
+	if spot {
+		capacityType = proto.CapacityType_SPOT
+		if len(node.SpotInstances) > 0 {
+			instances = node.SpotInstances
+		}
+	}
+
+	item := &proto.CostItem{Kind: costNodePool, Name: node.Name, Quantity: count, CapacityType: capacityType}
+	price, priced := 0.0, false
+	for _, instance := range instances {
+		if hourly, ok := nodeHourly(prices, spotRatio, provider, region, node, instance, spot); ok && hourly >= price {
+			price, priced, item.Type = hourly, true, instance
+		}
+	}
+
+	items := []*proto.CostItem{}
+	switch {
+	case instances[0] == "":
+		item.Warning = "instance type can not be resolved from the machine type"
+		items = append(items, item)
+	case !priced:
+		item.Type = instances[0]
+		item.Warning = fmt.Sprintf("no price for instance type '%s' in region '%s'", instances[0], region)
+		items = append(items, item)
+	default:
+		items = append(items, hourlyCost(item, price*float64(count)))
+	}
+
+	if node.DiskSize > 0 {
+		size := int64(node.DiskSize) * count
+		items = append(items, monthlyCost(&proto.CostItem{
+			Kind:     costNodeDisk,
+			Name:     node.Name,
+			Type:     pricing.DefaultVolumeType,
+			Quantity: size,
+		}, float64(size)*prices.VolumeMonthly(pricing.DefaultVolumeType)))
+	}
+	return items
+}
+
+//EstimateCost itemized cost of the cluster or node pool request from the price table of the provider and region
+func (s *spawnerService) EstimateCost(ctx context.Context, req *proto.EstimateCostRequest) (*proto.EstimateCostResponse, error) {
+	var provider, region string
+	var node *proto.NodeSpec
+	controlPlane := false
+	switch r := req.Request.(type) {
+	case *proto.EstimateCostRequest_Cluster:
+		provider, region, node, controlPlane = r.Cluster.Provider, r.Cluster.Region, r.Cluster.Node, true
+	case *proto.EstimateCostRequest_NodePool:
+		provider, region, node = r.NodePool.Provider, r.NodePool.Region, r.NodePool.NodeSpec
+	default:
+		return nil, status.Error(codes.InvalidArgument, "cluster or node pool request is required")
+	}
+	if provider == constants.FakeLabel {
+		//fake provider uses the aws machine types
+		provider = constants.AwsLabel
+	}
+
+	table, updatedAt := s.prices.Table()
+	prices, spotRatio, ok := table.Region(provider, region)
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "no prices for provider '%s'", provider)
+	}
+
+	items := []*proto.CostItem{}
+	if controlPlane {
+		items = append(items, hourlyCost(&proto.CostItem{Kind: costControlPlane, Quantity: 1}, prices.ControlPlaneHourly))
+	}
+	if node != nil {
+		items = append(items, estimateNodePool(prices, spotRatio, provider, region, node)...)
+	}
+	for i, v := range req.Volumes {
+		item := &proto.CostItem{Kind: costVolume, Name: fmt.Sprintf("volume-%d", i+1), Type: v.Volumetype, Quantity: v.Size}
+		if v.Size <= 0 {
+			item.Warning = "volume size is required"
+			items = append(items, item)
+			continue
+		}
+		items = append(items, monthlyCost(item, float64(v.Size)*prices.VolumeMonthly(v.Volumetype)))
+	}
+
+	res := &proto.EstimateCostResponse{
+		Items:           items,
+		Currency:        table.Currency,
+		PricesUpdatedAt: updatedAt.UTC().Format(time.RFC3339),
+	}
+	for _, item := range items {
+		if item.Warning == "" {
+			res.Hourly += item.Hourly
+			res.Monthly += item.Monthly
+		}
+	}
+	return res, nil
+}
@@ -0,0 +1,76 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gitlab.com/netbook-devs/spawner-service/pkg/pricing"
+	proto "gitlab.com/netbook-devs/spawner-service/proto/netbookai/spawner"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func Test_EstimateCost(t *testing.T) {
+	ctx := context.Background()
+	book, err := pricing.NewBook(zap.NewNop().Sugar(), "")
+	require.NoError(t, err)
+	s := &spawnerService{prices: book, logger: zap.NewNop().Sugar()}
+
+	res, err := s.EstimateCost(ctx, &proto.EstimateCostRequest{
+		Request: &proto.EstimateCostRequest_Cluster{Cluster: &proto.ClusterRequest{
+			Provider: "aws",
+			Region:   "us-west-2",
+			Node:     &proto.NodeSpec{Name: "gpu", MachineType: "xl+v100", Count: 2, DiskSize: 100},
+		}},
+		Volumes: []*proto.CreateVolumeRequest{{Volumetype: "gp3", Size: 500}},
+	})
+	require.NoError(t, err)
+	require.Len(t, res.Items, 4)
+	assert.Equal(t, "control-plane", res.Items[0].Kind)
+	assert.InDelta(t, 73, res.Items[0].Monthly, 1e-9)
+	assert.Equal(t, "p3.16xlarge", res.Items[1].Type)
+	assert.Equal(t, proto.CapacityType_ONDEMAND, res.Items[1].CapacityType)
+	assert.InDelta(t, 48.96, res.Items[1].Hourly, 1e-9)
+	assert.InDelta(t, 20, res.Items[2].Monthly, 1e-9, "200 GiB of node disks")
+	assert.InDelta(t, 40, res.Items[3].Monthly, 1e-9, "500 GiB gp3 volume")
+	assert.InDelta(t, 0.1+48.96+60.0/pricing.HoursPerMonth, res.Hourly, 1e-9)
+	assert.Equal(t, "USD", res.Currency)
+
+	res, err = s.EstimateCost(ctx, &proto.EstimateCostRequest{
+		Request: &proto.EstimateCostRequest_NodePool{NodePool: &proto.NodeSpawnRequest{
+			Provider: "gcp",
+			NodeSpec: &proto.NodeSpec{Name: "t4", MachineType: "m+t4", CapacityType: proto.CapacityType_SPOT},
+		}},
+	})
+	require.NoError(t, err)
+	require.Len(t, res.Items, 1)
+	assert.InDelta(t, 0.19*0.3+0.11, res.Items[0].Hourly, 1e-9, "spot instance with spot gpu attached")
+
+	res, err = s.EstimateCost(ctx, &proto.EstimateCostRequest{
+		Request: &proto.EstimateCostRequest_NodePool{NodePool: &proto.NodeSpawnRequest{
+			Provider: "aws",
+			NodeSpec: &proto.NodeSpec{Name: "spot", CapacityType: proto.CapacityType_SPOT, SpotInstances: []string{"m5.2xlarge", "m5.8xlarge", "x9.large"}, Count: 3},
+		}},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "m5.8xlarge", res.Items[0].Type, "most expensive spot instance")
+	assert.Empty(t, res.Items[0].Warning)
+
+	res, err = s.EstimateCost(ctx, &proto.EstimateCostRequest{
+		Request: &proto.EstimateCostRequest_NodePool{NodePool: &proto.NodeSpawnRequest{
+			Provider: "azure",
+			NodeSpec: &proto.NodeSpec{Name: "custom", Instance: "Standard_D2_v2"},
+		}},
+	})
+	require.NoError(t, err)
+	assert.NotEmpty(t, res.Items[0].Warning)
+	assert.Zero(t, res.Monthly, "items without price are not in the totals")
+
+	_, err = s.EstimateCost(ctx, &proto.EstimateCostRequest{})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = s.EstimateCost(ctx, &proto.EstimateCostRequest{Request: &proto.EstimateCostRequest_NodePool{NodePool: &proto.NodeSpawnRequest{Provider: "k8s"}}})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
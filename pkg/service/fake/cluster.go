@@ -0,0 +1,310 @@
+package fake
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/pkg/errors"
+	"gitlab.com/netbook-devs/spawner-service/pkg/inventory"
+	"gitlab.com/netbook-devs/spawner-service/pkg/operation"
+	"gitlab.com/netbook-devs/spawner-service/pkg/service/constants"
+	"gitlab.com/netbook-devs/spawner-service/pkg/service/labels"
+	proto "gitlab.com/netbook-devs/spawner-service/proto/netbookai/spawner"
+	gproto "google.golang.org/protobuf/proto"
+)
+
+//getCluster returns the cluster which is not deleted yet, must be called with lock held
+func (f *FakeController) getCluster(account, region, name string) (*cluster, error) {
+	f.sweep()
+	c, ok := f.clusters[clusterKey(account, region, name)]
+	if !ok {
+		return nil, errors.Wrapf(ERR_CLUSTER_NOT_FOUND, "cluster '%s' in region '%s'", name, region)
+	}
+	return c, nil
+}
+
+func inScope(tags map[string]string) bool {
+	scope, ok := tags[constants.Scope]
+	return ok && scope == labels.ScopeTag()
+}
+
+func (f *FakeController) nodeSpecs(c *cluster) []*proto.NodeSpec {
+	now := f.now()
+	names := make([]string, 0, len(c.nodePools))
+	for n := range c.nodePools {
+		names = append(names, n)
+	}
+	sort.Strings(names)
+
+	nodes := []*proto.NodeSpec{}
+	for _, n := range names {
+		np := c.nodePools[n]
+		state := constants.Inactive
+		if np.status(now, f.transition) == StatusActive {
+			state = constants.Active
+		}
+		nodes = append(nodes, &proto.NodeSpec{
+			Name:              n,
+			Instance:          np.instance,
+			DiskSize:          np.spec.DiskSize,
+			State:             state,
+			ClusterId:         c.id,
+			Labels:            np.tags,
+			GpuEnabled:        np.spec.GpuEnabled,
+			Health:            &proto.Health{},
+			Count:             np.spec.Count,
+			CapacityType:      np.spec.CapacityType,
+			MachineType:       np.spec.MachineType,
+			Availabilityzone:  fmt.Sprintf("%sa", c.region),
+			KubernetesVersion: np.version,
+		})
+	}
+	return nodes
+}
+
+//CreateCluster creates cluster in memory, cluster stays in CREATING state for the transition period
+func (f *FakeController) CreateCluster(ctx context.Context, req *proto.ClusterRequest) (*proto.ClusterResponse, error) {
+
+	var clusterName string
+	if clusterName = req.ClusterName; len(clusterName) == 0 {
+		clusterName = fmt.Sprintf("%s-%s", req.Provider, req.Region)
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.sweep()
+	key := clusterKey(req.AccountName, req.Region, clusterName)
+	if _, ok := f.clusters[key]; ok {
+		f.logger.Infof("cluster '%s', already exist", clusterName)
+		return nil, ERR_CLUSTER_EXIST
+	}
+
+	tags := aws.StringValueMap(labels.DefaultTags())
+	tags[constants.ClusterNameLabel] = clusterName
+	for k, v := range req.Labels {
+		tags[k] = v
+	}
+
+	version := req.KubernetesVersion
+	if version == "" {
+		version = defaultKubeVersion
+	}
+	if !validVersion(version) {
+		return nil, errors.Wrapf(ERR_INVALID_VERSION, "'%s'", version)
+	}
+
+	f.clusters[key] = &cluster{
+		lifecycle: lifecycle{createdAt: f.now()},
+		id:        newID("cluster"),
+		name:      clusterName,
+		account:   req.AccountName,
+		region:    req.Region,
+		tags:      tags,
+		nodePools: make(map[string]*nodePool),
+		version:   version,
+	}
+	f.logger.Infow("cluster is in creating state", "cluster", clusterName, "provider", constants.FakeLabel)
+	operation.Report(ctx, "cluster creation requested")
+	f.inventory.Created(ctx, inventory.Resource{
+		ID:         clusterName,
+		Kind:       inventory.KindCluster,
+		Account:    req.AccountName,
+		Region:     req.Region,
+		Name:       clusterName,
+		Labels:     req.Labels,
+		Attributes: map[string]string{"id": f.clusters[key].id},
+	})
+
+	return &proto.ClusterResponse{
+		ClusterName: clusterName,
+	}, nil
+}
+
+//GetCluster describe cluster with given name, each node in the node pool is listed separately
+func (f *FakeController) GetCluster(ctx context.Context, req *proto.GetClusterRequest) (*proto.ClusterSpec, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	c, err := f.getCluster(req.AccountName, req.Region, req.ClusterName)
+	if err != nil {
+		return nil, err
+	}
+
+	nodes := []*proto.NodeSpec{}
+	for _, pool := range f.nodeSpecs(c) {
+		count := pool.Count
+		if count == 0 {
+			count = 1
+		}
+		for i := int64(0); i < count; i++ {
+			node := gproto.Clone(pool).(*proto.NodeSpec)
+			node.HostName = fmt.Sprintf("%s-%s-%d", c.name, pool.Name, i)
+			node.Uuid = fmt.Sprintf("%s-%d", c.id, i)
+			node.IpAddr = fmt.Sprintf("192.168.0.%d", i+1)
+			node.Count = 0
+			nodes = append(nodes, node)
+		}
+	}
+
+	return &proto.ClusterSpec{
+		Name:              c.name,
+		ClusterId:         c.id,
+		NodeSpec:          nodes,
+		KubernetesVersion: c.version,
+	}, nil
+}
+
+//GetClusters return active, spawner created clusters in the scope
+func (f *FakeController) GetClusters(ctx context.Context, req *proto.GetClustersRequest) (*proto.GetClustersResponse, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.sweep()
+	now := f.now()
+
+	resp := &proto.GetClustersResponse{
+		Clusters: []*proto.ClusterSpec{},
+	}
+
+	keys := make([]string, 0, len(f.clusters))
+	for k := range f.clusters {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		c := f.clusters[k]
+		if c.account != req.AccountName || (req.Region != "" && c.region != req.Region) {
+			continue
+		}
+		if c.status(now, f.transition) != StatusActive {
+			continue
+		}
+		if c.tags[constants.CreatorLabel] != constants.SpawnerServiceLabel || !inScope(c.tags) {
+			continue
+		}
+		resp.Clusters = append(resp.Clusters, &proto.ClusterSpec{
+			Name:              c.name,
+			ClusterId:         c.id,
+			NodeSpec:          f.nodeSpecs(c),
+			KubernetesVersion: c.version,
+		})
+	}
+	return resp, nil
+}
+
+//ClusterStatus get the cluster status
+func (f *FakeController) ClusterStatus(ctx context.Context, req *proto.ClusterStatusRequest) (*proto.ClusterStatusResponse, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	c, err := f.getCluster(req.AccountName, req.Region, req.ClusterName)
+	if err != nil {
+		return &proto.ClusterStatusResponse{
+			Error: err.Error(),
+		}, err
+	}
+
+	return &proto.ClusterStatusResponse{
+		Status: c.status(f.now(), f.transition),
+	}, nil
+}
+
+//DeleteCluster marks the cluster for deletion, fails when node groups are attached unless force delete is requested
+func (f *FakeController) DeleteCluster(ctx context.Context, req *proto.ClusterDeleteRequest) (*proto.ClusterDeleteResponse, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	c, err := f.getCluster(req.AccountName, req.Region, req.ClusterName)
+	if err != nil {
+		return nil, errors.Wrap(err, "DeleteCluster: ")
+	}
+
+	if !inScope(c.tags) {
+		return nil, fmt.Errorf("cluster doesnt not available in '%s'", labels.ScopeTag())
+	}
+
+	if c.deleting() {
+		return nil, fmt.Errorf("cluster '%s' is already being deleted", c.name)
+	}
+
+	if len(c.nodePools) > 0 {
+		if !req.ForceDelete {
+			return nil, ERR_CLUSTER_HAS_NODES
+		}
+		f.logger.Infow("force deleting all nodegroups of cluster", "cluster", c.name)
+		//nodegroups are removed right away, waiting on them is what force delete does on real providers
+		c.nodePools = make(map[string]*nodePool)
+	}
+
+	c.deletingAt = f.now()
+	f.logger.Infow("requested cluster to be deleted", "cluster", c.name, "status", StatusDeleting)
+	f.inventory.Deleted(ctx, inventory.KindCluster, c.account, c.region, c.name)
+	return &proto.ClusterDeleteResponse{}, nil
+}
+
+//TagCluster adds or replaces the tags of the cluster
+func (f *FakeController) TagCluster(ctx context.Context, account, region, clusterName string, tags map[string]string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	c, err := f.getCluster(account, region, clusterName)
+	if err != nil {
+		return err
+	}
+	if !inScope(c.tags) {
+		return fmt.Errorf("cluster '%s' not available in scope '%s'", clusterName, labels.ScopeTag())
+	}
+	for k, v := range tags {
+		c.tags[k] = v
+	}
+	return nil
+}
+
+func validVersion(v string) bool {
+	for _, k := range kubeVersions {
+		if k == v {
+			return true
+		}
+	}
+	return false
+}
+
+//ListKubernetesVersions versions fake clusters can be created with, same in every region
+func (f *FakeController) ListKubernetesVersions(ctx context.Context, req *proto.ListKubernetesVersionsRequest) (*proto.ListKubernetesVersionsResponse, error) {
+	return &proto.ListKubernetesVersionsResponse{
+		Versions:       kubeVersions,
+		DefaultVersion: defaultKubeVersion,
+	}, nil
+}
+
+//UpgradeCluster sets the control plane version of the active cluster, node groups keep their version
+func (f *FakeController) UpgradeCluster(ctx context.Context, req *proto.UpgradeClusterRequest) (*proto.UpgradeClusterResponse, error) {
+	if !validVersion(req.KubernetesVersion) {
+		return nil, errors.Wrapf(ERR_INVALID_VERSION, "'%s'", req.KubernetesVersion)
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	c, err := f.getCluster(req.AccountName, req.Region, req.ClusterName)
+	if err != nil {
+		return nil, err
+	}
+	if c.status(f.now(), f.transition) != StatusActive {
+		return nil, errors.Wrapf(ERR_CLUSTER_NOT_ACTIVE, "cluster '%s'", c.name)
+	}
+	if !inScope(c.tags) {
+		return nil, fmt.Errorf("cluster '%s' not available in scope '%s'", c.name, labels.ScopeTag())
+	}
+
+	c.version = req.KubernetesVersion
+	f.logger.Infow("upgraded cluster", "cluster", c.name, "version", c.version)
+	operation.Report(ctx, "control plane upgraded to %s", c.version)
+	f.inventory.Updated(ctx, inventory.KindCluster, c.account, c.region, c.name, nil,
+		map[string]string{"kubernetesVersion": c.version})
+	return &proto.UpgradeClusterResponse{}, nil
+}
@@ -0,0 +1,208 @@
+package fake
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"gitlab.com/netbook-devs/spawner-service/pkg/config"
+	"gitlab.com/netbook-devs/spawner-service/pkg/inventory"
+	"gitlab.com/netbook-devs/spawner-service/pkg/service/common"
+	"gitlab.com/netbook-devs/spawner-service/pkg/service/constants"
+	proto "gitlab.com/netbook-devs/spawner-service/proto/netbookai/spawner"
+	"go.uber.org/zap"
+)
+
+//cluster and nodegroup status values, kept same as EKS so clients can treat fake like aws
+const (
+	StatusCreating = "CREATING"
+	StatusActive   = "ACTIVE"
+	StatusDeleting = "DELETING"
+
+	VolumeCreating  = "creating"
+	VolumeAvailable = "available"
+
+	SnapshotPending   = "pending"
+	SnapshotCompleted = "completed"
+
+	defaultTransition = time.Second * 10
+)
+
+var (
+	ERR_CLUSTER_EXIST      = errors.New("cluster already exist")
+	ERR_CLUSTER_NOT_FOUND  = common.ERR_CLUSTER_NOT_FOUND
+	ERR_CLUSTER_NOT_ACTIVE = errors.New("cluster is not active")
+	ERR_CLUSTER_HAS_NODES  = errors.New("cluster has nodegroups attached, delete them or use force delete")
+	ERR_NODEGROUP_EXIST    = errors.New("nodegroup already exist")
+	ERR_NODEGROUP_NOTFOUND = errors.New("nodegroup not found")
+	ERR_VOLUME_NOT_FOUND   = errors.New("volume not found")
+	ERR_SNAPSHOT_NOT_FOUND = errors.New("snapshot not found")
+	ERR_VOLUME_ATTACHED    = errors.New("volume is attached to an instance")
+	ERR_VOLUME_DETACHED    = errors.New("volume is not attached")
+	ERR_RECORD_NOT_FOUND   = errors.New("dns record not found")
+	ERR_INVALID_VERSION    = errors.New("unsupported kubernetes version")
+)
+
+//kubeVersions kubernetes versions the fake clusters can run, oldest first
+var kubeVersions = []string{"1.20", "1.21", "1.22", "1.23"}
+
+const defaultKubeVersion = "1.22"
+
+//lifecycle tracks the simulated state transitions of a resource
+//
+// resource stays in creating state for `transition` duration after creation and is considered gone
+// `transition` duration after deletion was requested.
+type lifecycle struct {
+	createdAt  time.Time
+	deletingAt time.Time
+}
+
+func (l *lifecycle) deleting() bool {
+	return !l.deletingAt.IsZero()
+}
+
+func (l *lifecycle) status(now time.Time, transition time.Duration) string {
+	if l.deleting() {
+		return StatusDeleting
+	}
+	if now.Sub(l.createdAt) < transition {
+		return StatusCreating
+	}
+	return StatusActive
+}
+
+func (l *lifecycle) gone(now time.Time, transition time.Duration) bool {
+	return l.deleting() && now.Sub(l.deletingAt) >= transition
+}
+
+type nodePool struct {
+	lifecycle
+	spec     *proto.NodeSpec
+	instance string
+	tags     map[string]string
+	//instanceTags tags added to the underlying instances through TagNodeInstance
+	instanceTags map[string]string
+	//taints value:effect by key
+	taints  map[string]string
+	version string
+	image   string
+}
+
+type cluster struct {
+	lifecycle
+	id        string
+	name      string
+	account   string
+	region    string
+	tags      map[string]string
+	nodePools map[string]*nodePool
+	//version kubernetes version of the control plane
+	version string
+}
+
+type volume struct {
+	lifecycle
+	id         string
+	account    string
+	region     string
+	zone       string
+	volumeType string
+	size       int64
+	snapshotId string
+	tags       map[string]string
+	//instance and device the volume is attached to
+	instance string
+	device   string
+}
+
+type snapshot struct {
+	lifecycle
+	id       string
+	account  string
+	region   string
+	volumeId string
+	size     int64
+	tags     map[string]string
+}
+
+//FakeController in-memory provider which simulates the cloud behaviour, meant for local development and tests
+type FakeController struct {
+	logger     *zap.SugaredLogger
+	inventory  *inventory.Recorder
+	transition time.Duration
+	now        func() time.Time
+
+	mu        sync.Mutex
+	clusters  map[string]*cluster
+	volumes   map[string]*volume
+	snapshots map[string]*snapshot
+	//records dns records by account and record id, records are changed right away
+	records map[string]*proto.DNSRecord
+}
+
+//NewController returns fake controller, resource state transitions takes FAKE_TRANSITION_SECONDS
+func NewController(logger *zap.SugaredLogger, store inventory.Store) *FakeController {
+	transition := time.Duration(config.Get().FakeTransitionSeconds) * time.Second
+	if transition <= 0 {
+		transition = defaultTransition
+	}
+	return newController(logger, store, transition, time.Now)
+}
+
+func newController(logger *zap.SugaredLogger, store inventory.Store, transition time.Duration, now func() time.Time) *FakeController {
+	return &FakeController{
+		logger:     logger,
+		inventory:  inventory.NewRecorder(store, constants.FakeLabel, logger),
+		transition: transition,
+		now:        now,
+		clusters:   make(map[string]*cluster),
+		volumes:    make(map[string]*volume),
+		snapshots:  make(map[string]*snapshot),
+		records:    make(map[string]*proto.DNSRecord),
+	}
+}
+
+func clusterKey(account, region, name string) string {
+	return fmt.Sprintf("%s/%s/%s", account, region, name)
+}
+
+func newID(prefix string) string {
+	b := make([]byte, 8)
+	rand.Read(b)
+	return fmt.Sprintf("%s-%s", prefix, hex.EncodeToString(b))
+}
+
+//sweep removes all the resources whose deletion has been completed, must be called with lock held
+func (f *FakeController) sweep() {
+	now := f.now()
+	for k, c := range f.clusters {
+		if c.gone(now, f.transition) {
+			delete(f.clusters, k)
+			continue
+		}
+		for n, np := range c.nodePools {
+			if np.gone(now, f.transition) {
+				delete(c.nodePools, n)
+			}
+		}
+	}
+	for k, v := range f.volumes {
+		if v.gone(now, f.transition) {
+			delete(f.volumes, k)
+		}
+	}
+	for k, s := range f.snapshots {
+		if s.gone(now, f.transition) {
+			delete(f.snapshots, k)
+		}
+	}
+}
+
+//AddToken deprecated
+func (f *FakeController) AddToken(ctx context.Context, req *proto.AddTokenRequest) (*proto.AddTokenResponse, error) {
+	return &proto.AddTokenResponse{}, nil
+}
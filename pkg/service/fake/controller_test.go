@@ -0,0 +1,245 @@
+package fake
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gitlab.com/netbook-devs/spawner-service/pkg/inventory"
+	proto "gitlab.com/netbook-devs/spawner-service/proto/netbookai/spawner"
+	"go.uber.org/zap"
+)
+
+type clock struct {
+	t time.Time
+}
+
+func (c *clock) now() time.Time {
+	return c.t
+}
+
+func (c *clock) advance(d time.Duration) {
+	c.t = c.t.Add(d)
+}
+
+func newTestController() (*FakeController, *clock) {
+	clk := &clock{t: time.Date(2022, 4, 1, 10, 0, 0, 0, time.UTC)}
+	return newController(zap.NewNop().Sugar(), nil, time.Minute, clk.now), clk
+}
+
+func Test_ClusterLifecycle(t *testing.T) {
+	ctx := context.Background()
+	f, clk := newTestController()
+
+	req := &proto.ClusterRequest{
+		Provider:    "fake",
+		Region:      "local-1",
+		AccountName: "dev",
+		ClusterName: "test",
+		Labels:      map[string]string{"workspaceid": "ws-1"},
+	}
+	_, err := f.CreateCluster(ctx, req)
+	require.NoError(t, err)
+
+	_, err = f.CreateCluster(ctx, req)
+	assert.True(t, errors.Is(err, ERR_CLUSTER_EXIST), "duplicate cluster must fail")
+
+	statusReq := &proto.ClusterStatusRequest{Region: "local-1", AccountName: "dev", ClusterName: "test"}
+	stat, err := f.ClusterStatus(ctx, statusReq)
+	require.NoError(t, err)
+	assert.Equal(t, StatusCreating, stat.Status)
+
+	nodeReq := &proto.NodeSpawnRequest{
+		Region:      "local-1",
+		AccountName: "dev",
+		ClusterName: "test",
+		NodeSpec:    &proto.NodeSpec{Name: "pool", MachineType: "m+t4", Count: 2},
+	}
+	_, err = f.AddNode(ctx, nodeReq)
+	assert.True(t, errors.Is(err, ERR_CLUSTER_NOT_ACTIVE), "node can not be added to creating cluster")
+
+	clk.advance(time.Minute)
+	stat, err = f.ClusterStatus(ctx, statusReq)
+	require.NoError(t, err)
+	assert.Equal(t, StatusActive, stat.Status)
+
+	_, err = f.AddNode(ctx, nodeReq)
+	require.NoError(t, err)
+	_, err = f.AddNode(ctx, nodeReq)
+	assert.True(t, errors.Is(err, ERR_NODEGROUP_EXIST), "duplicate nodegroup must fail")
+
+	clusters, err := f.GetClusters(ctx, &proto.GetClustersRequest{Region: "local-1", AccountName: "dev"})
+	require.NoError(t, err)
+	require.Len(t, clusters.Clusters, 1)
+	require.Len(t, clusters.Clusters[0].NodeSpec, 1)
+	pool := clusters.Clusters[0].NodeSpec[0]
+	assert.Equal(t, "g4dn.xlarge", pool.Instance)
+	assert.True(t, pool.GpuEnabled)
+	assert.Equal(t, "spawner-service", pool.Labels["creator"])
+
+	assert.Equal(t, defaultKubeVersion, pool.KubernetesVersion, "pool gets the cluster version")
+
+	require.NoError(t, f.TagNodePool(ctx, "dev", "local-1", "test", "pool", map[string]string{"expires-at": "1650000000"}))
+	assert.True(t, errors.Is(f.TagNodePool(ctx, "dev", "local-1", "test", "unknown", nil), ERR_NODEGROUP_NOTFOUND))
+	require.NoError(t, f.TagCluster(ctx, "dev", "local-1", "test", map[string]string{"expires-at": "1650000000"}))
+	assert.True(t, errors.Is(f.TagCluster(ctx, "dev", "local-1", "unknown", nil), ERR_CLUSTER_NOT_FOUND))
+	clusters, err = f.GetClusters(ctx, &proto.GetClustersRequest{Region: "local-1", AccountName: "dev"})
+	require.NoError(t, err)
+	assert.Equal(t, "1650000000", clusters.Clusters[0].NodeSpec[0].Labels["expires-at"])
+
+	upgradeReq := &proto.UpgradeClusterRequest{Region: "local-1", AccountName: "dev", ClusterName: "test", KubernetesVersion: "1.9"}
+	_, err = f.UpgradeCluster(ctx, upgradeReq)
+	assert.True(t, errors.Is(err, ERR_INVALID_VERSION), "unsupported version")
+	upgradeReq.KubernetesVersion = "1.23"
+	_, err = f.UpgradeCluster(ctx, upgradeReq)
+	require.NoError(t, err)
+
+	cluster, err := f.GetCluster(ctx, &proto.GetClusterRequest{Region: "local-1", AccountName: "dev", ClusterName: "test"})
+	require.NoError(t, err)
+	assert.Len(t, cluster.NodeSpec, 2, "each node of the pool is listed")
+	assert.Equal(t, "1.23", cluster.KubernetesVersion)
+	assert.Equal(t, defaultKubeVersion, cluster.NodeSpec[0].KubernetesVersion, "node pools keep their version")
+
+	_, err = f.DeleteNode(ctx, &proto.NodeDeleteRequest{Region: "local-1", AccountName: "dev", ClusterName: "test", NodeGroupName: "unknown"})
+	assert.True(t, errors.Is(err, ERR_NODEGROUP_NOTFOUND), "unknown nodegroup")
+
+	deleteReq := &proto.ClusterDeleteRequest{Region: "local-1", AccountName: "dev", ClusterName: "test"}
+	_, err = f.DeleteCluster(ctx, deleteReq)
+	assert.True(t, errors.Is(err, ERR_CLUSTER_HAS_NODES), "cluster with nodes can not be deleted")
+
+	deleteReq.ForceDelete = true
+	_, err = f.DeleteCluster(ctx, deleteReq)
+	require.NoError(t, err)
+
+	stat, err = f.ClusterStatus(ctx, statusReq)
+	require.NoError(t, err)
+	assert.Equal(t, StatusDeleting, stat.Status)
+
+	clk.advance(time.Minute)
+	_, err = f.ClusterStatus(ctx, statusReq)
+	assert.True(t, errors.Is(err, ERR_CLUSTER_NOT_FOUND), "cluster is gone after deletion")
+}
+
+func Test_InventoryRecords(t *testing.T) {
+	ctx := context.Background()
+	store := inventory.NewMemoryStore()
+	clk := &clock{t: time.Date(2022, 4, 1, 10, 0, 0, 0, time.UTC)}
+	f := newController(zap.NewNop().Sugar(), store, time.Minute, clk.now)
+
+	_, err := f.CreateCluster(ctx, &proto.ClusterRequest{Region: "local-1", AccountName: "dev", ClusterName: "test", Labels: map[string]string{"workspaceid": "ws-1"}})
+	require.NoError(t, err)
+	clk.advance(time.Minute)
+	_, err = f.AddNode(ctx, &proto.NodeSpawnRequest{Region: "local-1", AccountName: "dev", ClusterName: "test", NodeSpec: &proto.NodeSpec{Name: "pool", Instance: "t3.small"}})
+	require.NoError(t, err)
+
+	res, err := store.List(ctx, inventory.Filter{Provider: "fake", Workspace: "ws-1"})
+	require.NoError(t, err)
+	require.Len(t, res, 1, "node pool carries no workspace label")
+	assert.Equal(t, inventory.KindCluster, res[0].Kind)
+
+	_, err = f.DeleteCluster(ctx, &proto.ClusterDeleteRequest{Region: "local-1", AccountName: "dev", ClusterName: "test", ForceDelete: true})
+	require.NoError(t, err)
+
+	res, err = store.List(ctx, inventory.Filter{Provider: "fake"})
+	require.NoError(t, err)
+	assert.Empty(t, res, "cluster and its node pools are deleted")
+
+	res, err = store.List(ctx, inventory.Filter{Provider: "fake", IncludeDeleted: true})
+	require.NoError(t, err)
+	assert.Len(t, res, 2)
+}
+
+func Test_VolumeAndSnapshot(t *testing.T) {
+	ctx := context.Background()
+	f, _ := newTestController()
+
+	vol, err := f.CreateVolume(ctx, &proto.CreateVolumeRequest{Region: "local-1", AccountName: "dev", Size: 10, Volumetype: "gp2"})
+	require.NoError(t, err)
+
+	snap, err := f.CreateSnapshotAndDelete(ctx, &proto.CreateSnapshotAndDeleteRequest{Region: "local-1", AccountName: "dev", Volumeid: vol.Volumeid})
+	require.NoError(t, err)
+	assert.True(t, snap.Deleted)
+
+	_, err = f.DeleteVolume(ctx, &proto.DeleteVolumeRequest{Region: "local-1", AccountName: "dev", Volumeid: vol.Volumeid})
+	assert.True(t, errors.Is(err, ERR_VOLUME_NOT_FOUND), "volume is deleted")
+
+	restored, err := f.CreateVolume(ctx, &proto.CreateVolumeRequest{Region: "local-1", AccountName: "dev", Snapshotid: snap.Snapshotid, DeleteSnapshot: true})
+	require.NoError(t, err)
+	assert.NotEqual(t, vol.Volumeid, restored.Volumeid)
+
+	_, err = f.CreateVolume(ctx, &proto.CreateVolumeRequest{Region: "local-1", AccountName: "dev", Snapshotid: snap.Snapshotid})
+	assert.True(t, errors.Is(err, ERR_SNAPSHOT_NOT_FOUND), "snapshot deleted after restore")
+}
+
+func Test_VolumeLifecycle(t *testing.T) {
+	ctx := context.Background()
+	f, _ := newTestController()
+
+	vol, err := f.CreateVolume(ctx, &proto.CreateVolumeRequest{Region: "local-1", AccountName: "dev", Size: 10, Volumetype: "gp2"})
+	require.NoError(t, err)
+
+	_, err = f.ResizeVolume(ctx, &proto.ResizeVolumeRequest{Region: "local-1", AccountName: "dev", VolumeId: vol.Volumeid, Size: 5})
+	assert.Error(t, err, "volumes can not be shrunk")
+	_, err = f.ResizeVolume(ctx, &proto.ResizeVolumeRequest{Region: "local-1", AccountName: "dev", VolumeId: vol.Volumeid, Size: 20})
+	require.NoError(t, err)
+
+	_, err = f.DetachVolume(ctx, &proto.DetachVolumeRequest{Region: "local-1", AccountName: "dev", VolumeId: vol.Volumeid})
+	assert.True(t, errors.Is(err, ERR_VOLUME_DETACHED))
+
+	attached, err := f.AttachVolume(ctx, &proto.AttachVolumeRequest{Region: "local-1", AccountName: "dev", VolumeId: vol.Volumeid, Instance: "i-1"})
+	require.NoError(t, err)
+	assert.Equal(t, "/dev/sdf", attached.Device)
+	_, err = f.AttachVolume(ctx, &proto.AttachVolumeRequest{Region: "local-1", AccountName: "dev", VolumeId: vol.Volumeid, Instance: "i-2"})
+	assert.True(t, errors.Is(err, ERR_VOLUME_ATTACHED))
+
+	v, err := f.GetVolume(ctx, &proto.GetVolumeRequest{Region: "local-1", AccountName: "dev", VolumeId: vol.Volumeid})
+	require.NoError(t, err)
+	assert.Equal(t, int64(20), v.Size)
+	assert.Equal(t, []string{"i-1"}, v.Attachments)
+
+	_, err = f.DetachVolume(ctx, &proto.DetachVolumeRequest{Region: "local-1", AccountName: "dev", VolumeId: vol.Volumeid, Instance: "i-2"})
+	assert.True(t, errors.Is(err, ERR_VOLUME_DETACHED), "attached to another instance")
+	_, err = f.DetachVolume(ctx, &proto.DetachVolumeRequest{Region: "local-1", AccountName: "dev", VolumeId: vol.Volumeid})
+	require.NoError(t, err)
+
+	v, err = f.GetVolume(ctx, &proto.GetVolumeRequest{Region: "local-1", AccountName: "dev", VolumeId: vol.Volumeid})
+	require.NoError(t, err)
+	assert.Empty(t, v.Attachments)
+}
+
+func Test_RestoreAndCopySnapshot(t *testing.T) {
+	ctx := context.Background()
+	f, _ := newTestController()
+
+	vol, err := f.CreateVolume(ctx, &proto.CreateVolumeRequest{Region: "local-1", AccountName: "dev", Size: 10, Labels: map[string]string{"workspaceid": "ws-1"}})
+	require.NoError(t, err)
+	snap, err := f.CreateSnapshot(ctx, &proto.CreateSnapshotRequest{Region: "local-1", AccountName: "dev", Volumeid: vol.Volumeid, Labels: map[string]string{"workspaceid": "ws-1"}})
+	require.NoError(t, err)
+
+	restored, err := f.RestoreSnapshot(ctx, &proto.RestoreSnapshotRequest{Region: "local-1", AccountName: "dev", SnapshotId: snap.Snapshotid, Availabilityzone: "local-1b", Labels: map[string]string{"team": "ml"}})
+	require.NoError(t, err)
+	v, err := f.GetVolume(ctx, &proto.GetVolumeRequest{Region: "local-1", AccountName: "dev", VolumeId: restored.VolumeId})
+	require.NoError(t, err)
+	assert.Equal(t, int64(10), v.Size, "size defaults to the snapshot size")
+	assert.Equal(t, "local-1b", v.Availabilityzone)
+	assert.Equal(t, "ws-1", v.Labels["workspaceid"], "labels carried over from the snapshot")
+	assert.Equal(t, "ml", v.Labels["team"])
+
+	_, err = f.CopySnapshot(ctx, &proto.CopySnapshotRequest{Region: "local-1", AccountName: "dev", SnapshotId: snap.Snapshotid, DestinationRegion: "local-1"})
+	assert.Error(t, err, "copy to the same region")
+	copied, err := f.CopySnapshot(ctx, &proto.CopySnapshotRequest{Region: "local-1", AccountName: "dev", SnapshotId: snap.Snapshotid, DestinationRegion: "local-2"})
+	require.NoError(t, err)
+	assert.Equal(t, "local-2", copied.Region)
+
+	list, err := f.ListSnapshots(ctx, &proto.ListSnapshotsRequest{Region: "local-2", AccountName: "dev", Labels: map[string]string{"workspaceid": "ws-1"}})
+	require.NoError(t, err)
+	require.Len(t, list.Snapshots, 1)
+	assert.Equal(t, copied.SnapshotId, list.Snapshots[0].SnapshotId)
+	assert.Equal(t, vol.Volumeid, list.Snapshots[0].VolumeId)
+
+	_, err = f.RestoreSnapshot(ctx, &proto.RestoreSnapshotRequest{Region: "local-1", AccountName: "dev", SnapshotId: copied.SnapshotId})
+	assert.True(t, errors.Is(err, ERR_SNAPSHOT_NOT_FOUND), "copy is in another region")
+}
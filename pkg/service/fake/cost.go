@@ -0,0 +1,110 @@
+package fake
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	"gitlab.com/netbook-devs/spawner-service/pkg/service/common"
+	"gitlab.com/netbook-devs/spawner-service/pkg/service/constants"
+	proto "gitlab.com/netbook-devs/spawner-service/proto/netbookai/spawner"
+)
+
+//hourly rates used to make up the cost of fake resources
+const (
+	nodeHourlyRate      = 0.1
+	gpuNodeHourlyRate   = 1.0
+	volumeGiBHourlyRate = 0.0001
+
+	computeService = "compute"
+	storageService = "storage"
+)
+
+//hours returns the hours the resource was alive within the [start, end) window
+func hours(l lifecycle, start, end, now time.Time) float64 {
+	from := l.createdAt
+	if from.Before(start) {
+		from = start
+	}
+	to := now
+	if l.deleting() {
+		to = l.deletingAt
+	}
+	if to.After(end) {
+		to = end
+	}
+	if !to.After(from) {
+		return 0
+	}
+	return to.Sub(from).Hours()
+}
+
+func groupKey(groupBy *proto.GroupBy, service string, tags map[string]string) string {
+	if groupBy != nil && groupBy.Type == "TAG" {
+		return tags[groupBy.Key]
+	}
+	return service
+}
+
+//GetWorkspacesCost made up cost of the workspace resources, based on the time resources were alive
+func (f *FakeController) GetWorkspacesCost(ctx context.Context, req *proto.GetWorkspacesCostRequest) (*proto.GetWorkspacesCostResponse, error) {
+
+	start, err := time.Parse("2006-01-02", req.StartDate)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to parse start date: %s", req.StartDate)
+	}
+	end, err := time.Parse("2006-01-02", req.EndDate)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid end date: %s", req.EndDate)
+	}
+
+	workspaces := make(map[string]struct{}, len(req.WorkspaceIds))
+	for _, w := range req.WorkspaceIds {
+		workspaces[w] = struct{}{}
+	}
+	inWorkspace := func(tags map[string]string) bool {
+		_, ok := workspaces[tags[constants.WorkspaceId]]
+		return ok
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	now := f.now()
+	costMap := make(map[string]float64)
+	var totalCost float64
+
+	add := func(key string, cost float64) {
+		cost = common.RoundTo(cost, 4)
+		costMap[key] += cost
+		totalCost += cost
+	}
+
+	for _, c := range f.clusters {
+		if c.account != req.AccountName {
+			continue
+		}
+		for _, np := range c.nodePools {
+			if !inWorkspace(np.tags) {
+				continue
+			}
+			rate := nodeHourlyRate
+			if np.spec.GpuEnabled {
+				rate = gpuNodeHourlyRate
+			}
+			add(groupKey(req.GroupBy, computeService, np.tags), hours(np.lifecycle, start, end, now)*rate*float64(np.spec.Count))
+		}
+	}
+
+	for _, v := range f.volumes {
+		if v.account != req.AccountName || !inWorkspace(v.tags) {
+			continue
+		}
+		add(groupKey(req.GroupBy, storageService, v.tags), hours(v.lifecycle, start, end, now)*volumeGiBHourlyRate*float64(v.size))
+	}
+
+	return &proto.GetWorkspacesCostResponse{
+		TotalCost:   totalCost,
+		GroupedCost: costMap,
+	}, nil
+}
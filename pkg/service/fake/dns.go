@@ -0,0 +1,88 @@
+package fake
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+	"gitlab.com/netbook-devs/spawner-service/pkg/inventory"
+	proto "gitlab.com/netbook-devs/spawner-service/proto/netbookai/spawner"
+)
+
+func recordKey(account, zone, name, recordType string) string {
+	return account + "/" + inventory.DNSRecordID(zone, name, recordType)
+}
+
+//UpsertDNSRecord create or replace the record in the zone
+func (f *FakeController) UpsertDNSRecord(ctx context.Context, req *proto.UpsertDNSRecordRequest) (*proto.UpsertDNSRecordResponse, error) {
+	r := req.Record
+	if r.Zone == "" {
+		return nil, errors.New("dns zone is required")
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	record := &proto.DNSRecord{
+		Zone:        r.Zone,
+		Name:        strings.TrimSuffix(r.Name, "."),
+		Type:        r.Type,
+		Values:      append([]string{}, r.Values...),
+		Ttl:         r.Ttl,
+		ClusterName: r.ClusterName,
+	}
+	f.records[recordKey(req.AccountName, r.Zone, r.Name, r.Type)] = record
+	f.logger.Infow("upserted dns record", "zone", r.Zone, "name", r.Name, "type", r.Type)
+
+	f.inventory.Created(ctx, inventory.Resource{
+		ID:      inventory.DNSRecordID(r.Zone, r.Name, r.Type),
+		Kind:    inventory.KindDNSRecord,
+		Account: req.AccountName,
+		Region:  req.Region,
+		Cluster: r.ClusterName,
+		Name:    r.Name,
+		Attributes: map[string]string{
+			"zone":   r.Zone,
+			"type":   r.Type,
+			"values": strings.Join(r.Values, ","),
+		},
+	})
+	return &proto.UpsertDNSRecordResponse{Record: record, ChangeId: newID("change")}, nil
+}
+
+//DeleteDNSRecord delete the record from the zone
+func (f *FakeController) DeleteDNSRecord(ctx context.Context, req *proto.DeleteDNSRecordRequest) (*proto.DeleteDNSRecordResponse, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	key := recordKey(req.AccountName, req.Zone, req.Name, req.Type)
+	if _, ok := f.records[key]; !ok {
+		return &proto.DeleteDNSRecordResponse{}, errors.Wrapf(ERR_RECORD_NOT_FOUND, "%s record '%s'", req.Type, req.Name)
+	}
+	delete(f.records, key)
+	f.inventory.Deleted(ctx, inventory.KindDNSRecord, req.AccountName, req.Region, inventory.DNSRecordID(req.Zone, req.Name, req.Type))
+	return &proto.DeleteDNSRecordResponse{Deleted: true}, nil
+}
+
+//ListDNSRecords records of the zone, optionally of the type
+func (f *FakeController) ListDNSRecords(ctx context.Context, req *proto.ListDNSRecordsRequest) (*proto.ListDNSRecordsResponse, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	res := &proto.ListDNSRecordsResponse{Records: []*proto.DNSRecord{}}
+	prefix := req.AccountName + "/" + req.Zone + "/"
+	for k, r := range f.records {
+		if !strings.HasPrefix(k, prefix) || (req.Type != "" && r.Type != req.Type) {
+			continue
+		}
+		res.Records = append(res.Records, r)
+	}
+	sort.Slice(res.Records, func(i, j int) bool {
+		if res.Records[i].Name != res.Records[j].Name {
+			return res.Records[i].Name < res.Records[j].Name
+		}
+		return res.Records[i].Type < res.Records[j].Type
+	})
+	return res, nil
+}
@@ -0,0 +1,68 @@
+package fake
+
+import (
+	"context"
+	"fmt"
+
+	proto "gitlab.com/netbook-devs/spawner-service/proto/netbookai/spawner"
+	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+)
+
+func endpoint(c *cluster) string {
+	return fmt.Sprintf("https://%s.%s.fake.spawner.local", c.name, c.region)
+}
+
+func token(c *cluster) string {
+	return fmt.Sprintf("fake-token-%s", c.id)
+}
+
+//GetToken returns static token for the cluster
+func (f *FakeController) GetToken(ctx context.Context, req *proto.GetTokenRequest) (*proto.GetTokenResponse, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	c, err := f.getCluster(req.AccountName, req.Region, req.ClusterName)
+	if err != nil {
+		return nil, err
+	}
+	return &proto.GetTokenResponse{
+		Token:    token(c),
+		Endpoint: endpoint(c),
+	}, nil
+}
+
+//GetKubeConfig generates kubeconfig pointing to the fake endpoint of the cluster
+func (f *FakeController) GetKubeConfig(ctx context.Context, req *proto.GetKubeConfigRequest) (*proto.GetKubeConfigResponse, error) {
+	f.mu.Lock()
+	c, err := f.getCluster(req.AccountName, req.Region, req.ClusterName)
+	f.mu.Unlock()
+
+	if err != nil {
+		return nil, err
+	}
+
+	name := fmt.Sprintf("fake:%s:%s", c.region, c.name)
+	clientConfig := clientcmdapi.Config{
+		Kind:       "Config",
+		APIVersion: "v1",
+		Clusters: map[string]*clientcmdapi.Cluster{
+			name: {Server: endpoint(c), InsecureSkipTLSVerify: true},
+		},
+		Contexts: map[string]*clientcmdapi.Context{
+			name: {Cluster: name, AuthInfo: name},
+		},
+		AuthInfos: map[string]*clientcmdapi.AuthInfo{
+			name: {Token: token(c)},
+		},
+	}
+
+	b, err := clientcmd.Write(clientConfig)
+	if err != nil {
+		return nil, err
+	}
+	return &proto.GetKubeConfigResponse{
+		ClusterName: name,
+		Config:      b,
+	}, nil
+}
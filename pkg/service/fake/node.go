@@ -0,0 +1,259 @@
+package fake
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/pkg/errors"
+	"gitlab.com/netbook-devs/spawner-service/pkg/inventory"
+	"gitlab.com/netbook-devs/spawner-service/pkg/service/common"
+	"gitlab.com/netbook-devs/spawner-service/pkg/service/constants"
+	"gitlab.com/netbook-devs/spawner-service/pkg/service/labels"
+	proto "gitlab.com/netbook-devs/spawner-service/proto/netbookai/spawner"
+	gproto "google.golang.org/protobuf/proto"
+)
+
+//getInstance resolves the instance type of the node, fake provider uses the aws machine types
+func getInstance(nodeSpec *proto.NodeSpec) (string, error) {
+
+	if nodeSpec.CapacityType == proto.CapacityType_SPOT {
+		if len(nodeSpec.SpotInstances) == 0 {
+			return "", errors.New(constants.InvalidInstanceOrMachineType)
+		}
+		return nodeSpec.SpotInstances[0], nil
+	}
+
+	instance := ""
+	if nodeSpec.MachineType != "" {
+		instance = common.GetInstance(constants.AwsLabel, nodeSpec.MachineType)
+	}
+
+	if nodeSpec.Instance != "" {
+		instance = nodeSpec.Instance
+	}
+
+	if instance == "" {
+		return "", errors.New(constants.InvalidInstanceOrMachineType)
+	}
+	return instance, nil
+}
+
+//AddNode adds new node group to an active cluster
+func (f *FakeController) AddNode(ctx context.Context, req *proto.NodeSpawnRequest) (*proto.NodeSpawnResponse, error) {
+	nodeSpec := req.NodeSpec
+	if nodeSpec == nil || nodeSpec.Name == "" {
+		return nil, errors.New("nodeSpec with a name must be provided")
+	}
+
+	instance, err := getInstance(nodeSpec)
+	if err != nil {
+		return nil, err
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	c, err := f.getCluster(req.AccountName, req.Region, req.ClusterName)
+	if err != nil {
+		f.logger.Errorw("unable to get cluster", "error", err, "cluster", req.ClusterName, "region", req.Region)
+		return nil, err
+	}
+
+	if c.status(f.now(), f.transition) != StatusActive {
+		return nil, errors.Wrapf(ERR_CLUSTER_NOT_ACTIVE, "cluster '%s'", c.name)
+	}
+
+	if _, ok := c.nodePools[nodeSpec.Name]; ok {
+		return nil, ERR_NODEGROUP_EXIST
+	}
+
+	spec := gproto.Clone(nodeSpec).(*proto.NodeSpec)
+	if spec.Count == 0 {
+		spec.Count = 1
+	}
+	if common.IsGPU(spec.MachineType) {
+		spec.GpuEnabled = true
+	}
+
+	//node group gets the cluster version when none is given
+	version := c.version
+	if spec.KubernetesVersion != "" {
+		if !validVersion(spec.KubernetesVersion) {
+			return nil, errors.Wrapf(ERR_INVALID_VERSION, "'%s'", spec.KubernetesVersion)
+		}
+		version = spec.KubernetesVersion
+	}
+
+	c.nodePools[nodeSpec.Name] = &nodePool{
+		lifecycle:    lifecycle{createdAt: f.now()},
+		spec:         spec,
+		instance:     instance,
+		tags:         aws.StringValueMap(labels.GetNodeLabel(nodeSpec)),
+		instanceTags: map[string]string{},
+		version:      version,
+	}
+	f.logger.Infow("creating nodegroup", "nodegroup", nodeSpec.Name, "cluster", c.name, "status", StatusCreating)
+	np := c.nodePools[nodeSpec.Name]
+	f.inventory.Created(ctx, inventory.Resource{
+		ID:      inventory.NodePoolID(c.name, nodeSpec.Name),
+		Kind:    inventory.KindNodePool,
+		Account: c.account,
+		Region:  c.region,
+		Cluster: c.name,
+		Name:    nodeSpec.Name,
+		Labels:  np.tags,
+		Attributes: map[string]string{
+			"instance":     instance,
+			"count":        strconv.FormatInt(spec.Count, 10),
+			"capacityType": spec.CapacityType.String(),
+			"machineType":  spec.MachineType,
+			"gpuEnabled":   strconv.FormatBool(spec.GpuEnabled),
+		},
+	})
+	return &proto.NodeSpawnResponse{}, nil
+}
+
+//DeleteNode marks the node group for deletion
+func (f *FakeController) DeleteNode(ctx context.Context, req *proto.NodeDeleteRequest) (*proto.NodeDeleteResponse, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	c, err := f.getCluster(req.AccountName, req.Region, req.ClusterName)
+	if err != nil {
+		return nil, err
+	}
+
+	np, ok := c.nodePools[req.NodeGroupName]
+	if !ok {
+		return nil, errors.Wrapf(ERR_NODEGROUP_NOTFOUND, "nodegroup '%s' in cluster '%s'", req.NodeGroupName, c.name)
+	}
+
+	if !inScope(np.tags) {
+		return nil, fmt.Errorf("nodegroup '%s' not available in scope '%s'", req.NodeGroupName, labels.ScopeTag())
+	}
+
+	if !np.deleting() {
+		np.deletingAt = f.now()
+	}
+	f.logger.Infow("requested nodegroup to be deleted", "nodegroup", req.NodeGroupName, "status", StatusDeleting)
+	f.inventory.Deleted(ctx, inventory.KindNodePool, c.account, c.region, inventory.NodePoolID(c.name, req.NodeGroupName))
+	return &proto.NodeDeleteResponse{}, nil
+}
+
+//TagNodeInstance tag instances of the node group
+func (f *FakeController) TagNodeInstance(ctx context.Context, req *proto.TagNodeInstanceRequest) (*proto.TagNodeInstanceResponse, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	c, err := f.getCluster(req.AccountName, req.Region, req.ClusterName)
+	if err != nil {
+		return nil, errors.Wrap(err, "addTag: ")
+	}
+
+	np, ok := c.nodePools[req.NodeGroup]
+	if !ok {
+		return nil, errors.Wrapf(ERR_NODEGROUP_NOTFOUND, "nodegroup '%s' in cluster '%s'", req.NodeGroup, c.name)
+	}
+
+	if np.status(f.now(), f.transition) != StatusActive {
+		//same as aws, instances are not there until nodegroup is up
+		return nil, errors.New("no instances in cluster to tag")
+	}
+
+	for k, v := range req.Labels {
+		np.instanceTags[k] = v
+	}
+	for k, v := range labels.DefaultTags() {
+		np.instanceTags[k] = *v
+	}
+	return &proto.TagNodeInstanceResponse{}, nil
+}
+
+//TagNodePool adds or replaces the tags of the node group
+func (f *FakeController) TagNodePool(ctx context.Context, account, region, clusterName, name string, tags map[string]string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	_, np, err := f.activeNodePool(account, region, clusterName, name)
+	if err != nil {
+		return err
+	}
+	for k, v := range tags {
+		np.tags[k] = v
+	}
+	return nil
+}
+
+//activeNodePool returns the node group in scope which is not being deleted, caller must hold the lock
+func (f *FakeController) activeNodePool(account, region, clusterName, name string) (*cluster, *nodePool, error) {
+	c, err := f.getCluster(account, region, clusterName)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	np, ok := c.nodePools[name]
+	if !ok || np.deleting() {
+		return nil, nil, errors.Wrapf(ERR_NODEGROUP_NOTFOUND, "nodegroup '%s' in cluster '%s'", name, c.name)
+	}
+	if !inScope(np.tags) {
+		return nil, nil, fmt.Errorf("nodegroup '%s' not available in scope '%s'", name, labels.ScopeTag())
+	}
+	return c, np, nil
+}
+
+//ScaleNodePool sets the node count of the node group
+func (f *FakeController) ScaleNodePool(ctx context.Context, req *proto.ScaleNodePoolRequest) (*proto.ScaleNodePoolResponse, error) {
+	if req.Count < 0 {
+		return nil, errors.New("node count can not be negative")
+	}
+	if req.MaxCount > 0 && (req.MinCount > req.Count || req.Count > req.MaxCount) {
+		return nil, errors.New("node count must be between min and max count")
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	c, np, err := f.activeNodePool(req.AccountName, req.Region, req.ClusterName, req.NodeGroupName)
+	if err != nil {
+		return nil, err
+	}
+	np.spec.Count = req.Count
+	f.logger.Infow("scaled nodegroup", "nodegroup", req.NodeGroupName, "count", req.Count)
+	f.inventory.Updated(ctx, inventory.KindNodePool, c.account, c.region, inventory.NodePoolID(c.name, req.NodeGroupName), nil,
+		map[string]string{"count": strconv.FormatInt(req.Count, 10)})
+	return &proto.ScaleNodePoolResponse{}, nil
+}
+
+//UpdateNodePool adds or updates the labels and taints of the node group, sets its version and image
+func (f *FakeController) UpdateNodePool(ctx context.Context, req *proto.UpdateNodePoolRequest) (*proto.UpdateNodePoolResponse, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	c, np, err := f.activeNodePool(req.AccountName, req.Region, req.ClusterName, req.NodeGroupName)
+	if err != nil {
+		return nil, err
+	}
+	for k, v := range req.Labels {
+		np.tags[k] = v
+	}
+	if np.taints == nil && len(req.Taints) > 0 {
+		np.taints = map[string]string{}
+	}
+	for _, t := range req.Taints {
+		np.taints[t.Key] = t.Value + ":" + t.Effect
+	}
+	attributes := map[string]string{}
+	if req.KubernetesVersion != "" {
+		np.version = req.KubernetesVersion
+		attributes["kubernetesVersion"] = req.KubernetesVersion
+	}
+	if req.ImageVersion != "" {
+		np.image = req.ImageVersion
+		attributes["imageVersion"] = req.ImageVersion
+	}
+	f.logger.Infow("updated nodegroup", "nodegroup", req.NodeGroupName)
+	f.inventory.Updated(ctx, inventory.KindNodePool, c.account, c.region, inventory.NodePoolID(c.name, req.NodeGroupName), req.Labels, attributes)
+	return &proto.UpdateNodePoolResponse{}, nil
+}
@@ -0,0 +1,407 @@
+package fake
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/pkg/errors"
+	"gitlab.com/netbook-devs/spawner-service/pkg/inventory"
+	"gitlab.com/netbook-devs/spawner-service/pkg/service/labels"
+	proto "gitlab.com/netbook-devs/spawner-service/proto/netbookai/spawner"
+)
+
+func tagsWithDefault(label map[string]string) map[string]string {
+	tags := aws.StringValueMap(labels.DefaultTags())
+	for k, v := range label {
+		tags[k] = v
+	}
+	return tags
+}
+
+func resourceUri(account, region, id string) string {
+	return fmt.Sprintf("fake://%s/%s/%s", account, region, id)
+}
+
+//getVolume returns the volume which is not deleted yet, must be called with lock held
+func (f *FakeController) getVolume(account, region, id string) (*volume, error) {
+	f.sweep()
+	v, ok := f.volumes[id]
+	if !ok || v.deleting() || v.account != account || v.region != region {
+		return nil, errors.Wrapf(ERR_VOLUME_NOT_FOUND, "volume '%s'", id)
+	}
+	return v, nil
+}
+
+//CreateVolume create volume, optionally from the snapshot
+func (f *FakeController) CreateVolume(ctx context.Context, req *proto.CreateVolumeRequest) (*proto.CreateVolumeResponse, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.sweep()
+	size := req.Size
+	if req.Snapshotid != "" {
+		s, ok := f.snapshots[req.Snapshotid]
+		if !ok || s.deleting() || s.account != req.AccountName {
+			return &proto.CreateVolumeResponse{}, errors.Wrapf(ERR_SNAPSHOT_NOT_FOUND, "snapshot '%s'", req.Snapshotid)
+		}
+		if size < s.size {
+			size = s.size
+		}
+		if req.DeleteSnapshot {
+			f.logger.Infow("deleting snapshot", "ID", req.Snapshotid)
+			s.deletingAt = f.now()
+			f.inventory.Deleted(ctx, inventory.KindSnapshot, s.account, s.region, s.id)
+		}
+	}
+
+	if size <= 0 {
+		return &proto.CreateVolumeResponse{}, fmt.Errorf("invalid volume size %d", size)
+	}
+
+	v := &volume{
+		lifecycle:  lifecycle{createdAt: f.now()},
+		id:         newID("vol"),
+		account:    req.AccountName,
+		region:     req.Region,
+		zone:       req.Availabilityzone,
+		volumeType: req.Volumetype,
+		size:       size,
+		snapshotId: req.Snapshotid,
+		tags:       tagsWithDefault(req.Labels),
+	}
+	f.volumes[v.id] = v
+	f.logger.Infow("created volume", "volume", v.id, "size", size)
+	f.inventory.Created(ctx, inventory.Resource{
+		ID:      v.id,
+		Kind:    inventory.KindVolume,
+		Account: v.account,
+		Region:  v.region,
+		Labels:  v.tags,
+		Attributes: map[string]string{
+			"size":             strconv.FormatInt(size, 10),
+			"volumeType":       v.volumeType,
+			"availabilityZone": v.zone,
+			"snapshotId":       v.snapshotId,
+		},
+	})
+
+	return &proto.CreateVolumeResponse{
+		Volumeid:    v.id,
+		ResourceUri: resourceUri(v.account, v.region, v.id),
+	}, nil
+}
+
+//DeleteVolume delete the volume
+func (f *FakeController) DeleteVolume(ctx context.Context, req *proto.DeleteVolumeRequest) (*proto.DeleteVolumeResponse, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	v, err := f.getVolume(req.AccountName, req.Region, req.Volumeid)
+	if err != nil {
+		return &proto.DeleteVolumeResponse{}, err
+	}
+	v.deletingAt = f.now()
+	f.inventory.Deleted(ctx, inventory.KindVolume, v.account, v.region, v.id)
+	return &proto.DeleteVolumeResponse{Deleted: true}, nil
+}
+
+//createSnapshot must be called with lock held
+func (f *FakeController) createSnapshot(ctx context.Context, v *volume, label map[string]string) *snapshot {
+	s := &snapshot{
+		lifecycle: lifecycle{createdAt: f.now()},
+		id:        newID("snap"),
+		account:   v.account,
+		region:    v.region,
+		volumeId:  v.id,
+		size:      v.size,
+		tags:      tagsWithDefault(label),
+	}
+	f.snapshots[s.id] = s
+	f.logger.Infow("created snapshot", "snapshot", s.id, "volume", v.id)
+	f.inventory.Created(ctx, inventory.Resource{
+		ID:         s.id,
+		Kind:       inventory.KindSnapshot,
+		Account:    s.account,
+		Region:     s.region,
+		Labels:     s.tags,
+		Attributes: map[string]string{"volumeId": v.id},
+	})
+	return s
+}
+
+//CreateSnapshot create volume snapshot
+func (f *FakeController) CreateSnapshot(ctx context.Context, req *proto.CreateSnapshotRequest) (*proto.CreateSnapshotResponse, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	v, err := f.getVolume(req.AccountName, req.Region, req.Volumeid)
+	if err != nil {
+		return &proto.CreateSnapshotResponse{}, err
+	}
+
+	s := f.createSnapshot(ctx, v, req.Labels)
+	return &proto.CreateSnapshotResponse{
+		Snapshotid:  s.id,
+		SnapshotUri: resourceUri(s.account, s.region, s.id),
+	}, nil
+}
+
+//CreateSnapshotAndDelete create a snapshot of volume and delete the volume
+func (f *FakeController) CreateSnapshotAndDelete(ctx context.Context, req *proto.CreateSnapshotAndDeleteRequest) (*proto.CreateSnapshotAndDeleteResponse, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	v, err := f.getVolume(req.AccountName, req.Region, req.Volumeid)
+	if err != nil {
+		return &proto.CreateSnapshotAndDeleteResponse{}, err
+	}
+
+	s := f.createSnapshot(ctx, v, req.Labels)
+	v.deletingAt = f.now()
+	f.inventory.Deleted(ctx, inventory.KindVolume, v.account, v.region, v.id)
+	return &proto.CreateSnapshotAndDeleteResponse{
+		Snapshotid:  s.id,
+		SnapshotUri: resourceUri(s.account, s.region, s.id),
+		Deleted:     true,
+	}, nil
+}
+
+func (v *volume) proto(now time.Time, transition time.Duration) *proto.Volume {
+	attachments := []string{}
+	if v.instance != "" {
+		attachments = append(attachments, v.instance)
+	}
+	return &proto.Volume{
+		VolumeId:         v.id,
+		Region:           v.region,
+		Availabilityzone: v.zone,
+		Volumetype:       v.volumeType,
+		Size:             v.size,
+		State:            v.status(now, transition),
+		Attachments:      attachments,
+		Labels:           v.tags,
+		CreatedAt:        v.createdAt.Format(time.RFC3339),
+		SnapshotId:       v.snapshotId,
+	}
+}
+
+//ListVolumes list the spawner created volumes in the region
+func (f *FakeController) ListVolumes(ctx context.Context, req *proto.ListVolumesRequest) (*proto.ListVolumesResponse, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.sweep()
+	now := f.now()
+	res := &proto.ListVolumesResponse{Volumes: []*proto.Volume{}}
+	for _, v := range f.volumes {
+		if v.deleting() || v.account != req.AccountName || v.region != req.Region {
+			continue
+		}
+		if !labels.SpawnerOwned(v.tags) || !labels.Match(v.tags, req.Labels) {
+			continue
+		}
+		res.Volumes = append(res.Volumes, v.proto(now, f.transition))
+	}
+	sort.Slice(res.Volumes, func(i, j int) bool { return res.Volumes[i].VolumeId < res.Volumes[j].VolumeId })
+	return res, nil
+}
+
+//ListSnapshots list the spawner created snapshots in the region
+func (f *FakeController) ListSnapshots(ctx context.Context, req *proto.ListSnapshotsRequest) (*proto.ListSnapshotsResponse, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.sweep()
+	now := f.now()
+	res := &proto.ListSnapshotsResponse{Snapshots: []*proto.Snapshot{}}
+	for _, s := range f.snapshots {
+		if s.deleting() || s.account != req.AccountName || s.region != req.Region {
+			continue
+		}
+		if !labels.SpawnerOwned(s.tags) || !labels.Match(s.tags, req.Labels) {
+			continue
+		}
+		res.Snapshots = append(res.Snapshots, &proto.Snapshot{
+			SnapshotId: s.id,
+			Region:     s.region,
+			VolumeId:   s.volumeId,
+			Size:       s.size,
+			State:      s.status(now, f.transition),
+			Labels:     s.tags,
+			CreatedAt:  s.createdAt.Format(time.RFC3339),
+		})
+	}
+	sort.Slice(res.Snapshots, func(i, j int) bool { return res.Snapshots[i].SnapshotId < res.Snapshots[j].SnapshotId })
+	return res, nil
+}
+
+//DeleteSnapshot delete the snapshot
+func (f *FakeController) DeleteSnapshot(ctx context.Context, req *proto.DeleteSnapshotRequest) (*proto.DeleteSnapshotResponse, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.sweep()
+	s, ok := f.snapshots[req.SnapshotId]
+	if !ok || s.deleting() || s.account != req.AccountName || s.region != req.Region || !labels.SpawnerOwned(s.tags) {
+		return &proto.DeleteSnapshotResponse{}, errors.Wrapf(ERR_SNAPSHOT_NOT_FOUND, "snapshot '%s'", req.SnapshotId)
+	}
+	s.deletingAt = f.now()
+	f.inventory.Deleted(ctx, inventory.KindSnapshot, s.account, s.region, s.id)
+	return &proto.DeleteSnapshotResponse{Deleted: true}, nil
+}
+
+//GetVolume describe the volume
+func (f *FakeController) GetVolume(ctx context.Context, req *proto.GetVolumeRequest) (*proto.Volume, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	v, err := f.getVolume(req.AccountName, req.Region, req.VolumeId)
+	if err != nil {
+		return nil, err
+	}
+	return v.proto(f.now(), f.transition), nil
+}
+
+//ResizeVolume grow the volume
+func (f *FakeController) ResizeVolume(ctx context.Context, req *proto.ResizeVolumeRequest) (*proto.ResizeVolumeResponse, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	v, err := f.getVolume(req.AccountName, req.Region, req.VolumeId)
+	if err != nil {
+		return &proto.ResizeVolumeResponse{}, err
+	}
+	if req.Size <= v.size {
+		return &proto.ResizeVolumeResponse{}, fmt.Errorf("volume '%s' is %dGiB, new size must be larger", v.id, v.size)
+	}
+	v.size = req.Size
+	f.inventory.Updated(ctx, inventory.KindVolume, v.account, v.region, v.id, nil,
+		map[string]string{"size": strconv.FormatInt(v.size, 10)})
+	return &proto.ResizeVolumeResponse{VolumeId: v.id, Size: v.size}, nil
+}
+
+//AttachVolume attach the volume to the instance, volume can be attached to one instance at a time
+func (f *FakeController) AttachVolume(ctx context.Context, req *proto.AttachVolumeRequest) (*proto.AttachVolumeResponse, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	v, err := f.getVolume(req.AccountName, req.Region, req.VolumeId)
+	if err != nil {
+		return &proto.AttachVolumeResponse{}, err
+	}
+	if req.Instance == "" {
+		return &proto.AttachVolumeResponse{}, errors.New("instance is required")
+	}
+	if v.instance != "" {
+		return &proto.AttachVolumeResponse{}, errors.Wrapf(ERR_VOLUME_ATTACHED, "volume '%s' instance '%s'", v.id, v.instance)
+	}
+	v.instance, v.device = req.Instance, req.Device
+	if v.device == "" {
+		v.device = "/dev/sdf"
+	}
+	f.inventory.Updated(ctx, inventory.KindVolume, v.account, v.region, v.id, nil, map[string]string{"instance": v.instance})
+	return &proto.AttachVolumeResponse{VolumeId: v.id, Instance: v.instance, Device: v.device}, nil
+}
+
+//DetachVolume detach the volume from the instance it is attached to
+func (f *FakeController) DetachVolume(ctx context.Context, req *proto.DetachVolumeRequest) (*proto.DetachVolumeResponse, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	v, err := f.getVolume(req.AccountName, req.Region, req.VolumeId)
+	if err != nil {
+		return &proto.DetachVolumeResponse{}, err
+	}
+	if v.instance == "" || (req.Instance != "" && req.Instance != v.instance) {
+		return &proto.DetachVolumeResponse{}, errors.Wrapf(ERR_VOLUME_DETACHED, "volume '%s'", v.id)
+	}
+	v.instance, v.device = "", ""
+	f.inventory.Updated(ctx, inventory.KindVolume, v.account, v.region, v.id, nil, map[string]string{"instance": ""})
+	return &proto.DetachVolumeResponse{Detached: true}, nil
+}
+
+//getSnapshot returns the snapshot which is not deleted yet, must be called with lock held
+func (f *FakeController) getSnapshot(account, region, id string) (*snapshot, error) {
+	f.sweep()
+	s, ok := f.snapshots[id]
+	if !ok || s.deleting() || s.account != account || s.region != region {
+		return nil, errors.Wrapf(ERR_SNAPSHOT_NOT_FOUND, "snapshot '%s'", id)
+	}
+	return s, nil
+}
+
+//RestoreSnapshot create volume from the snapshot, volume carries the snapshot tags
+func (f *FakeController) RestoreSnapshot(ctx context.Context, req *proto.RestoreSnapshotRequest) (*proto.RestoreSnapshotResponse, error) {
+	f.mu.Lock()
+	s, err := f.getSnapshot(req.AccountName, req.Region, req.SnapshotId)
+	if err != nil {
+		f.mu.Unlock()
+		return &proto.RestoreSnapshotResponse{}, err
+	}
+	label := tagsWithDefault(s.tags)
+	f.mu.Unlock()
+
+	for k, v := range req.Labels {
+		label[k] = v
+	}
+	res, err := f.CreateVolume(ctx, &proto.CreateVolumeRequest{
+		Region:           req.Region,
+		AccountName:      req.AccountName,
+		Availabilityzone: req.Availabilityzone,
+		Volumetype:       req.Volumetype,
+		Size:             req.Size,
+		Snapshotid:       req.SnapshotId,
+		Labels:           label,
+	})
+	if err != nil {
+		return &proto.RestoreSnapshotResponse{}, err
+	}
+	return &proto.RestoreSnapshotResponse{VolumeId: res.Volumeid, ResourceUri: res.ResourceUri}, nil
+}
+
+//CopySnapshot copy the snapshot to the destination region
+func (f *FakeController) CopySnapshot(ctx context.Context, req *proto.CopySnapshotRequest) (*proto.CopySnapshotResponse, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	s, err := f.getSnapshot(req.AccountName, req.Region, req.SnapshotId)
+	if err != nil {
+		return &proto.CopySnapshotResponse{}, err
+	}
+	if req.DestinationRegion == "" || req.DestinationRegion == req.Region {
+		return &proto.CopySnapshotResponse{}, fmt.Errorf("destination region must differ from '%s'", req.Region)
+	}
+
+	tags := tagsWithDefault(s.tags)
+	for k, v := range req.Labels {
+		tags[k] = v
+	}
+	c := &snapshot{
+		lifecycle: lifecycle{createdAt: f.now()},
+		id:        newID("snap"),
+		account:   s.account,
+		region:    req.DestinationRegion,
+		volumeId:  s.volumeId,
+		size:      s.size,
+		tags:      tags,
+	}
+	f.snapshots[c.id] = c
+	f.logger.Infow("copied snapshot", "snapshot", s.id, "copy", c.id, "region", c.region)
+	f.inventory.Created(ctx, inventory.Resource{
+		ID:         c.id,
+		Kind:       inventory.KindSnapshot,
+		Account:    c.account,
+		Region:     c.region,
+		Labels:     c.tags,
+		Attributes: map[string]string{"volumeId": c.volumeId},
+	})
+	return &proto.CopySnapshotResponse{
+		SnapshotId:  c.id,
+		Region:      c.region,
+		SnapshotUri: resourceUri(c.account, c.region, c.id),
+	}, nil
+}
@@ -0,0 +1,143 @@
+package gcp
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"gitlab.com/netbook-devs/spawner-service/pkg/service/constants"
+	"gitlab.com/netbook-devs/spawner-service/pkg/service/system"
+	"golang.org/x/oauth2/google"
+	"google.golang.org/api/bigquery/v2"
+	"google.golang.org/api/compute/v1"
+	"google.golang.org/api/container/v1"
+	"google.golang.org/api/option"
+)
+
+const operationPollInterval = 10 * time.Second
+
+func clientOptions(ctx context.Context, c *system.GcpCredential) ([]option.ClientOption, error) {
+	creds, err := google.CredentialsFromJSON(ctx, []byte(c.ServiceAccountKey), compute.CloudPlatformScope)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid service account key")
+	}
+	return []option.ClientOption{
+		option.WithCredentials(creds),
+		option.WithUserAgent(constants.SpawnerServiceLabel),
+	}, nil
+}
+
+func getContainerService(ctx context.Context, c *system.GcpCredential) (*container.Service, error) {
+	opts, err := clientOptions(ctx, c)
+	if err != nil {
+		return nil, err
+	}
+	return container.NewService(ctx, opts...)
+}
+
+func getComputeService(ctx context.Context, c *system.GcpCredential) (*compute.Service, error) {
+	opts, err := clientOptions(ctx, c)
+	if err != nil {
+		return nil, err
+	}
+	return compute.NewService(ctx, opts...)
+}
+
+func getBigqueryService(ctx context.Context, c *system.GcpCredential) (*bigquery.Service, error) {
+	opts, err := clientOptions(ctx, c)
+	if err != nil {
+		return nil, err
+	}
+	return bigquery.NewService(ctx, opts...)
+}
+
+func locationPath(project, location string) string {
+	return fmt.Sprintf("projects/%s/locations/%s", project, location)
+}
+
+func clusterPath(project, location, cluster string) string {
+	return fmt.Sprintf("%s/clusters/%s", locationPath(project, location), cluster)
+}
+
+func nodePoolPath(project, location, cluster, pool string) string {
+	return fmt.Sprintf("%s/nodePools/%s", clusterPath(project, location, cluster), pool)
+}
+
+//lastSegment returns the resource name from the resource url, ex: .../zones/us-central1-a/instances/name
+func lastSegment(url string) string {
+	return url[strings.LastIndex(url, "/")+1:]
+}
+
+//urlSegment returns the path segment following key in the resource url
+func urlSegment(url, key string) string {
+	parts := strings.Split(url, "/")
+	for i := 0; i < len(parts)-1; i++ {
+		if parts[i] == key {
+			return parts[i+1]
+		}
+	}
+	return ""
+}
+
+//waitForClusterOperation polls the GKE operation until it is done
+func waitForClusterOperation(ctx context.Context, svc *container.Service, project, location, name string) error {
+	path := fmt.Sprintf("%s/operations/%s", locationPath(project, location), name)
+	for {
+		op, err := svc.Projects.Locations.Operations.Get(path).Context(ctx).Do()
+		if err != nil {
+			return errors.Wrapf(err, "failed to get operation '%s'", name)
+		}
+		if op.Status == "DONE" {
+			if op.Error != nil && op.Error.Code != 0 {
+				return errors.Errorf("operation %s failed: %s", op.OperationType, op.Error.Message)
+			}
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(operationPollInterval):
+		}
+	}
+}
+
+func computeOperationError(op *compute.Operation) error {
+	if op.Error == nil || len(op.Error.Errors) == 0 {
+		return nil
+	}
+	msgs := make([]string, 0, len(op.Error.Errors))
+	for _, e := range op.Error.Errors {
+		msgs = append(msgs, fmt.Sprintf("%s: %s", e.Code, e.Message))
+	}
+	return errors.Errorf("operation %s failed: %s", op.OperationType, strings.Join(msgs, ", "))
+}
+
+//waitForZoneOperation waits for the zonal compute operation, wait call returns after about 2 minutes
+//when the operation is not done yet, hence the loop
+func waitForZoneOperation(ctx context.Context, svc *compute.Service, project, zone, name string) error {
+	for {
+		op, err := svc.ZoneOperations.Wait(project, zone, name).Context(ctx).Do()
+		if err != nil {
+			return errors.Wrapf(err, "failed to wait on operation '%s'", name)
+		}
+		if op.Status == "DONE" {
+			return computeOperationError(op)
+		}
+	}
+}
+
+//waitForGlobalOperation waits for the global compute operation, ex: snapshot deletion
+func waitForGlobalOperation(ctx context.Context, svc *compute.Service, project, name string) error {
+	for {
+		op, err := svc.GlobalOperations.Wait(project, name).Context(ctx).Do()
+		if err != nil {
+			return errors.Wrapf(err, "failed to wait on operation '%s'", name)
+		}
+		if op.Status == "DONE" {
+			return computeOperationError(op)
+		}
+	}
+}
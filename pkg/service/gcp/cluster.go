@@ -0,0 +1,279 @@
+package gcp
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/pkg/errors"
+	"gitlab.com/netbook-devs/spawner-service/pkg/inventory"
+	"gitlab.com/netbook-devs/spawner-service/pkg/operation"
+	"gitlab.com/netbook-devs/spawner-service/pkg/service/common"
+	"gitlab.com/netbook-devs/spawner-service/pkg/service/constants"
+	"gitlab.com/netbook-devs/spawner-service/pkg/service/labels"
+	"gitlab.com/netbook-devs/spawner-service/pkg/service/system"
+	proto "gitlab.com/netbook-devs/spawner-service/proto/netbookai/spawner"
+	"google.golang.org/api/container/v1"
+)
+
+//clusterStates GKE cluster status mapped to the status reported by the other providers
+var clusterStates = map[string]string{
+	"PROVISIONING": "CREATING",
+	"RUNNING":      "ACTIVE",
+	"RECONCILING":  "UPDATING",
+	"STOPPING":     "DELETING",
+	"ERROR":        "FAILED",
+	"DEGRADED":     "DEGRADED",
+}
+
+func clusterState(status string) string {
+	if s, ok := clusterStates[status]; ok {
+		return s
+	}
+	return status
+}
+
+func (g *GCPController) createCluster(ctx context.Context, req *proto.ClusterRequest) (*proto.ClusterResponse, error) {
+
+	clusterName := req.ClusterName
+	account := req.AccountName
+	location := req.Region
+
+	cred, err := getCredentials(ctx, account)
+	if err != nil {
+		return nil, err
+	}
+
+	svc, err := getContainerService(ctx, cred)
+	if err != nil {
+		return nil, errors.Wrap(err, "createCluster: cannot get GKE client")
+	}
+
+	pool, err := g.nodePool(req.Region, req.Node)
+	if err != nil {
+		return nil, err
+	}
+
+	resLabels := resourceLabels(req.Labels)
+	resLabels[labelValue(constants.ClusterNameLabel)] = labelValue(clusterName)
+
+	//Doc : https://cloud.google.com/kubernetes-engine/docs/reference/rest/v1/projects.locations.clusters/create
+	g.logger.Infow("creating cluster in GKE", "name", clusterName, "project", cred.ProjectID, "location", location)
+	op, err := svc.Projects.Locations.Clusters.Create(locationPath(cred.ProjectID, location), &container.CreateClusterRequest{
+		Cluster: &container.Cluster{
+			Name:                  clusterName,
+			ResourceLabels:        resLabels,
+			NodePools:             []*container.NodePool{pool},
+			InitialClusterVersion: req.KubernetesVersion,
+		},
+	}).Context(ctx).Do()
+	if err != nil {
+		g.logger.Errorw("failed to create a GKE cluster", "error", err)
+		return nil, fmt.Errorf("cannot create GKE cluster: %v", err)
+	}
+
+	operation.Report(ctx, "cluster creation requested")
+	g.logger.Infow("waiting on the cluster creation", "operation", op.Name)
+	err = waitForClusterOperation(ctx, svc, cred.ProjectID, location, op.Name)
+	if err != nil {
+		g.logger.Errorw("failed to create a GKE cluster", "error", err)
+		return nil, err
+	}
+	operation.Report(ctx, "cluster created")
+
+	g.inventory.Created(ctx, inventory.Resource{
+		ID:         clusterName,
+		Kind:       inventory.KindCluster,
+		Account:    account,
+		Region:     location,
+		Name:       clusterName,
+		Labels:     req.Labels,
+		Attributes: map[string]string{"project": cred.ProjectID},
+	})
+	g.recordNodePool(ctx, account, location, clusterName, req.Node.GetMachineType(), pool, g.createdPoolSize(ctx, cred, svc, location, clusterName, pool))
+
+	return &proto.ClusterResponse{ClusterName: clusterName}, nil
+}
+
+func (g *GCPController) recordNodePool(ctx context.Context, account, location, cluster, machineType string, pool *container.NodePool, size int64) {
+	g.inventory.Created(ctx, inventory.Resource{
+		ID:      inventory.NodePoolID(cluster, pool.Name),
+		Kind:    inventory.KindNodePool,
+		Account: account,
+		Region:  location,
+		Cluster: cluster,
+		Name:    pool.Name,
+		Labels:  pool.Config.Labels,
+		Attributes: map[string]string{
+			"instance":    pool.Config.MachineType,
+			"count":       strconv.FormatInt(size, 10),
+			"preemptible": strconv.FormatBool(pool.Config.Preemptible),
+			"machineType": machineType,
+			//gpus are attached only for the machine types with known accelerators
+			"gpuEnabled": strconv.FormatBool(len(pool.Config.Accelerators) > 0),
+		},
+	})
+}
+
+func (g *GCPController) getClusterSpec(ctx context.Context, account, location, clusterName string) (*container.Cluster, error) {
+	cred, err := getCredentials(ctx, account)
+	if err != nil {
+		return nil, err
+	}
+
+	svc, err := getContainerService(ctx, cred)
+	if err != nil {
+		return nil, errors.Wrap(err, "getClusterSpec: cannot get GKE client")
+	}
+
+	//Doc : https://cloud.google.com/kubernetes-engine/docs/reference/rest/v1/projects.locations.clusters/get
+	cluster, err := svc.Projects.Locations.Clusters.Get(clusterPath(cred.ProjectID, location, clusterName)).Context(ctx).Do()
+	if err != nil {
+		g.logger.Errorw("failed to get cluster", "error", err, "cluster", clusterName)
+		return nil, err
+	}
+	return cluster, nil
+}
+
+//clusterProto cluster spec with the node pools at their live size, see poolSize
+func clusterProto(ctx context.Context, cred *system.GcpCredential, cluster *container.Cluster) (*proto.ClusterSpec, error) {
+	sizes, err := poolSizes(ctx, cred, cluster)
+	if err != nil {
+		return nil, err
+	}
+	nodes := make([]*proto.NodeSpec, 0, len(cluster.NodePools))
+	for _, pool := range cluster.NodePools {
+		nodes = append(nodes, nodeSpecProto(cluster, pool, sizes[pool.Name]))
+	}
+	return &proto.ClusterSpec{
+		Name:              cluster.Name,
+		ClusterId:         cluster.Id,
+		NodeSpec:          nodes,
+		KubernetesVersion: cluster.CurrentMasterVersion,
+	}, nil
+}
+
+func (g *GCPController) getCluster(ctx context.Context, req *proto.GetClusterRequest) (*proto.ClusterSpec, error) {
+	g.logger.Infow("fetching cluster information", "cluster", req.ClusterName)
+	cred, err := getCredentials(ctx, req.AccountName)
+	if err != nil {
+		return nil, err
+	}
+	cluster, err := g.getClusterSpec(ctx, req.AccountName, req.Region, req.ClusterName)
+	if err != nil {
+		return nil, err
+	}
+	return clusterProto(ctx, cred, cluster)
+}
+
+func (g *GCPController) getClusters(ctx context.Context, req *proto.GetClustersRequest) (*proto.GetClustersResponse, error) {
+
+	cred, err := getCredentials(ctx, req.AccountName)
+	if err != nil {
+		return nil, err
+	}
+
+	svc, err := getContainerService(ctx, cred)
+	if err != nil {
+		return nil, errors.Wrap(err, "getClusters: cannot get GKE client")
+	}
+
+	//'-' lists the clusters in all the locations
+	location := req.Region
+	if location == "" {
+		location = "-"
+	}
+
+	//Doc : https://cloud.google.com/kubernetes-engine/docs/reference/rest/v1/projects.locations.clusters/list
+	result, err := svc.Projects.Locations.Clusters.List(locationPath(cred.ProjectID, location)).Context(ctx).Do()
+	if err != nil {
+		g.logger.Errorw("failed to list the clusters", "error", err)
+		return nil, err
+	}
+
+	scope := labelValue(labels.ScopeTag())
+	clusters := make([]*proto.ClusterSpec, 0, len(result.Clusters))
+	for _, cluster := range result.Clusters {
+		if cluster.ResourceLabels[labelValue(constants.Scope)] != scope {
+			continue
+		}
+		spec, err := clusterProto(ctx, cred, cluster)
+		if err != nil {
+			g.logger.Errorw("failed to get the nodepool sizes", "cluster", cluster.Name, "error", err)
+			return nil, err
+		}
+		clusters = append(clusters, spec)
+	}
+
+	return &proto.GetClustersResponse{
+		Clusters: clusters,
+	}, nil
+}
+
+func (g *GCPController) clusterStatus(ctx context.Context, req *proto.ClusterStatusRequest) (*proto.ClusterStatusResponse, error) {
+	cluster, err := g.getClusterSpec(ctx, req.AccountName, req.Region, req.ClusterName)
+	if err != nil {
+		if isNotFound(err) {
+			err = errors.Wrapf(common.ERR_CLUSTER_NOT_FOUND, "cluster '%s'", req.ClusterName)
+		}
+		return &proto.ClusterStatusResponse{
+			Error: err.Error(),
+		}, err
+	}
+
+	return &proto.ClusterStatusResponse{
+		Status:            clusterState(cluster.Status),
+		ProvisioningState: cluster.Status,
+	}, nil
+}
+
+//deleteCluster GKE deletes the node pools along with the cluster, without force delete cluster must not have
+//any node pool other than the one it was created with
+func (g *GCPController) deleteCluster(ctx context.Context, req *proto.ClusterDeleteRequest) (*proto.ClusterDeleteResponse, error) {
+
+	clusterName := req.ClusterName
+	account := req.AccountName
+	location := req.Region
+
+	cred, err := getCredentials(ctx, account)
+	if err != nil {
+		return nil, err
+	}
+
+	svc, err := getContainerService(ctx, cred)
+	if err != nil {
+		return nil, errors.Wrap(err, "deleteCluster: cannot get GKE client")
+	}
+
+	cluster, err := svc.Projects.Locations.Clusters.Get(clusterPath(cred.ProjectID, location, clusterName)).Context(ctx).Do()
+	if err != nil {
+		return nil, errors.Wrap(err, "deleteCluster")
+	}
+
+	if cluster.ResourceLabels[labelValue(constants.Scope)] != labelValue(labels.ScopeTag()) {
+		return nil, fmt.Errorf("cluster doesnt not available in '%s'", labels.ScopeTag())
+	}
+
+	if !req.ForceDelete && len(cluster.NodePools) > 1 {
+		return nil, fmt.Errorf("cluster '%s' has %d nodepools attached, delete them or use force delete", clusterName, len(cluster.NodePools))
+	}
+
+	//Doc : https://cloud.google.com/kubernetes-engine/docs/reference/rest/v1/projects.locations.clusters/delete
+	op, err := svc.Projects.Locations.Clusters.Delete(clusterPath(cred.ProjectID, location, clusterName)).Context(ctx).Do()
+	if err != nil {
+		g.logger.Errorw("failed to delete the cluster", "error", err, "cluster", clusterName)
+		return nil, err
+	}
+
+	operation.Report(ctx, "cluster deletion requested")
+	err = waitForClusterOperation(ctx, svc, cred.ProjectID, location, op.Name)
+	if err != nil {
+		g.logger.Errorw("failed to delete the cluster", "error", err, "cluster", clusterName)
+		return nil, err
+	}
+
+	g.logger.Infow("cluster deleted successfully", "cluster", clusterName)
+	g.inventory.Deleted(ctx, inventory.KindCluster, account, location, clusterName)
+
+	return &proto.ClusterDeleteResponse{}, nil
+}
@@ -0,0 +1,149 @@
+package gcp
+
+import (
+	"context"
+	"fmt"
+
+	"gitlab.com/netbook-devs/spawner-service/pkg/inventory"
+	"gitlab.com/netbook-devs/spawner-service/pkg/service/constants"
+	proto "gitlab.com/netbook-devs/spawner-service/proto/netbookai/spawner"
+	"go.uber.org/zap"
+)
+
+//GCPController manage GKE clusters, node pools and compute engine disks.
+//
+//request region is the GKE location, clusters are regional when it is a region and zonal when it is a zone.
+type GCPController struct {
+	logger    *zap.SugaredLogger
+	inventory *inventory.Recorder
+}
+
+func NewController(logger *zap.SugaredLogger, store inventory.Store) *GCPController {
+	return &GCPController{
+		logger:    logger,
+		inventory: inventory.NewRecorder(store, constants.GcpLabel, logger),
+	}
+}
+
+func (g *GCPController) CreateCluster(ctx context.Context, req *proto.ClusterRequest) (*proto.ClusterResponse, error) {
+	return g.createCluster(ctx, req)
+}
+
+func (g *GCPController) GetCluster(ctx context.Context, req *proto.GetClusterRequest) (*proto.ClusterSpec, error) {
+	return g.getCluster(ctx, req)
+}
+
+func (g *GCPController) GetClusters(ctx context.Context, req *proto.GetClustersRequest) (*proto.GetClustersResponse, error) {
+	return g.getClusters(ctx, req)
+}
+
+func (g *GCPController) ClusterStatus(ctx context.Context, req *proto.ClusterStatusRequest) (*proto.ClusterStatusResponse, error) {
+	return g.clusterStatus(ctx, req)
+}
+
+func (g *GCPController) AddNode(ctx context.Context, req *proto.NodeSpawnRequest) (*proto.NodeSpawnResponse, error) {
+	return g.addNode(ctx, req)
+}
+
+func (g *GCPController) DeleteCluster(ctx context.Context, req *proto.ClusterDeleteRequest) (*proto.ClusterDeleteResponse, error) {
+	return g.deleteCluster(ctx, req)
+}
+
+func (g *GCPController) DeleteNode(ctx context.Context, req *proto.NodeDeleteRequest) (*proto.NodeDeleteResponse, error) {
+	return g.deleteNode(ctx, req)
+}
+
+//AddToken GKE accepts the google oauth token of the service account, nothing to add to the cluster
+func (g *GCPController) AddToken(ctx context.Context, req *proto.AddTokenRequest) (*proto.AddTokenResponse, error) {
+	return &proto.AddTokenResponse{}, nil
+}
+
+func (g *GCPController) GetToken(ctx context.Context, req *proto.GetTokenRequest) (*proto.GetTokenResponse, error) {
+	return g.getToken(ctx, req)
+}
+
+func (g *GCPController) CreateVolume(ctx context.Context, req *proto.CreateVolumeRequest) (*proto.CreateVolumeResponse, error) {
+	return g.createVolume(ctx, req)
+}
+
+func (g *GCPController) DeleteVolume(ctx context.Context, req *proto.DeleteVolumeRequest) (*proto.DeleteVolumeResponse, error) {
+	return g.deleteVolume(ctx, req)
+}
+
+func (g *GCPController) CreateSnapshot(ctx context.Context, req *proto.CreateSnapshotRequest) (*proto.CreateSnapshotResponse, error) {
+	return g.createSnapshot(ctx, req)
+}
+
+func (g *GCPController) CreateSnapshotAndDelete(ctx context.Context, req *proto.CreateSnapshotAndDeleteRequest) (*proto.CreateSnapshotAndDeleteResponse, error) {
+	return g.createSnapshotAndDelete(ctx, req)
+}
+
+func (g *GCPController) GetWorkspacesCost(ctx context.Context, req *proto.GetWorkspacesCostRequest) (*proto.GetWorkspacesCostResponse, error) {
+	return g.getWorkspacesCost(ctx, req)
+}
+
+func (g *GCPController) GetKubeConfig(ctx context.Context, req *proto.GetKubeConfigRequest) (*proto.GetKubeConfigResponse, error) {
+	return g.getKubeConfig(ctx, req)
+}
+
+func (g *GCPController) TagNodeInstance(ctx context.Context, req *proto.TagNodeInstanceRequest) (*proto.TagNodeInstanceResponse, error) {
+	return g.tagNodeInstance(ctx, req)
+}
+
+func (g *GCPController) ScaleNodePool(ctx context.Context, req *proto.ScaleNodePoolRequest) (*proto.ScaleNodePoolResponse, error) {
+	return g.scaleNodePool(ctx, req)
+}
+
+//UpdateNodePool node pool labels and taints can only be set on creation through the GKE v1 api,
+//node version and image type can be updated
+func (g *GCPController) UpdateNodePool(ctx context.Context, req *proto.UpdateNodePoolRequest) (*proto.UpdateNodePoolResponse, error) {
+	if len(req.Labels) > 0 || len(req.Taints) > 0 {
+		return nil, fmt.Errorf("updating labels and taints of nodepool '%s' is not supported on gcp, they are set when the nodepool is created", req.NodeGroupName)
+	}
+	return g.updateNodePool(ctx, req)
+}
+
+func (g *GCPController) ListVolumes(ctx context.Context, req *proto.ListVolumesRequest) (*proto.ListVolumesResponse, error) {
+	return g.listVolumes(ctx, req)
+}
+
+func (g *GCPController) ListSnapshots(ctx context.Context, req *proto.ListSnapshotsRequest) (*proto.ListSnapshotsResponse, error) {
+	return g.listSnapshots(ctx, req)
+}
+
+func (g *GCPController) DeleteSnapshot(ctx context.Context, req *proto.DeleteSnapshotRequest) (*proto.DeleteSnapshotResponse, error) {
+	return g.deleteVolumeSnapshot(ctx, req)
+}
+
+func (g *GCPController) ListKubernetesVersions(ctx context.Context, req *proto.ListKubernetesVersionsRequest) (*proto.ListKubernetesVersionsResponse, error) {
+	return g.listKubernetesVersions(ctx, req)
+}
+
+func (g *GCPController) UpgradeCluster(ctx context.Context, req *proto.UpgradeClusterRequest) (*proto.UpgradeClusterResponse, error) {
+	return g.upgradeCluster(ctx, req)
+}
+
+func (g *GCPController) GetVolume(ctx context.Context, req *proto.GetVolumeRequest) (*proto.Volume, error) {
+	return g.getVolume(ctx, req)
+}
+
+func (g *GCPController) ResizeVolume(ctx context.Context, req *proto.ResizeVolumeRequest) (*proto.ResizeVolumeResponse, error) {
+	return g.resizeVolume(ctx, req)
+}
+
+func (g *GCPController) AttachVolume(ctx context.Context, req *proto.AttachVolumeRequest) (*proto.AttachVolumeResponse, error) {
+	return g.attachVolume(ctx, req)
+}
+
+func (g *GCPController) DetachVolume(ctx context.Context, req *proto.DetachVolumeRequest) (*proto.DetachVolumeResponse, error) {
+	return g.detachVolume(ctx, req)
+}
+
+func (g *GCPController) RestoreSnapshot(ctx context.Context, req *proto.RestoreSnapshotRequest) (*proto.RestoreSnapshotResponse, error) {
+	return g.restoreSnapshot(ctx, req)
+}
+
+//CopySnapshot snapshots are global on gcp, they are restored in any region without copying
+func (g *GCPController) CopySnapshot(ctx context.Context, req *proto.CopySnapshotRequest) (*proto.CopySnapshotResponse, error) {
+	return nil, fmt.Errorf("gcp snapshots are global, snapshot '%s' can be restored in any region without copying", req.SnapshotId)
+}
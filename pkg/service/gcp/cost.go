@@ -0,0 +1,198 @@
+package gcp
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+	"gitlab.com/netbook-devs/spawner-service/pkg/service/common"
+	"gitlab.com/netbook-devs/spawner-service/pkg/service/constants"
+	proto "gitlab.com/netbook-devs/spawner-service/proto/netbookai/spawner"
+	"google.golang.org/api/bigquery/v2"
+)
+
+//billingTablePattern table name can not be a query parameter, it is validated before use in the query
+var billingTablePattern = regexp.MustCompile(`^[A-Za-z0-9_.:-]+$`)
+
+//costQuery sums the exported billing cost of the resources labelled with the workspaces
+const costQuery = `WITH usage AS (
+  SELECT %s AS grp, cost
+  FROM ` + "`%s`" + ` AS b
+  WHERE EXISTS (SELECT 1 FROM UNNEST(b.labels) AS l WHERE l.key = @workspaceKey AND l.value IN UNNEST(@workspaceIds))
+    AND DATE(b.usage_start_time) >= @startDate AND DATE(b.usage_start_time) < @endDate
+)
+SELECT IFNULL(grp, '') AS grp, SUM(cost) AS cost FROM usage GROUP BY grp`
+
+//groupColumn grouping only available for tag and service, same as the other providers
+func groupColumn(groupBy *proto.GroupBy) (string, error) {
+	if groupBy == nil {
+		return "", errors.New("GroupBy must be provided")
+	}
+	if groupBy.Type == "TAG" {
+		return "(SELECT value FROM UNNEST(b.labels) WHERE key = @groupKey LIMIT 1)", nil
+	}
+	if groupBy.Type == "DIMENSION" && groupBy.Key == "SERVICE" {
+		return "b.service.description", nil
+	}
+	return "", errors.New("GroupBy only possible for tag and service")
+}
+
+func stringParam(name, value string) *bigquery.QueryParameter {
+	return &bigquery.QueryParameter{
+		Name:           name,
+		ParameterType:  &bigquery.QueryParameterType{Type: "STRING"},
+		ParameterValue: &bigquery.QueryParameterValue{Value: value},
+	}
+}
+
+func dateParam(name, value string) (*bigquery.QueryParameter, error) {
+	if _, err := time.Parse("2006-01-02", value); err != nil {
+		return nil, errors.Wrapf(err, "invalid date '%s'", value)
+	}
+	return &bigquery.QueryParameter{
+		Name:           name,
+		ParameterType:  &bigquery.QueryParameterType{Type: "DATE"},
+		ParameterValue: &bigquery.QueryParameterValue{Value: value},
+	}, nil
+}
+
+func costQueryRequest(table string, req *proto.GetWorkspacesCostRequest) (*bigquery.QueryRequest, error) {
+	if !billingTablePattern.MatchString(table) {
+		return nil, errors.Errorf("invalid billing export table '%s'", table)
+	}
+
+	column, err := groupColumn(req.GroupBy)
+	if err != nil {
+		return nil, err
+	}
+
+	start, err := dateParam("startDate", req.StartDate)
+	if err != nil {
+		return nil, err
+	}
+	end, err := dateParam("endDate", req.EndDate)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]*bigquery.QueryParameterValue, 0, len(req.WorkspaceIds))
+	for _, id := range req.WorkspaceIds {
+		ids = append(ids, &bigquery.QueryParameterValue{Value: labelValue(id)})
+	}
+
+	params := []*bigquery.QueryParameter{
+		stringParam("workspaceKey", labelValue(constants.WorkspaceId)),
+		{
+			Name: "workspaceIds",
+			ParameterType: &bigquery.QueryParameterType{
+				Type:      "ARRAY",
+				ArrayType: &bigquery.QueryParameterType{Type: "STRING"},
+			},
+			ParameterValue: &bigquery.QueryParameterValue{ArrayValues: ids},
+		},
+		start,
+		end,
+	}
+	if req.GroupBy.Type == "TAG" {
+		params = append(params, stringParam("groupKey", labelValue(req.GroupBy.Key)))
+	}
+
+	legacy := false
+	return &bigquery.QueryRequest{
+		Query:           fmt.Sprintf(costQuery, column, table),
+		UseLegacySql:    &legacy,
+		ParameterMode:   "NAMED",
+		QueryParameters: params,
+	}, nil
+}
+
+//getWorkspacesCost queries the cloud billing export in bigquery, billing export must be enabled on the
+//billing account and the table set in the account credentials
+func (g *GCPController) getWorkspacesCost(ctx context.Context, req *proto.GetWorkspacesCostRequest) (*proto.GetWorkspacesCostResponse, error) {
+
+	cred, err := getCredentials(ctx, req.AccountName)
+	if err != nil {
+		return nil, err
+	}
+	if cred.BillingExportTable == "" {
+		return nil, errors.Errorf("billing export table is not set for account '%s'", req.AccountName)
+	}
+
+	query, err := costQueryRequest(cred.BillingExportTable, req)
+	if err != nil {
+		g.logger.Errorw("invalid cost request", "error", err, "req", req)
+		return nil, err
+	}
+
+	svc, err := getBigqueryService(ctx, cred)
+	if err != nil {
+		return nil, err
+	}
+
+	//Doc : https://cloud.google.com/bigquery/docs/reference/rest/v2/jobs/query
+	res, err := svc.Jobs.Query(cred.ProjectID, query).Context(ctx).Do()
+	if err != nil {
+		g.logger.Errorw("failed to query billing export", "error", err)
+		return nil, err
+	}
+
+	job := res.JobReference
+	rows := res.Rows
+	complete := res.JobComplete
+	pageToken := res.PageToken
+
+	for !complete || pageToken != "" {
+		call := svc.Jobs.GetQueryResults(job.ProjectId, job.JobId).Location(job.Location).Context(ctx)
+		if pageToken != "" {
+			call = call.PageToken(pageToken)
+		}
+		page, err := call.Do()
+		if err != nil {
+			g.logger.Errorw("failed to get billing export query results", "error", err)
+			return nil, err
+		}
+		if !page.JobComplete {
+			select {
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			case <-time.After(time.Second):
+			}
+			continue
+		}
+		if complete || len(rows) == 0 {
+			rows = append(rows, page.Rows...)
+		}
+		complete = true
+		pageToken = page.PageToken
+	}
+
+	var totalCost float64
+	groupedCost := make(map[string]float64)
+
+	for _, r := range rows {
+		if len(r.F) != 2 {
+			return nil, errors.New("failed to parse cost")
+		}
+		group, _ := r.F[0].V.(string)
+		value, _ := r.F[1].V.(string)
+
+		cost, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			g.logger.Errorw("error converting amount from str to float", "error", err)
+			return nil, errors.Wrap(err, "failed to parse cost")
+		}
+		cost = common.RoundTo(cost, 4)
+		groupedCost[group] += cost
+		totalCost += cost
+	}
+
+	totalCost = common.RoundTo(totalCost, 4)
+
+	return &proto.GetWorkspacesCostResponse{
+		TotalCost:   totalCost,
+		GroupedCost: groupedCost,
+	}, nil
+}
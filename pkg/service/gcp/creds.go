@@ -0,0 +1,35 @@
+package gcp
+
+import (
+	"context"
+	"io/ioutil"
+
+	"github.com/pkg/errors"
+	"gitlab.com/netbook-devs/spawner-service/pkg/config"
+	"gitlab.com/netbook-devs/spawner-service/pkg/service/constants"
+	"gitlab.com/netbook-devs/spawner-service/pkg/service/system"
+)
+
+func getCredentials(ctx context.Context, account string) (*system.GcpCredential, error) {
+	env := config.Get().Env
+
+	if env == "local" {
+		conf := config.Get()
+		key, err := ioutil.ReadFile(conf.GcpServiceAccountKeyFile)
+		if err != nil {
+			return nil, errors.Wrap(err, "getCredentials: failed to read service account key")
+		}
+		return &system.GcpCredential{
+			ProjectID:          conf.GcpProjectID,
+			ServiceAccountKey:  string(key),
+			BillingExportTable: conf.GcpBillingExportTable,
+			Name:               account,
+		}, nil
+	} else {
+		c, err := system.GetCredentials(ctx, config.Get().SecretHostRegion, account, constants.GcpLabel)
+		if err != nil {
+			return nil, errors.Wrap(err, "getCredentials")
+		}
+		return c.GetGcp(), nil
+	}
+}
@@ -0,0 +1,65 @@
+package gcp
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	proto "gitlab.com/netbook-devs/spawner-service/proto/netbookai/spawner"
+	"go.uber.org/zap"
+	"google.golang.org/api/container/v1"
+)
+
+func Test_LabelValue(t *testing.T) {
+	assert.Equal(t, "workspace_id", labelValue("Workspace.ID"))
+	assert.Equal(t, "a-b_c", labelValue("a-b_c"))
+	assert.Len(t, labelValue(strings.Repeat("x", 100)), maxLabelLength)
+}
+
+func Test_NodePoolAccelerators(t *testing.T) {
+	g := &GCPController{logger: zap.NewNop().Sugar()}
+	pool, err := g.nodePool("us-central1", &proto.NodeSpec{Name: "gpu", MachineType: "l+k80"})
+	assert.NoError(t, err)
+	assert.Equal(t, "custom-32-131072", pool.Config.MachineType)
+	assert.Equal(t, "nvidia-tesla-k80", pool.Config.Accelerators[0].AcceleratorType)
+	assert.Equal(t, int64(4), pool.Config.Accelerators[0].AcceleratorCount)
+
+	pool, err = g.nodePool("us-central1", &proto.NodeSpec{Name: "cpu", MachineType: "m"})
+	assert.NoError(t, err)
+	assert.Empty(t, pool.Config.Accelerators)
+}
+
+func Test_CostQueryRequest(t *testing.T) {
+	req := &proto.GetWorkspacesCostRequest{
+		WorkspaceIds: []string{"WS1"},
+		StartDate:    "2022-01-01",
+		EndDate:      "2022-02-01",
+		GroupBy:      &proto.GroupBy{Type: "TAG", Key: "user"},
+	}
+
+	q, err := costQueryRequest("project.billing.export", req)
+	assert.NoError(t, err)
+	assert.Contains(t, q.Query, "`project.billing.export`")
+	assert.Equal(t, "NAMED", q.ParameterMode)
+	assert.Len(t, q.QueryParameters, 5)
+	assert.Equal(t, "ws1", q.QueryParameters[1].ParameterValue.ArrayValues[0].Value)
+
+	_, err = costQueryRequest("project.billing`; DROP", req)
+	assert.Error(t, err)
+
+	req.GroupBy = &proto.GroupBy{Type: "DIMENSION", Key: "REGION"}
+	_, err = costQueryRequest("project.billing.export", req)
+	assert.Error(t, err)
+
+	req.GroupBy = &proto.GroupBy{Type: "DIMENSION", Key: "SERVICE"}
+	req.StartDate = "01-01-2022"
+	_, err = costQueryRequest("project.billing.export", req)
+	assert.Error(t, err)
+}
+
+func Test_NodeSpecProtoSize(t *testing.T) {
+	pool := &container.NodePool{Name: "gpu", InitialNodeCount: 1, Config: &container.NodeConfig{MachineType: "n1-standard-4"}}
+	spec := nodeSpecProto(&container.Cluster{Id: "c-1"}, pool, 3)
+	assert.Equal(t, int64(3), spec.Count, "live size is reported, not the initial size")
+	assert.Equal(t, "n1-standard-4", spec.Instance)
+}
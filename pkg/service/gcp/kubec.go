@@ -0,0 +1,119 @@
+package gcp
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+
+	"github.com/pkg/errors"
+	proto "gitlab.com/netbook-devs/spawner-service/proto/netbookai/spawner"
+	"golang.org/x/oauth2/google"
+	"google.golang.org/api/container/v1"
+	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+)
+
+func clusterCA(cluster *container.Cluster) ([]byte, error) {
+	if cluster.MasterAuth == nil {
+		return nil, errors.Errorf("cluster '%s' does not have master auth", cluster.Name)
+	}
+	return base64.StdEncoding.DecodeString(cluster.MasterAuth.ClusterCaCertificate)
+}
+
+//getToken returns the oauth access token of the service account, GKE authenticates it as the service account
+func (g *GCPController) getToken(ctx context.Context, req *proto.GetTokenRequest) (*proto.GetTokenResponse, error) {
+
+	cred, err := getCredentials(ctx, req.AccountName)
+	if err != nil {
+		return nil, err
+	}
+
+	cluster, err := g.getClusterSpec(ctx, req.AccountName, req.Region, req.ClusterName)
+	if err != nil {
+		return nil, err
+	}
+
+	ca, err := clusterCA(cluster)
+	if err != nil {
+		g.logger.Errorw("failed to decode cluster ca", "error", err)
+		return nil, errors.Wrap(err, "getToken: ")
+	}
+
+	creds, err := google.CredentialsFromJSON(ctx, []byte(cred.ServiceAccountKey), container.CloudPlatformScope)
+	if err != nil {
+		return nil, errors.Wrap(err, "getToken: invalid service account key")
+	}
+	token, err := creds.TokenSource.Token()
+	if err != nil {
+		g.logger.Errorw("failed to get access token", "error", err)
+		return nil, errors.Wrap(err, "getToken: ")
+	}
+
+	return &proto.GetTokenResponse{
+		Token:    token.AccessToken,
+		Endpoint: fmt.Sprintf("https://%s", cluster.Endpoint),
+		CaData:   string(ca),
+	}, nil
+}
+
+//getKubeConfig generates kubeconfig which authenticates with gke-gcloud-auth-plugin
+func (g *GCPController) getKubeConfig(ctx context.Context, req *proto.GetKubeConfigRequest) (*proto.GetKubeConfigResponse, error) {
+
+	cluster, err := g.getClusterSpec(ctx, req.AccountName, req.Region, req.ClusterName)
+	if err != nil {
+		return nil, err
+	}
+
+	ca, err := clusterCA(cluster)
+	if err != nil {
+		g.logger.Errorw("failed to decode cluster ca", "error", err)
+		return nil, errors.Wrap(err, "getKubeConfig: ")
+	}
+
+	cred, err := getCredentials(ctx, req.AccountName)
+	if err != nil {
+		return nil, err
+	}
+
+	//same context name as gcloud container clusters get-credentials
+	name := fmt.Sprintf("gke_%s_%s_%s", cred.ProjectID, req.Region, cluster.Name)
+
+	clusters := make(map[string]*clientcmdapi.Cluster)
+	clusters[name] = &clientcmdapi.Cluster{
+		Server:                   fmt.Sprintf("https://%s", cluster.Endpoint),
+		CertificateAuthorityData: ca,
+	}
+
+	contexts := make(map[string]*clientcmdapi.Context)
+	contexts[name] = &clientcmdapi.Context{
+		Cluster:  name,
+		AuthInfo: name,
+	}
+
+	authinfos := make(map[string]*clientcmdapi.AuthInfo)
+	authinfos[name] = &clientcmdapi.AuthInfo{
+		Exec: &clientcmdapi.ExecConfig{
+			Command:            "gke-gcloud-auth-plugin",
+			APIVersion:         "client.authentication.k8s.io/v1beta1",
+			InstallHint:        "install gke-gcloud-auth-plugin with 'gcloud components install gke-gcloud-auth-plugin'",
+			ProvideClusterInfo: true,
+		},
+	}
+
+	clientConfig := clientcmdapi.Config{
+		Kind:       "Config",
+		APIVersion: "v1",
+		Clusters:   clusters,
+		Contexts:   contexts,
+		AuthInfos:  authinfos,
+	}
+
+	b, err := clientcmd.Write(clientConfig)
+	if err != nil {
+		return nil, errors.Wrap(err, "getKubeConfig: ")
+	}
+	return &proto.GetKubeConfigResponse{
+		ClusterName: name,
+		Config:      b,
+	}, nil
+}
@@ -0,0 +1,53 @@
+package gcp
+
+import (
+	"strings"
+
+	"gitlab.com/netbook-devs/spawner-service/pkg/service/labels"
+)
+
+const maxLabelLength = 63
+
+//labelValue gcp labels allow only lowercase letters, digits, '_' and '-' upto 63 chars
+func labelValue(v string) string {
+	b := strings.Builder{}
+	for _, r := range strings.ToLower(v) {
+		switch {
+		case r >= 'a' && r <= 'z', r >= '0' && r <= '9', r == '_', r == '-':
+			b.WriteRune(r)
+		default:
+			b.WriteRune('_')
+		}
+	}
+	s := b.String()
+	if len(s) > maxLabelLength {
+		s = s[:maxLabelLength]
+	}
+	return s
+}
+
+//resourceLabels default spawner tags merged with the given labels, converted to valid gcp labels.
+//labels are exported with the billing data, workspace cost is queried on them
+func resourceLabels(label map[string]string) map[string]string {
+	res := map[string]string{}
+	for k, v := range labels.DefaultTags() {
+		res[labelValue(k)] = labelValue(*v)
+	}
+	for k, v := range label {
+		k := labelValue(k)
+		if k == "" {
+			continue
+		}
+		res[k] = labelValue(v)
+	}
+	return res
+}
+
+//acceleratorType gke accelerator of the catalog gpu model, gcp machine types do not come with gpus
+func acceleratorType(model string) string {
+	model = strings.ToLower(model)
+	if strings.HasPrefix(model, "nvidia-") {
+		return model
+	}
+	return "nvidia-tesla-" + model
+}
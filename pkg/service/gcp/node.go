@@ -0,0 +1,324 @@
+package gcp
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/pkg/errors"
+	"gitlab.com/netbook-devs/spawner-service/pkg/inventory"
+	"gitlab.com/netbook-devs/spawner-service/pkg/operation"
+	"gitlab.com/netbook-devs/spawner-service/pkg/service/common"
+	"gitlab.com/netbook-devs/spawner-service/pkg/service/constants"
+	"gitlab.com/netbook-devs/spawner-service/pkg/service/labels"
+	"gitlab.com/netbook-devs/spawner-service/pkg/service/system"
+	proto "gitlab.com/netbook-devs/spawner-service/proto/netbookai/spawner"
+	"google.golang.org/api/compute/v1"
+	"google.golang.org/api/container/v1"
+)
+
+//nodePool builds the GKE node pool from the spec.
+//
+//spot capacity is requested as preemptible VMs, gpus are attached for the gpu machine types.
+func (g *GCPController) nodePool(region string, node *proto.NodeSpec) (*container.NodePool, error) {
+	if node == nil {
+		return nil, errors.New("node spec must be provided")
+	}
+
+	instance := node.Instance
+	machine, known := common.GetCatalog().Resolve(constants.GcpLabel, region, node.MachineType)
+	if node.MachineType != "" {
+		instance = machine.Instance
+	}
+	if instance == "" {
+		return nil, errors.New(constants.InvalidInstanceOrMachineType)
+	}
+
+	count := int64(1)
+	if node.Count != 0 {
+		count = node.Count
+	}
+
+	config := &container.NodeConfig{
+		MachineType: instance,
+		DiskSizeGb:  int64(node.DiskSize),
+		Labels:      aws.StringValueMap(labels.GetNodeLabel(node)),
+		Preemptible: node.CapacityType == proto.CapacityType_SPOT,
+		OauthScopes: []string{"https://www.googleapis.com/auth/cloud-platform"},
+	}
+
+	if known && machine.GPUCount > 0 {
+		config.Accelerators = []*container.AcceleratorConfig{
+			{
+				AcceleratorType:  acceleratorType(machine.GPUModel),
+				AcceleratorCount: int64(machine.GPUCount),
+			},
+		}
+	} else if node.GpuEnabled {
+		g.logger.Warnw("gpu requested for instance without known accelerator, use machineType to attach gpu", "instance", instance)
+	}
+
+	pool := &container.NodePool{
+		Name:             node.Name,
+		InitialNodeCount: count,
+		Config:           config,
+		Version:          node.KubernetesVersion,
+	}
+	if node.Availabilityzone != "" {
+		pool.Locations = []string{node.Availabilityzone}
+	}
+	return pool, nil
+}
+
+//poolSize live size of the node pool per zone, the target size of its instance groups follows SetSize and the
+//autoscaler while InitialNodeCount stays as the pool was created
+func poolSize(ctx context.Context, svc *compute.Service, project string, pool *container.NodePool) (int64, error) {
+	size := int64(0)
+	for _, group := range pool.InstanceGroupUrls {
+		//Doc : https://cloud.google.com/compute/docs/reference/rest/v1/instanceGroupManagers/get
+		igm, err := svc.InstanceGroupManagers.Get(project, urlSegment(group, "zones"), lastSegment(group)).Context(ctx).Do()
+		if err != nil {
+			return 0, errors.Wrapf(err, "failed to get instance group of nodepool '%s'", pool.Name)
+		}
+		if igm.TargetSize > size {
+			size = igm.TargetSize
+		}
+	}
+	return size, nil
+}
+
+//poolSizes live size of the node pools of the cluster by pool name
+func poolSizes(ctx context.Context, cred *system.GcpCredential, cluster *container.Cluster) (map[string]int64, error) {
+	svc, err := getComputeService(ctx, cred)
+	if err != nil {
+		return nil, err
+	}
+	sizes := make(map[string]int64, len(cluster.NodePools))
+	for _, pool := range cluster.NodePools {
+		if sizes[pool.Name], err = poolSize(ctx, svc, cred.ProjectID, pool); err != nil {
+			return nil, err
+		}
+	}
+	return sizes, nil
+}
+
+//createdPoolSize live size of the node pool just created, requested size is used when it can not be read
+func (g *GCPController) createdPoolSize(ctx context.Context, cred *system.GcpCredential, svc *container.Service, location, cluster string, pool *container.NodePool) int64 {
+	created, err := svc.Projects.Locations.Clusters.NodePools.Get(nodePoolPath(cred.ProjectID, location, cluster, pool.Name)).Context(ctx).Do()
+	if err == nil {
+		var sizes map[string]int64
+		sizes, err = poolSizes(ctx, cred, &container.Cluster{NodePools: []*container.NodePool{created}})
+		if err == nil {
+			return sizes[pool.Name]
+		}
+	}
+	g.logger.Warnw("failed to get nodepool size, recording the requested size", "nodepool", pool.Name, "error", err)
+	return pool.InitialNodeCount
+}
+
+func nodeSpecProto(cluster *container.Cluster, pool *container.NodePool, size int64) *proto.NodeSpec {
+	spec := &proto.NodeSpec{
+		Name:      pool.Name,
+		State:     pool.Status,
+		ClusterId: cluster.Id,
+		Count:     size,
+		Health:    &proto.Health{},
+		//node pool version, ex: 1.21.6-gke.1500
+		KubernetesVersion: pool.Version,
+	}
+
+	if pool.Config != nil {
+		spec.Instance = pool.Config.MachineType
+		spec.DiskSize = int32(pool.Config.DiskSizeGb)
+		spec.Labels = pool.Config.Labels
+		spec.GpuEnabled = len(pool.Config.Accelerators) > 0
+		spec.CapacityType = proto.CapacityType_ONDEMAND
+		if pool.Config.Preemptible {
+			spec.CapacityType = proto.CapacityType_SPOT
+		}
+	}
+	if len(pool.Locations) > 0 {
+		spec.Availabilityzone = pool.Locations[0]
+	}
+
+	for _, cond := range pool.Conditions {
+		spec.Health.Issue = append(spec.Health.Issue, &proto.Issue{
+			Code:        cond.Code,
+			Description: cond.Message,
+			ResourceIds: []string{pool.Name},
+		})
+	}
+	return spec
+}
+
+func (g *GCPController) addNode(ctx context.Context, req *proto.NodeSpawnRequest) (*proto.NodeSpawnResponse, error) {
+
+	clusterName := req.ClusterName
+	account := req.AccountName
+	location := req.Region
+
+	cred, err := getCredentials(ctx, account)
+	if err != nil {
+		return nil, err
+	}
+
+	svc, err := getContainerService(ctx, cred)
+	if err != nil {
+		return nil, errors.Wrap(err, "addNode: cannot get GKE client")
+	}
+
+	pool, err := g.nodePool(req.Region, req.NodeSpec)
+	if err != nil {
+		return nil, err
+	}
+
+	//Doc : https://cloud.google.com/kubernetes-engine/docs/reference/rest/v1/projects.locations.clusters.nodePools/create
+	g.logger.Infow("adding nodepool", "cluster", clusterName, "nodepool", pool.Name)
+	op, err := svc.Projects.Locations.Clusters.NodePools.Create(clusterPath(cred.ProjectID, location, clusterName), &container.CreateNodePoolRequest{
+		NodePool: pool,
+	}).Context(ctx).Do()
+	if err != nil {
+		g.logger.Errorw("failed to add nodepool", "error", err)
+		return nil, errors.Wrapf(err, "failed to add nodepool to the cluster")
+	}
+
+	operation.Report(ctx, "nodepool '%s' creation requested", pool.Name)
+	err = waitForClusterOperation(ctx, svc, cred.ProjectID, location, op.Name)
+	if err != nil {
+		g.logger.Errorw("failed to add nodepool", "error", err)
+		return nil, errors.Wrapf(err, "failed to add nodepool to the cluster")
+	}
+	operation.Report(ctx, "nodepool '%s' created", pool.Name)
+
+	g.recordNodePool(ctx, account, location, clusterName, req.NodeSpec.GetMachineType(), pool, g.createdPoolSize(ctx, cred, svc, location, clusterName, pool))
+	return &proto.NodeSpawnResponse{}, nil
+}
+
+func (g *GCPController) deleteNode(ctx context.Context, req *proto.NodeDeleteRequest) (*proto.NodeDeleteResponse, error) {
+
+	cluster := req.ClusterName
+	node := req.NodeGroupName
+	account := req.AccountName
+	location := req.Region
+
+	cred, err := getCredentials(ctx, account)
+	if err != nil {
+		return nil, err
+	}
+
+	svc, err := getContainerService(ctx, cred)
+	if err != nil {
+		return nil, errors.Wrap(err, "deleteNode: cannot get GKE client")
+	}
+
+	//Doc : https://cloud.google.com/kubernetes-engine/docs/reference/rest/v1/projects.locations.clusters.nodePools/delete
+	op, err := svc.Projects.Locations.Clusters.NodePools.Delete(nodePoolPath(cred.ProjectID, location, cluster, node)).Context(ctx).Do()
+	if err != nil {
+		g.logger.Errorw("failed to delete the nodepool", "error", err)
+		return nil, fmt.Errorf("failed to delete nodepool '%s' in cluster '%s': %v", node, cluster, err)
+	}
+
+	operation.Report(ctx, "nodepool '%s' deletion requested", node)
+	err = waitForClusterOperation(ctx, svc, cred.ProjectID, location, op.Name)
+	if err != nil {
+		g.logger.Errorw("failed to delete the nodepool", "error", err)
+		return nil, err
+	}
+
+	g.logger.Infow("deleted nodepool successfully", "cluster", cluster, "nodepool", node)
+	g.inventory.Deleted(ctx, inventory.KindNodePool, account, location, inventory.NodePoolID(cluster, node))
+	return &proto.NodeDeleteResponse{}, nil
+}
+
+//scaleNodePool sets the size of the nodepool, autoscaling bounds are left as they are
+func (g *GCPController) scaleNodePool(ctx context.Context, req *proto.ScaleNodePoolRequest) (*proto.ScaleNodePoolResponse, error) {
+	cluster := req.ClusterName
+	node := req.NodeGroupName
+	location := req.Region
+
+	cred, err := getCredentials(ctx, req.AccountName)
+	if err != nil {
+		return nil, err
+	}
+
+	svc, err := getContainerService(ctx, cred)
+	if err != nil {
+		return nil, errors.Wrap(err, "scaleNodePool: cannot get GKE client")
+	}
+
+	//Doc : https://cloud.google.com/kubernetes-engine/docs/reference/rest/v1/projects.locations.clusters.nodePools/setSize
+	op, err := svc.Projects.Locations.Clusters.NodePools.SetSize(nodePoolPath(cred.ProjectID, location, cluster, node), &container.SetNodePoolSizeRequest{
+		NodeCount: req.Count,
+		//zero count must be sent explicitly
+		ForceSendFields: []string{"NodeCount"},
+	}).Context(ctx).Do()
+	if err != nil {
+		g.logger.Errorw("failed to scale the nodepool", "error", err)
+		return nil, fmt.Errorf("failed to scale nodepool '%s' in cluster '%s': %v", node, cluster, err)
+	}
+
+	operation.Report(ctx, "nodepool '%s' scaling to %d requested", node, req.Count)
+	err = waitForClusterOperation(ctx, svc, cred.ProjectID, location, op.Name)
+	if err != nil {
+		g.logger.Errorw("failed to scale the nodepool", "error", err)
+		return nil, err
+	}
+
+	g.inventory.Updated(ctx, inventory.KindNodePool, req.AccountName, location, inventory.NodePoolID(cluster, node), nil,
+		map[string]string{"count": strconv.FormatInt(req.Count, 10)})
+	return &proto.ScaleNodePoolResponse{}, nil
+}
+
+//updateNodePool upgrades the node version or image type of the nodepool
+func (g *GCPController) updateNodePool(ctx context.Context, req *proto.UpdateNodePoolRequest) (*proto.UpdateNodePoolResponse, error) {
+	cluster := req.ClusterName
+	node := req.NodeGroupName
+	location := req.Region
+
+	if req.KubernetesVersion == "" && req.ImageVersion == "" {
+		return nil, fmt.Errorf("nothing to update in nodepool '%s'", node)
+	}
+
+	cred, err := getCredentials(ctx, req.AccountName)
+	if err != nil {
+		return nil, err
+	}
+
+	svc, err := getContainerService(ctx, cred)
+	if err != nil {
+		return nil, errors.Wrap(err, "updateNodePool: cannot get GKE client")
+	}
+
+	//node version "-" keeps the current version when only the image type changes
+	version := req.KubernetesVersion
+	if version == "" {
+		version = "-"
+	}
+	//Doc : https://cloud.google.com/kubernetes-engine/docs/reference/rest/v1/projects.locations.clusters.nodePools/update
+	op, err := svc.Projects.Locations.Clusters.NodePools.Update(nodePoolPath(cred.ProjectID, location, cluster, node), &container.UpdateNodePoolRequest{
+		NodeVersion: version,
+		ImageType:   req.ImageVersion,
+	}).Context(ctx).Do()
+	if err != nil {
+		g.logger.Errorw("failed to update the nodepool", "error", err)
+		return nil, fmt.Errorf("failed to update nodepool '%s' in cluster '%s': %v", node, cluster, err)
+	}
+
+	operation.Report(ctx, "nodepool '%s' update requested", node)
+	err = waitForClusterOperation(ctx, svc, cred.ProjectID, location, op.Name)
+	if err != nil {
+		g.logger.Errorw("failed to update the nodepool", "error", err)
+		return nil, err
+	}
+
+	attributes := map[string]string{}
+	if req.KubernetesVersion != "" {
+		attributes["kubernetesVersion"] = req.KubernetesVersion
+	}
+	if req.ImageVersion != "" {
+		attributes["imageVersion"] = req.ImageVersion
+	}
+	g.inventory.Updated(ctx, inventory.KindNodePool, req.AccountName, location, inventory.NodePoolID(cluster, node), nil, attributes)
+	return &proto.UpdateNodePoolResponse{}, nil
+}
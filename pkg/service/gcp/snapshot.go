@@ -0,0 +1,243 @@
+package gcp
+
+import (
+	"context"
+	"strings"
+
+	"github.com/pkg/errors"
+	"gitlab.com/netbook-devs/spawner-service/pkg/inventory"
+	"gitlab.com/netbook-devs/spawner-service/pkg/operation"
+	proto "gitlab.com/netbook-devs/spawner-service/proto/netbookai/spawner"
+	"google.golang.org/api/compute/v1"
+)
+
+//createDiskSnapshot snapshots are global, returns the snapshot self link
+func (g *GCPController) createDiskSnapshot(ctx context.Context, svc *compute.Service, project string, disk *compute.Disk, name string, label map[string]string) (string, error) {
+	zone := lastSegment(disk.Zone)
+
+	//Doc : https://cloud.google.com/compute/docs/reference/rest/v1/disks/createSnapshot
+	op, err := svc.Disks.CreateSnapshot(project, zone, disk.Name, &compute.Snapshot{
+		Name:   name,
+		Labels: resourceLabels(label),
+	}).Context(ctx).Do()
+	if err != nil {
+		return "", errors.Wrap(err, "failed to create snapshot")
+	}
+
+	operation.Report(ctx, "snapshot '%s' creation requested", name)
+	err = waitForZoneOperation(ctx, svc, project, zone, op.Name)
+	if err != nil {
+		return "", errors.Wrap(err, "cannot get the create snapshot response")
+	}
+
+	snap, err := svc.Snapshots.Get(project, name).Context(ctx).Do()
+	if err != nil {
+		return "", err
+	}
+	return snap.SelfLink, nil
+}
+
+func (g *GCPController) recordSnapshot(ctx context.Context, account, region, name, uri, volumeId string, labels map[string]string) {
+	g.inventory.Created(ctx, inventory.Resource{
+		ID:      name,
+		Kind:    inventory.KindSnapshot,
+		Account: account,
+		Region:  region,
+		Labels:  labels,
+		Attributes: map[string]string{
+			"volumeId": volumeId,
+			"uri":      uri,
+		},
+	})
+}
+
+func (g *GCPController) deleteSnapshot(ctx context.Context, svc *compute.Service, project, name string) error {
+	//Doc : https://cloud.google.com/compute/docs/reference/rest/v1/snapshots/delete
+	op, err := svc.Snapshots.Delete(project, name).Context(ctx).Do()
+	if err != nil {
+		return err
+	}
+	return waitForGlobalOperation(ctx, svc, project, op.Name)
+}
+
+func (g *GCPController) createSnapshot(ctx context.Context, req *proto.CreateSnapshotRequest) (*proto.CreateSnapshotResponse, error) {
+
+	account := req.AccountName
+
+	cred, err := getCredentials(ctx, account)
+	if err != nil {
+		return nil, err
+	}
+
+	svc, err := getComputeService(ctx, cred)
+	if err != nil {
+		g.logger.Errorw("failed to get the compute client", "error", err)
+		return nil, err
+	}
+
+	disk, err := findDisk(ctx, svc, cred.ProjectID, req.Region, req.Volumeid)
+	if err != nil {
+		return nil, err
+	}
+
+	name := snapshotName(disk.Name)
+	uri, err := g.createDiskSnapshot(ctx, svc, cred.ProjectID, disk, name, req.Labels)
+	if err != nil {
+		g.logger.Errorw("failed to create snapshot", "disk", disk.Name, "error", err)
+		return nil, err
+	}
+	g.recordSnapshot(ctx, account, req.Region, name, uri, req.Volumeid, req.Labels)
+
+	return &proto.CreateSnapshotResponse{
+		Snapshotid:  name,
+		SnapshotUri: uri,
+	}, nil
+}
+
+func (g *GCPController) createSnapshotAndDelete(ctx context.Context, req *proto.CreateSnapshotAndDeleteRequest) (*proto.CreateSnapshotAndDeleteResponse, error) {
+
+	account := req.AccountName
+
+	cred, err := getCredentials(ctx, account)
+	if err != nil {
+		return nil, err
+	}
+
+	svc, err := getComputeService(ctx, cred)
+	if err != nil {
+		g.logger.Errorw("failed to get the compute client", "error", err)
+		return nil, err
+	}
+
+	disk, err := findDisk(ctx, svc, cred.ProjectID, req.Region, req.Volumeid)
+	if err != nil {
+		return nil, err
+	}
+
+	name := snapshotName(disk.Name)
+	uri, err := g.createDiskSnapshot(ctx, svc, cred.ProjectID, disk, name, req.Labels)
+	if err != nil {
+		g.logger.Errorw("failed to create snapshot", "disk", disk.Name, "error", err)
+		return nil, err
+	}
+	g.recordSnapshot(ctx, account, req.Region, name, uri, req.Volumeid, req.Labels)
+
+	err = g.deleteDisk(ctx, svc, cred.ProjectID, disk)
+	if err != nil {
+		g.logger.Errorw("failed to delete disk", "disk", disk.Name, "error", err)
+		return nil, err
+	}
+	g.inventory.Deleted(ctx, inventory.KindVolume, account, req.Region, req.Volumeid)
+
+	return &proto.CreateSnapshotAndDeleteResponse{
+		Snapshotid:  name,
+		SnapshotUri: uri,
+		Deleted:     true,
+	}, nil
+}
+
+//listSnapshots snapshots are global, the snapshots of the disks in the zones of the region are listed
+func (g *GCPController) listSnapshots(ctx context.Context, req *proto.ListSnapshotsRequest) (*proto.ListSnapshotsResponse, error) {
+	cred, err := getCredentials(ctx, req.AccountName)
+	if err != nil {
+		return nil, err
+	}
+	svc, err := getComputeService(ctx, cred)
+	if err != nil {
+		g.logger.Errorw("failed to get the compute client", "error", err)
+		return nil, err
+	}
+
+	res := &proto.ListSnapshotsResponse{Snapshots: []*proto.Snapshot{}}
+	//Doc : https://cloud.google.com/compute/docs/reference/rest/v1/snapshots/list
+	err = svc.Snapshots.List(cred.ProjectID).Pages(ctx, func(list *compute.SnapshotList) error {
+		for _, snap := range list.Items {
+			if !spawnerLabeled(snap.Labels, req.Labels) {
+				continue
+			}
+			if !strings.HasPrefix(urlSegment(snap.SourceDisk, "zones"), req.Region+"-") {
+				continue
+			}
+			res.Snapshots = append(res.Snapshots, &proto.Snapshot{
+				SnapshotId: snap.Name,
+				Region:     req.Region,
+				VolumeId:   lastSegment(snap.SourceDisk),
+				Size:       snap.DiskSizeGb,
+				State:      snap.Status,
+				Labels:     snap.Labels,
+				CreatedAt:  snap.CreationTimestamp,
+			})
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list snapshots")
+	}
+	return res, nil
+}
+
+func (g *GCPController) deleteVolumeSnapshot(ctx context.Context, req *proto.DeleteSnapshotRequest) (*proto.DeleteSnapshotResponse, error) {
+	cred, err := getCredentials(ctx, req.AccountName)
+	if err != nil {
+		return nil, err
+	}
+	svc, err := getComputeService(ctx, cred)
+	if err != nil {
+		g.logger.Errorw("failed to get the compute client", "error", err)
+		return nil, err
+	}
+
+	g.logger.Infow("deleting snapshot", "name", req.SnapshotId)
+	err = g.deleteSnapshot(ctx, svc, cred.ProjectID, req.SnapshotId)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to delete snapshot '%s'", req.SnapshotId)
+	}
+	g.inventory.Deleted(ctx, inventory.KindSnapshot, req.AccountName, req.Region, req.SnapshotId)
+	return &proto.DeleteSnapshotResponse{Deleted: true}, nil
+}
+
+//restoreSnapshot creates the disk from the snapshot, disk carries the snapshot labels
+func (g *GCPController) restoreSnapshot(ctx context.Context, req *proto.RestoreSnapshotRequest) (*proto.RestoreSnapshotResponse, error) {
+	cred, err := getCredentials(ctx, req.AccountName)
+	if err != nil {
+		return nil, err
+	}
+	svc, err := getComputeService(ctx, cred)
+	if err != nil {
+		g.logger.Errorw("failed to get the compute client", "error", err)
+		return nil, err
+	}
+
+	//Doc : https://cloud.google.com/compute/docs/reference/rest/v1/snapshots/get
+	snap, err := svc.Snapshots.Get(cred.ProjectID, req.SnapshotId).Context(ctx).Do()
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get snapshot '%s'", req.SnapshotId)
+	}
+
+	label := map[string]string{}
+	for k, v := range snap.Labels {
+		label[k] = v
+	}
+	for k, v := range req.Labels {
+		label[k] = v
+	}
+	size := req.Size
+	if size == 0 {
+		size = snap.DiskSizeGb
+	}
+
+	res, err := g.createVolume(ctx, &proto.CreateVolumeRequest{
+		Provider:         req.Provider,
+		Region:           req.Region,
+		AccountName:      req.AccountName,
+		Availabilityzone: req.Availabilityzone,
+		Volumetype:       req.Volumetype,
+		Size:             size,
+		Snapshotid:       req.SnapshotId,
+		Labels:           label,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &proto.RestoreSnapshotResponse{VolumeId: res.Volumeid, ResourceUri: res.ResourceUri}, nil
+}
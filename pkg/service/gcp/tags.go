@@ -0,0 +1,88 @@
+package gcp
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	proto "gitlab.com/netbook-devs/spawner-service/proto/netbookai/spawner"
+	"google.golang.org/api/compute/v1"
+)
+
+//tagNodeInstance adds labels to the compute instances of the node pool, instances created later by the
+//node pool are not labelled
+func (g *GCPController) tagNodeInstance(ctx context.Context, req *proto.TagNodeInstanceRequest) (*proto.TagNodeInstanceResponse, error) {
+
+	cred, err := getCredentials(ctx, req.AccountName)
+	if err != nil {
+		return nil, err
+	}
+
+	cluster, err := g.getClusterSpec(ctx, req.AccountName, req.Region, req.ClusterName)
+	if err != nil {
+		return nil, err
+	}
+
+	var groups []string
+	for _, pool := range cluster.NodePools {
+		if pool.Name == req.NodeGroup {
+			groups = pool.InstanceGroupUrls
+		}
+	}
+	if len(groups) == 0 {
+		return nil, errors.Errorf("nodepool '%s' not found in cluster '%s'", req.NodeGroup, req.ClusterName)
+	}
+
+	svc, err := getComputeService(ctx, cred)
+	if err != nil {
+		return nil, err
+	}
+
+	project := cred.ProjectID
+	newLabels := resourceLabels(req.Labels)
+	for _, group := range groups {
+		zone := urlSegment(group, "zones")
+		managed, err := svc.InstanceGroupManagers.ListManagedInstances(project, zone, lastSegment(group)).Context(ctx).Do()
+		if err != nil {
+			g.logger.Errorw("failed to list nodepool instances", "group", group, "error", err)
+			return nil, err
+		}
+
+		for _, mi := range managed.ManagedInstances {
+			name := lastSegment(mi.Instance)
+			err := g.labelInstance(ctx, svc, project, zone, name, newLabels)
+			if err != nil {
+				g.logger.Errorw("failed to label instance", "instance", name, "error", err)
+				return nil, err
+			}
+		}
+	}
+
+	g.logger.Infow("labelled nodepool instances", "cluster", req.ClusterName, "nodepool", req.NodeGroup)
+	return &proto.TagNodeInstanceResponse{}, nil
+}
+
+//labelInstance merges the labels with existing instance labels
+func (g *GCPController) labelInstance(ctx context.Context, svc *compute.Service, project, zone, name string, label map[string]string) error {
+	instance, err := svc.Instances.Get(project, zone, name).Context(ctx).Do()
+	if err != nil {
+		return err
+	}
+
+	merged := map[string]string{}
+	for k, v := range instance.Labels {
+		merged[k] = v
+	}
+	for k, v := range label {
+		merged[k] = v
+	}
+
+	//Doc : https://cloud.google.com/compute/docs/reference/rest/v1/instances/setLabels
+	op, err := svc.Instances.SetLabels(project, zone, name, &compute.InstancesSetLabelsRequest{
+		Labels:           merged,
+		LabelFingerprint: instance.LabelFingerprint,
+	}).Context(ctx).Do()
+	if err != nil {
+		return err
+	}
+	return waitForZoneOperation(ctx, svc, project, zone, op.Name)
+}
@@ -0,0 +1,85 @@
+package gcp
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"gitlab.com/netbook-devs/spawner-service/pkg/inventory"
+	"gitlab.com/netbook-devs/spawner-service/pkg/operation"
+	"gitlab.com/netbook-devs/spawner-service/pkg/service/common"
+	"gitlab.com/netbook-devs/spawner-service/pkg/service/constants"
+	"gitlab.com/netbook-devs/spawner-service/pkg/service/labels"
+	proto "gitlab.com/netbook-devs/spawner-service/proto/netbookai/spawner"
+	"google.golang.org/api/container/v1"
+)
+
+func (g *GCPController) listKubernetesVersions(ctx context.Context, req *proto.ListKubernetesVersionsRequest) (*proto.ListKubernetesVersionsResponse, error) {
+	cred, err := getCredentials(ctx, req.AccountName)
+	if err != nil {
+		return nil, err
+	}
+
+	svc, err := getContainerService(ctx, cred)
+	if err != nil {
+		return nil, errors.Wrap(err, "listKubernetesVersions: cannot get GKE client")
+	}
+
+	//Doc : https://cloud.google.com/kubernetes-engine/docs/reference/rest/v1/projects.locations/getServerConfig
+	config, err := svc.Projects.Locations.GetServerConfig(locationPath(cred.ProjectID, req.Region)).Context(ctx).Do()
+	if err != nil {
+		g.logger.Errorw("failed to get server config", "location", req.Region, "error", err)
+		return nil, err
+	}
+
+	versions := append([]string{}, config.ValidMasterVersions...)
+	common.SortVersions(versions)
+	return &proto.ListKubernetesVersionsResponse{
+		Versions:       versions,
+		DefaultVersion: config.DefaultClusterVersion,
+	}, nil
+}
+
+//upgradeCluster upgrades the master, node pools keep their version
+func (g *GCPController) upgradeCluster(ctx context.Context, req *proto.UpgradeClusterRequest) (*proto.UpgradeClusterResponse, error) {
+	location := req.Region
+	cluster, err := g.getClusterSpec(ctx, req.AccountName, location, req.ClusterName)
+	if err != nil {
+		return nil, err
+	}
+	if cluster.ResourceLabels[labelValue(constants.Scope)] != labelValue(labels.ScopeTag()) {
+		return nil, fmt.Errorf("cluster '%s' not available in scope '%s'", req.ClusterName, labels.ScopeTag())
+	}
+
+	cred, err := getCredentials(ctx, req.AccountName)
+	if err != nil {
+		return nil, err
+	}
+	svc, err := getContainerService(ctx, cred)
+	if err != nil {
+		return nil, errors.Wrap(err, "upgradeCluster: cannot get GKE client")
+	}
+
+	//Doc : https://cloud.google.com/kubernetes-engine/docs/reference/rest/v1/projects.locations.clusters/update
+	op, err := svc.Projects.Locations.Clusters.Update(clusterPath(cred.ProjectID, location, req.ClusterName), &container.UpdateClusterRequest{
+		Update: &container.ClusterUpdate{
+			DesiredMasterVersion: req.KubernetesVersion,
+		},
+	}).Context(ctx).Do()
+	if err != nil {
+		g.logger.Errorw("failed to upgrade cluster", "cluster", req.ClusterName, "version", req.KubernetesVersion, "error", err)
+		return nil, fmt.Errorf("failed to upgrade cluster '%s': %v", req.ClusterName, err)
+	}
+
+	operation.Report(ctx, "control plane upgrade to %s requested", req.KubernetesVersion)
+	err = waitForClusterOperation(ctx, svc, cred.ProjectID, location, op.Name)
+	if err != nil {
+		g.logger.Errorw("failed to upgrade cluster", "cluster", req.ClusterName, "error", err)
+		return nil, err
+	}
+	operation.Report(ctx, "control plane upgraded to %s", req.KubernetesVersion)
+
+	g.inventory.Updated(ctx, inventory.KindCluster, req.AccountName, location, req.ClusterName, nil,
+		map[string]string{"kubernetesVersion": req.KubernetesVersion})
+	return &proto.UpgradeClusterResponse{}, nil
+}
@@ -0,0 +1,400 @@
+package gcp
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+	"gitlab.com/netbook-devs/spawner-service/pkg/inventory"
+	"gitlab.com/netbook-devs/spawner-service/pkg/operation"
+	"gitlab.com/netbook-devs/spawner-service/pkg/service/labels"
+	proto "gitlab.com/netbook-devs/spawner-service/proto/netbookai/spawner"
+	"google.golang.org/api/compute/v1"
+	"google.golang.org/api/googleapi"
+)
+
+const defaultDiskType = "pd-standard"
+
+func diskName(size int64) string {
+	t := time.Now().Format("20060102150405")
+
+	return fmt.Sprintf("vol-%d-%s", size, t)
+}
+
+func snapshotName(disk string) string {
+	t := time.Now().Format("20060102150405")
+
+	return fmt.Sprintf("snap-%s-%s", disk, t)
+}
+
+//diskZone persistent disks are zonal, defaults to zone 'a' of the region when not specified
+func diskZone(region, zone string) string {
+	if zone != "" {
+		return zone
+	}
+	return region + "-a"
+}
+
+func isNotFound(err error) bool {
+	var gerr *googleapi.Error
+	return errors.As(err, &gerr) && gerr.Code == http.StatusNotFound
+}
+
+//findDisk looks up the disk in the zones of the region, volume requests other than create do not carry the zone
+func findDisk(ctx context.Context, svc *compute.Service, project, region, name string) (*compute.Disk, error) {
+	r, err := svc.Regions.Get(project, region).Context(ctx).Do()
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get region '%s'", region)
+	}
+
+	for _, z := range r.Zones {
+		disk, err := svc.Disks.Get(project, lastSegment(z), name).Context(ctx).Do()
+		if err == nil {
+			return disk, nil
+		}
+		if !isNotFound(err) {
+			return nil, err
+		}
+	}
+	return nil, errors.Errorf("disk '%s' not found in region '%s'", name, region)
+}
+
+func (g *GCPController) createVolume(ctx context.Context, req *proto.CreateVolumeRequest) (*proto.CreateVolumeResponse, error) {
+
+	account := req.AccountName
+
+	cred, err := getCredentials(ctx, account)
+	if err != nil {
+		return nil, err
+	}
+
+	svc, err := getComputeService(ctx, cred)
+	if err != nil {
+		g.logger.Errorw("failed to get the compute client", "error", err)
+		return nil, err
+	}
+
+	project := cred.ProjectID
+	zone := diskZone(req.Region, req.Availabilityzone)
+	name := diskName(req.Size)
+	diskType := req.Volumetype
+	if diskType == "" {
+		diskType = defaultDiskType
+	}
+
+	disk := &compute.Disk{
+		Name:   name,
+		SizeGb: req.Size,
+		Type:   fmt.Sprintf("zones/%s/diskTypes/%s", zone, diskType),
+		Labels: resourceLabels(req.Labels),
+	}
+	if req.Snapshotid != "" {
+		disk.SourceSnapshot = fmt.Sprintf("global/snapshots/%s", req.Snapshotid)
+	}
+
+	//Doc : https://cloud.google.com/compute/docs/reference/rest/v1/disks/insert
+	g.logger.Infow("creating disk", "name", name, "size", req.Size, "zone", zone)
+	op, err := svc.Disks.Insert(project, zone, disk).Context(ctx).Do()
+	if err != nil {
+		return nil, errors.Wrap(err, "createVolume: compute call failed")
+	}
+
+	operation.Report(ctx, "disk '%s' creation requested", name)
+	err = waitForZoneOperation(ctx, svc, project, zone, op.Name)
+	if err != nil {
+		return nil, errors.Wrap(err, "createVolume")
+	}
+
+	created, err := svc.Disks.Get(project, zone, name).Context(ctx).Do()
+	if err != nil {
+		return nil, err
+	}
+
+	g.inventory.Created(ctx, inventory.Resource{
+		ID:      name,
+		Kind:    inventory.KindVolume,
+		Account: account,
+		Region:  req.Region,
+		Labels:  req.Labels,
+		Attributes: map[string]string{
+			"size":             strconv.FormatInt(req.Size, 10),
+			"volumeType":       diskType,
+			"availabilityZone": zone,
+			"snapshotId":       req.Snapshotid,
+		},
+	})
+
+	if req.DeleteSnapshot && req.Snapshotid != "" {
+		g.logger.Infow("deleting snapshot", "snapshot", req.Snapshotid)
+		err = g.deleteSnapshot(ctx, svc, project, req.Snapshotid)
+		if err != nil {
+			//volume is created, snapshot can be deleted later
+			g.logger.Errorw("failed to delete snapshot", "snapshot", req.Snapshotid, "error", err)
+		} else {
+			g.inventory.Deleted(ctx, inventory.KindSnapshot, account, req.Region, req.Snapshotid)
+		}
+	}
+
+	return &proto.CreateVolumeResponse{
+		Volumeid:    name,
+		ResourceUri: created.SelfLink,
+	}, nil
+}
+
+func (g *GCPController) deleteDisk(ctx context.Context, svc *compute.Service, project string, disk *compute.Disk) error {
+	zone := lastSegment(disk.Zone)
+
+	//Doc : https://cloud.google.com/compute/docs/reference/rest/v1/disks/delete
+	op, err := svc.Disks.Delete(project, zone, disk.Name).Context(ctx).Do()
+	if err != nil {
+		return errors.Wrapf(err, "failed to delete disk '%s'", disk.Name)
+	}
+	return waitForZoneOperation(ctx, svc, project, zone, op.Name)
+}
+
+func (g *GCPController) deleteVolume(ctx context.Context, req *proto.DeleteVolumeRequest) (*proto.DeleteVolumeResponse, error) {
+
+	name := req.Volumeid
+	account := req.AccountName
+
+	cred, err := getCredentials(ctx, account)
+	if err != nil {
+		return nil, err
+	}
+
+	svc, err := getComputeService(ctx, cred)
+	if err != nil {
+		g.logger.Errorw("failed to get the compute client", "error", err)
+		return nil, err
+	}
+
+	disk, err := findDisk(ctx, svc, cred.ProjectID, req.Region, name)
+	if err != nil {
+		return nil, err
+	}
+
+	g.logger.Infow("deleting disk", "name", name)
+	err = g.deleteDisk(ctx, svc, cred.ProjectID, disk)
+	if err != nil {
+		return nil, err
+	}
+	g.inventory.Deleted(ctx, inventory.KindVolume, account, req.Region, name)
+	return &proto.DeleteVolumeResponse{Deleted: true}, nil
+}
+
+//spawnerLabeled true when the gcp labels carry the spawner tags and the requested labels
+func spawnerLabeled(l, want map[string]string) bool {
+	return labels.Match(l, resourceLabels(want))
+}
+
+func volumeProto(region string, disk *compute.Disk) *proto.Volume {
+	attachments := []string{}
+	for _, u := range disk.Users {
+		attachments = append(attachments, lastSegment(u))
+	}
+	return &proto.Volume{
+		VolumeId:         disk.Name,
+		Region:           region,
+		Availabilityzone: lastSegment(disk.Zone),
+		Volumetype:       lastSegment(disk.Type),
+		Size:             disk.SizeGb,
+		State:            disk.Status,
+		Attachments:      attachments,
+		Labels:           disk.Labels,
+		CreatedAt:        disk.CreationTimestamp,
+		SnapshotId:       lastSegment(disk.SourceSnapshot),
+	}
+}
+
+func (g *GCPController) listVolumes(ctx context.Context, req *proto.ListVolumesRequest) (*proto.ListVolumesResponse, error) {
+	cred, err := getCredentials(ctx, req.AccountName)
+	if err != nil {
+		return nil, err
+	}
+	svc, err := getComputeService(ctx, cred)
+	if err != nil {
+		g.logger.Errorw("failed to get the compute client", "error", err)
+		return nil, err
+	}
+
+	project := cred.ProjectID
+	r, err := svc.Regions.Get(project, req.Region).Context(ctx).Do()
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get region '%s'", req.Region)
+	}
+
+	res := &proto.ListVolumesResponse{Volumes: []*proto.Volume{}}
+	for _, z := range r.Zones {
+		zone := lastSegment(z)
+		//Doc : https://cloud.google.com/compute/docs/reference/rest/v1/disks/list
+		err := svc.Disks.List(project, zone).Pages(ctx, func(list *compute.DiskList) error {
+			for _, disk := range list.Items {
+				if !spawnerLabeled(disk.Labels, req.Labels) {
+					continue
+				}
+				res.Volumes = append(res.Volumes, volumeProto(req.Region, disk))
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to list disks in zone '%s'", zone)
+		}
+	}
+	return res, nil
+}
+
+func (g *GCPController) getVolume(ctx context.Context, req *proto.GetVolumeRequest) (*proto.Volume, error) {
+	cred, err := getCredentials(ctx, req.AccountName)
+	if err != nil {
+		return nil, err
+	}
+	svc, err := getComputeService(ctx, cred)
+	if err != nil {
+		g.logger.Errorw("failed to get the compute client", "error", err)
+		return nil, err
+	}
+
+	disk, err := findDisk(ctx, svc, cred.ProjectID, req.Region, req.VolumeId)
+	if err != nil {
+		return nil, err
+	}
+	return volumeProto(req.Region, disk), nil
+}
+
+func (g *GCPController) resizeVolume(ctx context.Context, req *proto.ResizeVolumeRequest) (*proto.ResizeVolumeResponse, error) {
+	cred, err := getCredentials(ctx, req.AccountName)
+	if err != nil {
+		return nil, err
+	}
+	svc, err := getComputeService(ctx, cred)
+	if err != nil {
+		g.logger.Errorw("failed to get the compute client", "error", err)
+		return nil, err
+	}
+
+	project := cred.ProjectID
+	disk, err := findDisk(ctx, svc, project, req.Region, req.VolumeId)
+	if err != nil {
+		return nil, err
+	}
+	if req.Size <= disk.SizeGb {
+		return nil, errors.Errorf("disk '%s' is %dGiB, new size must be larger", req.VolumeId, disk.SizeGb)
+	}
+
+	zone := lastSegment(disk.Zone)
+	//Doc : https://cloud.google.com/compute/docs/reference/rest/v1/disks/resize
+	op, err := svc.Disks.Resize(project, zone, disk.Name, &compute.DisksResizeRequest{SizeGb: req.Size}).Context(ctx).Do()
+	if err != nil {
+		return nil, errors.Wrap(err, "resizeVolume: compute call failed")
+	}
+	operation.Report(ctx, "disk '%s' resize to %dGiB requested", disk.Name, req.Size)
+	if err := waitForZoneOperation(ctx, svc, project, zone, op.Name); err != nil {
+		return nil, errors.Wrap(err, "resizeVolume")
+	}
+
+	g.inventory.Updated(ctx, inventory.KindVolume, req.AccountName, req.Region, req.VolumeId, nil,
+		map[string]string{"size": strconv.FormatInt(req.Size, 10)})
+	return &proto.ResizeVolumeResponse{VolumeId: req.VolumeId, Size: req.Size}, nil
+}
+
+//attachVolume attaches the disk to the instance in the zone of the disk, device name defaults to the disk name
+func (g *GCPController) attachVolume(ctx context.Context, req *proto.AttachVolumeRequest) (*proto.AttachVolumeResponse, error) {
+	if req.Instance == "" {
+		return nil, errors.New("instance is required")
+	}
+	cred, err := getCredentials(ctx, req.AccountName)
+	if err != nil {
+		return nil, err
+	}
+	svc, err := getComputeService(ctx, cred)
+	if err != nil {
+		g.logger.Errorw("failed to get the compute client", "error", err)
+		return nil, err
+	}
+
+	project := cred.ProjectID
+	disk, err := findDisk(ctx, svc, project, req.Region, req.VolumeId)
+	if err != nil {
+		return nil, err
+	}
+	device := req.Device
+	if device == "" {
+		device = disk.Name
+	}
+
+	zone := lastSegment(disk.Zone)
+	//Doc : https://cloud.google.com/compute/docs/reference/rest/v1/instances/attachDisk
+	op, err := svc.Instances.AttachDisk(project, zone, req.Instance, &compute.AttachedDisk{
+		Source:     disk.SelfLink,
+		DeviceName: device,
+	}).Context(ctx).Do()
+	if err != nil {
+		return nil, errors.Wrap(err, "attachVolume: compute call failed")
+	}
+	operation.Report(ctx, "disk '%s' attach to '%s' requested", disk.Name, req.Instance)
+	if err := waitForZoneOperation(ctx, svc, project, zone, op.Name); err != nil {
+		return nil, errors.Wrap(err, "attachVolume")
+	}
+
+	g.inventory.Updated(ctx, inventory.KindVolume, req.AccountName, req.Region, req.VolumeId, nil,
+		map[string]string{"instance": req.Instance})
+	return &proto.AttachVolumeResponse{VolumeId: req.VolumeId, Instance: req.Instance, Device: device}, nil
+}
+
+//detachVolume detaches the disk from the instance, detach is never forced on gcp
+func (g *GCPController) detachVolume(ctx context.Context, req *proto.DetachVolumeRequest) (*proto.DetachVolumeResponse, error) {
+	cred, err := getCredentials(ctx, req.AccountName)
+	if err != nil {
+		return nil, err
+	}
+	svc, err := getComputeService(ctx, cred)
+	if err != nil {
+		g.logger.Errorw("failed to get the compute client", "error", err)
+		return nil, err
+	}
+
+	project := cred.ProjectID
+	disk, err := findDisk(ctx, svc, project, req.Region, req.VolumeId)
+	if err != nil {
+		return nil, err
+	}
+	instance := req.Instance
+	if instance == "" {
+		if len(disk.Users) == 0 {
+			return nil, errors.Errorf("disk '%s' is not attached", disk.Name)
+		}
+		instance = lastSegment(disk.Users[0])
+	}
+
+	zone := lastSegment(disk.Zone)
+	vm, err := svc.Instances.Get(project, zone, instance).Context(ctx).Do()
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get instance '%s'", instance)
+	}
+	device := ""
+	for _, d := range vm.Disks {
+		if lastSegment(d.Source) == disk.Name {
+			device = d.DeviceName
+		}
+	}
+	if device == "" {
+		return nil, errors.Errorf("disk '%s' is not attached to '%s'", disk.Name, instance)
+	}
+
+	//Doc : https://cloud.google.com/compute/docs/reference/rest/v1/instances/detachDisk
+	op, err := svc.Instances.DetachDisk(project, zone, instance, device).Context(ctx).Do()
+	if err != nil {
+		return nil, errors.Wrap(err, "detachVolume: compute call failed")
+	}
+	operation.Report(ctx, "disk '%s' detach from '%s' requested", disk.Name, instance)
+	if err := waitForZoneOperation(ctx, svc, project, zone, op.Name); err != nil {
+		return nil, errors.Wrap(err, "detachVolume")
+	}
+
+	g.inventory.Updated(ctx, inventory.KindVolume, req.AccountName, req.Region, req.VolumeId, nil,
+		map[string]string{"instance": ""})
+	return &proto.DetachVolumeResponse{Detached: true}, nil
+}
@@ -0,0 +1,54 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"gitlab.com/netbook-devs/spawner-service/pkg/inventory"
+	proto "gitlab.com/netbook-devs/spawner-service/proto/netbookai/spawner"
+)
+
+func resourceProto(r inventory.Resource) *proto.Resource {
+	res := &proto.Resource{
+		Id:          r.ID,
+		Kind:        string(r.Kind),
+		Provider:    r.Provider,
+		AccountName: r.Account,
+		Region:      r.Region,
+		Workspace:   r.Workspace,
+		Cluster:     r.Cluster,
+		Name:        r.Name,
+		Labels:      r.Labels,
+		Attributes:  r.Attributes,
+		CreatedAt:   r.CreatedAt.Format(time.RFC3339),
+	}
+	if r.Deleted() {
+		res.DeletedAt = r.DeletedAt.Format(time.RFC3339)
+	}
+	return res
+}
+
+//ListResources list the resources created by spawner from the inventory
+func (s *spawnerService) ListResources(ctx context.Context, req *proto.ListResourcesRequest) (*proto.ListResourcesResponse, error) {
+	resources, err := s.inventory.List(ctx, inventory.Filter{
+		Provider:       req.GetProvider(),
+		Account:        req.GetAccountName(),
+		Region:         req.GetRegion(),
+		Workspace:      req.GetWorkspace(),
+		Kind:           inventory.Kind(req.GetKind()),
+		Labels:         req.GetLabels(),
+		IncludeDeleted: req.GetIncludeDeleted(),
+	})
+	if err != nil {
+		s.logger.Errorw("failed to list resources from inventory", "error", err)
+		return nil, err
+	}
+
+	res := &proto.ListResourcesResponse{
+		Resources: make([]*proto.Resource, 0, len(resources)),
+	}
+	for _, r := range resources {
+		res.Resources = append(res.Resources, resourceProto(r))
+	}
+	return res, nil
+}
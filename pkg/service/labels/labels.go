@@ -54,3 +54,18 @@ func DefaultTags() map[string]*string {
 		constants.CreatorLabel: &constants.SpawnerServiceLabel,
 	}
 }
+
+//SpawnerOwned true when the tags carry the creator and scope of this spawner
+func SpawnerOwned(tags map[string]string) bool {
+	return tags[constants.CreatorLabel] == constants.SpawnerServiceLabel && tags[constants.Scope] == ScopeTag()
+}
+
+//Match true when the tags have all the labels with same values
+func Match(tags, labels map[string]string) bool {
+	for k, v := range labels {
+		if tags[k] != v {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,198 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	"gitlab.com/netbook-devs/spawner-service/pkg/inventory"
+	"gitlab.com/netbook-devs/spawner-service/pkg/lease"
+	proto "gitlab.com/netbook-devs/spawner-service/proto/netbookai/spawner"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	gproto "google.golang.org/protobuf/proto"
+)
+
+const (
+	defaultLeaseCheckInterval = 5 * time.Minute
+	defaultLeaseWarning       = time.Hour
+	//leaseExpiryTimeout bounds the wait on the deletion of the expired resource,
+	//deletion still running is waited on by the next sweep
+	leaseExpiryTimeout = 30 * time.Minute
+)
+
+func minutesOr(minutes int, def time.Duration) time.Duration {
+	if minutes > 0 {
+		return time.Duration(minutes) * time.Minute
+	}
+	return def
+}
+
+func leaseError(err error) error {
+	if errors.Is(err, lease.ERR_INVALID_LEASE) {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	return err
+}
+
+//withLease returns the labels with the expiry tag of the ttl or explicit expiry
+func withLease(labels map[string]string, ttlMinutes int64, expiresAt string) (map[string]string, error) {
+	expiry, err := lease.Expiry(time.Now(), ttlMinutes, expiresAt)
+	if err != nil {
+		return nil, leaseError(err)
+	}
+	return lease.WithExpiry(labels, expiry), nil
+}
+
+//nodeWithLease returns copy of the node spec carrying its expiry tag
+func nodeWithLease(node *proto.NodeSpec) (*proto.NodeSpec, error) {
+	if node == nil || (node.TtlMinutes == 0 && node.ExpiresAt == "") {
+		return node, nil
+	}
+	labels, err := withLease(node.Labels, node.TtlMinutes, node.ExpiresAt)
+	if err != nil {
+		return nil, err
+	}
+	node = gproto.Clone(node).(*proto.NodeSpec)
+	node.Labels = labels
+	return node, nil
+}
+
+//leaseDeletion is the running deletion of the expired resource
+type leaseDeletion struct {
+	method      string
+	req         interface{}
+	start       time.Time
+	operationID string
+}
+
+//expireLease deletes the expired node pool or force deletes the expired cluster and waits for the deletion to complete
+//up to leaseExpiryTimeout, deletion started by the previous call is waited on instead of started again.
+//Finished deletion is recorded in the audit log with the system subject
+func (s *spawnerService) expireLease(ctx context.Context, r inventory.Resource) error {
+	d, err := s.startLeaseDeletion(ctx, r)
+	if err != nil {
+		if d.method != "" {
+			s.auditSystemCall(ctx, d.method, d.req, d.start, "", err)
+		}
+		return err
+	}
+
+	wctx, cancel := context.WithTimeout(ctx, leaseExpiryTimeout)
+	defer cancel()
+	_, err = s.waitOperation(wctx, d.operationID)
+	if err != nil && wctx.Err() != nil {
+		return errors.Wrapf(err, "deletion of %s '%s' still running, operation '%s'", r.Kind, r.ID, d.operationID)
+	}
+
+	s.leaseMu.Lock()
+	delete(s.leaseDeletions, r.Key())
+	s.leaseMu.Unlock()
+	s.auditSystemCall(ctx, d.method, d.req, d.start, d.operationID, err)
+	return err
+}
+
+//startLeaseDeletion starts the deletion of the expired resource unless it is already running
+func (s *spawnerService) startLeaseDeletion(ctx context.Context, r inventory.Resource) (leaseDeletion, error) {
+	s.leaseMu.Lock()
+	defer s.leaseMu.Unlock()
+	if d, ok := s.leaseDeletions[r.Key()]; ok {
+		return d, nil
+	}
+
+	d := leaseDeletion{start: time.Now()}
+	var err error
+	switch r.Kind {
+	case inventory.KindCluster:
+		del := &proto.ClusterDeleteRequest{
+			Provider:    r.Provider,
+			Region:      r.Region,
+			AccountName: r.Account,
+			ClusterName: r.Name,
+			ForceDelete: true,
+		}
+		d.method, d.req = "DeleteCluster", del
+		var res *proto.ClusterDeleteResponse
+		if res, err = s.DeleteCluster(ctx, del); err == nil {
+			d.operationID = res.OperationId
+		}
+	case inventory.KindNodePool:
+		del := &proto.NodeDeleteRequest{
+			Provider:      r.Provider,
+			Region:        r.Region,
+			AccountName:   r.Account,
+			ClusterName:   r.Cluster,
+			NodeGroupName: r.Name,
+		}
+		d.method, d.req = "DeleteNode", del
+		var res *proto.NodeDeleteResponse
+		if res, err = s.DeleteNode(ctx, del); err == nil {
+			d.operationID = res.OperationId
+		}
+	default:
+		return d, errors.Errorf("lease of %s is not supported", r.Kind)
+	}
+	if err != nil {
+		return d, err
+	}
+
+	if s.leaseDeletions == nil {
+		s.leaseDeletions = make(map[inventory.Key]leaseDeletion)
+	}
+	s.leaseDeletions[r.Key()] = d
+	return d, nil
+}
+
+//ExtendLease postpones the expiry of the cluster or node pool, the expiry tag of the cluster or node pool
+//is updated on the provider as well when it supports tagging
+func (s *spawnerService) ExtendLease(ctx context.Context, req *proto.ExtendLeaseRequest) (*proto.ExtendLeaseResponse, error) {
+	if req.TtlMinutes == 0 && req.ExpiresAt == "" {
+		return nil, status.Error(codes.InvalidArgument, "ttlMinutes or expiresAt is required")
+	}
+
+	key := inventory.Key{Provider: req.Provider, Account: req.AccountName, Region: req.Region, Kind: inventory.KindCluster, ID: req.ClusterName}
+	if req.NodeGroupName != "" {
+		key.Kind = inventory.KindNodePool
+		key.ID = inventory.NodePoolID(req.ClusterName, req.NodeGroupName)
+	}
+	r, err := s.inventory.Get(ctx, key)
+	if err != nil || r.Deleted() {
+		return nil, status.Errorf(codes.NotFound, "%s '%s' not found in inventory", key.Kind, key.ID)
+	}
+
+	//ttl extends the current lease, expired lease is extended from now
+	now := time.Now()
+	if current, ok := lease.ExpiryOf(r.Labels); ok && current.After(now) && req.TtlMinutes > 0 {
+		now = current
+	}
+	expiry, err := lease.Expiry(now, req.TtlMinutes, req.ExpiresAt)
+	if err != nil {
+		return nil, leaseError(err)
+	}
+	labels := lease.WithExpiry(nil, expiry)
+
+	if err := s.tagLease(ctx, req, labels); err != nil {
+		//inventory is the source of the janitor, provider tag is reported as drift
+		s.logger.Warnw("failed to update expiry tag on the provider", "kind", key.Kind, "id", key.ID, "error", err)
+	}
+	inventory.NewRecorder(s.inventory, req.Provider, s.logger).Updated(ctx, key.Kind, req.AccountName, req.Region, key.ID, labels, nil)
+
+	s.logger.Infow("lease extended", "kind", key.Kind, "id", key.ID, "expiresAt", expiry)
+	return &proto.ExtendLeaseResponse{ExpiresAt: expiry.Format(time.RFC3339)}, nil
+}
+
+//tagLease updates the expiry tag of the cluster or node pool on the provider
+func (s *spawnerService) tagLease(ctx context.Context, req *proto.ExtendLeaseRequest, labels map[string]string) error {
+	c, err := s.controller(req.Provider)
+	if err != nil {
+		return err
+	}
+	tagger, ok := c.(ResourceTagger)
+	if !ok {
+		return errors.Errorf("provider '%s' does not support tagging", req.Provider)
+	}
+	if req.NodeGroupName != "" {
+		return tagger.TagNodePool(ctx, req.AccountName, req.Region, req.ClusterName, req.NodeGroupName, labels)
+	}
+	return tagger.TagCluster(ctx, req.AccountName, req.Region, req.ClusterName, labels)
+}
@@ -0,0 +1,94 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gitlab.com/netbook-devs/spawner-service/pkg/audit"
+	"gitlab.com/netbook-devs/spawner-service/pkg/inventory"
+	"gitlab.com/netbook-devs/spawner-service/pkg/lease"
+	proto "gitlab.com/netbook-devs/spawner-service/proto/netbookai/spawner"
+)
+
+func Test_ExpireLease(t *testing.T) {
+	ctx := context.Background()
+	auditLog, err := audit.NewLog(audit.NewMemorySink())
+	require.NoError(t, err)
+	s, controller := newTestService(t, testOptions{audit: auditLog})
+
+	_, err = controller.CreateCluster(ctx, &proto.ClusterRequest{Provider: "fake", Region: "local", AccountName: "dev", ClusterName: "dev"})
+	require.NoError(t, err)
+	cluster := inventory.Resource{ID: "dev", Kind: inventory.KindCluster, Provider: "fake", Account: "dev", Region: "local", Name: "dev"}
+	require.NoError(t, s.expireLease(ctx, cluster))
+
+	//failed deletion is returned so that the janitor retries it
+	assert.Error(t, s.expireLease(ctx, inventory.Resource{ID: "gone", Kind: inventory.KindCluster, Provider: "fake", Account: "dev", Region: "local", Name: "gone"}))
+
+	entries, err := auditLog.Query(audit.Filter{Subject: audit.SystemSubject})
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+	assert.Equal(t, "DeleteCluster", entries[0].Method)
+	assert.Equal(t, "dev", entries[0].Target)
+	assert.Equal(t, "OK", entries[0].Outcome)
+	assert.NotEmpty(t, entries[0].OperationID)
+	assert.NotEmpty(t, entries[1].Error)
+}
+
+func Test_ExpireLeaseRunning(t *testing.T) {
+	auditLog, err := audit.NewLog(audit.NewMemorySink())
+	require.NoError(t, err)
+	s, _ := newTestService(t, testOptions{audit: auditLog})
+
+	release := make(chan struct{})
+	op := s.operations.Start(context.Background(), operationMeta("DeleteNode", "fake", "dev", "local", "dev/gpu"), func(ctx context.Context) (interface{}, error) {
+		<-release
+		return nil, nil
+	})
+	pool := inventory.Resource{ID: "dev/gpu", Kind: inventory.KindNodePool, Provider: "fake", Account: "dev", Region: "local", Cluster: "dev", Name: "gpu"}
+	s.leaseDeletions = map[inventory.Key]leaseDeletion{pool.Key(): {method: "DeleteNode", req: &proto.NodeDeleteRequest{}, start: time.Now(), operationID: op.ID}}
+
+	//deletion still running is neither audited nor started again
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	assert.Error(t, s.expireLease(ctx, pool))
+	entries, err := auditLog.Query(audit.Filter{Subject: audit.SystemSubject})
+	require.NoError(t, err)
+	assert.Empty(t, entries)
+
+	close(release)
+	require.NoError(t, s.expireLease(context.Background(), pool))
+	entries, err = auditLog.Query(audit.Filter{Subject: audit.SystemSubject})
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	assert.Equal(t, op.ID, entries[0].OperationID)
+	assert.Empty(t, s.leaseDeletions)
+}
+
+func Test_ExtendLease(t *testing.T) {
+	ctx := context.Background()
+	s, controller := newTestService(t, testOptions{})
+	store := s.inventory
+
+	_, err := controller.CreateCluster(ctx, &proto.ClusterRequest{Provider: "fake", Region: "local", AccountName: "dev", ClusterName: "dev"})
+	require.NoError(t, err)
+	res, err := s.ExtendLease(ctx, &proto.ExtendLeaseRequest{Provider: "fake", Region: "local", AccountName: "dev", ClusterName: "dev", TtlMinutes: 60})
+	require.NoError(t, err)
+	cluster, err := store.Get(ctx, inventory.Key{Provider: "fake", Account: "dev", Region: "local", Kind: inventory.KindCluster, ID: "dev"})
+	require.NoError(t, err)
+	expiry, ok := lease.ExpiryOf(cluster.Labels)
+	require.True(t, ok)
+	assert.Equal(t, res.ExpiresAt, expiry.Format(time.RFC3339))
+
+	//inventory is extended even when the provider tag can not be updated
+	key := inventory.Key{Provider: "fake", Account: "dev", Region: "local", Kind: inventory.KindNodePool, ID: inventory.NodePoolID("dev", "gone")}
+	require.NoError(t, store.Put(ctx, inventory.Resource{ID: key.ID, Kind: key.Kind, Provider: "fake", Account: "dev", Region: "local", Cluster: "dev", Name: "gone"}))
+	_, err = s.ExtendLease(ctx, &proto.ExtendLeaseRequest{Provider: "fake", Region: "local", AccountName: "dev", ClusterName: "dev", NodeGroupName: "gone", TtlMinutes: 60})
+	require.NoError(t, err)
+	pool, err := store.Get(ctx, key)
+	require.NoError(t, err)
+	_, ok = lease.ExpiryOf(pool.Labels)
+	assert.True(t, ok)
+}
@@ -0,0 +1,35 @@
+package service
+
+import (
+	"context"
+
+	"gitlab.com/netbook-devs/spawner-service/pkg/service/common"
+	"gitlab.com/netbook-devs/spawner-service/pkg/service/constants"
+	proto "gitlab.com/netbook-devs/spawner-service/proto/netbookai/spawner"
+)
+
+//ListMachineTypes machine types of the catalog with the instance type and resources on the provider
+func (s *spawnerService) ListMachineTypes(ctx context.Context, req *proto.ListMachineTypesRequest) (*proto.ListMachineTypesResponse, error) {
+	provider := req.Provider
+	if provider == constants.FakeLabel {
+		//fake provider uses the aws machine types
+		provider = constants.AwsLabel
+	}
+
+	res := &proto.ListMachineTypesResponse{MachineTypes: []*proto.MachineType{}}
+	for _, m := range common.GetCatalog().List(provider, req.Region) {
+		if req.Provider != "" {
+			m.Provider = req.Provider
+		}
+		res.MachineTypes = append(res.MachineTypes, &proto.MachineType{
+			Name:      m.Name,
+			Provider:  m.Provider,
+			Instance:  m.Instance,
+			Vcpu:      m.VCPU,
+			MemoryGiB: m.MemoryGiB,
+			GpuModel:  m.GPUModel,
+			GpuCount:  m.GPUCount,
+		})
+	}
+	return res, nil
+}
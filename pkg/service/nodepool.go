@@ -0,0 +1,132 @@
+package service
+
+import (
+	"context"
+	"strconv"
+
+	"gitlab.com/netbook-devs/spawner-service/pkg/inventory"
+	"gitlab.com/netbook-devs/spawner-service/pkg/quota"
+	proto "gitlab.com/netbook-devs/spawner-service/proto/netbookai/spawner"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+//taintEffects kubernetes taint effects accepted by UpdateNodePool
+var taintEffects = map[string]bool{
+	"NoSchedule":       true,
+	"PreferNoSchedule": true,
+	"NoExecute":        true,
+}
+
+func validateScale(req *proto.ScaleNodePoolRequest) error {
+	if req.Count < 0 || req.MinCount < 0 || req.MaxCount < 0 {
+		return status.Error(codes.InvalidArgument, "node counts can not be negative")
+	}
+	if req.MaxCount > 0 && (req.MinCount > req.Count || req.Count > req.MaxCount) {
+		return status.Error(codes.InvalidArgument, "count must be between min and max count")
+	}
+	return nil
+}
+
+func validateUpdate(req *proto.UpdateNodePoolRequest) error {
+	if len(req.Labels) == 0 && len(req.Taints) == 0 && req.KubernetesVersion == "" && req.ImageVersion == "" {
+		return status.Error(codes.InvalidArgument, "labels, taints, kubernetes version or image version is required")
+	}
+	for _, t := range req.Taints {
+		if t.Key == "" {
+			return status.Error(codes.InvalidArgument, "taint key is required")
+		}
+		if !taintEffects[t.Effect] {
+			return status.Errorf(codes.InvalidArgument, "invalid taint effect '%s', must be NoSchedule, PreferNoSchedule or NoExecute", t.Effect)
+		}
+	}
+	return nil
+}
+
+//validateFallback spot fallback policy only applies to spot node pools and needs somewhere to fall back to
+func validateFallback(node *proto.NodeSpec) error {
+	policy := node.GetSpotFallback()
+	if policy == nil {
+		return nil
+	}
+	if node.CapacityType != proto.CapacityType_SPOT {
+		return status.Error(codes.InvalidArgument, "spot fallback requires spot capacity type")
+	}
+	if len(policy.AlternateInstances) == 0 && !policy.OnDemand {
+		return status.Error(codes.InvalidArgument, "spot fallback requires alternate instances or on-demand")
+	}
+	if policy.WaitMinutes < 0 {
+		return status.Error(codes.InvalidArgument, "spot fallback wait can not be negative")
+	}
+	return nil
+}
+
+//scaleUpResources nodes added to the node pool recorded in the inventory, node pools not in the inventory are not counted
+func (s *spawnerService) scaleUpResources(ctx context.Context, req *proto.ScaleNodePoolRequest) (inventory.Resource, quota.Resources, bool) {
+	np, err := s.inventory.Get(ctx, inventory.Key{
+		Provider: req.Provider,
+		Account:  req.AccountName,
+		Region:   req.Region,
+		Kind:     inventory.KindNodePool,
+		ID:       inventory.NodePoolID(req.ClusterName, req.NodeGroupName),
+	})
+	if err != nil || np.Deleted() {
+		return np, quota.Resources{}, false
+	}
+	current, _ := strconv.ParseInt(np.Attributes["count"], 10, 64)
+	if req.Count <= current {
+		return np, quota.Resources{}, false
+	}
+	return np, quota.PoolResources(np, req.Count-current), true
+}
+
+//reserveScaleUp holds the nodes added by the scaling against the quotas, refused when the workspace is over its budget
+func (s *spawnerService) reserveScaleUp(ctx context.Context, req *proto.ScaleNodePoolRequest) (func(), error) {
+	np, added, ok := s.scaleUpResources(ctx, req)
+	if !ok {
+		return func() {}, nil
+	}
+	if err := s.checkBudget(np.Workspace); err != nil {
+		return nil, err
+	}
+	return s.reserveQuota(ctx, req.AccountName, np.Workspace, added)
+}
+
+//ScaleNodePool sets the desired size and autoscaling bounds of the node pool, nodes added are held against
+//the quotas and refused when the workspace is over its budget
+func (s *spawnerService) ScaleNodePool(ctx context.Context, req *proto.ScaleNodePoolRequest) (*proto.ScaleNodePoolResponse, error) {
+	if err := validateScale(req); err != nil {
+		return nil, err
+	}
+	provider, err := s.controller(req.Provider, CapNodePools)
+	if err != nil {
+		return nil, err
+	}
+
+	release, err := s.reserveScaleUp(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	op := s.operations.Start(ctx, operationMeta("ScaleNodePool", req.Provider, req.AccountName, req.Region, inventory.NodePoolID(req.ClusterName, req.NodeGroupName)), func(ctx context.Context) (interface{}, error) {
+		defer release()
+		return provider.ScaleNodePool(ctx, req)
+	})
+	return &proto.ScaleNodePoolResponse{OperationId: op.ID}, nil
+}
+
+//UpdateNodePool adds or updates the labels and taints of the node pool, upgrades its kubernetes version or node image
+func (s *spawnerService) UpdateNodePool(ctx context.Context, req *proto.UpdateNodePoolRequest) (*proto.UpdateNodePoolResponse, error) {
+	if err := validateUpdate(req); err != nil {
+		return nil, err
+	}
+	provider, err := s.controller(req.Provider, CapNodePools)
+	if err != nil {
+		return nil, err
+	}
+
+	op := s.operations.Start(ctx, operationMeta("UpdateNodePool", req.Provider, req.AccountName, req.Region, inventory.NodePoolID(req.ClusterName, req.NodeGroupName)), func(ctx context.Context) (interface{}, error) {
+		return provider.UpdateNodePool(ctx, req)
+	})
+	return &proto.UpdateNodePoolResponse{OperationId: op.ID}, nil
+}
@@ -0,0 +1,88 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gitlab.com/netbook-devs/spawner-service/pkg/inventory"
+	"gitlab.com/netbook-devs/spawner-service/pkg/operation"
+	"gitlab.com/netbook-devs/spawner-service/pkg/quota"
+	"gitlab.com/netbook-devs/spawner-service/pkg/schedule"
+	proto "gitlab.com/netbook-devs/spawner-service/proto/netbookai/spawner"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func Test_ValidateNodePoolChanges(t *testing.T) {
+	assert.NoError(t, validateScale(&proto.ScaleNodePoolRequest{Count: 0}))
+	assert.NoError(t, validateScale(&proto.ScaleNodePoolRequest{Count: 2, MinCount: 1, MaxCount: 4}))
+	for _, req := range []*proto.ScaleNodePoolRequest{{Count: -1}, {Count: 5, MaxCount: 4}, {Count: 1, MinCount: 2, MaxCount: 4}} {
+		assert.Equal(t, codes.InvalidArgument, status.Code(validateScale(req)), "expected invalid %v", req)
+	}
+
+	assert.NoError(t, validateUpdate(&proto.UpdateNodePoolRequest{Taints: []*proto.Taint{{Key: "gpu", Value: "true", Effect: "NoSchedule"}}}))
+	assert.NoError(t, validateUpdate(&proto.UpdateNodePoolRequest{KubernetesVersion: "1.22"}))
+	for _, req := range []*proto.UpdateNodePoolRequest{{}, {Taints: []*proto.Taint{{Key: "gpu", Effect: "NO_SCHEDULE"}}}, {Taints: []*proto.Taint{{Effect: "NoExecute"}}}} {
+		assert.Equal(t, codes.InvalidArgument, status.Code(validateUpdate(req)), "expected invalid %v", req)
+	}
+
+	assert.NoError(t, validateFallback(&proto.NodeSpec{}))
+	assert.NoError(t, validateFallback(&proto.NodeSpec{CapacityType: proto.CapacityType_SPOT, SpotFallback: &proto.SpotFallback{OnDemand: true}}))
+	for _, node := range []*proto.NodeSpec{
+		{SpotFallback: &proto.SpotFallback{OnDemand: true}},
+		{CapacityType: proto.CapacityType_SPOT, SpotFallback: &proto.SpotFallback{}},
+		{CapacityType: proto.CapacityType_SPOT, SpotFallback: &proto.SpotFallback{OnDemand: true, WaitMinutes: -1}},
+	} {
+		assert.Equal(t, codes.InvalidArgument, status.Code(validateFallback(node)), "expected invalid %v", node)
+	}
+}
+
+func Test_ScaleUpResources(t *testing.T) {
+	ctx := context.Background()
+	store := inventory.NewMemoryStore()
+	require.NoError(t, store.Put(ctx, inventory.Resource{
+		ID: inventory.NodePoolID("dev", "gpu"), Kind: inventory.KindNodePool, Provider: "fake", Account: "dev", Region: "local",
+		Cluster: "dev", Name: "gpu", Workspace: "ws-1", Attributes: map[string]string{"count": "1", "machineType": "m+t4"},
+	}))
+	s := &spawnerService{inventory: store, logger: zap.NewNop().Sugar()}
+
+	req := &proto.ScaleNodePoolRequest{Provider: "fake", AccountName: "dev", Region: "local", ClusterName: "dev", NodeGroupName: "gpu", Count: 3}
+	np, added, ok := s.scaleUpResources(ctx, req)
+	require.True(t, ok)
+	assert.Equal(t, "ws-1", np.Workspace)
+	assert.Equal(t, quota.Resources{Nodes: 2, GPUs: 2}, added)
+
+	req.Count = 0
+	_, _, ok = s.scaleUpResources(ctx, req)
+	assert.False(t, ok, "scale down holds no quota")
+
+	req.NodeGroupName = "unknown"
+	req.Count = 5
+	_, _, ok = s.scaleUpResources(ctx, req)
+	assert.False(t, ok)
+}
+
+func Test_ScaleOnScheduleQuota(t *testing.T) {
+	ctx := context.Background()
+	store := inventory.NewMemoryStore()
+	require.NoError(t, store.Put(ctx, inventory.Resource{
+		ID: inventory.NodePoolID("dev", "gpu"), Kind: inventory.KindNodePool, Provider: "fake", Account: "dev", Region: "local",
+		Cluster: "dev", Name: "gpu", Workspace: "ws-1", Attributes: map[string]string{"count": "1", "machineType": "m+t4"},
+	}))
+	s, _ := newTestService(t, testOptions{store: store, quotas: quota.Config{Workspaces: map[string]quota.Resources{"ws-1": {GPUs: 2}}}})
+	sc := schedule.Schedule{Provider: "fake", Account: "dev", Region: "local", Cluster: "dev", NodePool: "gpu"}
+
+	_, err := s.scaleOnSchedule(ctx, sc, schedule.Rule{DesiredSize: 4, MaxSize: 4})
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err), "scale up over the quota is refused")
+	assert.Empty(t, s.operations.List(operation.Filter{}))
+
+	id, err := s.scaleOnSchedule(ctx, sc, schedule.Rule{DesiredSize: 2, MaxSize: 4})
+	require.NoError(t, err)
+	assert.NotEmpty(t, id)
+
+	_, err = s.scaleOnSchedule(ctx, sc, schedule.Rule{DesiredSize: 0, MaxSize: 4})
+	require.NoError(t, err, "scale down holds no quota")
+}
@@ -0,0 +1,169 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	"gitlab.com/netbook-devs/spawner-service/pkg/auth"
+	"gitlab.com/netbook-devs/spawner-service/pkg/operation"
+	proto "gitlab.com/netbook-devs/spawner-service/proto/netbookai/spawner"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+//mutating calls are run as operations in the background, rpc returns the operation id right away
+//and the provider response is available as the operation result once it succeeds.
+
+//waitOperation blocks until the operation is done, returns the result of the succeeded operation
+func (s *spawnerService) waitOperation(ctx context.Context, id string) (interface{}, error) {
+	op, err := s.operations.Wait(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if op.Status != operation.Succeeded {
+		return nil, errors.Errorf("operation '%s' %s: %s", id, op.Status, op.Error)
+	}
+	return op.Result, nil
+}
+
+func operationMeta(typ, provider, account, region, target string) operation.Meta {
+	return operation.Meta{
+		Type:     typ,
+		Provider: provider,
+		Account:  account,
+		Region:   region,
+		Target:   target,
+	}
+}
+
+var operationStatus = map[operation.Status]proto.OperationStatus{
+	operation.Pending:   proto.OperationStatus_OPERATION_PENDING,
+	operation.Running:   proto.OperationStatus_OPERATION_RUNNING,
+	operation.Succeeded: proto.OperationStatus_OPERATION_SUCCEEDED,
+	operation.Failed:    proto.OperationStatus_OPERATION_FAILED,
+	operation.Cancelled: proto.OperationStatus_OPERATION_CANCELLED,
+}
+
+func operationProto(op operation.Operation) *proto.Operation {
+	res := &proto.Operation{
+		Id:          op.ID,
+		Type:        op.Type,
+		Provider:    op.Provider,
+		AccountName: op.Account,
+		Region:      op.Region,
+		Target:      op.Target,
+		Status:      operationStatus[op.Status],
+		Error:       op.Error,
+		CreatedAt:   op.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:   op.UpdatedAt.Format(time.RFC3339),
+	}
+
+	for _, step := range op.Steps {
+		res.Steps = append(res.Steps, &proto.OperationStep{
+			Name:        step.Name,
+			CompletedAt: step.CompletedAt.Format(time.RFC3339),
+		})
+	}
+
+	switch r := op.Result.(type) {
+	case *proto.ClusterResponse:
+		res.Result = &proto.Operation_CreateCluster{CreateCluster: r}
+	case *proto.NodeSpawnResponse:
+		res.Result = &proto.Operation_AddNode{AddNode: r}
+	case *proto.ClusterDeleteResponse:
+		res.Result = &proto.Operation_DeleteCluster{DeleteCluster: r}
+	case *proto.NodeDeleteResponse:
+		res.Result = &proto.Operation_DeleteNode{DeleteNode: r}
+	case *proto.CreateVolumeResponse:
+		res.Result = &proto.Operation_CreateVolume{CreateVolume: r}
+	case *proto.DeleteVolumeResponse:
+		res.Result = &proto.Operation_DeleteVolume{DeleteVolume: r}
+	case *proto.CreateSnapshotResponse:
+		res.Result = &proto.Operation_CreateSnapshot{CreateSnapshot: r}
+	case *proto.CreateSnapshotAndDeleteResponse:
+		res.Result = &proto.Operation_CreateSnapshotAndDelete{CreateSnapshotAndDelete: r}
+	case *proto.TagNodeInstanceResponse:
+		res.Result = &proto.Operation_TagNodeInstance{TagNodeInstance: r}
+	case *proto.ApplyClusterSpecResponse:
+		res.Result = &proto.Operation_ApplyClusterSpec{ApplyClusterSpec: r}
+	case *proto.DeleteSnapshotResponse:
+		res.Result = &proto.Operation_DeleteSnapshot{DeleteSnapshot: r}
+	case *proto.ScaleNodePoolResponse:
+		res.Result = &proto.Operation_ScaleNodePool{ScaleNodePool: r}
+	case *proto.UpdateNodePoolResponse:
+		res.Result = &proto.Operation_UpdateNodePool{UpdateNodePool: r}
+	case *proto.UpgradeClusterResponse:
+		res.Result = &proto.Operation_UpgradeCluster{UpgradeCluster: r}
+	case *proto.ResizeVolumeResponse:
+		res.Result = &proto.Operation_ResizeVolume{ResizeVolume: r}
+	case *proto.AttachVolumeResponse:
+		res.Result = &proto.Operation_AttachVolume{AttachVolume: r}
+	case *proto.DetachVolumeResponse:
+		res.Result = &proto.Operation_DetachVolume{DetachVolume: r}
+	case *proto.RestoreSnapshotResponse:
+		res.Result = &proto.Operation_RestoreSnapshot{RestoreSnapshot: r}
+	case *proto.CopySnapshotResponse:
+		res.Result = &proto.Operation_CopySnapshot{CopySnapshot: r}
+	case *proto.UpsertDNSRecordResponse:
+		res.Result = &proto.Operation_UpsertDNSRecord{UpsertDNSRecord: r}
+	case *proto.DeleteDNSRecordResponse:
+		res.Result = &proto.Operation_DeleteDNSRecord{DeleteDNSRecord: r}
+	}
+	return res
+}
+
+func operationError(err error) error {
+	switch {
+	case errors.Is(err, operation.ERR_NOT_FOUND):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, operation.ERR_ALREADY_DONE):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	}
+	return err
+}
+
+//GetOperation returns the progress of the operation
+func (s *spawnerService) GetOperation(ctx context.Context, req *proto.GetOperationRequest) (*proto.Operation, error) {
+	op, err := s.operations.Get(req.GetOperationId())
+	if err != nil {
+		return nil, operationError(err)
+	}
+	if err := auth.Authorize(ctx, "GetOperation", op.Provider, op.Account); err != nil {
+		return nil, err
+	}
+	return operationProto(op), nil
+}
+
+//ListOperations list the operations, latest first
+func (s *spawnerService) ListOperations(ctx context.Context, req *proto.ListOperationsRequest) (*proto.ListOperationsResponse, error) {
+	ops := s.operations.List(operation.Filter{
+		Meta:       operationMeta(req.GetType(), req.GetProvider(), req.GetAccountName(), req.GetRegion(), ""),
+		ActiveOnly: req.GetActiveOnly(),
+	})
+
+	res := &proto.ListOperationsResponse{
+		Operations: make([]*proto.Operation, 0, len(ops)),
+	}
+	for _, op := range ops {
+		res.Operations = append(res.Operations, operationProto(op))
+	}
+	return res, nil
+}
+
+//CancelOperation cancel the running operation, resources created so far are not rolled back
+func (s *spawnerService) CancelOperation(ctx context.Context, req *proto.CancelOperationRequest) (*proto.Operation, error) {
+	op, err := s.operations.Get(req.GetOperationId())
+	if err != nil {
+		return nil, operationError(err)
+	}
+	if err := auth.Authorize(ctx, "CancelOperation", op.Provider, op.Account); err != nil {
+		return nil, err
+	}
+
+	op, err = s.operations.Cancel(req.GetOperationId())
+	if err != nil {
+		return nil, operationError(err)
+	}
+	return operationProto(op), nil
+}
@@ -0,0 +1,130 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	"gitlab.com/netbook-devs/spawner-service/pkg/auth"
+	"gitlab.com/netbook-devs/spawner-service/pkg/gc"
+	"gitlab.com/netbook-devs/spawner-service/pkg/inventory"
+	proto "gitlab.com/netbook-devs/spawner-service/proto/netbookai/spawner"
+)
+
+const (
+	defaultOrphanGCInterval = time.Hour
+	defaultOrphanGrace      = 72 * time.Hour
+	defaultOrphanKeepLabel  = "spawner-keep"
+)
+
+func orphanGrace(hours int) time.Duration {
+	if hours > 0 {
+		return time.Duration(hours) * time.Hour
+	}
+	return defaultOrphanGrace
+}
+
+func orphanKeepLabel(label string) string {
+	if label != "" {
+		return label
+	}
+	return defaultOrphanKeepLabel
+}
+
+func (s *spawnerService) orphanVolumes(ctx context.Context, provider, account, region string) ([]*proto.Volume, error) {
+	p, err := s.controller(provider, CapVolumes)
+	if err != nil {
+		return nil, err
+	}
+	res, err := p.ListVolumes(ctx, &proto.ListVolumesRequest{Provider: provider, Region: region, AccountName: account})
+	if err != nil {
+		return nil, err
+	}
+	return res.Volumes, nil
+}
+
+func (s *spawnerService) orphanSnapshots(ctx context.Context, provider, account, region string) ([]*proto.Snapshot, error) {
+	p, err := s.controller(provider, CapSnapshots)
+	if err != nil {
+		return nil, err
+	}
+	res, err := p.ListSnapshots(ctx, &proto.ListSnapshotsRequest{Provider: provider, Region: region, AccountName: account})
+	if err != nil {
+		return nil, err
+	}
+	return res.Snapshots, nil
+}
+
+//orphanCluster whether the cluster exists on the provider, only not found reported by the provider counts as gone
+func (s *spawnerService) orphanCluster(ctx context.Context, provider, account, region, name string) (bool, error) {
+	p, err := s.controller(provider, CapClusters)
+	if err != nil {
+		return false, err
+	}
+	_, err = p.ClusterStatus(ctx, &proto.ClusterStatusRequest{Provider: provider, Region: region, AccountName: account, ClusterName: name})
+	if err == nil {
+		return true, nil
+	}
+	if isClusterNotFound(err) {
+		return false, nil
+	}
+	return false, err
+}
+
+//deleteOrphan deletes the orphan in the background, returns the operation id.
+//caller must be allowed to delete the orphan, ListOrphans itself is authorized as a read
+func (s *spawnerService) deleteOrphan(ctx context.Context, o gc.Orphan) (string, error) {
+	switch o.Kind {
+	case inventory.KindVolume:
+		if err := auth.Authorize(ctx, "DeleteVolume", o.Provider, o.Account); err != nil {
+			return "", err
+		}
+		res, err := s.DeleteVolume(ctx, &proto.DeleteVolumeRequest{Provider: o.Provider, Region: o.Region, AccountName: o.Account, Volumeid: o.ID})
+		if err != nil {
+			return "", err
+		}
+		return res.OperationId, nil
+	case inventory.KindSnapshot:
+		if err := auth.Authorize(ctx, "DeleteSnapshot", o.Provider, o.Account); err != nil {
+			return "", err
+		}
+		res, err := s.DeleteSnapshot(ctx, &proto.DeleteSnapshotRequest{Provider: o.Provider, Region: o.Region, AccountName: o.Account, SnapshotId: o.ID})
+		if err != nil {
+			return "", err
+		}
+		return res.OperationId, nil
+	}
+	return "", errors.Errorf("orphan %s is not supported", o.Kind)
+}
+
+func orphanProto(o gc.Orphan) *proto.Orphan {
+	return &proto.Orphan{
+		Kind:        string(o.Kind),
+		Provider:    o.Provider,
+		AccountName: o.Account,
+		Region:      o.Region,
+		Id:          o.ID,
+		Labels:      o.Labels,
+		CreatedAt:   o.CreatedAt.Format(time.RFC3339),
+		Reason:      o.Reason,
+		OperationId: o.OperationID,
+	}
+}
+
+//ListOrphans unattached spawner volumes and snapshots without live cluster or workspace, deleted when asked for
+func (s *spawnerService) ListOrphans(ctx context.Context, req *proto.ListOrphansRequest) (*proto.ListOrphansResponse, error) {
+	orphans, err := s.orphans.Collect(ctx, inventory.Filter{
+		Provider: req.GetProvider(),
+		Account:  req.GetAccountName(),
+		Region:   req.GetRegion(),
+	}, req.GetDelete())
+	if err != nil {
+		return nil, err
+	}
+
+	res := &proto.ListOrphansResponse{Orphans: make([]*proto.Orphan, 0, len(orphans))}
+	for _, o := range orphans {
+		res.Orphans = append(res.Orphans, orphanProto(o))
+	}
+	return res, nil
+}
@@ -0,0 +1,321 @@
+package plugin
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+	"gitlab.com/netbook-devs/spawner-service/pkg/inventory"
+	proto "gitlab.com/netbook-devs/spawner-service/proto/netbookai/spawner"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+)
+
+//describeTimeout time given to the plugin to respond to Describe during registration
+const describeTimeout = 10 * time.Second
+
+//PluginController forwards provider calls to an out of process plugin serving the ProviderPlugin gRPC service
+type PluginController struct {
+	name   string
+	addr   string
+	conn   *grpc.ClientConn
+	client proto.ProviderPluginClient
+	logger *zap.SugaredLogger
+	//inventory plugins do not have access to the store, resources are recorded from the responses
+	inventory *inventory.Recorder
+}
+
+//Dial connects to the plugin at addr and asks it to describe itself,
+//returned description carries the provider name and the capabilities of the plugin.
+//Connection is plaintext and unauthenticated, plugin must listen on a trusted local address (localhost or a sidecar in the same pod).
+//opts are added to the dial options
+func Dial(ctx context.Context, logger *zap.SugaredLogger, addr string, store inventory.Store, opts ...grpc.DialOption) (*PluginController, *proto.PluginDescription, error) {
+	conn, err := grpc.Dial(addr, append([]grpc.DialOption{grpc.WithInsecure()}, opts...)...)
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "failed to connect to plugin at '%s'", addr)
+	}
+
+	client := proto.NewProviderPluginClient(conn)
+
+	ctx, cancel := context.WithTimeout(ctx, describeTimeout)
+	defer cancel()
+	desc, err := client.Describe(ctx, &proto.Empty{})
+	if err != nil {
+		conn.Close()
+		return nil, nil, errors.Wrapf(err, "plugin at '%s' failed to describe itself", addr)
+	}
+
+	if desc.GetName() == "" {
+		conn.Close()
+		return nil, nil, errors.Errorf("plugin at '%s' did not report a provider name", addr)
+	}
+
+	return &PluginController{
+		name:      desc.GetName(),
+		addr:      addr,
+		conn:      conn,
+		client:    client,
+		logger:    logger.With("plugin", desc.GetName(), "addr", addr),
+		inventory: inventory.NewRecorder(store, desc.GetName(), logger),
+	}, desc, nil
+}
+
+//Close closes the connection to the plugin
+func (p *PluginController) Close() error {
+	return p.conn.Close()
+}
+
+func (p *PluginController) recordSnapshot(ctx context.Context, account, region, snapshotId, volumeId string, labels map[string]string) {
+	p.inventory.Created(ctx, inventory.Resource{
+		ID:         snapshotId,
+		Kind:       inventory.KindSnapshot,
+		Account:    account,
+		Region:     region,
+		Labels:     labels,
+		Attributes: map[string]string{"volumeId": volumeId},
+	})
+}
+
+func (p *PluginController) CreateCluster(ctx context.Context, req *proto.ClusterRequest) (*proto.ClusterResponse, error) {
+	res, err := p.client.CreateCluster(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	p.inventory.Created(ctx, inventory.Resource{
+		ID:      res.ClusterName,
+		Kind:    inventory.KindCluster,
+		Account: req.AccountName,
+		Region:  req.Region,
+		Name:    res.ClusterName,
+		Labels:  req.Labels,
+	})
+	return res, nil
+}
+
+func (p *PluginController) GetCluster(ctx context.Context, req *proto.GetClusterRequest) (*proto.ClusterSpec, error) {
+	return p.client.GetCluster(ctx, req)
+}
+
+func (p *PluginController) GetClusters(ctx context.Context, req *proto.GetClustersRequest) (*proto.GetClustersResponse, error) {
+	return p.client.GetClusters(ctx, req)
+}
+
+func (p *PluginController) AddToken(ctx context.Context, req *proto.AddTokenRequest) (*proto.AddTokenResponse, error) {
+	return p.client.AddToken(ctx, req)
+}
+
+func (p *PluginController) GetToken(ctx context.Context, req *proto.GetTokenRequest) (*proto.GetTokenResponse, error) {
+	return p.client.GetToken(ctx, req)
+}
+
+func (p *PluginController) ClusterStatus(ctx context.Context, req *proto.ClusterStatusRequest) (*proto.ClusterStatusResponse, error) {
+	return p.client.ClusterStatus(ctx, req)
+}
+
+func (p *PluginController) AddNode(ctx context.Context, req *proto.NodeSpawnRequest) (*proto.NodeSpawnResponse, error) {
+	res, err := p.client.AddNode(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	p.inventory.Created(ctx, inventory.Resource{
+		ID:      inventory.NodePoolID(req.ClusterName, req.NodeSpec.GetName()),
+		Kind:    inventory.KindNodePool,
+		Account: req.AccountName,
+		Region:  req.Region,
+		Cluster: req.ClusterName,
+		Name:    req.NodeSpec.GetName(),
+		Labels:  req.NodeSpec.GetLabels(),
+		Attributes: map[string]string{
+			"instance":    req.NodeSpec.GetInstance(),
+			"machineType": req.NodeSpec.GetMachineType(),
+			"gpuEnabled":  strconv.FormatBool(req.NodeSpec.GetGpuEnabled()),
+		},
+	})
+	return res, nil
+}
+
+func (p *PluginController) DeleteCluster(ctx context.Context, req *proto.ClusterDeleteRequest) (*proto.ClusterDeleteResponse, error) {
+	res, err := p.client.DeleteCluster(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	p.inventory.Deleted(ctx, inventory.KindCluster, req.AccountName, req.Region, req.ClusterName)
+	return res, nil
+}
+
+func (p *PluginController) DeleteNode(ctx context.Context, req *proto.NodeDeleteRequest) (*proto.NodeDeleteResponse, error) {
+	res, err := p.client.DeleteNode(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	p.inventory.Deleted(ctx, inventory.KindNodePool, req.AccountName, req.Region, inventory.NodePoolID(req.ClusterName, req.NodeGroupName))
+	return res, nil
+}
+
+func (p *PluginController) CreateVolume(ctx context.Context, req *proto.CreateVolumeRequest) (*proto.CreateVolumeResponse, error) {
+	res, err := p.client.CreateVolume(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	p.inventory.Created(ctx, inventory.Resource{
+		ID:         res.Volumeid,
+		Kind:       inventory.KindVolume,
+		Account:    req.AccountName,
+		Region:     req.Region,
+		Labels:     req.Labels,
+		Attributes: map[string]string{"resourceUri": res.ResourceUri},
+	})
+	return res, nil
+}
+
+func (p *PluginController) DeleteVolume(ctx context.Context, req *proto.DeleteVolumeRequest) (*proto.DeleteVolumeResponse, error) {
+	res, err := p.client.DeleteVolume(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	p.inventory.Deleted(ctx, inventory.KindVolume, req.AccountName, req.Region, req.Volumeid)
+	return res, nil
+}
+
+func (p *PluginController) CreateSnapshot(ctx context.Context, req *proto.CreateSnapshotRequest) (*proto.CreateSnapshotResponse, error) {
+	res, err := p.client.CreateSnapshot(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	p.recordSnapshot(ctx, req.AccountName, req.Region, res.Snapshotid, req.Volumeid, req.Labels)
+	return res, nil
+}
+
+func (p *PluginController) CreateSnapshotAndDelete(ctx context.Context, req *proto.CreateSnapshotAndDeleteRequest) (*proto.CreateSnapshotAndDeleteResponse, error) {
+	res, err := p.client.CreateSnapshotAndDelete(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	p.recordSnapshot(ctx, req.AccountName, req.Region, res.Snapshotid, req.Volumeid, req.Labels)
+	if res.Deleted {
+		p.inventory.Deleted(ctx, inventory.KindVolume, req.AccountName, req.Region, req.Volumeid)
+	}
+	return res, nil
+}
+
+func (p *PluginController) GetWorkspacesCost(ctx context.Context, req *proto.GetWorkspacesCostRequest) (*proto.GetWorkspacesCostResponse, error) {
+	return p.client.GetWorkspacesCost(ctx, req)
+}
+
+func (p *PluginController) GetKubeConfig(ctx context.Context, req *proto.GetKubeConfigRequest) (*proto.GetKubeConfigResponse, error) {
+	return p.client.GetKubeConfig(ctx, req)
+}
+
+func (p *PluginController) TagNodeInstance(ctx context.Context, req *proto.TagNodeInstanceRequest) (*proto.TagNodeInstanceResponse, error) {
+	return p.client.TagNodeInstance(ctx, req)
+}
+
+func (p *PluginController) ScaleNodePool(ctx context.Context, req *proto.ScaleNodePoolRequest) (*proto.ScaleNodePoolResponse, error) {
+	res, err := p.client.ScaleNodePool(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	p.inventory.Updated(ctx, inventory.KindNodePool, req.AccountName, req.Region, inventory.NodePoolID(req.ClusterName, req.NodeGroupName), nil,
+		map[string]string{"count": strconv.FormatInt(req.Count, 10)})
+	return res, nil
+}
+
+func (p *PluginController) UpdateNodePool(ctx context.Context, req *proto.UpdateNodePoolRequest) (*proto.UpdateNodePoolResponse, error) {
+	res, err := p.client.UpdateNodePool(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	p.inventory.Updated(ctx, inventory.KindNodePool, req.AccountName, req.Region, inventory.NodePoolID(req.ClusterName, req.NodeGroupName), req.Labels, nil)
+	return res, nil
+}
+
+func (p *PluginController) ListVolumes(ctx context.Context, req *proto.ListVolumesRequest) (*proto.ListVolumesResponse, error) {
+	return p.client.ListVolumes(ctx, req)
+}
+
+func (p *PluginController) ListSnapshots(ctx context.Context, req *proto.ListSnapshotsRequest) (*proto.ListSnapshotsResponse, error) {
+	return p.client.ListSnapshots(ctx, req)
+}
+
+func (p *PluginController) DeleteSnapshot(ctx context.Context, req *proto.DeleteSnapshotRequest) (*proto.DeleteSnapshotResponse, error) {
+	res, err := p.client.DeleteSnapshot(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	p.inventory.Deleted(ctx, inventory.KindSnapshot, req.AccountName, req.Region, req.SnapshotId)
+	return res, nil
+}
+
+func (p *PluginController) ListKubernetesVersions(ctx context.Context, req *proto.ListKubernetesVersionsRequest) (*proto.ListKubernetesVersionsResponse, error) {
+	return p.client.ListKubernetesVersions(ctx, req)
+}
+
+func (p *PluginController) UpgradeCluster(ctx context.Context, req *proto.UpgradeClusterRequest) (*proto.UpgradeClusterResponse, error) {
+	res, err := p.client.UpgradeCluster(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	p.inventory.Updated(ctx, inventory.KindCluster, req.AccountName, req.Region, req.ClusterName, nil,
+		map[string]string{"kubernetesVersion": req.KubernetesVersion})
+	return res, nil
+}
+
+func (p *PluginController) GetVolume(ctx context.Context, req *proto.GetVolumeRequest) (*proto.Volume, error) {
+	return p.client.GetVolume(ctx, req)
+}
+
+func (p *PluginController) ResizeVolume(ctx context.Context, req *proto.ResizeVolumeRequest) (*proto.ResizeVolumeResponse, error) {
+	res, err := p.client.ResizeVolume(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	p.inventory.Updated(ctx, inventory.KindVolume, req.AccountName, req.Region, req.VolumeId, nil,
+		map[string]string{"size": strconv.FormatInt(res.Size, 10)})
+	return res, nil
+}
+
+func (p *PluginController) AttachVolume(ctx context.Context, req *proto.AttachVolumeRequest) (*proto.AttachVolumeResponse, error) {
+	res, err := p.client.AttachVolume(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	p.inventory.Updated(ctx, inventory.KindVolume, req.AccountName, req.Region, req.VolumeId, nil,
+		map[string]string{"instance": res.Instance})
+	return res, nil
+}
+
+func (p *PluginController) DetachVolume(ctx context.Context, req *proto.DetachVolumeRequest) (*proto.DetachVolumeResponse, error) {
+	res, err := p.client.DetachVolume(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	p.inventory.Updated(ctx, inventory.KindVolume, req.AccountName, req.Region, req.VolumeId, nil,
+		map[string]string{"instance": ""})
+	return res, nil
+}
+
+func (p *PluginController) RestoreSnapshot(ctx context.Context, req *proto.RestoreSnapshotRequest) (*proto.RestoreSnapshotResponse, error) {
+	res, err := p.client.RestoreSnapshot(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	p.inventory.Created(ctx, inventory.Resource{
+		ID:         res.VolumeId,
+		Kind:       inventory.KindVolume,
+		Account:    req.AccountName,
+		Region:     req.Region,
+		Labels:     req.Labels,
+		Attributes: map[string]string{"resourceUri": res.ResourceUri, "snapshotId": req.SnapshotId},
+	})
+	return res, nil
+}
+
+func (p *PluginController) CopySnapshot(ctx context.Context, req *proto.CopySnapshotRequest) (*proto.CopySnapshotResponse, error) {
+	res, err := p.client.CopySnapshot(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	p.recordSnapshot(ctx, req.AccountName, req.DestinationRegion, res.SnapshotId, "", req.Labels)
+	return res, nil
+}
@@ -0,0 +1,122 @@
+package plugin
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gitlab.com/netbook-devs/spawner-service/pkg/inventory"
+	proto "gitlab.com/netbook-devs/spawner-service/proto/netbookai/spawner"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+//stubPlugin serves Describe, CreateCluster and GetCluster, the rest of the calls are unimplemented
+type stubPlugin struct {
+	proto.UnimplementedProviderPluginServer
+	desc     *proto.PluginDescription
+	clusters map[string]*proto.ClusterSpec
+}
+
+func (s *stubPlugin) Describe(ctx context.Context, _ *proto.Empty) (*proto.PluginDescription, error) {
+	return s.desc, nil
+}
+
+func (s *stubPlugin) CreateCluster(ctx context.Context, req *proto.ClusterRequest) (*proto.ClusterResponse, error) {
+	s.clusters[req.ClusterName] = &proto.ClusterSpec{Name: req.ClusterName, ClusterId: "id-" + req.ClusterName}
+	return &proto.ClusterResponse{ClusterName: req.ClusterName, OperationId: "op-1"}, nil
+}
+
+func (s *stubPlugin) GetCluster(ctx context.Context, req *proto.GetClusterRequest) (*proto.ClusterSpec, error) {
+	c, ok := s.clusters[req.ClusterName]
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "cluster '%s' not found", req.ClusterName)
+	}
+	return c, nil
+}
+
+//serve starts the plugin on in-memory listener and returns the dial option connecting to it
+func serve(t *testing.T, s *stubPlugin) grpc.DialOption {
+	lis := bufconn.Listen(1024 * 1024)
+	srv := grpc.NewServer()
+	proto.RegisterProviderPluginServer(srv, s)
+	go srv.Serve(lis)
+	t.Cleanup(srv.Stop)
+
+	return grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+		return lis.DialContext(ctx)
+	})
+}
+
+func newStubPlugin(name string) *stubPlugin {
+	return &stubPlugin{
+		desc: &proto.PluginDescription{
+			Name:         name,
+			Version:      "1.0.0",
+			Capabilities: &proto.ProviderCapabilities{Clusters: true, NodePools: true},
+		},
+		clusters: map[string]*proto.ClusterSpec{},
+	}
+}
+
+func Test_Dial(t *testing.T) {
+	dialer := serve(t, newStubPlugin("inhouse"))
+
+	p, desc, err := Dial(context.Background(), zap.NewNop().Sugar(), "bufnet", nil, dialer)
+	require.NoError(t, err)
+	defer p.Close()
+
+	assert.Equal(t, "inhouse", p.name)
+	assert.Equal(t, "1.0.0", desc.GetVersion())
+	assert.True(t, desc.GetCapabilities().GetClusters())
+	assert.False(t, desc.GetCapabilities().GetVolumes())
+}
+
+func Test_DialWithoutName(t *testing.T) {
+	dialer := serve(t, newStubPlugin(""))
+
+	_, _, err := Dial(context.Background(), zap.NewNop().Sugar(), "bufnet", nil, dialer)
+	assert.EqualError(t, err, "plugin at 'bufnet' did not report a provider name")
+}
+
+func Test_ForwardedCalls(t *testing.T) {
+	ctx := context.Background()
+	store := inventory.NewMemoryStore()
+	dialer := serve(t, newStubPlugin("inhouse"))
+
+	p, _, err := Dial(ctx, zap.NewNop().Sugar(), "bufnet", store, dialer)
+	require.NoError(t, err)
+	defer p.Close()
+
+	res, err := p.CreateCluster(ctx, &proto.ClusterRequest{
+		Provider:    "inhouse",
+		Region:      "dc-1",
+		AccountName: "netbook",
+		ClusterName: "c1",
+		Labels:      map[string]string{"workspaceid": "ws-1"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "op-1", res.OperationId)
+
+	r, err := store.Get(ctx, inventory.Key{Provider: "inhouse", Account: "netbook", Region: "dc-1", Kind: inventory.KindCluster, ID: "c1"})
+	require.NoError(t, err, "created cluster is recorded under the plugin name")
+	assert.Equal(t, "c1", r.Name)
+
+	spec, err := p.GetCluster(ctx, &proto.GetClusterRequest{Provider: "inhouse", Region: "dc-1", AccountName: "netbook", ClusterName: "c1"})
+	require.NoError(t, err)
+	assert.Equal(t, "id-c1", spec.ClusterId)
+
+	_, err = p.GetCluster(ctx, &proto.GetClusterRequest{ClusterName: "missing"})
+	assert.Equal(t, codes.NotFound, status.Code(err), "plugin status is returned as is")
+
+	_, err = p.DeleteCluster(ctx, &proto.ClusterDeleteRequest{ClusterName: "c1"})
+	assert.Equal(t, codes.Unimplemented, status.Code(err))
+	r, err = store.Get(ctx, r.Key())
+	require.NoError(t, err)
+	assert.False(t, r.Deleted(), "failed deletion is not recorded")
+}
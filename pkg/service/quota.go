@@ -0,0 +1,64 @@
+package service
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"gitlab.com/netbook-devs/spawner-service/pkg/quota"
+	"gitlab.com/netbook-devs/spawner-service/pkg/service/constants"
+	proto "gitlab.com/netbook-devs/spawner-service/proto/netbookai/spawner"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+//workspace of the request taken from the first labels having it
+func workspace(labels ...map[string]string) string {
+	for _, l := range labels {
+		if w := l[constants.WorkspaceLabel]; w != "" {
+			return w
+		}
+	}
+	return ""
+}
+
+//reserveQuota holds the requested resources against the account and workspace quotas,
+//over quota requests fail with ResourceExhausted
+func (s *spawnerService) reserveQuota(ctx context.Context, account, workspace string, requested quota.Resources) (func(), error) {
+	release, err := s.quotas.Reserve(ctx, account, workspace, requested)
+	if errors.Is(err, quota.ERR_QUOTA_EXCEEDED) {
+		return nil, status.Error(codes.ResourceExhausted, err.Error())
+	}
+	return release, err
+}
+
+func quotaResourcesProto(r quota.Resources) *proto.QuotaResources {
+	return &proto.QuotaResources{
+		Clusters:  r.Clusters,
+		Nodes:     r.Nodes,
+		Gpus:      r.GPUs,
+		VolumeGiB: r.VolumeGiB,
+	}
+}
+
+//GetQuotaUsage returns the limits and consumption of the account and workspace quotas
+func (s *spawnerService) GetQuotaUsage(ctx context.Context, req *proto.GetQuotaUsageRequest) (*proto.GetQuotaUsageResponse, error) {
+	usage, err := s.quotas.Usage(ctx, req.GetAccountName(), req.GetWorkspace())
+	if err != nil {
+		s.logger.Errorw("failed to get quota usage", "error", err)
+		return nil, err
+	}
+
+	res := &proto.GetQuotaUsageResponse{
+		Usage: make([]*proto.QuotaUsage, 0, len(usage)),
+	}
+	for _, u := range usage {
+		res.Usage = append(res.Usage, &proto.QuotaUsage{
+			Scope:   string(u.Scope),
+			Name:    u.Name,
+			Limits:  quotaResourcesProto(u.Limits),
+			Used:    quotaResourcesProto(u.Used),
+			Pending: quotaResourcesProto(u.Pending),
+		})
+	}
+	return res, nil
+}
@@ -0,0 +1,290 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/pkg/errors"
+	"gitlab.com/netbook-devs/spawner-service/pkg/inventory"
+	aws "gitlab.com/netbook-devs/spawner-service/pkg/service/aws"
+	"gitlab.com/netbook-devs/spawner-service/pkg/service/azure"
+	"gitlab.com/netbook-devs/spawner-service/pkg/service/constants"
+	"gitlab.com/netbook-devs/spawner-service/pkg/service/fake"
+	"gitlab.com/netbook-devs/spawner-service/pkg/service/gcp"
+	"gitlab.com/netbook-devs/spawner-service/pkg/service/plugin"
+	proto "gitlab.com/netbook-devs/spawner-service/proto/netbookai/spawner"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+//Capability is a feature which provider may or may not support
+type Capability string
+
+const (
+	CapClusters      Capability = "clusters"
+	CapNodePools     Capability = "node pools"
+	CapVolumes       Capability = "volumes"
+	CapSnapshots     Capability = "snapshots"
+	CapCost          Capability = "cost"
+	CapKubeConfig    Capability = "kubeconfig"
+	CapSpotInstances Capability = "spot instances"
+	CapGPU           Capability = "gpu"
+	CapSpotFallback  Capability = "spot fallback"
+)
+
+//Capabilities describes the features supported by the provider
+type Capabilities struct {
+	Clusters      bool
+	NodePools     bool
+	Volumes       bool
+	Snapshots     bool
+	Cost          bool
+	KubeConfig    bool
+	SpotInstances bool
+	GPU           bool
+	SpotFallback  bool
+}
+
+//Supports check if the capability is supported
+func (c Capabilities) Supports(cap Capability) bool {
+	switch cap {
+	case CapClusters:
+		return c.Clusters
+	case CapNodePools:
+		return c.NodePools
+	case CapVolumes:
+		return c.Volumes
+	case CapSnapshots:
+		return c.Snapshots
+	case CapCost:
+		return c.Cost
+	case CapKubeConfig:
+		return c.KubeConfig
+	case CapSpotInstances:
+		return c.SpotInstances
+	case CapGPU:
+		return c.GPU
+	case CapSpotFallback:
+		return c.SpotFallback
+	}
+	return false
+}
+
+func capabilitiesFromProto(c *proto.ProviderCapabilities) Capabilities {
+	return Capabilities{
+		Clusters:      c.GetClusters(),
+		NodePools:     c.GetNodePools(),
+		Volumes:       c.GetVolumes(),
+		Snapshots:     c.GetSnapshots(),
+		Cost:          c.GetCost(),
+		KubeConfig:    c.GetKubeConfig(),
+		SpotInstances: c.GetSpotInstances(),
+		GPU:           c.GetGpu(),
+		SpotFallback:  c.GetSpotFallback(),
+	}
+}
+
+//nodeCapabilities capabilities required to spawn the node
+func nodeCapabilities(node *proto.NodeSpec) []Capability {
+	caps := []Capability{}
+	if node.GetCapacityType() == proto.CapacityType_SPOT {
+		caps = append(caps, CapSpotInstances)
+	}
+	if node.GetGpuEnabled() {
+		caps = append(caps, CapGPU)
+	}
+	if node.GetSpotFallback() != nil {
+		caps = append(caps, CapSpotFallback)
+	}
+	return caps
+}
+
+//ProviderFactory creates the controller for the builtin provider
+type ProviderFactory func(logger *zap.SugaredLogger, store inventory.Store) Controller
+
+type builtin struct {
+	factory      ProviderFactory
+	capabilities Capabilities
+}
+
+//builtins providers shipped with the service, enabled with PROVIDERS config
+var builtins = map[string]builtin{
+	constants.AwsLabel: {
+		factory: func(logger *zap.SugaredLogger, store inventory.Store) Controller {
+			return aws.NewAWSController(logger, store)
+		},
+		capabilities: Capabilities{
+			Clusters: true, NodePools: true, Volumes: true, Snapshots: true,
+			Cost: true, KubeConfig: true, SpotInstances: true, GPU: true,
+			SpotFallback: true,
+		},
+	},
+	constants.AzureLabel: {
+		factory: func(logger *zap.SugaredLogger, store inventory.Store) Controller {
+			return azure.NewController(logger, store)
+		},
+		capabilities: Capabilities{
+			Clusters: true, NodePools: true, Volumes: true, Snapshots: true,
+			Cost: true, KubeConfig: true, GPU: true,
+		},
+	},
+	constants.GcpLabel: {
+		factory: func(logger *zap.SugaredLogger, store inventory.Store) Controller {
+			return gcp.NewController(logger, store)
+		},
+		capabilities: Capabilities{
+			Clusters: true, NodePools: true, Volumes: true, Snapshots: true,
+			Cost: true, KubeConfig: true, SpotInstances: true, GPU: true,
+		},
+	},
+	constants.FakeLabel: {
+		factory: func(logger *zap.SugaredLogger, store inventory.Store) Controller {
+			return fake.NewController(logger, store)
+		},
+		capabilities: Capabilities{
+			Clusters: true, NodePools: true, Volumes: true, Snapshots: true,
+			Cost: true, KubeConfig: true, SpotInstances: true, GPU: true,
+			SpotFallback: true,
+		},
+	},
+}
+
+type registration struct {
+	controller   Controller
+	capabilities Capabilities
+}
+
+//Registry holds the provider controllers registered by name
+type Registry struct {
+	mu        sync.RWMutex
+	providers map[string]registration
+}
+
+//NewRegistry returns empty registry
+func NewRegistry() *Registry {
+	return &Registry{
+		providers: make(map[string]registration),
+	}
+}
+
+//Register adds controller under the given provider name, name must be unique
+func (r *Registry) Register(name string, controller Controller, capabilities Capabilities) error {
+	if name == "" {
+		return errors.New("provider name must not be empty")
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.providers[name]; ok {
+		return fmt.Errorf("provider '%s' is already registered", name)
+	}
+	r.providers[name] = registration{
+		controller:   controller,
+		capabilities: capabilities,
+	}
+	return nil
+}
+
+//Names returns sorted names of the registered providers
+func (r *Registry) Names() []string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	names := make([]string, 0, len(r.providers))
+	for n := range r.providers {
+		names = append(names, n)
+	}
+	sort.Strings(names)
+	return names
+}
+
+//Capabilities of the registered provider
+func (r *Registry) Capabilities(name string) (Capabilities, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	p, ok := r.providers[name]
+	return p.capabilities, ok
+}
+
+//Lookup finds the provider controller, fails if the provider is not registered or
+//does not support any of the required capabilities
+func (r *Registry) Lookup(name string, required ...Capability) (Controller, error) {
+	r.mu.RLock()
+	p, ok := r.providers[name]
+	r.mu.RUnlock()
+
+	if !ok {
+		quoted := []string{}
+		for _, n := range r.Names() {
+			quoted = append(quoted, fmt.Sprintf("'%s'", n))
+		}
+		return nil, fmt.Errorf(ProviderNotFound, strings.Join(quoted, ", "), name)
+	}
+
+	for _, c := range required {
+		if !p.capabilities.Supports(c) {
+			return nil, status.Errorf(codes.Unimplemented, "provider '%s' does not support %s", name, c)
+		}
+	}
+	return p.controller, nil
+}
+
+func splitList(s string) []string {
+	items := []string{}
+	for _, i := range strings.Split(s, ",") {
+		if i = strings.TrimSpace(i); i != "" {
+			items = append(items, i)
+		}
+	}
+	return items
+}
+
+//defaultProviders builtin providers enabled when the list is empty, fake is enabled only when listed
+var defaultProviders = []string{constants.AwsLabel, constants.AzureLabel, constants.GcpLabel}
+
+//registerBuiltins registers the enabled builtin providers, defaultProviders are enabled when the list is empty
+func registerBuiltins(logger *zap.SugaredLogger, r *Registry, store inventory.Store, enabled string) {
+	names := splitList(enabled)
+	if len(names) == 0 {
+		names = defaultProviders
+	}
+
+	for _, name := range names {
+		b, ok := builtins[name]
+		if !ok {
+			logger.Errorw("unknown builtin provider, skipping", "provider", name)
+			continue
+		}
+		if err := r.Register(name, b.factory(logger, store), b.capabilities); err != nil {
+			logger.Errorw("failed to register provider", "provider", name, "error", err)
+			continue
+		}
+		logger.Infow("registered provider", "provider", name)
+	}
+}
+
+//registerPlugins connects to the plugins and registers them under the name they describe themselves with,
+//opts are added to the dial options of every plugin
+func registerPlugins(ctx context.Context, logger *zap.SugaredLogger, r *Registry, store inventory.Store, addrs string, opts ...grpc.DialOption) {
+	for _, addr := range splitList(addrs) {
+		p, desc, err := plugin.Dial(ctx, logger, addr, store, opts...)
+		if err != nil {
+			logger.Errorw("failed to load provider plugin", "addr", addr, "error", err)
+			continue
+		}
+
+		if err := r.Register(desc.GetName(), p, capabilitiesFromProto(desc.GetCapabilities())); err != nil {
+			logger.Errorw("failed to register provider plugin", "addr", addr, "error", err)
+			p.Close()
+			continue
+		}
+		logger.Infow("registered provider plugin", "provider", desc.GetName(), "version", desc.GetVersion(), "addr", addr)
+	}
+}
@@ -0,0 +1,79 @@
+package service
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gitlab.com/netbook-devs/spawner-service/pkg/service/fake"
+	proto "gitlab.com/netbook-devs/spawner-service/proto/netbookai/spawner"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+func Test_RegistryLookup(t *testing.T) {
+	r := NewRegistry()
+	controller := fake.NewController(zap.NewNop().Sugar(), nil)
+
+	require.NoError(t, r.Register("fake", controller, Capabilities{Clusters: true}))
+	require.NoError(t, r.Register("inhouse", controller, Capabilities{}))
+	assert.Error(t, r.Register("fake", controller, Capabilities{}), "duplicate provider must fail")
+
+	assert.Equal(t, []string{"fake", "inhouse"}, r.Names())
+
+	c, err := r.Lookup("fake", CapClusters)
+	require.NoError(t, err)
+	assert.Equal(t, controller, c)
+
+	_, err = r.Lookup("fake", CapVolumes)
+	assert.Equal(t, codes.Unimplemented, status.Code(err))
+
+	_, err = r.Lookup("aws")
+	assert.EqualError(t, err, "provider not found, must be one of ['fake', 'inhouse'], got aws")
+}
+
+func Test_RegisterBuiltins(t *testing.T) {
+	r := NewRegistry()
+	registerBuiltins(zap.NewNop().Sugar(), r, nil, " fake, unknown")
+	assert.Equal(t, []string{"fake"}, r.Names())
+
+	r = NewRegistry()
+	registerBuiltins(zap.NewNop().Sugar(), r, nil, "")
+	assert.Equal(t, []string{"aws", "azure", "gcp"}, r.Names(), "fake is enabled only when listed")
+}
+
+type describePlugin struct {
+	proto.UnimplementedProviderPluginServer
+}
+
+func (describePlugin) Describe(ctx context.Context, _ *proto.Empty) (*proto.PluginDescription, error) {
+	return &proto.PluginDescription{Name: "inhouse", Capabilities: &proto.ProviderCapabilities{Clusters: true}}, nil
+}
+
+func Test_RegisterPlugins(t *testing.T) {
+	lis := bufconn.Listen(1024 * 1024)
+	srv := grpc.NewServer()
+	proto.RegisterProviderPluginServer(srv, describePlugin{})
+	go srv.Serve(lis)
+	defer srv.Stop()
+	dialer := grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+		return lis.DialContext(ctx)
+	})
+
+	r := NewRegistry()
+	require.NoError(t, r.Register("fake", fake.NewController(zap.NewNop().Sugar(), nil), Capabilities{}))
+	registerPlugins(context.Background(), zap.NewNop().Sugar(), r, nil, "bufnet", dialer)
+	assert.Equal(t, []string{"fake", "inhouse"}, r.Names())
+
+	caps, ok := r.Capabilities("inhouse")
+	require.True(t, ok)
+	assert.Equal(t, Capabilities{Clusters: true}, caps, "plugin is registered with the capabilities it describes")
+
+	_, err := r.Lookup("inhouse", CapVolumes)
+	assert.Equal(t, codes.Unimplemented, status.Code(err))
+}
@@ -2,16 +2,46 @@ package service
 
 import (
 	"context"
+	"net"
 
-	"gitlab.com/netbook-devs/spawner-service/pkg/service/system"
+	proto "gitlab.com/netbook-devs/spawner-service/proto/netbookai/spawner"
 )
 
-func (svc *spawnerService) addRoute53Record(ctx context.Context, dnsName, recordName, regionName string, isAwsResource bool) (string, error) {
-	changeId, err := system.AddRoute53Record(ctx, dnsName, recordName, regionName, isAwsResource)
+//route53RecordType A record for the ip address, CNAME for the load balancer host name
+func route53RecordType(dnsName string) string {
+	if ip := net.ParseIP(dnsName); ip != nil && ip.To4() != nil {
+		return "A"
+	}
+	return "CNAME"
+}
+
+//addRoute53Record upserts the record in the zone through the dns provider, record is recorded
+//in the inventory with its cluster so it is deleted with the cluster
+func (svc *spawnerService) addRoute53Record(ctx context.Context, provider, zone string, req *proto.AddRoute53RecordRequest) (string, error) {
+	record := &proto.DNSRecord{
+		Zone:        zone,
+		Name:        req.RecordName,
+		Type:        route53RecordType(req.DnsName),
+		Values:      []string{req.DnsName},
+		ClusterName: req.ClusterName,
+	}
+	if err := validateDNSRecord(record); err != nil {
+		return "", err
+	}
+	dns, err := svc.dnsProvider(provider)
 	if err != nil {
-		svc.logger.Errorw("failed to add route53 record", "error", err)
 		return "", err
 	}
 
-	return changeId, nil
+	res, err := dns.UpsertDNSRecord(ctx, &proto.UpsertDNSRecordRequest{
+		Provider:    provider,
+		Region:      req.Region,
+		AccountName: req.AccountName,
+		Record:      record,
+	})
+	if err != nil {
+		svc.logger.Errorw("failed to add route53 record", "record", req.RecordName, "error", err)
+		return "", err
+	}
+	return res.ChangeId, nil
 }
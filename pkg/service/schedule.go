@@ -0,0 +1,145 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	"gitlab.com/netbook-devs/spawner-service/pkg/inventory"
+	"gitlab.com/netbook-devs/spawner-service/pkg/schedule"
+	proto "gitlab.com/netbook-devs/spawner-service/proto/netbookai/spawner"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const defaultScheduleCheckInterval = time.Minute
+
+func scheduleCheckInterval(seconds int) time.Duration {
+	if seconds > 0 {
+		return time.Duration(seconds) * time.Second
+	}
+	return defaultScheduleCheckInterval
+}
+
+//scaleOnSchedule scales the node pool to the fired rule as ScaleNodePool operation, scale up is refused
+//like ScaleNodePool when over the quota or budget and the rule is retried on next run
+func (s *spawnerService) scaleOnSchedule(ctx context.Context, sc schedule.Schedule, r schedule.Rule) (string, error) {
+	provider, err := s.controller(sc.Provider, CapNodePools)
+	if err != nil {
+		return "", err
+	}
+	req := &proto.ScaleNodePoolRequest{
+		Provider:      sc.Provider,
+		Region:        sc.Region,
+		AccountName:   sc.Account,
+		ClusterName:   sc.Cluster,
+		NodeGroupName: sc.NodePool,
+		Count:         r.DesiredSize,
+		MinCount:      r.MinSize,
+		MaxCount:      r.MaxSize,
+	}
+	release, err := s.reserveScaleUp(ctx, req)
+	if err != nil {
+		return "", err
+	}
+	op := s.operations.Start(ctx, operationMeta("ScaleNodePool", sc.Provider, sc.Account, sc.Region, inventory.NodePoolID(sc.Cluster, sc.NodePool)), func(ctx context.Context) (interface{}, error) {
+		defer release()
+		return provider.ScaleNodePool(ctx, req)
+	})
+	return op.ID, nil
+}
+
+func scheduleError(err error) error {
+	switch {
+	case errors.Is(err, schedule.ERR_NOT_FOUND):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, schedule.ERR_INVALID_SCHEDULE):
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	return err
+}
+
+func scheduleRuleProto(r schedule.Rule) *proto.ScheduleRule {
+	return &proto.ScheduleRule{
+		Cron:        r.Cron,
+		DesiredSize: r.DesiredSize,
+		MinSize:     r.MinSize,
+		MaxSize:     r.MaxSize,
+	}
+}
+
+func scheduleProto(sc schedule.Schedule) *proto.NodePoolSchedule {
+	res := &proto.NodePoolSchedule{
+		Provider:      sc.Provider,
+		Region:        sc.Region,
+		AccountName:   sc.Account,
+		ClusterName:   sc.Cluster,
+		NodeGroupName: sc.NodePool,
+		Timezone:      sc.Timezone,
+	}
+	for _, r := range sc.Rules {
+		res.Rules = append(res.Rules, scheduleRuleProto(r))
+	}
+	if !sc.UpdatedAt.IsZero() {
+		res.UpdatedAt = sc.UpdatedAt.Format(time.RFC3339)
+	}
+	return res
+}
+
+//SetNodePoolSchedule creates or replaces the scaling schedule of the node pool, schedule is removed when no rules are given
+func (s *spawnerService) SetNodePoolSchedule(ctx context.Context, req *proto.SetNodePoolScheduleRequest) (*proto.NodePoolSchedule, error) {
+	sc := schedule.Schedule{
+		Provider: req.Provider,
+		Account:  req.AccountName,
+		Region:   req.Region,
+		Cluster:  req.ClusterName,
+		NodePool: req.NodeGroupName,
+		Timezone: req.Timezone,
+	}
+	if len(req.Rules) == 0 {
+		if err := s.schedules.Delete(ctx, sc.Key()); err != nil {
+			return nil, scheduleError(err)
+		}
+		return scheduleProto(sc), nil
+	}
+
+	if _, err := s.controller(req.Provider, CapNodePools); err != nil {
+		return nil, err
+	}
+	for _, r := range req.Rules {
+		sc.Rules = append(sc.Rules, schedule.Rule{
+			Cron:        r.Cron,
+			DesiredSize: r.DesiredSize,
+			MinSize:     r.MinSize,
+			MaxSize:     r.MaxSize,
+		})
+	}
+	saved, err := s.schedules.Set(ctx, sc)
+	if err != nil {
+		s.logger.Errorw("failed to set node pool schedule", "nodePool", sc.Key(), "error", err)
+		return nil, scheduleError(err)
+	}
+
+	//rule fired last is applied right away instead of waiting for next run
+	go s.schedules.Apply(context.Background(), saved)
+	return scheduleProto(saved), nil
+}
+
+//GetNodePoolSchedule returns the scaling schedule of the node pool and the rule applied last
+func (s *spawnerService) GetNodePoolSchedule(ctx context.Context, req *proto.GetNodePoolScheduleRequest) (*proto.GetNodePoolScheduleResponse, error) {
+	st, err := s.schedules.Status(ctx, schedule.Key(req.Provider, req.AccountName, req.Region, req.ClusterName, req.NodeGroupName))
+	if err != nil {
+		return nil, scheduleError(err)
+	}
+
+	res := &proto.GetNodePoolScheduleResponse{
+		Schedule:    scheduleProto(st.Schedule),
+		OperationId: st.OperationID,
+		Error:       st.Error,
+	}
+	if st.Applied != nil {
+		res.AppliedRule = scheduleRuleProto(*st.Applied)
+		res.FiredAt = st.FiredAt.Format(time.RFC3339)
+	}
+	return res, nil
+}
@@ -3,12 +3,23 @@ package service
 import (
 	"context"
 	"fmt"
+	"sync"
+	"time"
 
 	"go.uber.org/zap"
 
 	rnchrClient "github.com/rancher/rancher/pkg/client/generated/management/v3"
-	aws "gitlab.com/netbook-devs/spawner-service/pkg/service/aws"
-	"gitlab.com/netbook-devs/spawner-service/pkg/service/azure"
+	"gitlab.com/netbook-devs/spawner-service/pkg/audit"
+	"gitlab.com/netbook-devs/spawner-service/pkg/backup"
+	"gitlab.com/netbook-devs/spawner-service/pkg/budget"
+	"gitlab.com/netbook-devs/spawner-service/pkg/drift"
+	"gitlab.com/netbook-devs/spawner-service/pkg/gc"
+	"gitlab.com/netbook-devs/spawner-service/pkg/inventory"
+	"gitlab.com/netbook-devs/spawner-service/pkg/lease"
+	"gitlab.com/netbook-devs/spawner-service/pkg/operation"
+	"gitlab.com/netbook-devs/spawner-service/pkg/pricing"
+	"gitlab.com/netbook-devs/spawner-service/pkg/quota"
+	"gitlab.com/netbook-devs/spawner-service/pkg/schedule"
 	"gitlab.com/netbook-devs/spawner-service/pkg/service/constants"
 	"gitlab.com/netbook-devs/spawner-service/pkg/service/rancher"
 	"gitlab.com/netbook-devs/spawner-service/pkg/service/system"
@@ -17,7 +28,7 @@ import (
 	proto "gitlab.com/netbook-devs/spawner-service/proto/netbookai/spawner"
 )
 
-const ProviderNotFound = "provider not found, must be one of ['aws', 'azure'], got %s"
+const ProviderNotFound = "provider not found, must be one of [%s], got %s"
 
 type SpawnerService interface {
 	CreateCluster(ctx context.Context, req *proto.ClusterRequest) (*proto.ClusterResponse, error)
@@ -36,6 +47,44 @@ type SpawnerService interface {
 	GetWorkspacesCost(context.Context, *proto.GetWorkspacesCostRequest) (*proto.GetWorkspacesCostResponse, error)
 	GetKubeConfig(ctx context.Context, in *proto.GetKubeConfigRequest) (*proto.GetKubeConfigResponse, error)
 	TagNodeInstance(ctx context.Context, req *proto.TagNodeInstanceRequest) (*proto.TagNodeInstanceResponse, error)
+	ListResources(ctx context.Context, req *proto.ListResourcesRequest) (*proto.ListResourcesResponse, error)
+	GetOperation(ctx context.Context, req *proto.GetOperationRequest) (*proto.Operation, error)
+	ListOperations(ctx context.Context, req *proto.ListOperationsRequest) (*proto.ListOperationsResponse, error)
+	CancelOperation(ctx context.Context, req *proto.CancelOperationRequest) (*proto.Operation, error)
+	WatchCluster(req *proto.WatchClusterRequest, stream proto.SpawnerService_WatchClusterServer) error
+	WatchNodePool(req *proto.WatchNodePoolRequest, stream proto.SpawnerService_WatchNodePoolServer) error
+	QueryAuditLog(ctx context.Context, req *proto.QueryAuditLogRequest) (*proto.QueryAuditLogResponse, error)
+	GetQuotaUsage(ctx context.Context, req *proto.GetQuotaUsageRequest) (*proto.GetQuotaUsageResponse, error)
+	SetBudget(ctx context.Context, req *proto.SetBudgetRequest) (*proto.Budget, error)
+	DeleteBudget(ctx context.Context, req *proto.DeleteBudgetRequest) (*proto.DeleteBudgetResponse, error)
+	GetBudgetStatus(ctx context.Context, req *proto.GetBudgetStatusRequest) (*proto.GetBudgetStatusResponse, error)
+	ApplyClusterSpec(ctx context.Context, req *proto.ApplyClusterSpecRequest) (*proto.ApplyClusterSpecResponse, error)
+	GetDrift(ctx context.Context, req *proto.GetDriftRequest) (*proto.GetDriftResponse, error)
+	ExtendLease(ctx context.Context, req *proto.ExtendLeaseRequest) (*proto.ExtendLeaseResponse, error)
+	ListOrphans(ctx context.Context, req *proto.ListOrphansRequest) (*proto.ListOrphansResponse, error)
+	SetNodePoolSchedule(ctx context.Context, req *proto.SetNodePoolScheduleRequest) (*proto.NodePoolSchedule, error)
+	GetNodePoolSchedule(ctx context.Context, req *proto.GetNodePoolScheduleRequest) (*proto.GetNodePoolScheduleResponse, error)
+	ScaleNodePool(ctx context.Context, req *proto.ScaleNodePoolRequest) (*proto.ScaleNodePoolResponse, error)
+	UpdateNodePool(ctx context.Context, req *proto.UpdateNodePoolRequest) (*proto.UpdateNodePoolResponse, error)
+	ListKubernetesVersions(ctx context.Context, req *proto.ListKubernetesVersionsRequest) (*proto.ListKubernetesVersionsResponse, error)
+	UpgradeCluster(ctx context.Context, req *proto.UpgradeClusterRequest) (*proto.UpgradeClusterResponse, error)
+	ListMachineTypes(ctx context.Context, req *proto.ListMachineTypesRequest) (*proto.ListMachineTypesResponse, error)
+	EstimateCost(ctx context.Context, req *proto.EstimateCostRequest) (*proto.EstimateCostResponse, error)
+	ListVolumes(ctx context.Context, req *proto.ListVolumesRequest) (*proto.ListVolumesResponse, error)
+	GetVolume(ctx context.Context, req *proto.GetVolumeRequest) (*proto.Volume, error)
+	ResizeVolume(ctx context.Context, req *proto.ResizeVolumeRequest) (*proto.ResizeVolumeResponse, error)
+	AttachVolume(ctx context.Context, req *proto.AttachVolumeRequest) (*proto.AttachVolumeResponse, error)
+	DetachVolume(ctx context.Context, req *proto.DetachVolumeRequest) (*proto.DetachVolumeResponse, error)
+	ListSnapshots(ctx context.Context, req *proto.ListSnapshotsRequest) (*proto.ListSnapshotsResponse, error)
+	DeleteSnapshot(ctx context.Context, req *proto.DeleteSnapshotRequest) (*proto.DeleteSnapshotResponse, error)
+	RestoreSnapshot(ctx context.Context, req *proto.RestoreSnapshotRequest) (*proto.RestoreSnapshotResponse, error)
+	CopySnapshot(ctx context.Context, req *proto.CopySnapshotRequest) (*proto.CopySnapshotResponse, error)
+	CreateSnapshotPolicy(ctx context.Context, req *proto.CreateSnapshotPolicyRequest) (*proto.SnapshotPolicy, error)
+	DeleteSnapshotPolicy(ctx context.Context, req *proto.DeleteSnapshotPolicyRequest) (*proto.DeleteSnapshotPolicyResponse, error)
+	ListSnapshotPolicies(ctx context.Context, req *proto.ListSnapshotPoliciesRequest) (*proto.ListSnapshotPoliciesResponse, error)
+	UpsertDNSRecord(ctx context.Context, req *proto.UpsertDNSRecordRequest) (*proto.UpsertDNSRecordResponse, error)
+	DeleteDNSRecord(ctx context.Context, req *proto.DeleteDNSRecordRequest) (*proto.DeleteDNSRecordResponse, error)
+	ListDNSRecords(ctx context.Context, req *proto.ListDNSRecordsRequest) (*proto.ListDNSRecordsResponse, error)
 
 	RegisterWithRancher(context.Context, *proto.RancherRegistrationRequest) (*proto.RancherRegistrationResponse, error)
 	WriteCredential(context.Context, *proto.WriteCredentialRequest) (*proto.WriteCredentialResponse, error)
@@ -45,47 +94,108 @@ type SpawnerService interface {
 
 //spawnerService manage provider and clusters
 type spawnerService struct {
-	awsController   Controller
-	azureController Controller
-	logger          *zap.SugaredLogger
+	providers  *Registry
+	inventory  inventory.Store
+	operations *operation.Manager
+	audit      *audit.Log
+	quotas     *quota.Manager
+	budgets    *budget.Evaluator
+	drift      *drift.Detector
+	orphans    *gc.Collector
+	schedules  *schedule.Scheduler
+	prices     *pricing.Book
+	logger     *zap.SugaredLogger
+
+	snapshotPolicies *backup.Runner
+
+	leaseMu sync.Mutex
+	//leaseDeletions holds the running deletions of the expired resources
+	leaseDeletions map[inventory.Key]leaseDeletion
 
 	proto.UnimplementedSpawnerServiceServer
 }
 
-//New return ClusterController
-func New(logger *zap.SugaredLogger) SpawnerService {
+//New return ClusterController with its background jobs started, resources created by the providers are recorded in the store
+func New(logger *zap.SugaredLogger, store inventory.Store, auditLog *audit.Log, quotas *quota.Manager, budgets budget.Store, schedules schedule.Store, prices *pricing.Book, policies backup.Store) SpawnerService {
+
+	conf := config.Get()
+	providers := NewRegistry()
+	registerBuiltins(logger, providers, store, conf.Providers)
+	registerPlugins(context.Background(), logger, providers, store, conf.ProviderPlugins)
 
 	svc := &spawnerService{
-		awsController:   aws.NewAWSController(logger),
-		azureController: azure.NewController(logger),
-		logger:          logger,
+		providers:  providers,
+		inventory:  store,
+		operations: operation.NewManager(logger, time.Duration(conf.OperationRetentionMinutes)*time.Minute),
+		audit:      auditLog,
+		quotas:     quotas,
+		prices:     prices,
+		logger:     logger,
 	}
+	svc.budgets = budget.NewEvaluator(logger, budgets, svc.workspaceCost, svc.scaleDownGPU)
+	go svc.budgets.Run(context.Background(), budgetEvaluationInterval(conf.BudgetEvaluationMinutes))
+	svc.drift = drift.NewDetector(logger, store, svc.driftClusters, svc.driftStatus)
+	go svc.drift.Run(context.Background(), driftDetectionInterval(conf.DriftDetectionMinutes))
+	janitor := lease.NewJanitor(logger, store, lease.Webhook(conf.LeaseWebhookURL), svc.expireLease, minutesOr(conf.LeaseWarningMinutes, defaultLeaseWarning))
+	go janitor.Run(context.Background(), minutesOr(conf.LeaseCheckMinutes, defaultLeaseCheckInterval))
+	svc.orphans = gc.NewCollector(logger, store, svc.orphanVolumes, svc.orphanSnapshots, svc.orphanCluster, svc.deleteOrphan, orphanGrace(conf.OrphanGCGraceHours), orphanKeepLabel(conf.OrphanGCKeepLabel))
+	go svc.orphans.Run(context.Background(), minutesOr(conf.OrphanGCMinutes, defaultOrphanGCInterval), !conf.OrphanGCDelete)
+	svc.schedules = schedule.NewScheduler(logger, schedules, svc.scaleOnSchedule)
+	go svc.schedules.Run(context.Background(), scheduleCheckInterval(conf.ScheduleCheckSeconds))
+	go prices.Run(context.Background(), minutesOr(conf.PriceRefreshMinutes, defaultPriceRefreshInterval))
+	svc.snapshotPolicies = backup.NewRunner(logger, policies, policyBackend{s: svc})
+	go svc.snapshotPolicies.Run(context.Background(), minutesOr(conf.SnapshotPolicyCheckMinutes, defaultSnapshotPolicyCheckInterval))
 	return svc
 }
 
-func (s *spawnerService) controller(provider string) (Controller, error) {
-	switch provider {
-	case "aws":
-		return s.awsController, nil
-	case "azure":
-		return s.azureController, nil
-	}
-	return nil, fmt.Errorf(ProviderNotFound, provider)
+//controller looks up the registered provider which supports all the required capabilities
+func (s *spawnerService) controller(provider string, required ...Capability) (Controller, error) {
+	return s.providers.Lookup(provider, required...)
 }
 
 //CreateCluster create cluster on the provider specified in request
 func (s *spawnerService) CreateCluster(ctx context.Context, req *proto.ClusterRequest) (*proto.ClusterResponse, error) {
-	provider, err := s.controller(req.Provider)
+	provider, err := s.controller(req.Provider, append(nodeCapabilities(req.Node), CapClusters)...)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := validateVersion(req.KubernetesVersion); err != nil {
+		return nil, err
+	}
+	if err := validateFallback(req.Node); err != nil {
+		return nil, err
+	}
+
+	labels, err := withLease(req.Labels, req.TtlMinutes, req.ExpiresAt)
+	if err != nil {
+		return nil, err
+	}
+	node, err := nodeWithLease(req.Node)
+	if err != nil {
+		return nil, err
+	}
+	req.Labels, req.Node = labels, node
+
+	requested := quota.Resources{Clusters: 1}
+	if req.Node != nil {
+		requested = requested.Add(quota.NodeResources(req.Node.MachineType, req.Node.GpuEnabled, req.Node.Count))
+	}
+	release, err := s.reserveQuota(ctx, req.AccountName, workspace(req.Labels, req.Node.GetLabels()), requested)
 	if err != nil {
 		return nil, err
 	}
 
-	return provider.CreateCluster(ctx, req)
+	op := s.operations.Start(ctx, operationMeta("CreateCluster", req.Provider, req.AccountName, req.Region, req.ClusterName), func(ctx context.Context) (interface{}, error) {
+		defer release()
+		return provider.CreateCluster(ctx, req)
+	})
+	return &proto.ClusterResponse{ClusterName: req.ClusterName, OperationId: op.ID}, nil
 }
 
 //GetCluster get cluster on the providerr specified in request
 func (s *spawnerService) GetCluster(ctx context.Context, req *proto.GetClusterRequest) (*proto.ClusterSpec, error) {
-	provider, err := s.controller(req.Provider)
+	provider, err := s.controller(req.Provider, CapClusters)
 	if err != nil {
 		return nil, err
 	}
@@ -95,7 +205,7 @@ func (s *spawnerService) GetCluster(ctx context.Context, req *proto.GetClusterRe
 //GetClusters get the available clusters in the given provider
 func (s *spawnerService) GetClusters(ctx context.Context, req *proto.GetClustersRequest) (*proto.GetClustersResponse, error) {
 
-	provider, err := s.controller(req.Provider)
+	provider, err := s.controller(req.Provider, CapClusters)
 	if err != nil {
 		return nil, err
 	}
@@ -105,7 +215,7 @@ func (s *spawnerService) GetClusters(ctx context.Context, req *proto.GetClusters
 //AddToken deprecated as of now
 func (s *spawnerService) AddToken(ctx context.Context, req *proto.AddTokenRequest) (*proto.AddTokenResponse, error) {
 
-	provider, err := s.controller(req.Provider)
+	provider, err := s.controller(req.Provider, CapClusters)
 	if err != nil {
 		return nil, err
 	}
@@ -114,7 +224,7 @@ func (s *spawnerService) AddToken(ctx context.Context, req *proto.AddTokenReques
 
 //GetToken return the kube token for the cluster in given provider
 func (s *spawnerService) GetToken(ctx context.Context, req *proto.GetTokenRequest) (*proto.GetTokenResponse, error) {
-	provider, err := s.controller(req.Provider)
+	provider, err := s.controller(req.Provider, CapKubeConfig)
 	if err != nil {
 		return nil, err
 	}
@@ -123,7 +233,7 @@ func (s *spawnerService) GetToken(ctx context.Context, req *proto.GetTokenReques
 
 //ClusterStatus get cluster status in given provider
 func (s *spawnerService) ClusterStatus(ctx context.Context, req *proto.ClusterStatusRequest) (*proto.ClusterStatusResponse, error) {
-	provider, err := s.controller(req.Provider)
+	provider, err := s.controller(req.Provider, CapClusters)
 	if err != nil {
 		return nil, err
 	}
@@ -132,70 +242,129 @@ func (s *spawnerService) ClusterStatus(ctx context.Context, req *proto.ClusterSt
 
 //AddNode adds new node to the cluster on the provider
 func (s *spawnerService) AddNode(ctx context.Context, req *proto.NodeSpawnRequest) (*proto.NodeSpawnResponse, error) {
-	provider, err := s.controller(req.Provider)
+	provider, err := s.controller(req.Provider, append(nodeCapabilities(req.NodeSpec), CapNodePools)...)
 	if err != nil {
 		return nil, err
 	}
-	return provider.AddNode(ctx, req)
+	if err := validateFallback(req.NodeSpec); err != nil {
+		return nil, err
+	}
+	node, err := nodeWithLease(req.NodeSpec)
+	if err != nil {
+		return nil, err
+	}
+	req.NodeSpec = node
+	if err := s.checkBudget(workspace(node.GetLabels())); err != nil {
+		return nil, err
+	}
+	release, err := s.reserveQuota(ctx, req.AccountName, workspace(node.GetLabels()), quota.NodeResources(node.GetMachineType(), node.GetGpuEnabled(), node.GetCount()))
+	if err != nil {
+		return nil, err
+	}
+
+	op := s.operations.Start(ctx, operationMeta("AddNode", req.Provider, req.AccountName, req.Region, inventory.NodePoolID(req.ClusterName, req.NodeSpec.GetName())), func(ctx context.Context) (interface{}, error) {
+		defer release()
+		return provider.AddNode(ctx, req)
+	})
+	return &proto.NodeSpawnResponse{OperationId: op.ID}, nil
 }
 
-//DeleteCluster deletes empty cluster on the provider, fails when cluster has nodegroup
+//DeleteCluster deletes empty cluster on the provider, fails when cluster has nodegroup.
+//dns records tied to the cluster are deleted once the cluster is gone
 func (s *spawnerService) DeleteCluster(ctx context.Context, req *proto.ClusterDeleteRequest) (*proto.ClusterDeleteResponse, error) {
-	provider, err := s.controller(req.Provider)
+	provider, err := s.controller(req.Provider, CapClusters)
 	if err != nil {
 		return nil, err
 	}
-	return provider.DeleteCluster(ctx, req)
+	op := s.operations.Start(ctx, operationMeta("DeleteCluster", req.Provider, req.AccountName, req.Region, req.ClusterName), func(ctx context.Context) (interface{}, error) {
+		res, err := provider.DeleteCluster(ctx, req)
+		if err != nil {
+			return res, err
+		}
+		s.deleteClusterRecords(ctx, req)
+		return res, nil
+	})
+	return &proto.ClusterDeleteResponse{OperationId: op.ID}, nil
 }
 
 //DeleteNode deletes node on the given provider cluster
 func (s *spawnerService) DeleteNode(ctx context.Context, req *proto.NodeDeleteRequest) (*proto.NodeDeleteResponse, error) {
-	provider, err := s.controller(req.Provider)
+	provider, err := s.controller(req.Provider, CapNodePools)
 	if err != nil {
 		return nil, err
 	}
-	return provider.DeleteNode(ctx, req)
+	op := s.operations.Start(ctx, operationMeta("DeleteNode", req.Provider, req.AccountName, req.Region, inventory.NodePoolID(req.ClusterName, req.NodeGroupName)), func(ctx context.Context) (interface{}, error) {
+		return provider.DeleteNode(ctx, req)
+	})
+	return &proto.NodeDeleteResponse{OperationId: op.ID}, nil
 }
 
 //CreateVolume create new volume on the provider
 func (s *spawnerService) CreateVolume(ctx context.Context, req *proto.CreateVolumeRequest) (*proto.CreateVolumeResponse, error) {
-	provider, err := s.controller(req.Provider)
+	provider, err := s.controller(req.Provider, CapVolumes)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkBudget(workspace(req.Labels)); err != nil {
+		return nil, err
+	}
+	release, err := s.reserveQuota(ctx, req.AccountName, workspace(req.Labels), quota.Resources{VolumeGiB: req.Size})
 	if err != nil {
 		return nil, err
 	}
-	return provider.CreateVolume(ctx, req)
+
+	//volume id is known once it is created, volume name label identifies it until then
+	op := s.operations.Start(ctx, operationMeta("CreateVolume", req.Provider, req.AccountName, req.Region, req.Labels[constants.VolumeNameLabel]), func(ctx context.Context) (interface{}, error) {
+		defer release()
+		res, err := provider.CreateVolume(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		operation.SetTarget(ctx, res.Volumeid)
+		return res, nil
+	})
+	return &proto.CreateVolumeResponse{OperationId: op.ID}, nil
 }
 
 //DeleteVolume delete the volumne on the provider
 func (s *spawnerService) DeleteVolume(ctx context.Context, req *proto.DeleteVolumeRequest) (*proto.DeleteVolumeResponse, error) {
-	provider, err := s.controller(req.Provider)
+	provider, err := s.controller(req.Provider, CapVolumes)
 	if err != nil {
 		return nil, err
 	}
-	return provider.DeleteVolume(ctx, req)
+	op := s.operations.Start(ctx, operationMeta("DeleteVolume", req.Provider, req.AccountName, req.Region, req.Volumeid), func(ctx context.Context) (interface{}, error) {
+		return provider.DeleteVolume(ctx, req)
+	})
+	return &proto.DeleteVolumeResponse{OperationId: op.ID}, nil
 }
 
 //CreateSnapshot
 func (s *spawnerService) CreateSnapshot(ctx context.Context, req *proto.CreateSnapshotRequest) (*proto.CreateSnapshotResponse, error) {
-	provider, err := s.controller(req.Provider)
+	provider, err := s.controller(req.Provider, CapSnapshots)
 	if err != nil {
 		return nil, err
 	}
-	return provider.CreateSnapshot(ctx, req)
+	op := s.operations.Start(ctx, operationMeta("CreateSnapshot", req.Provider, req.AccountName, req.Region, req.Volumeid), func(ctx context.Context) (interface{}, error) {
+		return provider.CreateSnapshot(ctx, req)
+	})
+	return &proto.CreateSnapshotResponse{OperationId: op.ID}, nil
 }
 
 //CreateSnapshotAndDelete
 func (s *spawnerService) CreateSnapshotAndDelete(ctx context.Context, req *proto.CreateSnapshotAndDeleteRequest) (*proto.CreateSnapshotAndDeleteResponse, error) {
-	provider, err := s.controller(req.Provider)
+	provider, err := s.controller(req.Provider, CapSnapshots)
 	if err != nil {
 		return nil, err
 	}
-	return provider.CreateSnapshotAndDelete(ctx, req)
+	op := s.operations.Start(ctx, operationMeta("CreateSnapshotAndDelete", req.Provider, req.AccountName, req.Region, req.Volumeid), func(ctx context.Context) (interface{}, error) {
+		return provider.CreateSnapshotAndDelete(ctx, req)
+	})
+	return &proto.CreateSnapshotAndDeleteResponse{OperationId: op.ID}, nil
 }
 
 //GetWorkspaceCost returns workspace cost grouped by given group
 func (s *spawnerService) GetWorkspacesCost(ctx context.Context, req *proto.GetWorkspacesCostRequest) (*proto.GetWorkspacesCostResponse, error) {
-	provider, err := s.controller(req.Provider)
+	provider, err := s.controller(req.Provider, CapCost)
 	if err != nil {
 		return nil, err
 	}
@@ -290,6 +459,16 @@ func (s *spawnerService) WriteCredential(ctx context.Context, req *proto.WriteCr
 				Name:           account,
 			}
 		}
+	case constants.GcpLabel:
+		cred_type = "GcpCredential"
+		if c := req.GetGcpCred(); c != nil {
+			cred = &system.GcpCredential{
+				ProjectID:          c.GetProjectID(),
+				ServiceAccountKey:  c.GetServiceAccountKey(),
+				BillingExportTable: c.GetBillingExportTable(),
+				Name:               account,
+			}
+		}
 	default:
 		return nil, fmt.Errorf("invalid provider '%s'", provider)
 	}
@@ -346,21 +525,25 @@ func (s *spawnerService) ReadCredential(ctx context.Context, req *proto.ReadCred
 				ResourceGroup:  c.ResourceGroup,
 			},
 		}
+	case constants.GcpLabel:
+		c := creds.GetGcp()
+		p.Cred = &proto.ReadCredentialResponse_GcpCred{
+			GcpCred: &proto.GcpCredentials{
+				ProjectID:          c.ProjectID,
+				ServiceAccountKey:  c.ServiceAccountKey,
+				BillingExportTable: c.BillingExportTable,
+			},
+		}
 	}
 
 	s.logger.Debugw("credentials found", "account", account, "provider", provider)
 	return p, nil
 }
 
-//AddRoute53Record
+//AddRoute53Record upserts the record pointing to the load balancer or ip address in the spawner hosted zone
 func (s *spawnerService) AddRoute53Record(ctx context.Context, req *proto.AddRoute53RecordRequest) (*proto.AddRoute53RecordResponse, error) {
-	dnsName := req.GetDnsName()
-	recordName := req.GetRecordName()
-	regionName := req.GetRegion()
-
-	isAwsResource := req.Provider == string(constants.AwsCloud)
-
-	changeId, err := s.addRoute53Record(ctx, dnsName, recordName, regionName, isAwsResource)
+	//records are created in the spawner hosted zone, dns provider is always aws
+	changeId, err := s.addRoute53Record(ctx, constants.AwsLabel, config.Get().AwsRoute53HostedZoneID, req)
 	if err != nil {
 		s.logger.Errorw("failed to add route53 record", "error", err)
 		return nil, err
@@ -370,7 +553,7 @@ func (s *spawnerService) AddRoute53Record(ctx context.Context, req *proto.AddRou
 }
 
 func (s *spawnerService) GetKubeConfig(ctx context.Context, req *proto.GetKubeConfigRequest) (*proto.GetKubeConfigResponse, error) {
-	provider, err := s.controller(req.Provider)
+	provider, err := s.controller(req.Provider, CapKubeConfig)
 	if err != nil {
 		return nil, err
 	}
@@ -378,9 +561,12 @@ func (s *spawnerService) GetKubeConfig(ctx context.Context, req *proto.GetKubeCo
 }
 
 func (s *spawnerService) TagNodeInstance(ctx context.Context, req *proto.TagNodeInstanceRequest) (*proto.TagNodeInstanceResponse, error) {
-	provider, err := s.controller(req.Provider)
+	provider, err := s.controller(req.Provider, CapNodePools)
 	if err != nil {
 		return nil, err
 	}
-	return provider.TagNodeInstance(ctx, req)
+	op := s.operations.Start(ctx, operationMeta("TagNodeInstance", req.Provider, req.AccountName, req.Region, inventory.NodePoolID(req.ClusterName, req.NodeGroup)), func(ctx context.Context) (interface{}, error) {
+		return provider.TagNodeInstance(ctx, req)
+	})
+	return &proto.TagNodeInstanceResponse{OperationId: op.ID}, nil
 }
@@ -0,0 +1,46 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"gitlab.com/netbook-devs/spawner-service/pkg/audit"
+	"gitlab.com/netbook-devs/spawner-service/pkg/budget"
+	"gitlab.com/netbook-devs/spawner-service/pkg/inventory"
+	"gitlab.com/netbook-devs/spawner-service/pkg/operation"
+	"gitlab.com/netbook-devs/spawner-service/pkg/quota"
+	"gitlab.com/netbook-devs/spawner-service/pkg/service/fake"
+	"go.uber.org/zap"
+)
+
+//testOptions of the service built by newTestService
+type testOptions struct {
+	//store inventory of the service and the fake provider, empty memory store when nil
+	store inventory.Store
+	//quotas limits of the quota manager, nothing is limited when empty
+	quotas quota.Config
+	audit  *audit.Log
+}
+
+//newTestService returns the service with the fake provider registered, its budgets have no limits
+func newTestService(t *testing.T, opts testOptions) (*spawnerService, *fake.FakeController) {
+	logger := zap.NewNop().Sugar()
+	store := opts.store
+	if store == nil {
+		store = inventory.NewMemoryStore()
+	}
+	controller := fake.NewController(logger, store)
+	r := NewRegistry()
+	require.NoError(t, r.Register("fake", controller, builtins["fake"].capabilities))
+
+	return &spawnerService{
+		providers:  r,
+		operations: operation.NewManager(logger, time.Minute),
+		inventory:  store,
+		audit:      opts.audit,
+		quotas:     quota.NewManager(logger, store, opts.quotas),
+		budgets:    budget.NewEvaluator(logger, budget.NewMemoryStore(), nil, nil),
+		logger:     logger,
+	}, controller
+}
@@ -0,0 +1,144 @@
+package service
+
+import (
+	"context"
+
+	"gitlab.com/netbook-devs/spawner-service/pkg/quota"
+	"gitlab.com/netbook-devs/spawner-service/pkg/service/constants"
+	proto "gitlab.com/netbook-devs/spawner-service/proto/netbookai/spawner"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func validateSnapshotId(id string) error {
+	if id == "" {
+		return status.Error(codes.InvalidArgument, "snapshot id is required")
+	}
+	return nil
+}
+
+func validateRestore(req *proto.RestoreSnapshotRequest) error {
+	if err := validateSnapshotId(req.SnapshotId); err != nil {
+		return err
+	}
+	if req.Size < 0 {
+		return status.Errorf(codes.InvalidArgument, "invalid volume size %d", req.Size)
+	}
+	return nil
+}
+
+func validateCopy(req *proto.CopySnapshotRequest) error {
+	if err := validateSnapshotId(req.SnapshotId); err != nil {
+		return err
+	}
+	if req.DestinationRegion == "" {
+		return status.Error(codes.InvalidArgument, "destination region is required")
+	}
+	if req.DestinationRegion == req.Region {
+		return status.Errorf(codes.InvalidArgument, "snapshot is already in region '%s'", req.Region)
+	}
+	return nil
+}
+
+//ListSnapshots spawner created snapshots of the region, workspace filter is applied as the workspace label
+func (s *spawnerService) ListSnapshots(ctx context.Context, req *proto.ListSnapshotsRequest) (*proto.ListSnapshotsResponse, error) {
+	provider, err := s.controller(req.Provider, CapSnapshots)
+	if err != nil {
+		return nil, err
+	}
+	if req.Workspace != "" {
+		labels := map[string]string{constants.WorkspaceLabel: req.Workspace}
+		for k, v := range req.Labels {
+			labels[k] = v
+		}
+		req.Labels = labels
+	}
+	return provider.ListSnapshots(ctx, req)
+}
+
+//DeleteSnapshot delete the snapshot on the provider
+func (s *spawnerService) DeleteSnapshot(ctx context.Context, req *proto.DeleteSnapshotRequest) (*proto.DeleteSnapshotResponse, error) {
+	if err := validateSnapshotId(req.SnapshotId); err != nil {
+		return nil, err
+	}
+	provider, err := s.controller(req.Provider, CapSnapshots)
+	if err != nil {
+		return nil, err
+	}
+	op := s.operations.Start(ctx, operationMeta("DeleteSnapshot", req.Provider, req.AccountName, req.Region, req.SnapshotId), func(ctx context.Context) (interface{}, error) {
+		return provider.DeleteSnapshot(ctx, req)
+	})
+	return &proto.DeleteSnapshotResponse{OperationId: op.ID}, nil
+}
+
+//restoreSource size and workspace of the volume restored from the snapshot, size of the snapshot when none is requested
+//and workspace of the snapshot the volume is tagged with unless the request sets one
+func (s *spawnerService) restoreSource(ctx context.Context, provider Controller, req *proto.RestoreSnapshotRequest) (int64, string, error) {
+	res, err := provider.ListSnapshots(ctx, &proto.ListSnapshotsRequest{
+		Provider:    req.Provider,
+		Region:      req.Region,
+		AccountName: req.AccountName,
+	})
+	if err != nil {
+		s.logger.Errorw("failed to list snapshots", "snapshot", req.SnapshotId, "error", err)
+		return 0, "", err
+	}
+	size, labels := req.Size, map[string]string{}
+	for _, snap := range res.Snapshots {
+		if snap.SnapshotId == req.SnapshotId {
+			labels = snap.Labels
+			if size == 0 {
+				size = snap.Size
+			}
+			break
+		}
+	}
+	if size <= 0 {
+		return 0, "", status.Errorf(codes.FailedPrecondition, "size of snapshot '%s' is not known, set the size to restore it", req.SnapshotId)
+	}
+	return size, workspace(req.Labels, labels), nil
+}
+
+//RestoreSnapshot creates a volume from the snapshot, volume size is held against the quotas like CreateVolume
+//in the workspace of the snapshot or the requested one
+func (s *spawnerService) RestoreSnapshot(ctx context.Context, req *proto.RestoreSnapshotRequest) (*proto.RestoreSnapshotResponse, error) {
+	if err := validateRestore(req); err != nil {
+		return nil, err
+	}
+	provider, err := s.controller(req.Provider, CapSnapshots, CapVolumes)
+	if err != nil {
+		return nil, err
+	}
+	size, ws, err := s.restoreSource(ctx, provider, req)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkBudget(ws); err != nil {
+		return nil, err
+	}
+	release, err := s.reserveQuota(ctx, req.AccountName, ws, quota.Resources{VolumeGiB: size})
+	if err != nil {
+		return nil, err
+	}
+
+	op := s.operations.Start(ctx, operationMeta("RestoreSnapshot", req.Provider, req.AccountName, req.Region, req.SnapshotId), func(ctx context.Context) (interface{}, error) {
+		defer release()
+		return provider.RestoreSnapshot(ctx, req)
+	})
+	return &proto.RestoreSnapshotResponse{OperationId: op.ID}, nil
+}
+
+//CopySnapshot copies the snapshot to another region
+func (s *spawnerService) CopySnapshot(ctx context.Context, req *proto.CopySnapshotRequest) (*proto.CopySnapshotResponse, error) {
+	if err := validateCopy(req); err != nil {
+		return nil, err
+	}
+	provider, err := s.controller(req.Provider, CapSnapshots)
+	if err != nil {
+		return nil, err
+	}
+	op := s.operations.Start(ctx, operationMeta("CopySnapshot", req.Provider, req.AccountName, req.Region, req.SnapshotId), func(ctx context.Context) (interface{}, error) {
+		return provider.CopySnapshot(ctx, req)
+	})
+	return &proto.CopySnapshotResponse{OperationId: op.ID}, nil
+}
@@ -0,0 +1,58 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gitlab.com/netbook-devs/spawner-service/pkg/quota"
+	proto "gitlab.com/netbook-devs/spawner-service/proto/netbookai/spawner"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func Test_ValidateSnapshotChanges(t *testing.T) {
+	assert.NoError(t, validateRestore(&proto.RestoreSnapshotRequest{SnapshotId: "snap-1"}))
+	assert.NoError(t, validateRestore(&proto.RestoreSnapshotRequest{SnapshotId: "snap-1", Size: 20}))
+	for _, req := range []*proto.RestoreSnapshotRequest{{Size: 20}, {SnapshotId: "snap-1", Size: -1}} {
+		assert.Equal(t, codes.InvalidArgument, status.Code(validateRestore(req)), "expected invalid %v", req)
+	}
+
+	assert.NoError(t, validateCopy(&proto.CopySnapshotRequest{SnapshotId: "snap-1", Region: "us-west-2", DestinationRegion: "us-east-1"}))
+	for _, req := range []*proto.CopySnapshotRequest{
+		{Region: "us-west-2", DestinationRegion: "us-east-1"},
+		{SnapshotId: "snap-1", Region: "us-west-2"},
+		{SnapshotId: "snap-1", Region: "us-west-2", DestinationRegion: "us-west-2"},
+	} {
+		assert.Equal(t, codes.InvalidArgument, status.Code(validateCopy(req)), "expected invalid %v", req)
+	}
+}
+
+func Test_RestoreSnapshotQuota(t *testing.T) {
+	ctx := context.Background()
+	s, f := newTestService(t, testOptions{quotas: quota.Config{Workspaces: map[string]quota.Resources{"ws-1": {VolumeGiB: 15}}}})
+
+	vol, err := f.CreateVolume(ctx, &proto.CreateVolumeRequest{Region: "local-1", AccountName: "dev", Size: 20})
+	require.NoError(t, err)
+	snap, err := f.CreateSnapshot(ctx, &proto.CreateSnapshotRequest{Region: "local-1", AccountName: "dev", Volumeid: vol.Volumeid})
+	require.NoError(t, err)
+
+	req := &proto.RestoreSnapshotRequest{Provider: "fake", Region: "local-1", AccountName: "dev", SnapshotId: snap.Snapshotid, Labels: map[string]string{"workspaceid": "ws-1"}}
+	_, err = s.RestoreSnapshot(ctx, req)
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err), "snapshot size is held when no size is requested")
+
+	req.SnapshotId = "snap-unknown"
+	_, err = s.RestoreSnapshot(ctx, req)
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	req.Size = 10
+	_, err = s.RestoreSnapshot(ctx, req)
+	assert.NoError(t, err, "requested size is held as is")
+
+	//restored volume carries the workspace of the snapshot
+	snap, err = f.CreateSnapshot(ctx, &proto.CreateSnapshotRequest{Region: "local-1", AccountName: "dev", Volumeid: vol.Volumeid, Labels: map[string]string{"workspaceid": "ws-1"}})
+	require.NoError(t, err)
+	_, err = s.RestoreSnapshot(ctx, &proto.RestoreSnapshotRequest{Provider: "fake", Region: "local-1", AccountName: "dev", SnapshotId: snap.Snapshotid})
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err), "snapshot workspace quota is held")
+}
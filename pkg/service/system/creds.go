@@ -1,6 +1,7 @@
 package system
 
 import (
+	"encoding/base64"
 	"errors"
 	"fmt"
 	"strings"
@@ -22,14 +23,25 @@ type AwsCredential struct {
 	Token  string
 }
 
+//GcpCredential service account key of the project, BillingExportTable is the bigquery table
+//the cloud billing data is exported to, ex: project.dataset.gcp_billing_export_v1_XXXXXX
+type GcpCredential struct {
+	ProjectID          string
+	ServiceAccountKey  string
+	BillingExportTable string
+	Name               string
+}
+
 type Credentials interface {
 	GetAzure() *AzureCredential
 	GetAws() *AwsCredential
+	GetGcp() *GcpCredential
 	AsSecretValue() string
 }
 
 var _ Credentials = (*AzureCredential)(nil)
 var _ Credentials = (*AwsCredential)(nil)
+var _ Credentials = (*GcpCredential)(nil)
 
 //Azure credentials
 
@@ -41,6 +53,10 @@ func (a *AzureCredential) GetAws() *AwsCredential {
 	return nil
 }
 
+func (a *AzureCredential) GetGcp() *GcpCredential {
+	return nil
+}
+
 func (a *AzureCredential) AsSecretValue() string {
 	return fmt.Sprintf("%s,%s,%s,%s,%s", a.SubscriptionID, a.TenantID, a.ClientID, a.ClientSecret, a.ResourceGroup)
 }
@@ -55,10 +71,33 @@ func (a *AwsCredential) GetAws() *AwsCredential {
 	return a
 }
 
+func (a *AwsCredential) GetGcp() *GcpCredential {
+	return nil
+}
+
 func (a *AwsCredential) AsSecretValue() string {
 	return fmt.Sprintf("%s,%s,%s", a.Id, a.Secret, a.Token)
 }
 
+//Gcp credential
+
+func (g *GcpCredential) GetAzure() *AzureCredential {
+	return nil
+}
+
+func (g *GcpCredential) GetAws() *AwsCredential {
+	return nil
+}
+
+func (g *GcpCredential) GetGcp() *GcpCredential {
+	return g
+}
+
+//AsSecretValue service account key is json, it is base64 encoded to keep the value comma separated
+func (g *GcpCredential) AsSecretValue() string {
+	return fmt.Sprintf("%s,%s,%s", g.ProjectID, g.BillingExportTable, base64.StdEncoding.EncodeToString([]byte(g.ServiceAccountKey)))
+}
+
 //NewAwsCredential recieves comma separated list of credential parts and creates a AwsCredential
 //there can be 2 or 3 parts, when the token is present we will use the latest version of Credentials
 func NewAwsCredential(blob string) (*AwsCredential, error) {
@@ -96,3 +135,21 @@ func NewAzureCredential(blob string) (*AzureCredential, error) {
 	}, nil
 
 }
+
+//NewGcpCredential recieves comma separated list of credential parts and creates a GcpCredential
+func NewGcpCredential(blob string) (*GcpCredential, error) {
+	//project_id,billing_export_table,base64(service_account_key)
+	splits := strings.Split(blob, ",")
+	if len(splits) != 3 {
+		return nil, errors.New("NewGcpCredential: invalid credentials found in secrets")
+	}
+	key, err := base64.StdEncoding.DecodeString(splits[2])
+	if err != nil {
+		return nil, errors.New("NewGcpCredential: invalid service account key found in secrets")
+	}
+	return &GcpCredential{
+		ProjectID:          splits[0],
+		BillingExportTable: splits[1],
+		ServiceAccountKey:  string(key),
+	}, nil
+}
@@ -145,6 +145,8 @@ func GetCredentials(ctx context.Context, region, accountName, provider string) (
 		cred, err = NewAwsCredential(*result.SecretString)
 	case constants.AzureLabel:
 		cred, err = NewAzureCredential(*result.SecretString)
+	case constants.GcpLabel:
+		cred, err = NewGcpCredential(*result.SecretString)
 	}
 
 	if err != nil {
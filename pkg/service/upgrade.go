@@ -0,0 +1,183 @@
+package service
+
+import (
+	"context"
+	"strings"
+
+	"github.com/pkg/errors"
+	"gitlab.com/netbook-devs/spawner-service/pkg/operation"
+	"gitlab.com/netbook-devs/spawner-service/pkg/service/common"
+	proto "gitlab.com/netbook-devs/spawner-service/proto/netbookai/spawner"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+//maxNodeSkew minor versions the nodes may be behind the control plane, https://kubernetes.io/releases/version-skew-policy/
+const maxNodeSkew = 2
+
+//upgradePlan what UpgradeCluster changes, decided by the pre-check
+type upgradePlan struct {
+	controlPlane bool
+	//nodePools names of the node pools not at the target version yet
+	nodePools []string
+}
+
+func validateVersion(v string) error {
+	if v == "" {
+		return nil
+	}
+	if _, err := common.ParseKubeVersion(v); err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	return nil
+}
+
+//supportedVersion target is listed by the provider, major.minor matches any of its patch versions
+func supportedVersion(target string, versions []string) bool {
+	if len(versions) == 0 {
+		return true
+	}
+	minorOnly := strings.Count(target, ".") == 1
+	for _, v := range versions {
+		if v == target || (minorOnly && strings.HasPrefix(v, target+".")) {
+			return true
+		}
+	}
+	return false
+}
+
+//atVersion current version matches the target, target without patch matches every patch of the minor
+func atVersion(current, target string) bool {
+	cur, err := common.ParseKubeVersion(current)
+	if err != nil {
+		return false
+	}
+	want, err := common.ParseKubeVersion(target)
+	if err != nil || cur != want {
+		return false
+	}
+	return strings.Count(target, ".") == 1 || strings.HasPrefix(strings.TrimPrefix(current, "v"), target)
+}
+
+//planUpgrade checks the version skew of the upgrade, control plane is upgraded one minor version at a time and
+//nodes may neither be newer than the control plane nor more than maxNodeSkew minor versions behind it
+func planUpgrade(cluster *proto.ClusterSpec, target string, versions []string) (upgradePlan, error) {
+	want, err := common.ParseKubeVersion(target)
+	if err != nil {
+		return upgradePlan{}, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if !supportedVersion(target, versions) {
+		return upgradePlan{}, status.Errorf(codes.InvalidArgument, "kubernetes version '%s' is not supported, available versions: %s", target, strings.Join(versions, ", "))
+	}
+
+	plan := upgradePlan{controlPlane: !atVersion(cluster.KubernetesVersion, target)}
+	if cluster.KubernetesVersion != "" {
+		current, err := common.ParseKubeVersion(cluster.KubernetesVersion)
+		if err != nil {
+			return upgradePlan{}, status.Errorf(codes.FailedPrecondition, "cluster '%s' reports %s", cluster.Name, err.Error())
+		}
+		switch skew := want.MinorsSince(current); {
+		case skew < 0:
+			return upgradePlan{}, status.Errorf(codes.FailedPrecondition, "cluster '%s' is at %s, downgrade to %s is not supported", cluster.Name, cluster.KubernetesVersion, target)
+		case skew > 1:
+			return upgradePlan{}, status.Errorf(codes.FailedPrecondition, "cluster '%s' is at %s, control plane can only be upgraded one minor version at a time, upgrade to %d.%d first",
+				cluster.Name, cluster.KubernetesVersion, current.Major, current.Minor+1)
+		}
+	}
+
+	//each node is listed separately by some providers
+	seen := map[string]bool{}
+	for _, node := range cluster.NodeSpec {
+		if seen[node.Name] {
+			continue
+		}
+		seen[node.Name] = true
+		if node.KubernetesVersion != "" {
+			v, err := common.ParseKubeVersion(node.KubernetesVersion)
+			if err != nil {
+				return upgradePlan{}, status.Errorf(codes.FailedPrecondition, "node pool '%s' reports %s", node.Name, err.Error())
+			}
+			if skew := want.MinorsSince(v); skew < 0 {
+				return upgradePlan{}, status.Errorf(codes.FailedPrecondition, "node pool '%s' is at %s, newer than %s", node.Name, node.KubernetesVersion, target)
+			} else if skew > maxNodeSkew {
+				return upgradePlan{}, status.Errorf(codes.FailedPrecondition, "node pool '%s' is at %s, more than %d minor versions behind %s, upgrade it first",
+					node.Name, node.KubernetesVersion, maxNodeSkew, target)
+			}
+		}
+		if !atVersion(node.KubernetesVersion, target) {
+			plan.nodePools = append(plan.nodePools, node.Name)
+		}
+	}
+	return plan, nil
+}
+
+//ListKubernetesVersions kubernetes versions clusters can be created with or upgraded to in the region
+func (s *spawnerService) ListKubernetesVersions(ctx context.Context, req *proto.ListKubernetesVersionsRequest) (*proto.ListKubernetesVersionsResponse, error) {
+	provider, err := s.controller(req.Provider, CapClusters)
+	if err != nil {
+		return nil, err
+	}
+	return provider.ListKubernetesVersions(ctx, req)
+}
+
+//UpgradeCluster upgrades the control plane to the requested version, then rolls the node pools one after another
+func (s *spawnerService) UpgradeCluster(ctx context.Context, req *proto.UpgradeClusterRequest) (*proto.UpgradeClusterResponse, error) {
+	if req.KubernetesVersion == "" {
+		return nil, status.Error(codes.InvalidArgument, "kubernetes version is required")
+	}
+	provider, err := s.controller(req.Provider, CapClusters, CapNodePools)
+	if err != nil {
+		return nil, err
+	}
+
+	cluster, err := provider.GetCluster(ctx, &proto.GetClusterRequest{
+		Provider:    req.Provider,
+		Region:      req.Region,
+		AccountName: req.AccountName,
+		ClusterName: req.ClusterName,
+	})
+	if err != nil {
+		return nil, err
+	}
+	versions, err := provider.ListKubernetesVersions(ctx, &proto.ListKubernetesVersionsRequest{
+		Provider:    req.Provider,
+		Region:      req.Region,
+		AccountName: req.AccountName,
+	})
+	if err != nil {
+		s.logger.Errorw("failed to list kubernetes versions", "provider", req.Provider, "region", req.Region, "error", err)
+		return nil, err
+	}
+	plan, err := planUpgrade(cluster, req.KubernetesVersion, versions.Versions)
+	if err != nil {
+		return nil, err
+	}
+
+	op := s.operations.Start(ctx, operationMeta("UpgradeCluster", req.Provider, req.AccountName, req.Region, req.ClusterName), func(ctx context.Context) (interface{}, error) {
+		if plan.controlPlane {
+			operation.Report(ctx, "upgrading control plane from %s to %s", cluster.KubernetesVersion, req.KubernetesVersion)
+			if _, err := provider.UpgradeCluster(ctx, req); err != nil {
+				return nil, errors.Wrap(err, "control plane upgrade failed")
+			}
+		} else {
+			operation.Report(ctx, "control plane already at %s", cluster.KubernetesVersion)
+		}
+
+		for i, name := range plan.nodePools {
+			operation.Report(ctx, "upgrading node pool '%s' (%d of %d)", name, i+1, len(plan.nodePools))
+			_, err := provider.UpdateNodePool(ctx, &proto.UpdateNodePoolRequest{
+				Provider:          req.Provider,
+				Region:            req.Region,
+				AccountName:       req.AccountName,
+				ClusterName:       req.ClusterName,
+				NodeGroupName:     name,
+				KubernetesVersion: req.KubernetesVersion,
+			})
+			if err != nil {
+				return nil, errors.Wrapf(err, "node pool '%s' upgrade failed, node pools after it were not upgraded", name)
+			}
+		}
+		return &proto.UpgradeClusterResponse{}, nil
+	})
+	return &proto.UpgradeClusterResponse{OperationId: op.ID}, nil
+}
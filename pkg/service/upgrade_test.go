@@ -0,0 +1,57 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	proto "gitlab.com/netbook-devs/spawner-service/proto/netbookai/spawner"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func Test_PlanUpgrade(t *testing.T) {
+	versions := []string{"1.20.9", "1.21.6", "1.22.4", "1.22.6"}
+	cluster := &proto.ClusterSpec{
+		Name:              "dev",
+		KubernetesVersion: "1.21.6",
+		NodeSpec: []*proto.NodeSpec{
+			{Name: "cpu", KubernetesVersion: "v1.21.5-eks-bc4871b"},
+			{Name: "cpu", KubernetesVersion: "v1.21.5-eks-bc4871b"},
+			{Name: "gpu", KubernetesVersion: "1.20.9"},
+			{Name: "new", KubernetesVersion: "1.22.4"},
+		},
+	}
+
+	plan, err := planUpgrade(cluster, "1.22", versions)
+	require.NoError(t, err)
+	assert.True(t, plan.controlPlane)
+	assert.Equal(t, []string{"cpu", "gpu"}, plan.nodePools, "pools are listed once, pools at target are skipped")
+
+	plan, err = planUpgrade(cluster, "1.22.6", versions)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"cpu", "gpu", "new"}, plan.nodePools, "patch upgrade rolls every pool")
+
+	cluster.KubernetesVersion = "1.22.4"
+	plan, err = planUpgrade(cluster, "1.22", versions)
+	require.NoError(t, err)
+	assert.False(t, plan.controlPlane, "control plane at target is not upgraded again")
+
+	for target, code := range map[string]codes.Code{
+		"latest": codes.InvalidArgument,
+		"1.23":   codes.InvalidArgument,
+		"1.21":   codes.FailedPrecondition,
+	} {
+		_, err := planUpgrade(cluster, target, versions)
+		assert.Equal(t, code, status.Code(err), target)
+	}
+
+	_, err = planUpgrade(&proto.ClusterSpec{KubernetesVersion: "1.20"}, "1.22", versions)
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err), "control plane skips a minor version")
+
+	_, err = planUpgrade(&proto.ClusterSpec{
+		KubernetesVersion: "1.22",
+		NodeSpec:          []*proto.NodeSpec{{Name: "old", KubernetesVersion: "1.19"}},
+	}, "1.22", []string{"1.19", "1.22"})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err), "node pool more than two minor versions behind")
+}
@@ -0,0 +1,147 @@
+package service
+
+import (
+	"context"
+	"strconv"
+
+	"gitlab.com/netbook-devs/spawner-service/pkg/inventory"
+	"gitlab.com/netbook-devs/spawner-service/pkg/quota"
+	"gitlab.com/netbook-devs/spawner-service/pkg/service/constants"
+	proto "gitlab.com/netbook-devs/spawner-service/proto/netbookai/spawner"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func validateVolumeId(id string) error {
+	if id == "" {
+		return status.Error(codes.InvalidArgument, "volume id is required")
+	}
+	return nil
+}
+
+func validateResize(req *proto.ResizeVolumeRequest) error {
+	if err := validateVolumeId(req.VolumeId); err != nil {
+		return err
+	}
+	if req.Size <= 0 {
+		return status.Errorf(codes.InvalidArgument, "invalid volume size %d", req.Size)
+	}
+	return nil
+}
+
+func validateAttach(req *proto.AttachVolumeRequest) error {
+	if err := validateVolumeId(req.VolumeId); err != nil {
+		return err
+	}
+	if req.Instance == "" {
+		return status.Error(codes.InvalidArgument, "instance is required")
+	}
+	return nil
+}
+
+//ListVolumes spawner created volumes of the region, workspace filter is applied as the workspace label
+func (s *spawnerService) ListVolumes(ctx context.Context, req *proto.ListVolumesRequest) (*proto.ListVolumesResponse, error) {
+	provider, err := s.controller(req.Provider, CapVolumes)
+	if err != nil {
+		return nil, err
+	}
+	if req.Workspace != "" {
+		labels := map[string]string{constants.WorkspaceLabel: req.Workspace}
+		for k, v := range req.Labels {
+			labels[k] = v
+		}
+		req.Labels = labels
+	}
+	return provider.ListVolumes(ctx, req)
+}
+
+//GetVolume state, size, type, zone, attachments and tags of the volume
+func (s *spawnerService) GetVolume(ctx context.Context, req *proto.GetVolumeRequest) (*proto.Volume, error) {
+	if err := validateVolumeId(req.VolumeId); err != nil {
+		return nil, err
+	}
+	provider, err := s.controller(req.Provider, CapVolumes)
+	if err != nil {
+		return nil, err
+	}
+	return provider.GetVolume(ctx, req)
+}
+
+//growResources GiB added to the volume recorded in the inventory, volumes not in the inventory are not counted
+func (s *spawnerService) growResources(ctx context.Context, req *proto.ResizeVolumeRequest) (inventory.Resource, quota.Resources, bool) {
+	v, err := s.inventory.Get(ctx, inventory.Key{
+		Provider: req.Provider,
+		Account:  req.AccountName,
+		Region:   req.Region,
+		Kind:     inventory.KindVolume,
+		ID:       req.VolumeId,
+	})
+	if err != nil || v.Deleted() {
+		return v, quota.Resources{}, false
+	}
+	current, _ := strconv.ParseInt(v.Attributes["size"], 10, 64)
+	if req.Size <= current {
+		return v, quota.Resources{}, false
+	}
+	return v, quota.Resources{VolumeGiB: req.Size - current}, true
+}
+
+//ResizeVolume grows the volume, GiB added are held against the quotas and refused when the workspace is over its budget
+func (s *spawnerService) ResizeVolume(ctx context.Context, req *proto.ResizeVolumeRequest) (*proto.ResizeVolumeResponse, error) {
+	if err := validateResize(req); err != nil {
+		return nil, err
+	}
+	provider, err := s.controller(req.Provider, CapVolumes)
+	if err != nil {
+		return nil, err
+	}
+
+	release := func() {}
+	if v, added, ok := s.growResources(ctx, req); ok {
+		if err := s.checkBudget(v.Workspace); err != nil {
+			return nil, err
+		}
+		release, err = s.reserveQuota(ctx, req.AccountName, v.Workspace, added)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	op := s.operations.Start(ctx, operationMeta("ResizeVolume", req.Provider, req.AccountName, req.Region, req.VolumeId), func(ctx context.Context) (interface{}, error) {
+		defer release()
+		return provider.ResizeVolume(ctx, req)
+	})
+	return &proto.ResizeVolumeResponse{VolumeId: req.VolumeId, OperationId: op.ID}, nil
+}
+
+//AttachVolume attaches the volume to the instance
+func (s *spawnerService) AttachVolume(ctx context.Context, req *proto.AttachVolumeRequest) (*proto.AttachVolumeResponse, error) {
+	if err := validateAttach(req); err != nil {
+		return nil, err
+	}
+	provider, err := s.controller(req.Provider, CapVolumes)
+	if err != nil {
+		return nil, err
+	}
+
+	op := s.operations.Start(ctx, operationMeta("AttachVolume", req.Provider, req.AccountName, req.Region, req.VolumeId), func(ctx context.Context) (interface{}, error) {
+		return provider.AttachVolume(ctx, req)
+	})
+	return &proto.AttachVolumeResponse{VolumeId: req.VolumeId, OperationId: op.ID}, nil
+}
+
+//DetachVolume detaches the volume from the requested instance or the instance it is attached to
+func (s *spawnerService) DetachVolume(ctx context.Context, req *proto.DetachVolumeRequest) (*proto.DetachVolumeResponse, error) {
+	if err := validateVolumeId(req.VolumeId); err != nil {
+		return nil, err
+	}
+	provider, err := s.controller(req.Provider, CapVolumes)
+	if err != nil {
+		return nil, err
+	}
+
+	op := s.operations.Start(ctx, operationMeta("DetachVolume", req.Provider, req.AccountName, req.Region, req.VolumeId), func(ctx context.Context) (interface{}, error) {
+		return provider.DetachVolume(ctx, req)
+	})
+	return &proto.DetachVolumeResponse{OperationId: op.ID}, nil
+}
@@ -0,0 +1,70 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gitlab.com/netbook-devs/spawner-service/pkg/inventory"
+	"gitlab.com/netbook-devs/spawner-service/pkg/operation"
+	"gitlab.com/netbook-devs/spawner-service/pkg/quota"
+	"gitlab.com/netbook-devs/spawner-service/pkg/service/constants"
+	proto "gitlab.com/netbook-devs/spawner-service/proto/netbookai/spawner"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func Test_ValidateVolumeChanges(t *testing.T) {
+	assert.NoError(t, validateResize(&proto.ResizeVolumeRequest{VolumeId: "vol-1", Size: 20}))
+	for _, req := range []*proto.ResizeVolumeRequest{{Size: 20}, {VolumeId: "vol-1"}, {VolumeId: "vol-1", Size: -1}} {
+		assert.Equal(t, codes.InvalidArgument, status.Code(validateResize(req)), "expected invalid %v", req)
+	}
+
+	assert.NoError(t, validateAttach(&proto.AttachVolumeRequest{VolumeId: "vol-1", Instance: "i-1"}))
+	for _, req := range []*proto.AttachVolumeRequest{{Instance: "i-1"}, {VolumeId: "vol-1"}} {
+		assert.Equal(t, codes.InvalidArgument, status.Code(validateAttach(req)), "expected invalid %v", req)
+	}
+}
+
+func Test_GrowResources(t *testing.T) {
+	ctx := context.Background()
+	store := inventory.NewMemoryStore()
+	require.NoError(t, store.Put(ctx, inventory.Resource{
+		ID: "vol-1", Kind: inventory.KindVolume, Provider: "fake", Account: "dev", Region: "local",
+		Workspace: "ws-1", Attributes: map[string]string{"size": "10"},
+	}))
+	s := &spawnerService{inventory: store, logger: zap.NewNop().Sugar()}
+
+	req := &proto.ResizeVolumeRequest{Provider: "fake", AccountName: "dev", Region: "local", VolumeId: "vol-1", Size: 25}
+	v, added, ok := s.growResources(ctx, req)
+	require.True(t, ok)
+	assert.Equal(t, "ws-1", v.Workspace)
+	assert.Equal(t, quota.Resources{VolumeGiB: 15}, added)
+
+	req.Size = 10
+	_, _, ok = s.growResources(ctx, req)
+	assert.False(t, ok, "same size holds no quota")
+
+	req.VolumeId = "unknown"
+	req.Size = 25
+	_, _, ok = s.growResources(ctx, req)
+	assert.False(t, ok, "volumes missing in inventory are not counted")
+}
+
+func Test_CreateVolumeTarget(t *testing.T) {
+	ctx := context.Background()
+	s, _ := newTestService(t, testOptions{})
+
+	res, err := s.CreateVolume(ctx, &proto.CreateVolumeRequest{
+		Provider: "fake", AccountName: "dev", Region: "local-1", Size: 10,
+		Labels: map[string]string{constants.VolumeNameLabel: "data"},
+	})
+	require.NoError(t, err)
+
+	op, err := s.operations.Wait(ctx, res.OperationId)
+	require.NoError(t, err)
+	require.Equal(t, operation.Succeeded, op.Status, op.Error)
+	assert.Equal(t, op.Result.(*proto.CreateVolumeResponse).Volumeid, op.Target, "volume name is replaced by the volume id once created")
+}
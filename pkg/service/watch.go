@@ -0,0 +1,168 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"gitlab.com/netbook-devs/spawner-service/pkg/config"
+	proto "gitlab.com/netbook-devs/spawner-service/proto/netbookai/spawner"
+	gproto "google.golang.org/protobuf/proto"
+)
+
+//providers do not push status changes, watch polls them and streams only the changes
+
+const defaultWatchInterval = 15 * time.Second
+
+func watchInterval(seconds int32) time.Duration {
+	if seconds > 0 {
+		return time.Duration(seconds) * time.Second
+	}
+	if conf := config.Get().WatchIntervalSeconds; conf > 0 {
+		return time.Duration(conf) * time.Second
+	}
+	return defaultWatchInterval
+}
+
+//watch calls poll right away and then every interval until ctx is done, send is called when the polled event
+//differs from the last one sent
+func watch(ctx context.Context, interval time.Duration, poll func() gproto.Message, send func(gproto.Message) error) error {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	var last gproto.Message
+	for {
+		event := poll()
+		if ctx.Err() != nil {
+			return nil
+		}
+		if last == nil || !gproto.Equal(last, event) {
+			last = event
+			if err := send(gproto.Clone(event)); err != nil {
+				return err
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+func observedAt() string {
+	return time.Now().UTC().Format(time.RFC3339)
+}
+
+//nodePoolEvents one event per nodepool, providers listing each node separately report the first node of the pool
+func nodePoolEvents(cluster string, spec *proto.ClusterSpec) []*proto.NodePoolEvent {
+	events := []*proto.NodePoolEvent{}
+	seen := map[string]bool{}
+	for _, node := range spec.GetNodeSpec() {
+		if seen[node.Name] {
+			continue
+		}
+		seen[node.Name] = true
+		events = append(events, &proto.NodePoolEvent{
+			ClusterName:  cluster,
+			NodePoolName: node.Name,
+			State:        node.State,
+			Health:       node.Health,
+			Fallbacks:    node.Fallbacks,
+		})
+	}
+	return events
+}
+
+func clusterEvent(ctx context.Context, provider Controller, req *proto.WatchClusterRequest) *proto.ClusterEvent {
+	event := &proto.ClusterEvent{ClusterName: req.ClusterName}
+
+	stat, err := provider.ClusterStatus(ctx, &proto.ClusterStatusRequest{
+		Provider:    req.Provider,
+		Region:      req.Region,
+		AccountName: req.AccountName,
+		ClusterName: req.ClusterName,
+	})
+	if err != nil {
+		event.Error = err.Error()
+		return event
+	}
+	event.Status = stat.Status
+	event.ProvisioningState = stat.ProvisioningState
+
+	spec, err := provider.GetCluster(ctx, &proto.GetClusterRequest{
+		Provider:    req.Provider,
+		Region:      req.Region,
+		AccountName: req.AccountName,
+		ClusterName: req.ClusterName,
+	})
+	if err != nil {
+		event.Error = err.Error()
+		return event
+	}
+	event.NodePools = nodePoolEvents(req.ClusterName, spec)
+	return event
+}
+
+//WatchCluster streams the cluster status, provisioning state and nodepool health as they change
+func (s *spawnerService) WatchCluster(req *proto.WatchClusterRequest, stream proto.SpawnerService_WatchClusterServer) error {
+	provider, err := s.controller(req.Provider, CapClusters)
+	if err != nil {
+		return err
+	}
+
+	ctx := stream.Context()
+	s.logger.Infow("watching cluster", "cluster", req.ClusterName, "provider", req.Provider, "region", req.Region)
+	return watch(ctx, watchInterval(req.IntervalSeconds), func() gproto.Message {
+		return clusterEvent(ctx, provider, req)
+	}, func(m gproto.Message) error {
+		event := m.(*proto.ClusterEvent)
+		event.ObservedAt = observedAt()
+		return stream.Send(event)
+	})
+}
+
+func nodePoolEvent(ctx context.Context, provider Controller, req *proto.WatchNodePoolRequest) *proto.NodePoolEvent {
+	spec, err := provider.GetCluster(ctx, &proto.GetClusterRequest{
+		Provider:    req.Provider,
+		Region:      req.Region,
+		AccountName: req.AccountName,
+		ClusterName: req.ClusterName,
+	})
+	if err != nil {
+		return &proto.NodePoolEvent{
+			ClusterName:  req.ClusterName,
+			NodePoolName: req.NodePoolName,
+			Error:        err.Error(),
+		}
+	}
+
+	for _, event := range nodePoolEvents(req.ClusterName, spec) {
+		if event.NodePoolName == req.NodePoolName {
+			return event
+		}
+	}
+	return &proto.NodePoolEvent{
+		ClusterName:  req.ClusterName,
+		NodePoolName: req.NodePoolName,
+		Error:        "nodepool not found",
+	}
+}
+
+//WatchNodePool streams the nodepool state and health as they change
+func (s *spawnerService) WatchNodePool(req *proto.WatchNodePoolRequest, stream proto.SpawnerService_WatchNodePoolServer) error {
+	provider, err := s.controller(req.Provider, CapNodePools)
+	if err != nil {
+		return err
+	}
+
+	ctx := stream.Context()
+	s.logger.Infow("watching nodepool", "cluster", req.ClusterName, "nodepool", req.NodePoolName, "provider", req.Provider)
+	return watch(ctx, watchInterval(req.IntervalSeconds), func() gproto.Message {
+		return nodePoolEvent(ctx, provider, req)
+	}, func(m gproto.Message) error {
+		event := m.(*proto.NodePoolEvent)
+		event.ObservedAt = observedAt()
+		return stream.Send(event)
+	})
+}
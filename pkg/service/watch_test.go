@@ -0,0 +1,91 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	proto "gitlab.com/netbook-devs/spawner-service/proto/netbookai/spawner"
+	"google.golang.org/grpc"
+	gproto "google.golang.org/protobuf/proto"
+)
+
+type clusterStream struct {
+	grpc.ServerStream
+	ctx    context.Context
+	events chan *proto.ClusterEvent
+}
+
+func (s *clusterStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *clusterStream) Send(e *proto.ClusterEvent) error {
+	s.events <- e
+	return nil
+}
+
+func Test_WatchCluster(t *testing.T) {
+	s, controller := newTestService(t, testOptions{})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	_, err := controller.CreateCluster(ctx, &proto.ClusterRequest{Provider: "fake", Region: "local", ClusterName: "dev"})
+	require.NoError(t, err)
+
+	stream := &clusterStream{ctx: ctx, events: make(chan *proto.ClusterEvent, 4)}
+	done := make(chan error)
+	go func() {
+		done <- s.WatchCluster(&proto.WatchClusterRequest{Provider: "fake", Region: "local", ClusterName: "dev", IntervalSeconds: 1}, stream)
+	}()
+
+	event := <-stream.events
+	assert.Equal(t, "dev", event.ClusterName)
+	assert.Equal(t, "CREATING", event.Status)
+	assert.Empty(t, event.Error)
+	assert.NotEmpty(t, event.ObservedAt)
+
+	//status did not change, nothing more is sent
+	select {
+	case e := <-stream.events:
+		t.Fatalf("unexpected event %v", e)
+	case <-time.After(1500 * time.Millisecond):
+	}
+
+	cancel()
+	assert.NoError(t, <-done)
+}
+
+func Test_Watch(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	polled := []*proto.NodePoolEvent{
+		{NodePoolName: "gpu", State: "CREATING"},
+		{NodePoolName: "gpu", State: "CREATING"},
+		{NodePoolName: "gpu", State: "ACTIVE", Health: &proto.Health{Issue: []*proto.Issue{{Code: "Ec2InstanceTypeDoesNotExist"}}}},
+	}
+	sent := []*proto.NodePoolEvent{}
+	i := 0
+	err := watch(ctx, time.Millisecond, func() gproto.Message {
+		if i == len(polled) {
+			cancel()
+			return polled[i-1]
+		}
+		i++
+		return polled[i-1]
+	}, func(m gproto.Message) error {
+		sent = append(sent, m.(*proto.NodePoolEvent))
+		return nil
+	})
+	require.NoError(t, err)
+
+	//unchanged poll is not sent again
+	require.Len(t, sent, 2)
+	assert.Equal(t, "CREATING", sent[0].State)
+	assert.Equal(t, "ACTIVE", sent[1].State)
+	assert.Equal(t, "Ec2InstanceTypeDoesNotExist", sent[1].Health.Issue[0].Code)
+}
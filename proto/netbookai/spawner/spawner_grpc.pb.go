@@ -30,7 +30,7 @@ type SpawnerServiceClient interface {
 	AddToken(ctx context.Context, in *AddTokenRequest, opts ...grpc.CallOption) (*AddTokenResponse, error)
 	// Create get token to secret manager
 	GetToken(ctx context.Context, in *GetTokenRequest, opts ...grpc.CallOption) (*GetTokenResponse, error)
-	// Add Route53 record for Caddy
+	// Add Route53 record for Caddy, deprecated in favour of UpsertDNSRecord
 	AddRoute53Record(ctx context.Context, in *AddRoute53RecordRequest, opts ...grpc.CallOption) (*AddRoute53RecordResponse, error)
 	// Get Cluster
 	GetCluster(ctx context.Context, in *GetClusterRequest, opts ...grpc.CallOption) (*ClusterSpec, error)
@@ -56,6 +56,78 @@ type SpawnerServiceClient interface {
 	ReadCredential(ctx context.Context, in *ReadCredentialRequest, opts ...grpc.CallOption) (*ReadCredentialResponse, error)
 	GetKubeConfig(ctx context.Context, in *GetKubeConfigRequest, opts ...grpc.CallOption) (*GetKubeConfigResponse, error)
 	TagNodeInstance(ctx context.Context, in *TagNodeInstanceRequest, opts ...grpc.CallOption) (*TagNodeInstanceResponse, error)
+	// List resources created by spawner from the inventory
+	ListResources(ctx context.Context, in *ListResourcesRequest, opts ...grpc.CallOption) (*ListResourcesResponse, error)
+	// Mutating calls run in background and return operation id, follow the
+	// progress with GetOperation
+	GetOperation(ctx context.Context, in *GetOperationRequest, opts ...grpc.CallOption) (*Operation, error)
+	ListOperations(ctx context.Context, in *ListOperationsRequest, opts ...grpc.CallOption) (*ListOperationsResponse, error)
+	CancelOperation(ctx context.Context, in *CancelOperationRequest, opts ...grpc.CallOption) (*Operation, error)
+	// Watch streams the cluster and nodepool status whenever it changes, stream
+	// is open until the client cancels it
+	WatchCluster(ctx context.Context, in *WatchClusterRequest, opts ...grpc.CallOption) (SpawnerService_WatchClusterClient, error)
+	WatchNodePool(ctx context.Context, in *WatchNodePoolRequest, opts ...grpc.CallOption) (SpawnerService_WatchNodePoolClient, error)
+	// Query the hash chained audit log of the mutating calls
+	QueryAuditLog(ctx context.Context, in *QueryAuditLogRequest, opts ...grpc.CallOption) (*QueryAuditLogResponse, error)
+	// Quota limits and current consumption of the account and workspace
+	GetQuotaUsage(ctx context.Context, in *GetQuotaUsageRequest, opts ...grpc.CallOption) (*GetQuotaUsageResponse, error)
+	// Monthly workspace budgets, AddNode and CreateVolume are refused once the
+	// workspace cost crosses the hard threshold
+	SetBudget(ctx context.Context, in *SetBudgetRequest, opts ...grpc.CallOption) (*Budget, error)
+	DeleteBudget(ctx context.Context, in *DeleteBudgetRequest, opts ...grpc.CallOption) (*DeleteBudgetResponse, error)
+	GetBudgetStatus(ctx context.Context, in *GetBudgetStatusRequest, opts ...grpc.CallOption) (*GetBudgetStatusResponse, error)
+	// Reconcile the cluster with the declarative spec, only the differences to
+	// GetCluster are applied
+	ApplyClusterSpec(ctx context.Context, in *ApplyClusterSpecRequest, opts ...grpc.CallOption) (*ApplyClusterSpecResponse, error)
+	// Differences between the inventory and the spawner created resources found
+	// on the provider
+	GetDrift(ctx context.Context, in *GetDriftRequest, opts ...grpc.CallOption) (*GetDriftResponse, error)
+	// Postpone the expiry of the cluster or node pool
+	ExtendLease(ctx context.Context, in *ExtendLeaseRequest, opts ...grpc.CallOption) (*ExtendLeaseResponse, error)
+	// Spawner tagged volumes and snapshots left without owner, optionally
+	// deleted
+	ListOrphans(ctx context.Context, in *ListOrphansRequest, opts ...grpc.CallOption) (*ListOrphansResponse, error)
+	// Create or replace the scaling schedule of the node pool, schedule is
+	// removed when no rules are given
+	SetNodePoolSchedule(ctx context.Context, in *SetNodePoolScheduleRequest, opts ...grpc.CallOption) (*NodePoolSchedule, error)
+	GetNodePoolSchedule(ctx context.Context, in *GetNodePoolScheduleRequest, opts ...grpc.CallOption) (*GetNodePoolScheduleResponse, error)
+	// Set the desired size and autoscaling bounds of the node pool
+	ScaleNodePool(ctx context.Context, in *ScaleNodePoolRequest, opts ...grpc.CallOption) (*ScaleNodePoolResponse, error)
+	// Update labels, taints, kubernetes version or node image of the node pool
+	UpdateNodePool(ctx context.Context, in *UpdateNodePoolRequest, opts ...grpc.CallOption) (*UpdateNodePoolResponse, error)
+	// Kubernetes versions clusters can be created with or upgraded to
+	ListKubernetesVersions(ctx context.Context, in *ListKubernetesVersionsRequest, opts ...grpc.CallOption) (*ListKubernetesVersionsResponse, error)
+	// Upgrade the control plane of the cluster, then every node pool
+	UpgradeCluster(ctx context.Context, in *UpgradeClusterRequest, opts ...grpc.CallOption) (*UpgradeClusterResponse, error)
+	// Machine types of the catalog available on the provider
+	ListMachineTypes(ctx context.Context, in *ListMachineTypesRequest, opts ...grpc.CallOption) (*ListMachineTypesResponse, error)
+	// Itemized hourly and monthly cost of the cluster or node pool before it is
+	// created
+	EstimateCost(ctx context.Context, in *EstimateCostRequest, opts ...grpc.CallOption) (*EstimateCostResponse, error)
+	// Spawner created volumes of the region, optionally of a single workspace
+	ListVolumes(ctx context.Context, in *ListVolumesRequest, opts ...grpc.CallOption) (*ListVolumesResponse, error)
+	GetVolume(ctx context.Context, in *GetVolumeRequest, opts ...grpc.CallOption) (*Volume, error)
+	// Grow the volume, volumes can not be shrunk
+	ResizeVolume(ctx context.Context, in *ResizeVolumeRequest, opts ...grpc.CallOption) (*ResizeVolumeResponse, error)
+	AttachVolume(ctx context.Context, in *AttachVolumeRequest, opts ...grpc.CallOption) (*AttachVolumeResponse, error)
+	DetachVolume(ctx context.Context, in *DetachVolumeRequest, opts ...grpc.CallOption) (*DetachVolumeResponse, error)
+	// Spawner created snapshots of the region, optionally of a single workspace
+	ListSnapshots(ctx context.Context, in *ListSnapshotsRequest, opts ...grpc.CallOption) (*ListSnapshotsResponse, error)
+	DeleteSnapshot(ctx context.Context, in *DeleteSnapshotRequest, opts ...grpc.CallOption) (*DeleteSnapshotResponse, error)
+	// Create a new volume from the snapshot, volume carries the snapshot labels
+	RestoreSnapshot(ctx context.Context, in *RestoreSnapshotRequest, opts ...grpc.CallOption) (*RestoreSnapshotResponse, error)
+	// Copy the snapshot to another region, copy carries the snapshot labels
+	CopySnapshot(ctx context.Context, in *CopySnapshotRequest, opts ...grpc.CallOption) (*CopySnapshotResponse, error)
+	// Scheduled snapshots of the volumes selected by labels, expired snapshots
+	// are pruned, policy is replaced when it exists
+	CreateSnapshotPolicy(ctx context.Context, in *CreateSnapshotPolicyRequest, opts ...grpc.CallOption) (*SnapshotPolicy, error)
+	DeleteSnapshotPolicy(ctx context.Context, in *DeleteSnapshotPolicyRequest, opts ...grpc.CallOption) (*DeleteSnapshotPolicyResponse, error)
+	ListSnapshotPolicies(ctx context.Context, in *ListSnapshotPoliciesRequest, opts ...grpc.CallOption) (*ListSnapshotPoliciesResponse, error)
+	// A, CNAME and TXT records of the dns zones hosted by the provider, records
+	// tied to a cluster are deleted with the cluster
+	UpsertDNSRecord(ctx context.Context, in *UpsertDNSRecordRequest, opts ...grpc.CallOption) (*UpsertDNSRecordResponse, error)
+	DeleteDNSRecord(ctx context.Context, in *DeleteDNSRecordRequest, opts ...grpc.CallOption) (*DeleteDNSRecordResponse, error)
+	ListDNSRecords(ctx context.Context, in *ListDNSRecordsRequest, opts ...grpc.CallOption) (*ListDNSRecordsResponse, error)
 }
 
 type spawnerServiceClient struct {
@@ -264,6 +336,394 @@ func (c *spawnerServiceClient) TagNodeInstance(ctx context.Context, in *TagNodeI
 	return out, nil
 }
 
+func (c *spawnerServiceClient) ListResources(ctx context.Context, in *ListResourcesRequest, opts ...grpc.CallOption) (*ListResourcesResponse, error) {
+	out := new(ListResourcesResponse)
+	err := c.cc.Invoke(ctx, "/spawner.SpawnerService/ListResources", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *spawnerServiceClient) GetOperation(ctx context.Context, in *GetOperationRequest, opts ...grpc.CallOption) (*Operation, error) {
+	out := new(Operation)
+	err := c.cc.Invoke(ctx, "/spawner.SpawnerService/GetOperation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *spawnerServiceClient) ListOperations(ctx context.Context, in *ListOperationsRequest, opts ...grpc.CallOption) (*ListOperationsResponse, error) {
+	out := new(ListOperationsResponse)
+	err := c.cc.Invoke(ctx, "/spawner.SpawnerService/ListOperations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *spawnerServiceClient) CancelOperation(ctx context.Context, in *CancelOperationRequest, opts ...grpc.CallOption) (*Operation, error) {
+	out := new(Operation)
+	err := c.cc.Invoke(ctx, "/spawner.SpawnerService/CancelOperation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *spawnerServiceClient) WatchCluster(ctx context.Context, in *WatchClusterRequest, opts ...grpc.CallOption) (SpawnerService_WatchClusterClient, error) {
+	stream, err := c.cc.NewStream(ctx, &SpawnerService_ServiceDesc.Streams[0], "/spawner.SpawnerService/WatchCluster", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &spawnerServiceWatchClusterClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type SpawnerService_WatchClusterClient interface {
+	Recv() (*ClusterEvent, error)
+	grpc.ClientStream
+}
+
+type spawnerServiceWatchClusterClient struct {
+	grpc.ClientStream
+}
+
+func (x *spawnerServiceWatchClusterClient) Recv() (*ClusterEvent, error) {
+	m := new(ClusterEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *spawnerServiceClient) WatchNodePool(ctx context.Context, in *WatchNodePoolRequest, opts ...grpc.CallOption) (SpawnerService_WatchNodePoolClient, error) {
+	stream, err := c.cc.NewStream(ctx, &SpawnerService_ServiceDesc.Streams[1], "/spawner.SpawnerService/WatchNodePool", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &spawnerServiceWatchNodePoolClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type SpawnerService_WatchNodePoolClient interface {
+	Recv() (*NodePoolEvent, error)
+	grpc.ClientStream
+}
+
+type spawnerServiceWatchNodePoolClient struct {
+	grpc.ClientStream
+}
+
+func (x *spawnerServiceWatchNodePoolClient) Recv() (*NodePoolEvent, error) {
+	m := new(NodePoolEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *spawnerServiceClient) QueryAuditLog(ctx context.Context, in *QueryAuditLogRequest, opts ...grpc.CallOption) (*QueryAuditLogResponse, error) {
+	out := new(QueryAuditLogResponse)
+	err := c.cc.Invoke(ctx, "/spawner.SpawnerService/QueryAuditLog", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *spawnerServiceClient) GetQuotaUsage(ctx context.Context, in *GetQuotaUsageRequest, opts ...grpc.CallOption) (*GetQuotaUsageResponse, error) {
+	out := new(GetQuotaUsageResponse)
+	err := c.cc.Invoke(ctx, "/spawner.SpawnerService/GetQuotaUsage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *spawnerServiceClient) SetBudget(ctx context.Context, in *SetBudgetRequest, opts ...grpc.CallOption) (*Budget, error) {
+	out := new(Budget)
+	err := c.cc.Invoke(ctx, "/spawner.SpawnerService/SetBudget", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *spawnerServiceClient) DeleteBudget(ctx context.Context, in *DeleteBudgetRequest, opts ...grpc.CallOption) (*DeleteBudgetResponse, error) {
+	out := new(DeleteBudgetResponse)
+	err := c.cc.Invoke(ctx, "/spawner.SpawnerService/DeleteBudget", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *spawnerServiceClient) GetBudgetStatus(ctx context.Context, in *GetBudgetStatusRequest, opts ...grpc.CallOption) (*GetBudgetStatusResponse, error) {
+	out := new(GetBudgetStatusResponse)
+	err := c.cc.Invoke(ctx, "/spawner.SpawnerService/GetBudgetStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *spawnerServiceClient) ApplyClusterSpec(ctx context.Context, in *ApplyClusterSpecRequest, opts ...grpc.CallOption) (*ApplyClusterSpecResponse, error) {
+	out := new(ApplyClusterSpecResponse)
+	err := c.cc.Invoke(ctx, "/spawner.SpawnerService/ApplyClusterSpec", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *spawnerServiceClient) GetDrift(ctx context.Context, in *GetDriftRequest, opts ...grpc.CallOption) (*GetDriftResponse, error) {
+	out := new(GetDriftResponse)
+	err := c.cc.Invoke(ctx, "/spawner.SpawnerService/GetDrift", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *spawnerServiceClient) ExtendLease(ctx context.Context, in *ExtendLeaseRequest, opts ...grpc.CallOption) (*ExtendLeaseResponse, error) {
+	out := new(ExtendLeaseResponse)
+	err := c.cc.Invoke(ctx, "/spawner.SpawnerService/ExtendLease", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *spawnerServiceClient) ListOrphans(ctx context.Context, in *ListOrphansRequest, opts ...grpc.CallOption) (*ListOrphansResponse, error) {
+	out := new(ListOrphansResponse)
+	err := c.cc.Invoke(ctx, "/spawner.SpawnerService/ListOrphans", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *spawnerServiceClient) SetNodePoolSchedule(ctx context.Context, in *SetNodePoolScheduleRequest, opts ...grpc.CallOption) (*NodePoolSchedule, error) {
+	out := new(NodePoolSchedule)
+	err := c.cc.Invoke(ctx, "/spawner.SpawnerService/SetNodePoolSchedule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *spawnerServiceClient) GetNodePoolSchedule(ctx context.Context, in *GetNodePoolScheduleRequest, opts ...grpc.CallOption) (*GetNodePoolScheduleResponse, error) {
+	out := new(GetNodePoolScheduleResponse)
+	err := c.cc.Invoke(ctx, "/spawner.SpawnerService/GetNodePoolSchedule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *spawnerServiceClient) ScaleNodePool(ctx context.Context, in *ScaleNodePoolRequest, opts ...grpc.CallOption) (*ScaleNodePoolResponse, error) {
+	out := new(ScaleNodePoolResponse)
+	err := c.cc.Invoke(ctx, "/spawner.SpawnerService/ScaleNodePool", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *spawnerServiceClient) UpdateNodePool(ctx context.Context, in *UpdateNodePoolRequest, opts ...grpc.CallOption) (*UpdateNodePoolResponse, error) {
+	out := new(UpdateNodePoolResponse)
+	err := c.cc.Invoke(ctx, "/spawner.SpawnerService/UpdateNodePool", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *spawnerServiceClient) ListKubernetesVersions(ctx context.Context, in *ListKubernetesVersionsRequest, opts ...grpc.CallOption) (*ListKubernetesVersionsResponse, error) {
+	out := new(ListKubernetesVersionsResponse)
+	err := c.cc.Invoke(ctx, "/spawner.SpawnerService/ListKubernetesVersions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *spawnerServiceClient) UpgradeCluster(ctx context.Context, in *UpgradeClusterRequest, opts ...grpc.CallOption) (*UpgradeClusterResponse, error) {
+	out := new(UpgradeClusterResponse)
+	err := c.cc.Invoke(ctx, "/spawner.SpawnerService/UpgradeCluster", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *spawnerServiceClient) ListMachineTypes(ctx context.Context, in *ListMachineTypesRequest, opts ...grpc.CallOption) (*ListMachineTypesResponse, error) {
+	out := new(ListMachineTypesResponse)
+	err := c.cc.Invoke(ctx, "/spawner.SpawnerService/ListMachineTypes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *spawnerServiceClient) EstimateCost(ctx context.Context, in *EstimateCostRequest, opts ...grpc.CallOption) (*EstimateCostResponse, error) {
+	out := new(EstimateCostResponse)
+	err := c.cc.Invoke(ctx, "/spawner.SpawnerService/EstimateCost", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *spawnerServiceClient) ListVolumes(ctx context.Context, in *ListVolumesRequest, opts ...grpc.CallOption) (*ListVolumesResponse, error) {
+	out := new(ListVolumesResponse)
+	err := c.cc.Invoke(ctx, "/spawner.SpawnerService/ListVolumes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *spawnerServiceClient) GetVolume(ctx context.Context, in *GetVolumeRequest, opts ...grpc.CallOption) (*Volume, error) {
+	out := new(Volume)
+	err := c.cc.Invoke(ctx, "/spawner.SpawnerService/GetVolume", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *spawnerServiceClient) ResizeVolume(ctx context.Context, in *ResizeVolumeRequest, opts ...grpc.CallOption) (*ResizeVolumeResponse, error) {
+	out := new(ResizeVolumeResponse)
+	err := c.cc.Invoke(ctx, "/spawner.SpawnerService/ResizeVolume", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *spawnerServiceClient) AttachVolume(ctx context.Context, in *AttachVolumeRequest, opts ...grpc.CallOption) (*AttachVolumeResponse, error) {
+	out := new(AttachVolumeResponse)
+	err := c.cc.Invoke(ctx, "/spawner.SpawnerService/AttachVolume", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *spawnerServiceClient) DetachVolume(ctx context.Context, in *DetachVolumeRequest, opts ...grpc.CallOption) (*DetachVolumeResponse, error) {
+	out := new(DetachVolumeResponse)
+	err := c.cc.Invoke(ctx, "/spawner.SpawnerService/DetachVolume", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *spawnerServiceClient) ListSnapshots(ctx context.Context, in *ListSnapshotsRequest, opts ...grpc.CallOption) (*ListSnapshotsResponse, error) {
+	out := new(ListSnapshotsResponse)
+	err := c.cc.Invoke(ctx, "/spawner.SpawnerService/ListSnapshots", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *spawnerServiceClient) DeleteSnapshot(ctx context.Context, in *DeleteSnapshotRequest, opts ...grpc.CallOption) (*DeleteSnapshotResponse, error) {
+	out := new(DeleteSnapshotResponse)
+	err := c.cc.Invoke(ctx, "/spawner.SpawnerService/DeleteSnapshot", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *spawnerServiceClient) RestoreSnapshot(ctx context.Context, in *RestoreSnapshotRequest, opts ...grpc.CallOption) (*RestoreSnapshotResponse, error) {
+	out := new(RestoreSnapshotResponse)
+	err := c.cc.Invoke(ctx, "/spawner.SpawnerService/RestoreSnapshot", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *spawnerServiceClient) CopySnapshot(ctx context.Context, in *CopySnapshotRequest, opts ...grpc.CallOption) (*CopySnapshotResponse, error) {
+	out := new(CopySnapshotResponse)
+	err := c.cc.Invoke(ctx, "/spawner.SpawnerService/CopySnapshot", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *spawnerServiceClient) CreateSnapshotPolicy(ctx context.Context, in *CreateSnapshotPolicyRequest, opts ...grpc.CallOption) (*SnapshotPolicy, error) {
+	out := new(SnapshotPolicy)
+	err := c.cc.Invoke(ctx, "/spawner.SpawnerService/CreateSnapshotPolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *spawnerServiceClient) DeleteSnapshotPolicy(ctx context.Context, in *DeleteSnapshotPolicyRequest, opts ...grpc.CallOption) (*DeleteSnapshotPolicyResponse, error) {
+	out := new(DeleteSnapshotPolicyResponse)
+	err := c.cc.Invoke(ctx, "/spawner.SpawnerService/DeleteSnapshotPolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *spawnerServiceClient) ListSnapshotPolicies(ctx context.Context, in *ListSnapshotPoliciesRequest, opts ...grpc.CallOption) (*ListSnapshotPoliciesResponse, error) {
+	out := new(ListSnapshotPoliciesResponse)
+	err := c.cc.Invoke(ctx, "/spawner.SpawnerService/ListSnapshotPolicies", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *spawnerServiceClient) UpsertDNSRecord(ctx context.Context, in *UpsertDNSRecordRequest, opts ...grpc.CallOption) (*UpsertDNSRecordResponse, error) {
+	out := new(UpsertDNSRecordResponse)
+	err := c.cc.Invoke(ctx, "/spawner.SpawnerService/UpsertDNSRecord", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *spawnerServiceClient) DeleteDNSRecord(ctx context.Context, in *DeleteDNSRecordRequest, opts ...grpc.CallOption) (*DeleteDNSRecordResponse, error) {
+	out := new(DeleteDNSRecordResponse)
+	err := c.cc.Invoke(ctx, "/spawner.SpawnerService/DeleteDNSRecord", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *spawnerServiceClient) ListDNSRecords(ctx context.Context, in *ListDNSRecordsRequest, opts ...grpc.CallOption) (*ListDNSRecordsResponse, error) {
+	out := new(ListDNSRecordsResponse)
+	err := c.cc.Invoke(ctx, "/spawner.SpawnerService/ListDNSRecords", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // SpawnerServiceServer is the server API for SpawnerService service.
 // All implementations must embed UnimplementedSpawnerServiceServer
 // for forward compatibility
@@ -276,7 +736,7 @@ type SpawnerServiceServer interface {
 	AddToken(context.Context, *AddTokenRequest) (*AddTokenResponse, error)
 	// Create get token to secret manager
 	GetToken(context.Context, *GetTokenRequest) (*GetTokenResponse, error)
-	// Add Route53 record for Caddy
+	// Add Route53 record for Caddy, deprecated in favour of UpsertDNSRecord
 	AddRoute53Record(context.Context, *AddRoute53RecordRequest) (*AddRoute53RecordResponse, error)
 	// Get Cluster
 	GetCluster(context.Context, *GetClusterRequest) (*ClusterSpec, error)
@@ -302,6 +762,78 @@ type SpawnerServiceServer interface {
 	ReadCredential(context.Context, *ReadCredentialRequest) (*ReadCredentialResponse, error)
 	GetKubeConfig(context.Context, *GetKubeConfigRequest) (*GetKubeConfigResponse, error)
 	TagNodeInstance(context.Context, *TagNodeInstanceRequest) (*TagNodeInstanceResponse, error)
+	// List resources created by spawner from the inventory
+	ListResources(context.Context, *ListResourcesRequest) (*ListResourcesResponse, error)
+	// Mutating calls run in background and return operation id, follow the
+	// progress with GetOperation
+	GetOperation(context.Context, *GetOperationRequest) (*Operation, error)
+	ListOperations(context.Context, *ListOperationsRequest) (*ListOperationsResponse, error)
+	CancelOperation(context.Context, *CancelOperationRequest) (*Operation, error)
+	// Watch streams the cluster and nodepool status whenever it changes, stream
+	// is open until the client cancels it
+	WatchCluster(*WatchClusterRequest, SpawnerService_WatchClusterServer) error
+	WatchNodePool(*WatchNodePoolRequest, SpawnerService_WatchNodePoolServer) error
+	// Query the hash chained audit log of the mutating calls
+	QueryAuditLog(context.Context, *QueryAuditLogRequest) (*QueryAuditLogResponse, error)
+	// Quota limits and current consumption of the account and workspace
+	GetQuotaUsage(context.Context, *GetQuotaUsageRequest) (*GetQuotaUsageResponse, error)
+	// Monthly workspace budgets, AddNode and CreateVolume are refused once the
+	// workspace cost crosses the hard threshold
+	SetBudget(context.Context, *SetBudgetRequest) (*Budget, error)
+	DeleteBudget(context.Context, *DeleteBudgetRequest) (*DeleteBudgetResponse, error)
+	GetBudgetStatus(context.Context, *GetBudgetStatusRequest) (*GetBudgetStatusResponse, error)
+	// Reconcile the cluster with the declarative spec, only the differences to
+	// GetCluster are applied
+	ApplyClusterSpec(context.Context, *ApplyClusterSpecRequest) (*ApplyClusterSpecResponse, error)
+	// Differences between the inventory and the spawner created resources found
+	// on the provider
+	GetDrift(context.Context, *GetDriftRequest) (*GetDriftResponse, error)
+	// Postpone the expiry of the cluster or node pool
+	ExtendLease(context.Context, *ExtendLeaseRequest) (*ExtendLeaseResponse, error)
+	// Spawner tagged volumes and snapshots left without owner, optionally
+	// deleted
+	ListOrphans(context.Context, *ListOrphansRequest) (*ListOrphansResponse, error)
+	// Create or replace the scaling schedule of the node pool, schedule is
+	// removed when no rules are given
+	SetNodePoolSchedule(context.Context, *SetNodePoolScheduleRequest) (*NodePoolSchedule, error)
+	GetNodePoolSchedule(context.Context, *GetNodePoolScheduleRequest) (*GetNodePoolScheduleResponse, error)
+	// Set the desired size and autoscaling bounds of the node pool
+	ScaleNodePool(context.Context, *ScaleNodePoolRequest) (*ScaleNodePoolResponse, error)
+	// Update labels, taints, kubernetes version or node image of the node pool
+	UpdateNodePool(context.Context, *UpdateNodePoolRequest) (*UpdateNodePoolResponse, error)
+	// Kubernetes versions clusters can be created with or upgraded to
+	ListKubernetesVersions(context.Context, *ListKubernetesVersionsRequest) (*ListKubernetesVersionsResponse, error)
+	// Upgrade the control plane of the cluster, then every node pool
+	UpgradeCluster(context.Context, *UpgradeClusterRequest) (*UpgradeClusterResponse, error)
+	// Machine types of the catalog available on the provider
+	ListMachineTypes(context.Context, *ListMachineTypesRequest) (*ListMachineTypesResponse, error)
+	// Itemized hourly and monthly cost of the cluster or node pool before it is
+	// created
+	EstimateCost(context.Context, *EstimateCostRequest) (*EstimateCostResponse, error)
+	// Spawner created volumes of the region, optionally of a single workspace
+	ListVolumes(context.Context, *ListVolumesRequest) (*ListVolumesResponse, error)
+	GetVolume(context.Context, *GetVolumeRequest) (*Volume, error)
+	// Grow the volume, volumes can not be shrunk
+	ResizeVolume(context.Context, *ResizeVolumeRequest) (*ResizeVolumeResponse, error)
+	AttachVolume(context.Context, *AttachVolumeRequest) (*AttachVolumeResponse, error)
+	DetachVolume(context.Context, *DetachVolumeRequest) (*DetachVolumeResponse, error)
+	// Spawner created snapshots of the region, optionally of a single workspace
+	ListSnapshots(context.Context, *ListSnapshotsRequest) (*ListSnapshotsResponse, error)
+	DeleteSnapshot(context.Context, *DeleteSnapshotRequest) (*DeleteSnapshotResponse, error)
+	// Create a new volume from the snapshot, volume carries the snapshot labels
+	RestoreSnapshot(context.Context, *RestoreSnapshotRequest) (*RestoreSnapshotResponse, error)
+	// Copy the snapshot to another region, copy carries the snapshot labels
+	CopySnapshot(context.Context, *CopySnapshotRequest) (*CopySnapshotResponse, error)
+	// Scheduled snapshots of the volumes selected by labels, expired snapshots
+	// are pruned, policy is replaced when it exists
+	CreateSnapshotPolicy(context.Context, *CreateSnapshotPolicyRequest) (*SnapshotPolicy, error)
+	DeleteSnapshotPolicy(context.Context, *DeleteSnapshotPolicyRequest) (*DeleteSnapshotPolicyResponse, error)
+	ListSnapshotPolicies(context.Context, *ListSnapshotPoliciesRequest) (*ListSnapshotPoliciesResponse, error)
+	// A, CNAME and TXT records of the dns zones hosted by the provider, records
+	// tied to a cluster are deleted with the cluster
+	UpsertDNSRecord(context.Context, *UpsertDNSRecordRequest) (*UpsertDNSRecordResponse, error)
+	DeleteDNSRecord(context.Context, *DeleteDNSRecordRequest) (*DeleteDNSRecordResponse, error)
+	ListDNSRecords(context.Context, *ListDNSRecordsRequest) (*ListDNSRecordsResponse, error)
 	mustEmbedUnimplementedSpawnerServiceServer()
 }
 
@@ -369,515 +901,2608 @@ func (UnimplementedSpawnerServiceServer) WriteCredential(context.Context, *Write
 func (UnimplementedSpawnerServiceServer) ReadCredential(context.Context, *ReadCredentialRequest) (*ReadCredentialResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ReadCredential not implemented")
 }
-func (UnimplementedSpawnerServiceServer) GetKubeConfig(context.Context, *GetKubeConfigRequest) (*GetKubeConfigResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetKubeConfig not implemented")
+func (UnimplementedSpawnerServiceServer) GetKubeConfig(context.Context, *GetKubeConfigRequest) (*GetKubeConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetKubeConfig not implemented")
+}
+func (UnimplementedSpawnerServiceServer) TagNodeInstance(context.Context, *TagNodeInstanceRequest) (*TagNodeInstanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TagNodeInstance not implemented")
+}
+func (UnimplementedSpawnerServiceServer) ListResources(context.Context, *ListResourcesRequest) (*ListResourcesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListResources not implemented")
+}
+func (UnimplementedSpawnerServiceServer) GetOperation(context.Context, *GetOperationRequest) (*Operation, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOperation not implemented")
+}
+func (UnimplementedSpawnerServiceServer) ListOperations(context.Context, *ListOperationsRequest) (*ListOperationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListOperations not implemented")
+}
+func (UnimplementedSpawnerServiceServer) CancelOperation(context.Context, *CancelOperationRequest) (*Operation, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelOperation not implemented")
+}
+func (UnimplementedSpawnerServiceServer) WatchCluster(*WatchClusterRequest, SpawnerService_WatchClusterServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchCluster not implemented")
+}
+func (UnimplementedSpawnerServiceServer) WatchNodePool(*WatchNodePoolRequest, SpawnerService_WatchNodePoolServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchNodePool not implemented")
+}
+func (UnimplementedSpawnerServiceServer) QueryAuditLog(context.Context, *QueryAuditLogRequest) (*QueryAuditLogResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryAuditLog not implemented")
+}
+func (UnimplementedSpawnerServiceServer) GetQuotaUsage(context.Context, *GetQuotaUsageRequest) (*GetQuotaUsageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetQuotaUsage not implemented")
+}
+func (UnimplementedSpawnerServiceServer) SetBudget(context.Context, *SetBudgetRequest) (*Budget, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetBudget not implemented")
+}
+func (UnimplementedSpawnerServiceServer) DeleteBudget(context.Context, *DeleteBudgetRequest) (*DeleteBudgetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteBudget not implemented")
+}
+func (UnimplementedSpawnerServiceServer) GetBudgetStatus(context.Context, *GetBudgetStatusRequest) (*GetBudgetStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBudgetStatus not implemented")
+}
+func (UnimplementedSpawnerServiceServer) ApplyClusterSpec(context.Context, *ApplyClusterSpecRequest) (*ApplyClusterSpecResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApplyClusterSpec not implemented")
+}
+func (UnimplementedSpawnerServiceServer) GetDrift(context.Context, *GetDriftRequest) (*GetDriftResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDrift not implemented")
+}
+func (UnimplementedSpawnerServiceServer) ExtendLease(context.Context, *ExtendLeaseRequest) (*ExtendLeaseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExtendLease not implemented")
+}
+func (UnimplementedSpawnerServiceServer) ListOrphans(context.Context, *ListOrphansRequest) (*ListOrphansResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListOrphans not implemented")
+}
+func (UnimplementedSpawnerServiceServer) SetNodePoolSchedule(context.Context, *SetNodePoolScheduleRequest) (*NodePoolSchedule, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetNodePoolSchedule not implemented")
+}
+func (UnimplementedSpawnerServiceServer) GetNodePoolSchedule(context.Context, *GetNodePoolScheduleRequest) (*GetNodePoolScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNodePoolSchedule not implemented")
+}
+func (UnimplementedSpawnerServiceServer) ScaleNodePool(context.Context, *ScaleNodePoolRequest) (*ScaleNodePoolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScaleNodePool not implemented")
+}
+func (UnimplementedSpawnerServiceServer) UpdateNodePool(context.Context, *UpdateNodePoolRequest) (*UpdateNodePoolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateNodePool not implemented")
+}
+func (UnimplementedSpawnerServiceServer) ListKubernetesVersions(context.Context, *ListKubernetesVersionsRequest) (*ListKubernetesVersionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListKubernetesVersions not implemented")
+}
+func (UnimplementedSpawnerServiceServer) UpgradeCluster(context.Context, *UpgradeClusterRequest) (*UpgradeClusterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpgradeCluster not implemented")
+}
+func (UnimplementedSpawnerServiceServer) ListMachineTypes(context.Context, *ListMachineTypesRequest) (*ListMachineTypesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMachineTypes not implemented")
+}
+func (UnimplementedSpawnerServiceServer) EstimateCost(context.Context, *EstimateCostRequest) (*EstimateCostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EstimateCost not implemented")
+}
+func (UnimplementedSpawnerServiceServer) ListVolumes(context.Context, *ListVolumesRequest) (*ListVolumesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListVolumes not implemented")
+}
+func (UnimplementedSpawnerServiceServer) GetVolume(context.Context, *GetVolumeRequest) (*Volume, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetVolume not implemented")
+}
+func (UnimplementedSpawnerServiceServer) ResizeVolume(context.Context, *ResizeVolumeRequest) (*ResizeVolumeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResizeVolume not implemented")
+}
+func (UnimplementedSpawnerServiceServer) AttachVolume(context.Context, *AttachVolumeRequest) (*AttachVolumeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AttachVolume not implemented")
+}
+func (UnimplementedSpawnerServiceServer) DetachVolume(context.Context, *DetachVolumeRequest) (*DetachVolumeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DetachVolume not implemented")
+}
+func (UnimplementedSpawnerServiceServer) ListSnapshots(context.Context, *ListSnapshotsRequest) (*ListSnapshotsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSnapshots not implemented")
+}
+func (UnimplementedSpawnerServiceServer) DeleteSnapshot(context.Context, *DeleteSnapshotRequest) (*DeleteSnapshotResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteSnapshot not implemented")
+}
+func (UnimplementedSpawnerServiceServer) RestoreSnapshot(context.Context, *RestoreSnapshotRequest) (*RestoreSnapshotResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreSnapshot not implemented")
+}
+func (UnimplementedSpawnerServiceServer) CopySnapshot(context.Context, *CopySnapshotRequest) (*CopySnapshotResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CopySnapshot not implemented")
+}
+func (UnimplementedSpawnerServiceServer) CreateSnapshotPolicy(context.Context, *CreateSnapshotPolicyRequest) (*SnapshotPolicy, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSnapshotPolicy not implemented")
+}
+func (UnimplementedSpawnerServiceServer) DeleteSnapshotPolicy(context.Context, *DeleteSnapshotPolicyRequest) (*DeleteSnapshotPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteSnapshotPolicy not implemented")
+}
+func (UnimplementedSpawnerServiceServer) ListSnapshotPolicies(context.Context, *ListSnapshotPoliciesRequest) (*ListSnapshotPoliciesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSnapshotPolicies not implemented")
+}
+func (UnimplementedSpawnerServiceServer) UpsertDNSRecord(context.Context, *UpsertDNSRecordRequest) (*UpsertDNSRecordResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpsertDNSRecord not implemented")
+}
+func (UnimplementedSpawnerServiceServer) DeleteDNSRecord(context.Context, *DeleteDNSRecordRequest) (*DeleteDNSRecordResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteDNSRecord not implemented")
+}
+func (UnimplementedSpawnerServiceServer) ListDNSRecords(context.Context, *ListDNSRecordsRequest) (*ListDNSRecordsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDNSRecords not implemented")
+}
+func (UnimplementedSpawnerServiceServer) mustEmbedUnimplementedSpawnerServiceServer() {}
+
+// UnsafeSpawnerServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to SpawnerServiceServer will
+// result in compilation errors.
+type UnsafeSpawnerServiceServer interface {
+	mustEmbedUnimplementedSpawnerServiceServer()
+}
+
+func RegisterSpawnerServiceServer(s grpc.ServiceRegistrar, srv SpawnerServiceServer) {
+	s.RegisterService(&SpawnerService_ServiceDesc, srv)
+}
+
+func _SpawnerService_HealthCheck_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SpawnerServiceServer).HealthCheck(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/spawner.SpawnerService/HealthCheck",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SpawnerServiceServer).HealthCheck(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SpawnerService_Echo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EchoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SpawnerServiceServer).Echo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/spawner.SpawnerService/Echo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SpawnerServiceServer).Echo(ctx, req.(*EchoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SpawnerService_CreateCluster_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClusterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SpawnerServiceServer).CreateCluster(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/spawner.SpawnerService/CreateCluster",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SpawnerServiceServer).CreateCluster(ctx, req.(*ClusterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SpawnerService_AddToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SpawnerServiceServer).AddToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/spawner.SpawnerService/AddToken",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SpawnerServiceServer).AddToken(ctx, req.(*AddTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SpawnerService_GetToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SpawnerServiceServer).GetToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/spawner.SpawnerService/GetToken",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SpawnerServiceServer).GetToken(ctx, req.(*GetTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SpawnerService_AddRoute53Record_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddRoute53RecordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SpawnerServiceServer).AddRoute53Record(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/spawner.SpawnerService/AddRoute53Record",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SpawnerServiceServer).AddRoute53Record(ctx, req.(*AddRoute53RecordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SpawnerService_GetCluster_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetClusterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SpawnerServiceServer).GetCluster(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/spawner.SpawnerService/GetCluster",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SpawnerServiceServer).GetCluster(ctx, req.(*GetClusterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SpawnerService_GetClusters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetClustersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SpawnerServiceServer).GetClusters(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/spawner.SpawnerService/GetClusters",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SpawnerServiceServer).GetClusters(ctx, req.(*GetClustersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SpawnerService_AddNode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NodeSpawnRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SpawnerServiceServer).AddNode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/spawner.SpawnerService/AddNode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SpawnerServiceServer).AddNode(ctx, req.(*NodeSpawnRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SpawnerService_ClusterStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClusterStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SpawnerServiceServer).ClusterStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/spawner.SpawnerService/ClusterStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SpawnerServiceServer).ClusterStatus(ctx, req.(*ClusterStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SpawnerService_DeleteCluster_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClusterDeleteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SpawnerServiceServer).DeleteCluster(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/spawner.SpawnerService/DeleteCluster",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SpawnerServiceServer).DeleteCluster(ctx, req.(*ClusterDeleteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SpawnerService_DeleteNode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NodeDeleteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SpawnerServiceServer).DeleteNode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/spawner.SpawnerService/DeleteNode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SpawnerServiceServer).DeleteNode(ctx, req.(*NodeDeleteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SpawnerService_CreateVolume_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateVolumeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SpawnerServiceServer).CreateVolume(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/spawner.SpawnerService/CreateVolume",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SpawnerServiceServer).CreateVolume(ctx, req.(*CreateVolumeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SpawnerService_DeleteVolume_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteVolumeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SpawnerServiceServer).DeleteVolume(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/spawner.SpawnerService/DeleteVolume",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SpawnerServiceServer).DeleteVolume(ctx, req.(*DeleteVolumeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SpawnerService_CreateSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateSnapshotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SpawnerServiceServer).CreateSnapshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/spawner.SpawnerService/CreateSnapshot",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SpawnerServiceServer).CreateSnapshot(ctx, req.(*CreateSnapshotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SpawnerService_CreateSnapshotAndDelete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateSnapshotAndDeleteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SpawnerServiceServer).CreateSnapshotAndDelete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/spawner.SpawnerService/CreateSnapshotAndDelete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SpawnerServiceServer).CreateSnapshotAndDelete(ctx, req.(*CreateSnapshotAndDeleteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SpawnerService_RegisterWithRancher_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RancherRegistrationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SpawnerServiceServer).RegisterWithRancher(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/spawner.SpawnerService/RegisterWithRancher",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SpawnerServiceServer).RegisterWithRancher(ctx, req.(*RancherRegistrationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SpawnerService_GetWorkspacesCost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWorkspacesCostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SpawnerServiceServer).GetWorkspacesCost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/spawner.SpawnerService/GetWorkspacesCost",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SpawnerServiceServer).GetWorkspacesCost(ctx, req.(*GetWorkspacesCostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SpawnerService_WriteCredential_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WriteCredentialRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SpawnerServiceServer).WriteCredential(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/spawner.SpawnerService/WriteCredential",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SpawnerServiceServer).WriteCredential(ctx, req.(*WriteCredentialRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SpawnerService_ReadCredential_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReadCredentialRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SpawnerServiceServer).ReadCredential(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/spawner.SpawnerService/ReadCredential",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SpawnerServiceServer).ReadCredential(ctx, req.(*ReadCredentialRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SpawnerService_GetKubeConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetKubeConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SpawnerServiceServer).GetKubeConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/spawner.SpawnerService/GetKubeConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SpawnerServiceServer).GetKubeConfig(ctx, req.(*GetKubeConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SpawnerService_TagNodeInstance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TagNodeInstanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SpawnerServiceServer).TagNodeInstance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/spawner.SpawnerService/TagNodeInstance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SpawnerServiceServer).TagNodeInstance(ctx, req.(*TagNodeInstanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SpawnerService_ListResources_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListResourcesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SpawnerServiceServer).ListResources(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/spawner.SpawnerService/ListResources",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SpawnerServiceServer).ListResources(ctx, req.(*ListResourcesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SpawnerService_GetOperation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOperationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SpawnerServiceServer).GetOperation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/spawner.SpawnerService/GetOperation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SpawnerServiceServer).GetOperation(ctx, req.(*GetOperationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SpawnerService_ListOperations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListOperationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SpawnerServiceServer).ListOperations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/spawner.SpawnerService/ListOperations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SpawnerServiceServer).ListOperations(ctx, req.(*ListOperationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SpawnerService_CancelOperation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelOperationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SpawnerServiceServer).CancelOperation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/spawner.SpawnerService/CancelOperation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SpawnerServiceServer).CancelOperation(ctx, req.(*CancelOperationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SpawnerService_WatchCluster_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchClusterRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(SpawnerServiceServer).WatchCluster(m, &spawnerServiceWatchClusterServer{stream})
+}
+
+type SpawnerService_WatchClusterServer interface {
+	Send(*ClusterEvent) error
+	grpc.ServerStream
+}
+
+type spawnerServiceWatchClusterServer struct {
+	grpc.ServerStream
+}
+
+func (x *spawnerServiceWatchClusterServer) Send(m *ClusterEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _SpawnerService_WatchNodePool_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchNodePoolRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(SpawnerServiceServer).WatchNodePool(m, &spawnerServiceWatchNodePoolServer{stream})
+}
+
+type SpawnerService_WatchNodePoolServer interface {
+	Send(*NodePoolEvent) error
+	grpc.ServerStream
+}
+
+type spawnerServiceWatchNodePoolServer struct {
+	grpc.ServerStream
+}
+
+func (x *spawnerServiceWatchNodePoolServer) Send(m *NodePoolEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _SpawnerService_QueryAuditLog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAuditLogRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SpawnerServiceServer).QueryAuditLog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/spawner.SpawnerService/QueryAuditLog",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SpawnerServiceServer).QueryAuditLog(ctx, req.(*QueryAuditLogRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SpawnerService_GetQuotaUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetQuotaUsageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SpawnerServiceServer).GetQuotaUsage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/spawner.SpawnerService/GetQuotaUsage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SpawnerServiceServer).GetQuotaUsage(ctx, req.(*GetQuotaUsageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SpawnerService_SetBudget_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetBudgetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SpawnerServiceServer).SetBudget(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/spawner.SpawnerService/SetBudget",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SpawnerServiceServer).SetBudget(ctx, req.(*SetBudgetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SpawnerService_DeleteBudget_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteBudgetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SpawnerServiceServer).DeleteBudget(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/spawner.SpawnerService/DeleteBudget",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SpawnerServiceServer).DeleteBudget(ctx, req.(*DeleteBudgetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SpawnerService_GetBudgetStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBudgetStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SpawnerServiceServer).GetBudgetStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/spawner.SpawnerService/GetBudgetStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SpawnerServiceServer).GetBudgetStatus(ctx, req.(*GetBudgetStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SpawnerService_ApplyClusterSpec_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplyClusterSpecRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SpawnerServiceServer).ApplyClusterSpec(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/spawner.SpawnerService/ApplyClusterSpec",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SpawnerServiceServer).ApplyClusterSpec(ctx, req.(*ApplyClusterSpecRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SpawnerService_GetDrift_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDriftRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SpawnerServiceServer).GetDrift(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/spawner.SpawnerService/GetDrift",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SpawnerServiceServer).GetDrift(ctx, req.(*GetDriftRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SpawnerService_ExtendLease_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExtendLeaseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SpawnerServiceServer).ExtendLease(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/spawner.SpawnerService/ExtendLease",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SpawnerServiceServer).ExtendLease(ctx, req.(*ExtendLeaseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SpawnerService_ListOrphans_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListOrphansRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SpawnerServiceServer).ListOrphans(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/spawner.SpawnerService/ListOrphans",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SpawnerServiceServer).ListOrphans(ctx, req.(*ListOrphansRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SpawnerService_SetNodePoolSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetNodePoolScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SpawnerServiceServer).SetNodePoolSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/spawner.SpawnerService/SetNodePoolSchedule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SpawnerServiceServer).SetNodePoolSchedule(ctx, req.(*SetNodePoolScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SpawnerService_GetNodePoolSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetNodePoolScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SpawnerServiceServer).GetNodePoolSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/spawner.SpawnerService/GetNodePoolSchedule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SpawnerServiceServer).GetNodePoolSchedule(ctx, req.(*GetNodePoolScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SpawnerService_ScaleNodePool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScaleNodePoolRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SpawnerServiceServer).ScaleNodePool(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/spawner.SpawnerService/ScaleNodePool",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SpawnerServiceServer).ScaleNodePool(ctx, req.(*ScaleNodePoolRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SpawnerService_UpdateNodePool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateNodePoolRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SpawnerServiceServer).UpdateNodePool(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/spawner.SpawnerService/UpdateNodePool",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SpawnerServiceServer).UpdateNodePool(ctx, req.(*UpdateNodePoolRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SpawnerService_ListKubernetesVersions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListKubernetesVersionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SpawnerServiceServer).ListKubernetesVersions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/spawner.SpawnerService/ListKubernetesVersions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SpawnerServiceServer).ListKubernetesVersions(ctx, req.(*ListKubernetesVersionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SpawnerService_UpgradeCluster_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpgradeClusterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SpawnerServiceServer).UpgradeCluster(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/spawner.SpawnerService/UpgradeCluster",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SpawnerServiceServer).UpgradeCluster(ctx, req.(*UpgradeClusterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SpawnerService_ListMachineTypes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMachineTypesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SpawnerServiceServer).ListMachineTypes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/spawner.SpawnerService/ListMachineTypes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SpawnerServiceServer).ListMachineTypes(ctx, req.(*ListMachineTypesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SpawnerService_EstimateCost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EstimateCostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SpawnerServiceServer).EstimateCost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/spawner.SpawnerService/EstimateCost",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SpawnerServiceServer).EstimateCost(ctx, req.(*EstimateCostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SpawnerService_ListVolumes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListVolumesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SpawnerServiceServer).ListVolumes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/spawner.SpawnerService/ListVolumes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SpawnerServiceServer).ListVolumes(ctx, req.(*ListVolumesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SpawnerService_GetVolume_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetVolumeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SpawnerServiceServer).GetVolume(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/spawner.SpawnerService/GetVolume",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SpawnerServiceServer).GetVolume(ctx, req.(*GetVolumeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SpawnerService_ResizeVolume_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResizeVolumeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SpawnerServiceServer).ResizeVolume(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/spawner.SpawnerService/ResizeVolume",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SpawnerServiceServer).ResizeVolume(ctx, req.(*ResizeVolumeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SpawnerService_AttachVolume_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AttachVolumeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SpawnerServiceServer).AttachVolume(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/spawner.SpawnerService/AttachVolume",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SpawnerServiceServer).AttachVolume(ctx, req.(*AttachVolumeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SpawnerService_DetachVolume_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DetachVolumeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SpawnerServiceServer).DetachVolume(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/spawner.SpawnerService/DetachVolume",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SpawnerServiceServer).DetachVolume(ctx, req.(*DetachVolumeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SpawnerService_ListSnapshots_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSnapshotsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SpawnerServiceServer).ListSnapshots(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/spawner.SpawnerService/ListSnapshots",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SpawnerServiceServer).ListSnapshots(ctx, req.(*ListSnapshotsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SpawnerService_DeleteSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteSnapshotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SpawnerServiceServer).DeleteSnapshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/spawner.SpawnerService/DeleteSnapshot",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SpawnerServiceServer).DeleteSnapshot(ctx, req.(*DeleteSnapshotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SpawnerService_RestoreSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreSnapshotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SpawnerServiceServer).RestoreSnapshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/spawner.SpawnerService/RestoreSnapshot",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SpawnerServiceServer).RestoreSnapshot(ctx, req.(*RestoreSnapshotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SpawnerService_CopySnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CopySnapshotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SpawnerServiceServer).CopySnapshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/spawner.SpawnerService/CopySnapshot",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SpawnerServiceServer).CopySnapshot(ctx, req.(*CopySnapshotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SpawnerService_CreateSnapshotPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateSnapshotPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SpawnerServiceServer).CreateSnapshotPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/spawner.SpawnerService/CreateSnapshotPolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SpawnerServiceServer).CreateSnapshotPolicy(ctx, req.(*CreateSnapshotPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SpawnerService_DeleteSnapshotPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteSnapshotPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SpawnerServiceServer).DeleteSnapshotPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/spawner.SpawnerService/DeleteSnapshotPolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SpawnerServiceServer).DeleteSnapshotPolicy(ctx, req.(*DeleteSnapshotPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SpawnerService_ListSnapshotPolicies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSnapshotPoliciesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SpawnerServiceServer).ListSnapshotPolicies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/spawner.SpawnerService/ListSnapshotPolicies",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SpawnerServiceServer).ListSnapshotPolicies(ctx, req.(*ListSnapshotPoliciesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SpawnerService_UpsertDNSRecord_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpsertDNSRecordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SpawnerServiceServer).UpsertDNSRecord(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/spawner.SpawnerService/UpsertDNSRecord",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SpawnerServiceServer).UpsertDNSRecord(ctx, req.(*UpsertDNSRecordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SpawnerService_DeleteDNSRecord_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteDNSRecordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SpawnerServiceServer).DeleteDNSRecord(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/spawner.SpawnerService/DeleteDNSRecord",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SpawnerServiceServer).DeleteDNSRecord(ctx, req.(*DeleteDNSRecordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SpawnerService_ListDNSRecords_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDNSRecordsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SpawnerServiceServer).ListDNSRecords(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/spawner.SpawnerService/ListDNSRecords",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SpawnerServiceServer).ListDNSRecords(ctx, req.(*ListDNSRecordsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// SpawnerService_ServiceDesc is the grpc.ServiceDesc for SpawnerService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var SpawnerService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "spawner.SpawnerService",
+	HandlerType: (*SpawnerServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "HealthCheck",
+			Handler:    _SpawnerService_HealthCheck_Handler,
+		},
+		{
+			MethodName: "Echo",
+			Handler:    _SpawnerService_Echo_Handler,
+		},
+		{
+			MethodName: "CreateCluster",
+			Handler:    _SpawnerService_CreateCluster_Handler,
+		},
+		{
+			MethodName: "AddToken",
+			Handler:    _SpawnerService_AddToken_Handler,
+		},
+		{
+			MethodName: "GetToken",
+			Handler:    _SpawnerService_GetToken_Handler,
+		},
+		{
+			MethodName: "AddRoute53Record",
+			Handler:    _SpawnerService_AddRoute53Record_Handler,
+		},
+		{
+			MethodName: "GetCluster",
+			Handler:    _SpawnerService_GetCluster_Handler,
+		},
+		{
+			MethodName: "GetClusters",
+			Handler:    _SpawnerService_GetClusters_Handler,
+		},
+		{
+			MethodName: "AddNode",
+			Handler:    _SpawnerService_AddNode_Handler,
+		},
+		{
+			MethodName: "ClusterStatus",
+			Handler:    _SpawnerService_ClusterStatus_Handler,
+		},
+		{
+			MethodName: "DeleteCluster",
+			Handler:    _SpawnerService_DeleteCluster_Handler,
+		},
+		{
+			MethodName: "DeleteNode",
+			Handler:    _SpawnerService_DeleteNode_Handler,
+		},
+		{
+			MethodName: "CreateVolume",
+			Handler:    _SpawnerService_CreateVolume_Handler,
+		},
+		{
+			MethodName: "DeleteVolume",
+			Handler:    _SpawnerService_DeleteVolume_Handler,
+		},
+		{
+			MethodName: "CreateSnapshot",
+			Handler:    _SpawnerService_CreateSnapshot_Handler,
+		},
+		{
+			MethodName: "CreateSnapshotAndDelete",
+			Handler:    _SpawnerService_CreateSnapshotAndDelete_Handler,
+		},
+		{
+			MethodName: "RegisterWithRancher",
+			Handler:    _SpawnerService_RegisterWithRancher_Handler,
+		},
+		{
+			MethodName: "GetWorkspacesCost",
+			Handler:    _SpawnerService_GetWorkspacesCost_Handler,
+		},
+		{
+			MethodName: "WriteCredential",
+			Handler:    _SpawnerService_WriteCredential_Handler,
+		},
+		{
+			MethodName: "ReadCredential",
+			Handler:    _SpawnerService_ReadCredential_Handler,
+		},
+		{
+			MethodName: "GetKubeConfig",
+			Handler:    _SpawnerService_GetKubeConfig_Handler,
+		},
+		{
+			MethodName: "TagNodeInstance",
+			Handler:    _SpawnerService_TagNodeInstance_Handler,
+		},
+		{
+			MethodName: "ListResources",
+			Handler:    _SpawnerService_ListResources_Handler,
+		},
+		{
+			MethodName: "GetOperation",
+			Handler:    _SpawnerService_GetOperation_Handler,
+		},
+		{
+			MethodName: "ListOperations",
+			Handler:    _SpawnerService_ListOperations_Handler,
+		},
+		{
+			MethodName: "CancelOperation",
+			Handler:    _SpawnerService_CancelOperation_Handler,
+		},
+		{
+			MethodName: "QueryAuditLog",
+			Handler:    _SpawnerService_QueryAuditLog_Handler,
+		},
+		{
+			MethodName: "GetQuotaUsage",
+			Handler:    _SpawnerService_GetQuotaUsage_Handler,
+		},
+		{
+			MethodName: "SetBudget",
+			Handler:    _SpawnerService_SetBudget_Handler,
+		},
+		{
+			MethodName: "DeleteBudget",
+			Handler:    _SpawnerService_DeleteBudget_Handler,
+		},
+		{
+			MethodName: "GetBudgetStatus",
+			Handler:    _SpawnerService_GetBudgetStatus_Handler,
+		},
+		{
+			MethodName: "ApplyClusterSpec",
+			Handler:    _SpawnerService_ApplyClusterSpec_Handler,
+		},
+		{
+			MethodName: "GetDrift",
+			Handler:    _SpawnerService_GetDrift_Handler,
+		},
+		{
+			MethodName: "ExtendLease",
+			Handler:    _SpawnerService_ExtendLease_Handler,
+		},
+		{
+			MethodName: "ListOrphans",
+			Handler:    _SpawnerService_ListOrphans_Handler,
+		},
+		{
+			MethodName: "SetNodePoolSchedule",
+			Handler:    _SpawnerService_SetNodePoolSchedule_Handler,
+		},
+		{
+			MethodName: "GetNodePoolSchedule",
+			Handler:    _SpawnerService_GetNodePoolSchedule_Handler,
+		},
+		{
+			MethodName: "ScaleNodePool",
+			Handler:    _SpawnerService_ScaleNodePool_Handler,
+		},
+		{
+			MethodName: "UpdateNodePool",
+			Handler:    _SpawnerService_UpdateNodePool_Handler,
+		},
+		{
+			MethodName: "ListKubernetesVersions",
+			Handler:    _SpawnerService_ListKubernetesVersions_Handler,
+		},
+		{
+			MethodName: "UpgradeCluster",
+			Handler:    _SpawnerService_UpgradeCluster_Handler,
+		},
+		{
+			MethodName: "ListMachineTypes",
+			Handler:    _SpawnerService_ListMachineTypes_Handler,
+		},
+		{
+			MethodName: "EstimateCost",
+			Handler:    _SpawnerService_EstimateCost_Handler,
+		},
+		{
+			MethodName: "ListVolumes",
+			Handler:    _SpawnerService_ListVolumes_Handler,
+		},
+		{
+			MethodName: "GetVolume",
+			Handler:    _SpawnerService_GetVolume_Handler,
+		},
+		{
+			MethodName: "ResizeVolume",
+			Handler:    _SpawnerService_ResizeVolume_Handler,
+		},
+		{
+			MethodName: "AttachVolume",
+			Handler:    _SpawnerService_AttachVolume_Handler,
+		},
+		{
+			MethodName: "DetachVolume",
+			Handler:    _SpawnerService_DetachVolume_Handler,
+		},
+		{
+			MethodName: "ListSnapshots",
+			Handler:    _SpawnerService_ListSnapshots_Handler,
+		},
+		{
+			MethodName: "DeleteSnapshot",
+			Handler:    _SpawnerService_DeleteSnapshot_Handler,
+		},
+		{
+			MethodName: "RestoreSnapshot",
+			Handler:    _SpawnerService_RestoreSnapshot_Handler,
+		},
+		{
+			MethodName: "CopySnapshot",
+			Handler:    _SpawnerService_CopySnapshot_Handler,
+		},
+		{
+			MethodName: "CreateSnapshotPolicy",
+			Handler:    _SpawnerService_CreateSnapshotPolicy_Handler,
+		},
+		{
+			MethodName: "DeleteSnapshotPolicy",
+			Handler:    _SpawnerService_DeleteSnapshotPolicy_Handler,
+		},
+		{
+			MethodName: "ListSnapshotPolicies",
+			Handler:    _SpawnerService_ListSnapshotPolicies_Handler,
+		},
+		{
+			MethodName: "UpsertDNSRecord",
+			Handler:    _SpawnerService_UpsertDNSRecord_Handler,
+		},
+		{
+			MethodName: "DeleteDNSRecord",
+			Handler:    _SpawnerService_DeleteDNSRecord_Handler,
+		},
+		{
+			MethodName: "ListDNSRecords",
+			Handler:    _SpawnerService_ListDNSRecords_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchCluster",
+			Handler:       _SpawnerService_WatchCluster_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "WatchNodePool",
+			Handler:       _SpawnerService_WatchNodePool_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "proto/netbookai/spawner/spawner.proto",
+}
+
+// ProviderPluginClient is the client API for ProviderPlugin service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ProviderPluginClient interface {
+	Describe(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*PluginDescription, error)
+	CreateCluster(ctx context.Context, in *ClusterRequest, opts ...grpc.CallOption) (*ClusterResponse, error)
+	GetCluster(ctx context.Context, in *GetClusterRequest, opts ...grpc.CallOption) (*ClusterSpec, error)
+	GetClusters(ctx context.Context, in *GetClustersRequest, opts ...grpc.CallOption) (*GetClustersResponse, error)
+	AddToken(ctx context.Context, in *AddTokenRequest, opts ...grpc.CallOption) (*AddTokenResponse, error)
+	GetToken(ctx context.Context, in *GetTokenRequest, opts ...grpc.CallOption) (*GetTokenResponse, error)
+	ClusterStatus(ctx context.Context, in *ClusterStatusRequest, opts ...grpc.CallOption) (*ClusterStatusResponse, error)
+	AddNode(ctx context.Context, in *NodeSpawnRequest, opts ...grpc.CallOption) (*NodeSpawnResponse, error)
+	DeleteCluster(ctx context.Context, in *ClusterDeleteRequest, opts ...grpc.CallOption) (*ClusterDeleteResponse, error)
+	DeleteNode(ctx context.Context, in *NodeDeleteRequest, opts ...grpc.CallOption) (*NodeDeleteResponse, error)
+	CreateVolume(ctx context.Context, in *CreateVolumeRequest, opts ...grpc.CallOption) (*CreateVolumeResponse, error)
+	DeleteVolume(ctx context.Context, in *DeleteVolumeRequest, opts ...grpc.CallOption) (*DeleteVolumeResponse, error)
+	CreateSnapshot(ctx context.Context, in *CreateSnapshotRequest, opts ...grpc.CallOption) (*CreateSnapshotResponse, error)
+	CreateSnapshotAndDelete(ctx context.Context, in *CreateSnapshotAndDeleteRequest, opts ...grpc.CallOption) (*CreateSnapshotAndDeleteResponse, error)
+	GetWorkspacesCost(ctx context.Context, in *GetWorkspacesCostRequest, opts ...grpc.CallOption) (*GetWorkspacesCostResponse, error)
+	GetKubeConfig(ctx context.Context, in *GetKubeConfigRequest, opts ...grpc.CallOption) (*GetKubeConfigResponse, error)
+	TagNodeInstance(ctx context.Context, in *TagNodeInstanceRequest, opts ...grpc.CallOption) (*TagNodeInstanceResponse, error)
+	ScaleNodePool(ctx context.Context, in *ScaleNodePoolRequest, opts ...grpc.CallOption) (*ScaleNodePoolResponse, error)
+	UpdateNodePool(ctx context.Context, in *UpdateNodePoolRequest, opts ...grpc.CallOption) (*UpdateNodePoolResponse, error)
+	ListVolumes(ctx context.Context, in *ListVolumesRequest, opts ...grpc.CallOption) (*ListVolumesResponse, error)
+	ListSnapshots(ctx context.Context, in *ListSnapshotsRequest, opts ...grpc.CallOption) (*ListSnapshotsResponse, error)
+	DeleteSnapshot(ctx context.Context, in *DeleteSnapshotRequest, opts ...grpc.CallOption) (*DeleteSnapshotResponse, error)
+	ListKubernetesVersions(ctx context.Context, in *ListKubernetesVersionsRequest, opts ...grpc.CallOption) (*ListKubernetesVersionsResponse, error)
+	// upgrades the control plane only, node pools are upgraded with
+	// UpdateNodePool
+	UpgradeCluster(ctx context.Context, in *UpgradeClusterRequest, opts ...grpc.CallOption) (*UpgradeClusterResponse, error)
+	GetVolume(ctx context.Context, in *GetVolumeRequest, opts ...grpc.CallOption) (*Volume, error)
+	ResizeVolume(ctx context.Context, in *ResizeVolumeRequest, opts ...grpc.CallOption) (*ResizeVolumeResponse, error)
+	AttachVolume(ctx context.Context, in *AttachVolumeRequest, opts ...grpc.CallOption) (*AttachVolumeResponse, error)
+	DetachVolume(ctx context.Context, in *DetachVolumeRequest, opts ...grpc.CallOption) (*DetachVolumeResponse, error)
+	RestoreSnapshot(ctx context.Context, in *RestoreSnapshotRequest, opts ...grpc.CallOption) (*RestoreSnapshotResponse, error)
+	CopySnapshot(ctx context.Context, in *CopySnapshotRequest, opts ...grpc.CallOption) (*CopySnapshotResponse, error)
+}
+
+type providerPluginClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewProviderPluginClient(cc grpc.ClientConnInterface) ProviderPluginClient {
+	return &providerPluginClient{cc}
+}
+
+func (c *providerPluginClient) Describe(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*PluginDescription, error) {
+	out := new(PluginDescription)
+	err := c.cc.Invoke(ctx, "/spawner.ProviderPlugin/Describe", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *providerPluginClient) CreateCluster(ctx context.Context, in *ClusterRequest, opts ...grpc.CallOption) (*ClusterResponse, error) {
+	out := new(ClusterResponse)
+	err := c.cc.Invoke(ctx, "/spawner.ProviderPlugin/CreateCluster", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *providerPluginClient) GetCluster(ctx context.Context, in *GetClusterRequest, opts ...grpc.CallOption) (*ClusterSpec, error) {
+	out := new(ClusterSpec)
+	err := c.cc.Invoke(ctx, "/spawner.ProviderPlugin/GetCluster", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *providerPluginClient) GetClusters(ctx context.Context, in *GetClustersRequest, opts ...grpc.CallOption) (*GetClustersResponse, error) {
+	out := new(GetClustersResponse)
+	err := c.cc.Invoke(ctx, "/spawner.ProviderPlugin/GetClusters", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *providerPluginClient) AddToken(ctx context.Context, in *AddTokenRequest, opts ...grpc.CallOption) (*AddTokenResponse, error) {
+	out := new(AddTokenResponse)
+	err := c.cc.Invoke(ctx, "/spawner.ProviderPlugin/AddToken", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *providerPluginClient) GetToken(ctx context.Context, in *GetTokenRequest, opts ...grpc.CallOption) (*GetTokenResponse, error) {
+	out := new(GetTokenResponse)
+	err := c.cc.Invoke(ctx, "/spawner.ProviderPlugin/GetToken", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *providerPluginClient) ClusterStatus(ctx context.Context, in *ClusterStatusRequest, opts ...grpc.CallOption) (*ClusterStatusResponse, error) {
+	out := new(ClusterStatusResponse)
+	err := c.cc.Invoke(ctx, "/spawner.ProviderPlugin/ClusterStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *providerPluginClient) AddNode(ctx context.Context, in *NodeSpawnRequest, opts ...grpc.CallOption) (*NodeSpawnResponse, error) {
+	out := new(NodeSpawnResponse)
+	err := c.cc.Invoke(ctx, "/spawner.ProviderPlugin/AddNode", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *providerPluginClient) DeleteCluster(ctx context.Context, in *ClusterDeleteRequest, opts ...grpc.CallOption) (*ClusterDeleteResponse, error) {
+	out := new(ClusterDeleteResponse)
+	err := c.cc.Invoke(ctx, "/spawner.ProviderPlugin/DeleteCluster", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *providerPluginClient) DeleteNode(ctx context.Context, in *NodeDeleteRequest, opts ...grpc.CallOption) (*NodeDeleteResponse, error) {
+	out := new(NodeDeleteResponse)
+	err := c.cc.Invoke(ctx, "/spawner.ProviderPlugin/DeleteNode", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *providerPluginClient) CreateVolume(ctx context.Context, in *CreateVolumeRequest, opts ...grpc.CallOption) (*CreateVolumeResponse, error) {
+	out := new(CreateVolumeResponse)
+	err := c.cc.Invoke(ctx, "/spawner.ProviderPlugin/CreateVolume", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *providerPluginClient) DeleteVolume(ctx context.Context, in *DeleteVolumeRequest, opts ...grpc.CallOption) (*DeleteVolumeResponse, error) {
+	out := new(DeleteVolumeResponse)
+	err := c.cc.Invoke(ctx, "/spawner.ProviderPlugin/DeleteVolume", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *providerPluginClient) CreateSnapshot(ctx context.Context, in *CreateSnapshotRequest, opts ...grpc.CallOption) (*CreateSnapshotResponse, error) {
+	out := new(CreateSnapshotResponse)
+	err := c.cc.Invoke(ctx, "/spawner.ProviderPlugin/CreateSnapshot", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *providerPluginClient) CreateSnapshotAndDelete(ctx context.Context, in *CreateSnapshotAndDeleteRequest, opts ...grpc.CallOption) (*CreateSnapshotAndDeleteResponse, error) {
+	out := new(CreateSnapshotAndDeleteResponse)
+	err := c.cc.Invoke(ctx, "/spawner.ProviderPlugin/CreateSnapshotAndDelete", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *providerPluginClient) GetWorkspacesCost(ctx context.Context, in *GetWorkspacesCostRequest, opts ...grpc.CallOption) (*GetWorkspacesCostResponse, error) {
+	out := new(GetWorkspacesCostResponse)
+	err := c.cc.Invoke(ctx, "/spawner.ProviderPlugin/GetWorkspacesCost", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *providerPluginClient) GetKubeConfig(ctx context.Context, in *GetKubeConfigRequest, opts ...grpc.CallOption) (*GetKubeConfigResponse, error) {
+	out := new(GetKubeConfigResponse)
+	err := c.cc.Invoke(ctx, "/spawner.ProviderPlugin/GetKubeConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *providerPluginClient) TagNodeInstance(ctx context.Context, in *TagNodeInstanceRequest, opts ...grpc.CallOption) (*TagNodeInstanceResponse, error) {
+	out := new(TagNodeInstanceResponse)
+	err := c.cc.Invoke(ctx, "/spawner.ProviderPlugin/TagNodeInstance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *providerPluginClient) ScaleNodePool(ctx context.Context, in *ScaleNodePoolRequest, opts ...grpc.CallOption) (*ScaleNodePoolResponse, error) {
+	out := new(ScaleNodePoolResponse)
+	err := c.cc.Invoke(ctx, "/spawner.ProviderPlugin/ScaleNodePool", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *providerPluginClient) UpdateNodePool(ctx context.Context, in *UpdateNodePoolRequest, opts ...grpc.CallOption) (*UpdateNodePoolResponse, error) {
+	out := new(UpdateNodePoolResponse)
+	err := c.cc.Invoke(ctx, "/spawner.ProviderPlugin/UpdateNodePool", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *providerPluginClient) ListVolumes(ctx context.Context, in *ListVolumesRequest, opts ...grpc.CallOption) (*ListVolumesResponse, error) {
+	out := new(ListVolumesResponse)
+	err := c.cc.Invoke(ctx, "/spawner.ProviderPlugin/ListVolumes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *providerPluginClient) ListSnapshots(ctx context.Context, in *ListSnapshotsRequest, opts ...grpc.CallOption) (*ListSnapshotsResponse, error) {
+	out := new(ListSnapshotsResponse)
+	err := c.cc.Invoke(ctx, "/spawner.ProviderPlugin/ListSnapshots", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *providerPluginClient) DeleteSnapshot(ctx context.Context, in *DeleteSnapshotRequest, opts ...grpc.CallOption) (*DeleteSnapshotResponse, error) {
+	out := new(DeleteSnapshotResponse)
+	err := c.cc.Invoke(ctx, "/spawner.ProviderPlugin/DeleteSnapshot", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *providerPluginClient) ListKubernetesVersions(ctx context.Context, in *ListKubernetesVersionsRequest, opts ...grpc.CallOption) (*ListKubernetesVersionsResponse, error) {
+	out := new(ListKubernetesVersionsResponse)
+	err := c.cc.Invoke(ctx, "/spawner.ProviderPlugin/ListKubernetesVersions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *providerPluginClient) UpgradeCluster(ctx context.Context, in *UpgradeClusterRequest, opts ...grpc.CallOption) (*UpgradeClusterResponse, error) {
+	out := new(UpgradeClusterResponse)
+	err := c.cc.Invoke(ctx, "/spawner.ProviderPlugin/UpgradeCluster", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *providerPluginClient) GetVolume(ctx context.Context, in *GetVolumeRequest, opts ...grpc.CallOption) (*Volume, error) {
+	out := new(Volume)
+	err := c.cc.Invoke(ctx, "/spawner.ProviderPlugin/GetVolume", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *providerPluginClient) ResizeVolume(ctx context.Context, in *ResizeVolumeRequest, opts ...grpc.CallOption) (*ResizeVolumeResponse, error) {
+	out := new(ResizeVolumeResponse)
+	err := c.cc.Invoke(ctx, "/spawner.ProviderPlugin/ResizeVolume", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *providerPluginClient) AttachVolume(ctx context.Context, in *AttachVolumeRequest, opts ...grpc.CallOption) (*AttachVolumeResponse, error) {
+	out := new(AttachVolumeResponse)
+	err := c.cc.Invoke(ctx, "/spawner.ProviderPlugin/AttachVolume", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *providerPluginClient) DetachVolume(ctx context.Context, in *DetachVolumeRequest, opts ...grpc.CallOption) (*DetachVolumeResponse, error) {
+	out := new(DetachVolumeResponse)
+	err := c.cc.Invoke(ctx, "/spawner.ProviderPlugin/DetachVolume", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *providerPluginClient) RestoreSnapshot(ctx context.Context, in *RestoreSnapshotRequest, opts ...grpc.CallOption) (*RestoreSnapshotResponse, error) {
+	out := new(RestoreSnapshotResponse)
+	err := c.cc.Invoke(ctx, "/spawner.ProviderPlugin/RestoreSnapshot", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *providerPluginClient) CopySnapshot(ctx context.Context, in *CopySnapshotRequest, opts ...grpc.CallOption) (*CopySnapshotResponse, error) {
+	out := new(CopySnapshotResponse)
+	err := c.cc.Invoke(ctx, "/spawner.ProviderPlugin/CopySnapshot", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ProviderPluginServer is the server API for ProviderPlugin service.
+// All implementations must embed UnimplementedProviderPluginServer
+// for forward compatibility
+type ProviderPluginServer interface {
+	Describe(context.Context, *Empty) (*PluginDescription, error)
+	CreateCluster(context.Context, *ClusterRequest) (*ClusterResponse, error)
+	GetCluster(context.Context, *GetClusterRequest) (*ClusterSpec, error)
+	GetClusters(context.Context, *GetClustersRequest) (*GetClustersResponse, error)
+	AddToken(context.Context, *AddTokenRequest) (*AddTokenResponse, error)
+	GetToken(context.Context, *GetTokenRequest) (*GetTokenResponse, error)
+	ClusterStatus(context.Context, *ClusterStatusRequest) (*ClusterStatusResponse, error)
+	AddNode(context.Context, *NodeSpawnRequest) (*NodeSpawnResponse, error)
+	DeleteCluster(context.Context, *ClusterDeleteRequest) (*ClusterDeleteResponse, error)
+	DeleteNode(context.Context, *NodeDeleteRequest) (*NodeDeleteResponse, error)
+	CreateVolume(context.Context, *CreateVolumeRequest) (*CreateVolumeResponse, error)
+	DeleteVolume(context.Context, *DeleteVolumeRequest) (*DeleteVolumeResponse, error)
+	CreateSnapshot(context.Context, *CreateSnapshotRequest) (*CreateSnapshotResponse, error)
+	CreateSnapshotAndDelete(context.Context, *CreateSnapshotAndDeleteRequest) (*CreateSnapshotAndDeleteResponse, error)
+	GetWorkspacesCost(context.Context, *GetWorkspacesCostRequest) (*GetWorkspacesCostResponse, error)
+	GetKubeConfig(context.Context, *GetKubeConfigRequest) (*GetKubeConfigResponse, error)
+	TagNodeInstance(context.Context, *TagNodeInstanceRequest) (*TagNodeInstanceResponse, error)
+	ScaleNodePool(context.Context, *ScaleNodePoolRequest) (*ScaleNodePoolResponse, error)
+	UpdateNodePool(context.Context, *UpdateNodePoolRequest) (*UpdateNodePoolResponse, error)
+	ListVolumes(context.Context, *ListVolumesRequest) (*ListVolumesResponse, error)
+	ListSnapshots(context.Context, *ListSnapshotsRequest) (*ListSnapshotsResponse, error)
+	DeleteSnapshot(context.Context, *DeleteSnapshotRequest) (*DeleteSnapshotResponse, error)
+	ListKubernetesVersions(context.Context, *ListKubernetesVersionsRequest) (*ListKubernetesVersionsResponse, error)
+	// upgrades the control plane only, node pools are upgraded with
+	// UpdateNodePool
+	UpgradeCluster(context.Context, *UpgradeClusterRequest) (*UpgradeClusterResponse, error)
+	GetVolume(context.Context, *GetVolumeRequest) (*Volume, error)
+	ResizeVolume(context.Context, *ResizeVolumeRequest) (*ResizeVolumeResponse, error)
+	AttachVolume(context.Context, *AttachVolumeRequest) (*AttachVolumeResponse, error)
+	DetachVolume(context.Context, *DetachVolumeRequest) (*DetachVolumeResponse, error)
+	RestoreSnapshot(context.Context, *RestoreSnapshotRequest) (*RestoreSnapshotResponse, error)
+	CopySnapshot(context.Context, *CopySnapshotRequest) (*CopySnapshotResponse, error)
+	mustEmbedUnimplementedProviderPluginServer()
+}
+
+// UnimplementedProviderPluginServer must be embedded to have forward compatible implementations.
+type UnimplementedProviderPluginServer struct {
+}
+
+func (UnimplementedProviderPluginServer) Describe(context.Context, *Empty) (*PluginDescription, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Describe not implemented")
+}
+func (UnimplementedProviderPluginServer) CreateCluster(context.Context, *ClusterRequest) (*ClusterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCluster not implemented")
+}
+func (UnimplementedProviderPluginServer) GetCluster(context.Context, *GetClusterRequest) (*ClusterSpec, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCluster not implemented")
+}
+func (UnimplementedProviderPluginServer) GetClusters(context.Context, *GetClustersRequest) (*GetClustersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetClusters not implemented")
+}
+func (UnimplementedProviderPluginServer) AddToken(context.Context, *AddTokenRequest) (*AddTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddToken not implemented")
+}
+func (UnimplementedProviderPluginServer) GetToken(context.Context, *GetTokenRequest) (*GetTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetToken not implemented")
+}
+func (UnimplementedProviderPluginServer) ClusterStatus(context.Context, *ClusterStatusRequest) (*ClusterStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClusterStatus not implemented")
+}
+func (UnimplementedProviderPluginServer) AddNode(context.Context, *NodeSpawnRequest) (*NodeSpawnResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddNode not implemented")
+}
+func (UnimplementedProviderPluginServer) DeleteCluster(context.Context, *ClusterDeleteRequest) (*ClusterDeleteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteCluster not implemented")
+}
+func (UnimplementedProviderPluginServer) DeleteNode(context.Context, *NodeDeleteRequest) (*NodeDeleteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteNode not implemented")
+}
+func (UnimplementedProviderPluginServer) CreateVolume(context.Context, *CreateVolumeRequest) (*CreateVolumeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateVolume not implemented")
+}
+func (UnimplementedProviderPluginServer) DeleteVolume(context.Context, *DeleteVolumeRequest) (*DeleteVolumeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteVolume not implemented")
+}
+func (UnimplementedProviderPluginServer) CreateSnapshot(context.Context, *CreateSnapshotRequest) (*CreateSnapshotResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSnapshot not implemented")
+}
+func (UnimplementedProviderPluginServer) CreateSnapshotAndDelete(context.Context, *CreateSnapshotAndDeleteRequest) (*CreateSnapshotAndDeleteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSnapshotAndDelete not implemented")
+}
+func (UnimplementedProviderPluginServer) GetWorkspacesCost(context.Context, *GetWorkspacesCostRequest) (*GetWorkspacesCostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWorkspacesCost not implemented")
+}
+func (UnimplementedProviderPluginServer) GetKubeConfig(context.Context, *GetKubeConfigRequest) (*GetKubeConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetKubeConfig not implemented")
+}
+func (UnimplementedProviderPluginServer) TagNodeInstance(context.Context, *TagNodeInstanceRequest) (*TagNodeInstanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TagNodeInstance not implemented")
+}
+func (UnimplementedProviderPluginServer) ScaleNodePool(context.Context, *ScaleNodePoolRequest) (*ScaleNodePoolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScaleNodePool not implemented")
+}
+func (UnimplementedProviderPluginServer) UpdateNodePool(context.Context, *UpdateNodePoolRequest) (*UpdateNodePoolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateNodePool not implemented")
+}
+func (UnimplementedProviderPluginServer) ListVolumes(context.Context, *ListVolumesRequest) (*ListVolumesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListVolumes not implemented")
+}
+func (UnimplementedProviderPluginServer) ListSnapshots(context.Context, *ListSnapshotsRequest) (*ListSnapshotsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSnapshots not implemented")
+}
+func (UnimplementedProviderPluginServer) DeleteSnapshot(context.Context, *DeleteSnapshotRequest) (*DeleteSnapshotResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteSnapshot not implemented")
+}
+func (UnimplementedProviderPluginServer) ListKubernetesVersions(context.Context, *ListKubernetesVersionsRequest) (*ListKubernetesVersionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListKubernetesVersions not implemented")
+}
+func (UnimplementedProviderPluginServer) UpgradeCluster(context.Context, *UpgradeClusterRequest) (*UpgradeClusterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpgradeCluster not implemented")
+}
+func (UnimplementedProviderPluginServer) GetVolume(context.Context, *GetVolumeRequest) (*Volume, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetVolume not implemented")
+}
+func (UnimplementedProviderPluginServer) ResizeVolume(context.Context, *ResizeVolumeRequest) (*ResizeVolumeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResizeVolume not implemented")
+}
+func (UnimplementedProviderPluginServer) AttachVolume(context.Context, *AttachVolumeRequest) (*AttachVolumeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AttachVolume not implemented")
+}
+func (UnimplementedProviderPluginServer) DetachVolume(context.Context, *DetachVolumeRequest) (*DetachVolumeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DetachVolume not implemented")
+}
+func (UnimplementedProviderPluginServer) RestoreSnapshot(context.Context, *RestoreSnapshotRequest) (*RestoreSnapshotResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreSnapshot not implemented")
+}
+func (UnimplementedProviderPluginServer) CopySnapshot(context.Context, *CopySnapshotRequest) (*CopySnapshotResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CopySnapshot not implemented")
+}
+func (UnimplementedProviderPluginServer) mustEmbedUnimplementedProviderPluginServer() {}
+
+// UnsafeProviderPluginServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ProviderPluginServer will
+// result in compilation errors.
+type UnsafeProviderPluginServer interface {
+	mustEmbedUnimplementedProviderPluginServer()
+}
+
+func RegisterProviderPluginServer(s grpc.ServiceRegistrar, srv ProviderPluginServer) {
+	s.RegisterService(&ProviderPlugin_ServiceDesc, srv)
+}
+
+func _ProviderPlugin_Describe_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProviderPluginServer).Describe(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/spawner.ProviderPlugin/Describe",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProviderPluginServer).Describe(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProviderPlugin_CreateCluster_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClusterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProviderPluginServer).CreateCluster(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/spawner.ProviderPlugin/CreateCluster",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProviderPluginServer).CreateCluster(ctx, req.(*ClusterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProviderPlugin_GetCluster_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetClusterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProviderPluginServer).GetCluster(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/spawner.ProviderPlugin/GetCluster",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProviderPluginServer).GetCluster(ctx, req.(*GetClusterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProviderPlugin_GetClusters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetClustersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProviderPluginServer).GetClusters(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/spawner.ProviderPlugin/GetClusters",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProviderPluginServer).GetClusters(ctx, req.(*GetClustersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProviderPlugin_AddToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProviderPluginServer).AddToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/spawner.ProviderPlugin/AddToken",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProviderPluginServer).AddToken(ctx, req.(*AddTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedSpawnerServiceServer) TagNodeInstance(context.Context, *TagNodeInstanceRequest) (*TagNodeInstanceResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method TagNodeInstance not implemented")
+
+func _ProviderPlugin_GetToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProviderPluginServer).GetToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/spawner.ProviderPlugin/GetToken",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProviderPluginServer).GetToken(ctx, req.(*GetTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedSpawnerServiceServer) mustEmbedUnimplementedSpawnerServiceServer() {}
 
-// UnsafeSpawnerServiceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to SpawnerServiceServer will
-// result in compilation errors.
-type UnsafeSpawnerServiceServer interface {
-	mustEmbedUnimplementedSpawnerServiceServer()
+func _ProviderPlugin_ClusterStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClusterStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProviderPluginServer).ClusterStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/spawner.ProviderPlugin/ClusterStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProviderPluginServer).ClusterStatus(ctx, req.(*ClusterStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterSpawnerServiceServer(s grpc.ServiceRegistrar, srv SpawnerServiceServer) {
-	s.RegisterService(&SpawnerService_ServiceDesc, srv)
+func _ProviderPlugin_AddNode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NodeSpawnRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProviderPluginServer).AddNode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/spawner.ProviderPlugin/AddNode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProviderPluginServer).AddNode(ctx, req.(*NodeSpawnRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _SpawnerService_HealthCheck_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Empty)
+func _ProviderPlugin_DeleteCluster_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClusterDeleteRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SpawnerServiceServer).HealthCheck(ctx, in)
+		return srv.(ProviderPluginServer).DeleteCluster(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/spawner.SpawnerService/HealthCheck",
+		FullMethod: "/spawner.ProviderPlugin/DeleteCluster",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SpawnerServiceServer).HealthCheck(ctx, req.(*Empty))
+		return srv.(ProviderPluginServer).DeleteCluster(ctx, req.(*ClusterDeleteRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SpawnerService_Echo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(EchoRequest)
+func _ProviderPlugin_DeleteNode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NodeDeleteRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SpawnerServiceServer).Echo(ctx, in)
+		return srv.(ProviderPluginServer).DeleteNode(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/spawner.SpawnerService/Echo",
+		FullMethod: "/spawner.ProviderPlugin/DeleteNode",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SpawnerServiceServer).Echo(ctx, req.(*EchoRequest))
+		return srv.(ProviderPluginServer).DeleteNode(ctx, req.(*NodeDeleteRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SpawnerService_CreateCluster_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ClusterRequest)
+func _ProviderPlugin_CreateVolume_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateVolumeRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SpawnerServiceServer).CreateCluster(ctx, in)
+		return srv.(ProviderPluginServer).CreateVolume(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/spawner.SpawnerService/CreateCluster",
+		FullMethod: "/spawner.ProviderPlugin/CreateVolume",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SpawnerServiceServer).CreateCluster(ctx, req.(*ClusterRequest))
+		return srv.(ProviderPluginServer).CreateVolume(ctx, req.(*CreateVolumeRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SpawnerService_AddToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AddTokenRequest)
+func _ProviderPlugin_DeleteVolume_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteVolumeRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SpawnerServiceServer).AddToken(ctx, in)
+		return srv.(ProviderPluginServer).DeleteVolume(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/spawner.SpawnerService/AddToken",
+		FullMethod: "/spawner.ProviderPlugin/DeleteVolume",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SpawnerServiceServer).AddToken(ctx, req.(*AddTokenRequest))
+		return srv.(ProviderPluginServer).DeleteVolume(ctx, req.(*DeleteVolumeRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SpawnerService_GetToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetTokenRequest)
+func _ProviderPlugin_CreateSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateSnapshotRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SpawnerServiceServer).GetToken(ctx, in)
+		return srv.(ProviderPluginServer).CreateSnapshot(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/spawner.SpawnerService/GetToken",
+		FullMethod: "/spawner.ProviderPlugin/CreateSnapshot",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SpawnerServiceServer).GetToken(ctx, req.(*GetTokenRequest))
+		return srv.(ProviderPluginServer).CreateSnapshot(ctx, req.(*CreateSnapshotRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SpawnerService_AddRoute53Record_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AddRoute53RecordRequest)
+func _ProviderPlugin_CreateSnapshotAndDelete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateSnapshotAndDeleteRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SpawnerServiceServer).AddRoute53Record(ctx, in)
+		return srv.(ProviderPluginServer).CreateSnapshotAndDelete(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/spawner.SpawnerService/AddRoute53Record",
+		FullMethod: "/spawner.ProviderPlugin/CreateSnapshotAndDelete",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SpawnerServiceServer).AddRoute53Record(ctx, req.(*AddRoute53RecordRequest))
+		return srv.(ProviderPluginServer).CreateSnapshotAndDelete(ctx, req.(*CreateSnapshotAndDeleteRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SpawnerService_GetCluster_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetClusterRequest)
+func _ProviderPlugin_GetWorkspacesCost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWorkspacesCostRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SpawnerServiceServer).GetCluster(ctx, in)
+		return srv.(ProviderPluginServer).GetWorkspacesCost(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/spawner.SpawnerService/GetCluster",
+		FullMethod: "/spawner.ProviderPlugin/GetWorkspacesCost",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SpawnerServiceServer).GetCluster(ctx, req.(*GetClusterRequest))
+		return srv.(ProviderPluginServer).GetWorkspacesCost(ctx, req.(*GetWorkspacesCostRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SpawnerService_GetClusters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetClustersRequest)
+func _ProviderPlugin_GetKubeConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetKubeConfigRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SpawnerServiceServer).GetClusters(ctx, in)
+		return srv.(ProviderPluginServer).GetKubeConfig(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/spawner.SpawnerService/GetClusters",
+		FullMethod: "/spawner.ProviderPlugin/GetKubeConfig",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SpawnerServiceServer).GetClusters(ctx, req.(*GetClustersRequest))
+		return srv.(ProviderPluginServer).GetKubeConfig(ctx, req.(*GetKubeConfigRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SpawnerService_AddNode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(NodeSpawnRequest)
+func _ProviderPlugin_TagNodeInstance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TagNodeInstanceRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SpawnerServiceServer).AddNode(ctx, in)
+		return srv.(ProviderPluginServer).TagNodeInstance(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/spawner.SpawnerService/AddNode",
+		FullMethod: "/spawner.ProviderPlugin/TagNodeInstance",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SpawnerServiceServer).AddNode(ctx, req.(*NodeSpawnRequest))
+		return srv.(ProviderPluginServer).TagNodeInstance(ctx, req.(*TagNodeInstanceRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SpawnerService_ClusterStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ClusterStatusRequest)
+func _ProviderPlugin_ScaleNodePool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScaleNodePoolRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SpawnerServiceServer).ClusterStatus(ctx, in)
+		return srv.(ProviderPluginServer).ScaleNodePool(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/spawner.SpawnerService/ClusterStatus",
+		FullMethod: "/spawner.ProviderPlugin/ScaleNodePool",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SpawnerServiceServer).ClusterStatus(ctx, req.(*ClusterStatusRequest))
+		return srv.(ProviderPluginServer).ScaleNodePool(ctx, req.(*ScaleNodePoolRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SpawnerService_DeleteCluster_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ClusterDeleteRequest)
+func _ProviderPlugin_UpdateNodePool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateNodePoolRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SpawnerServiceServer).DeleteCluster(ctx, in)
+		return srv.(ProviderPluginServer).UpdateNodePool(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/spawner.SpawnerService/DeleteCluster",
+		FullMethod: "/spawner.ProviderPlugin/UpdateNodePool",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SpawnerServiceServer).DeleteCluster(ctx, req.(*ClusterDeleteRequest))
+		return srv.(ProviderPluginServer).UpdateNodePool(ctx, req.(*UpdateNodePoolRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SpawnerService_DeleteNode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(NodeDeleteRequest)
+func _ProviderPlugin_ListVolumes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListVolumesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SpawnerServiceServer).DeleteNode(ctx, in)
+		return srv.(ProviderPluginServer).ListVolumes(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/spawner.SpawnerService/DeleteNode",
+		FullMethod: "/spawner.ProviderPlugin/ListVolumes",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SpawnerServiceServer).DeleteNode(ctx, req.(*NodeDeleteRequest))
+		return srv.(ProviderPluginServer).ListVolumes(ctx, req.(*ListVolumesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SpawnerService_CreateVolume_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateVolumeRequest)
+func _ProviderPlugin_ListSnapshots_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSnapshotsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SpawnerServiceServer).CreateVolume(ctx, in)
+		return srv.(ProviderPluginServer).ListSnapshots(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/spawner.SpawnerService/CreateVolume",
+		FullMethod: "/spawner.ProviderPlugin/ListSnapshots",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SpawnerServiceServer).CreateVolume(ctx, req.(*CreateVolumeRequest))
+		return srv.(ProviderPluginServer).ListSnapshots(ctx, req.(*ListSnapshotsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SpawnerService_DeleteVolume_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteVolumeRequest)
+func _ProviderPlugin_DeleteSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteSnapshotRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SpawnerServiceServer).DeleteVolume(ctx, in)
+		return srv.(ProviderPluginServer).DeleteSnapshot(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/spawner.SpawnerService/DeleteVolume",
+		FullMethod: "/spawner.ProviderPlugin/DeleteSnapshot",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SpawnerServiceServer).DeleteVolume(ctx, req.(*DeleteVolumeRequest))
+		return srv.(ProviderPluginServer).DeleteSnapshot(ctx, req.(*DeleteSnapshotRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SpawnerService_CreateSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateSnapshotRequest)
+func _ProviderPlugin_ListKubernetesVersions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListKubernetesVersionsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SpawnerServiceServer).CreateSnapshot(ctx, in)
+		return srv.(ProviderPluginServer).ListKubernetesVersions(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/spawner.SpawnerService/CreateSnapshot",
+		FullMethod: "/spawner.ProviderPlugin/ListKubernetesVersions",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SpawnerServiceServer).CreateSnapshot(ctx, req.(*CreateSnapshotRequest))
+		return srv.(ProviderPluginServer).ListKubernetesVersions(ctx, req.(*ListKubernetesVersionsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SpawnerService_CreateSnapshotAndDelete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateSnapshotAndDeleteRequest)
+func _ProviderPlugin_UpgradeCluster_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpgradeClusterRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SpawnerServiceServer).CreateSnapshotAndDelete(ctx, in)
+		return srv.(ProviderPluginServer).UpgradeCluster(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/spawner.SpawnerService/CreateSnapshotAndDelete",
+		FullMethod: "/spawner.ProviderPlugin/UpgradeCluster",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SpawnerServiceServer).CreateSnapshotAndDelete(ctx, req.(*CreateSnapshotAndDeleteRequest))
+		return srv.(ProviderPluginServer).UpgradeCluster(ctx, req.(*UpgradeClusterRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SpawnerService_RegisterWithRancher_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RancherRegistrationRequest)
+func _ProviderPlugin_GetVolume_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetVolumeRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SpawnerServiceServer).RegisterWithRancher(ctx, in)
+		return srv.(ProviderPluginServer).GetVolume(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/spawner.SpawnerService/RegisterWithRancher",
+		FullMethod: "/spawner.ProviderPlugin/GetVolume",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SpawnerServiceServer).RegisterWithRancher(ctx, req.(*RancherRegistrationRequest))
+		return srv.(ProviderPluginServer).GetVolume(ctx, req.(*GetVolumeRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SpawnerService_GetWorkspacesCost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetWorkspacesCostRequest)
+func _ProviderPlugin_ResizeVolume_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResizeVolumeRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SpawnerServiceServer).GetWorkspacesCost(ctx, in)
+		return srv.(ProviderPluginServer).ResizeVolume(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/spawner.SpawnerService/GetWorkspacesCost",
+		FullMethod: "/spawner.ProviderPlugin/ResizeVolume",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SpawnerServiceServer).GetWorkspacesCost(ctx, req.(*GetWorkspacesCostRequest))
+		return srv.(ProviderPluginServer).ResizeVolume(ctx, req.(*ResizeVolumeRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SpawnerService_WriteCredential_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(WriteCredentialRequest)
+func _ProviderPlugin_AttachVolume_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AttachVolumeRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SpawnerServiceServer).WriteCredential(ctx, in)
+		return srv.(ProviderPluginServer).AttachVolume(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/spawner.SpawnerService/WriteCredential",
+		FullMethod: "/spawner.ProviderPlugin/AttachVolume",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SpawnerServiceServer).WriteCredential(ctx, req.(*WriteCredentialRequest))
+		return srv.(ProviderPluginServer).AttachVolume(ctx, req.(*AttachVolumeRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SpawnerService_ReadCredential_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ReadCredentialRequest)
+func _ProviderPlugin_DetachVolume_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DetachVolumeRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SpawnerServiceServer).ReadCredential(ctx, in)
+		return srv.(ProviderPluginServer).DetachVolume(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/spawner.SpawnerService/ReadCredential",
+		FullMethod: "/spawner.ProviderPlugin/DetachVolume",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SpawnerServiceServer).ReadCredential(ctx, req.(*ReadCredentialRequest))
+		return srv.(ProviderPluginServer).DetachVolume(ctx, req.(*DetachVolumeRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SpawnerService_GetKubeConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetKubeConfigRequest)
+func _ProviderPlugin_RestoreSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreSnapshotRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SpawnerServiceServer).GetKubeConfig(ctx, in)
+		return srv.(ProviderPluginServer).RestoreSnapshot(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/spawner.SpawnerService/GetKubeConfig",
+		FullMethod: "/spawner.ProviderPlugin/RestoreSnapshot",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SpawnerServiceServer).GetKubeConfig(ctx, req.(*GetKubeConfigRequest))
+		return srv.(ProviderPluginServer).RestoreSnapshot(ctx, req.(*RestoreSnapshotRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SpawnerService_TagNodeInstance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(TagNodeInstanceRequest)
+func _ProviderPlugin_CopySnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CopySnapshotRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SpawnerServiceServer).TagNodeInstance(ctx, in)
+		return srv.(ProviderPluginServer).CopySnapshot(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/spawner.SpawnerService/TagNodeInstance",
+		FullMethod: "/spawner.ProviderPlugin/CopySnapshot",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SpawnerServiceServer).TagNodeInstance(ctx, req.(*TagNodeInstanceRequest))
+		return srv.(ProviderPluginServer).CopySnapshot(ctx, req.(*CopySnapshotRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-// SpawnerService_ServiceDesc is the grpc.ServiceDesc for SpawnerService service.
+// ProviderPlugin_ServiceDesc is the grpc.ServiceDesc for ProviderPlugin service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
-var SpawnerService_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "spawner.SpawnerService",
-	HandlerType: (*SpawnerServiceServer)(nil),
+var ProviderPlugin_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "spawner.ProviderPlugin",
+	HandlerType: (*ProviderPluginServer)(nil),
 	Methods: []grpc.MethodDesc{
 		{
-			MethodName: "HealthCheck",
-			Handler:    _SpawnerService_HealthCheck_Handler,
-		},
-		{
-			MethodName: "Echo",
-			Handler:    _SpawnerService_Echo_Handler,
+			MethodName: "Describe",
+			Handler:    _ProviderPlugin_Describe_Handler,
 		},
 		{
 			MethodName: "CreateCluster",
-			Handler:    _SpawnerService_CreateCluster_Handler,
+			Handler:    _ProviderPlugin_CreateCluster_Handler,
 		},
 		{
-			MethodName: "AddToken",
-			Handler:    _SpawnerService_AddToken_Handler,
+			MethodName: "GetCluster",
+			Handler:    _ProviderPlugin_GetCluster_Handler,
 		},
 		{
-			MethodName: "GetToken",
-			Handler:    _SpawnerService_GetToken_Handler,
+			MethodName: "GetClusters",
+			Handler:    _ProviderPlugin_GetClusters_Handler,
 		},
 		{
-			MethodName: "AddRoute53Record",
-			Handler:    _SpawnerService_AddRoute53Record_Handler,
+			MethodName: "AddToken",
+			Handler:    _ProviderPlugin_AddToken_Handler,
 		},
 		{
-			MethodName: "GetCluster",
-			Handler:    _SpawnerService_GetCluster_Handler,
+			MethodName: "GetToken",
+			Handler:    _ProviderPlugin_GetToken_Handler,
 		},
 		{
-			MethodName: "GetClusters",
-			Handler:    _SpawnerService_GetClusters_Handler,
+			MethodName: "ClusterStatus",
+			Handler:    _ProviderPlugin_ClusterStatus_Handler,
 		},
 		{
 			MethodName: "AddNode",
-			Handler:    _SpawnerService_AddNode_Handler,
-		},
-		{
-			MethodName: "ClusterStatus",
-			Handler:    _SpawnerService_ClusterStatus_Handler,
+			Handler:    _ProviderPlugin_AddNode_Handler,
 		},
 		{
 			MethodName: "DeleteCluster",
-			Handler:    _SpawnerService_DeleteCluster_Handler,
+			Handler:    _ProviderPlugin_DeleteCluster_Handler,
 		},
 		{
 			MethodName: "DeleteNode",
-			Handler:    _SpawnerService_DeleteNode_Handler,
+			Handler:    _ProviderPlugin_DeleteNode_Handler,
 		},
 		{
 			MethodName: "CreateVolume",
-			Handler:    _SpawnerService_CreateVolume_Handler,
+			Handler:    _ProviderPlugin_CreateVolume_Handler,
 		},
 		{
 			MethodName: "DeleteVolume",
-			Handler:    _SpawnerService_DeleteVolume_Handler,
+			Handler:    _ProviderPlugin_DeleteVolume_Handler,
 		},
 		{
 			MethodName: "CreateSnapshot",
-			Handler:    _SpawnerService_CreateSnapshot_Handler,
+			Handler:    _ProviderPlugin_CreateSnapshot_Handler,
 		},
 		{
 			MethodName: "CreateSnapshotAndDelete",
-			Handler:    _SpawnerService_CreateSnapshotAndDelete_Handler,
+			Handler:    _ProviderPlugin_CreateSnapshotAndDelete_Handler,
 		},
 		{
-			MethodName: "RegisterWithRancher",
-			Handler:    _SpawnerService_RegisterWithRancher_Handler,
+			MethodName: "GetWorkspacesCost",
+			Handler:    _ProviderPlugin_GetWorkspacesCost_Handler,
 		},
 		{
-			MethodName: "GetWorkspacesCost",
-			Handler:    _SpawnerService_GetWorkspacesCost_Handler,
+			MethodName: "GetKubeConfig",
+			Handler:    _ProviderPlugin_GetKubeConfig_Handler,
 		},
 		{
-			MethodName: "WriteCredential",
-			Handler:    _SpawnerService_WriteCredential_Handler,
+			MethodName: "TagNodeInstance",
+			Handler:    _ProviderPlugin_TagNodeInstance_Handler,
 		},
 		{
-			MethodName: "ReadCredential",
-			Handler:    _SpawnerService_ReadCredential_Handler,
+			MethodName: "ScaleNodePool",
+			Handler:    _ProviderPlugin_ScaleNodePool_Handler,
 		},
 		{
-			MethodName: "GetKubeConfig",
-			Handler:    _SpawnerService_GetKubeConfig_Handler,
+			MethodName: "UpdateNodePool",
+			Handler:    _ProviderPlugin_UpdateNodePool_Handler,
 		},
 		{
-			MethodName: "TagNodeInstance",
-			Handler:    _SpawnerService_TagNodeInstance_Handler,
+			MethodName: "ListVolumes",
+			Handler:    _ProviderPlugin_ListVolumes_Handler,
+		},
+		{
+			MethodName: "ListSnapshots",
+			Handler:    _ProviderPlugin_ListSnapshots_Handler,
+		},
+		{
+			MethodName: "DeleteSnapshot",
+			Handler:    _ProviderPlugin_DeleteSnapshot_Handler,
+		},
+		{
+			MethodName: "ListKubernetesVersions",
+			Handler:    _ProviderPlugin_ListKubernetesVersions_Handler,
+		},
+		{
+			MethodName: "UpgradeCluster",
+			Handler:    _ProviderPlugin_UpgradeCluster_Handler,
+		},
+		{
+			MethodName: "GetVolume",
+			Handler:    _ProviderPlugin_GetVolume_Handler,
+		},
+		{
+			MethodName: "ResizeVolume",
+			Handler:    _ProviderPlugin_ResizeVolume_Handler,
+		},
+		{
+			MethodName: "AttachVolume",
+			Handler:    _ProviderPlugin_AttachVolume_Handler,
+		},
+		{
+			MethodName: "DetachVolume",
+			Handler:    _ProviderPlugin_DetachVolume_Handler,
+		},
+		{
+			MethodName: "RestoreSnapshot",
+			Handler:    _ProviderPlugin_RestoreSnapshot_Handler,
+		},
+		{
+			MethodName: "CopySnapshot",
+			Handler:    _ProviderPlugin_CopySnapshot_Handler,
 		},
 	},
 	Streams:  []grpc.StreamDesc{},